@@ -0,0 +1,428 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"gorm.io/gorm"
+)
+
+var ErrAccountTransferSame = errors.New("the transfer must be between different accounts")
+
+// Reconciliation is the result of entering the real balance of an account, Adjustment is nil
+// when the balance already matched
+type Reconciliation struct {
+	Account    domain.Account
+	Adjustment *domain.AccountEntry
+}
+
+type IAccountApp interface {
+	Create(ctx context.Context, account domain.Account) (domain.Account, error)
+	FindByUserId(ctx context.Context, userId uint) ([]domain.Account, error)
+	FindEntries(ctx context.Context, userId, id uint) ([]domain.AccountEntry, error)
+	Delete(ctx context.Context, userId, id uint) error
+	Transfer(ctx context.Context, userId, fromId, toId uint, amount float64, description string) ([]domain.AccountEntry, error)
+	Reconcile(ctx context.Context, userId, id uint, balance float64) (Reconciliation, error)
+	Sync(ctx context.Context, userId, budgetId uint) ([]domain.BudgetAvailable, error)
+	SyncEvent(ctx context.Context, event shared.Event) error
+	PurgeEvent(ctx context.Context, event shared.Event) error
+	DeleteUserEvent(ctx context.Context, event shared.Event) error
+}
+
+type accountApp struct {
+	tm                  persistent.TransactionManager
+	accountRepo         domain.AccountRepository
+	budgetRepo          domain.BudgetRepository
+	budgetAvailableRepo domain.BudgetAvailableRepository
+	logApp              application.ILogApp
+}
+
+func (app *accountApp) Create(ctx context.Context, account domain.Account) (domain.Account, error) {
+	account.ID = 0
+	account.Balance = roundCents(account.Balance)
+	account.CreatedAt = time.Now()
+	err := app.tm.Transaction(func(tx persistent.Transaction) error {
+		accountRepo := app.accountRepo.WithTransaction(tx)
+		id, err := accountRepo.Save(ctx, account)
+		if err != nil {
+			return err
+		}
+
+		account.ID = id
+		if account.Balance != 0 {
+			if _, err := accountRepo.SaveEntry(ctx, domain.AccountEntry{
+				AccountId:   account.ID,
+				Type:        domain.Opening,
+				Amount:      account.Balance,
+				Balance:     account.Balance,
+				Description: "Saldo inicial",
+			}); err != nil {
+				return err
+			}
+		}
+
+		detail := map[string]any{
+			"id":      account.ID,
+			"name":    account.Name,
+			"type":    account.Type,
+			"balance": account.Balance,
+		}
+		return app.logApp.Create(ctx, "Se crea una cuenta", shared.User, account.UserId, detail, tx)
+	})
+	if err != nil {
+		return domain.Account{}, err
+	}
+
+	return account, nil
+}
+
+func (app *accountApp) FindByUserId(ctx context.Context, userId uint) ([]domain.Account, error) {
+	return app.accountRepo.SearchAllByExample(ctx, domain.Account{
+		UserId: userId,
+	})
+}
+
+func (app *accountApp) FindEntries(ctx context.Context, userId, id uint) ([]domain.AccountEntry, error) {
+	account, err := app.findById(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.accountRepo.SearchAllEntries(ctx, account.ID)
+}
+
+func (app *accountApp) Delete(ctx context.Context, userId, id uint) error {
+	account, err := app.findById(ctx, userId, id)
+	if err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		accountRepo := app.accountRepo.WithTransaction(tx)
+		if err := accountRepo.Delete(ctx, account.ID); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"id":   account.ID,
+			"name": account.Name,
+		}
+		return app.logApp.Create(ctx, "Se elimina una cuenta", shared.User, userId, detail, tx)
+	})
+}
+
+// Transfer moves the amount between two accounts of the user recording an entry in each one,
+// both entries point to the other account. The balances are read again with the accounts locked,
+// always in the same order so two opposite transfers don't wait for each other.
+func (app *accountApp) Transfer(ctx context.Context, userId, fromId, toId uint, amount float64, description string) ([]domain.AccountEntry, error) {
+	if fromId == toId {
+		return nil, ErrAccountTransferSame
+	}
+
+	from, err := app.findById(ctx, userId, fromId)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := app.findById(ctx, userId, toId)
+	if err != nil {
+		return nil, err
+	}
+
+	amount = roundCents(amount)
+	if description == "" {
+		description = fmt.Sprintf("Transferencia de %s a %s", from.Name, to.Name)
+	}
+
+	entries := []domain.AccountEntry{
+		{AccountId: from.ID, Type: domain.Transfer, Amount: -amount, Description: description, CounterpartId: &to.ID},
+		{AccountId: to.ID, Type: domain.Transfer, Amount: amount, Description: description, CounterpartId: &from.ID},
+	}
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		accountRepo := app.accountRepo.WithTransaction(tx)
+		lockIds := []uint{from.ID, to.ID}
+		if from.ID > to.ID {
+			lockIds = []uint{to.ID, from.ID}
+		}
+
+		locked := map[uint]domain.Account{}
+		for _, id := range lockIds {
+			account, err := accountRepo.SearchForUpdate(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			locked[id] = account
+		}
+
+		for i, id := range []uint{from.ID, to.ID} {
+			account := locked[id]
+			account.Balance = roundCents(account.Balance + entries[i].Amount)
+			entries[i].Balance = account.Balance
+			entries[i].CreatedAt = time.Now()
+			entryId, err := app.move(ctx, tx, account, entries[i])
+			if err != nil {
+				return err
+			}
+
+			entries[i].ID = entryId
+		}
+
+		descriptionLog := fmt.Sprintf("Se transfiere de la cuenta %s a la cuenta %s", from.Name, to.Name)
+		detail := map[string]any{
+			"fromId":      from.ID,
+			"toId":        to.ID,
+			"amount":      amount,
+			"description": description,
+		}
+		return app.logApp.Create(ctx, descriptionLog, shared.User, userId, detail, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Reconcile sets the real balance of the account recording the difference as an adjustment, the
+// difference is taken from the balance read with the account locked
+func (app *accountApp) Reconcile(ctx context.Context, userId, id uint, balance float64) (Reconciliation, error) {
+	account, err := app.findById(ctx, userId, id)
+	if err != nil {
+		return Reconciliation{}, err
+	}
+
+	balance = roundCents(balance)
+	var adjustment *domain.AccountEntry
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		locked, err := app.accountRepo.WithTransaction(tx).SearchForUpdate(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		account = locked
+		difference := roundCents(balance - account.Balance)
+		if difference == 0 {
+			return nil
+		}
+
+		adjustment = &domain.AccountEntry{
+			AccountId:   account.ID,
+			Type:        domain.Adjustment,
+			Amount:      difference,
+			Balance:     balance,
+			Description: "Conciliación",
+			CreatedAt:   time.Now(),
+		}
+		detail := map[string]any{
+			"id":         account.ID,
+			"before":     account.Balance,
+			"balance":    balance,
+			"adjustment": difference,
+		}
+		account.Balance = balance
+		entryId, err := app.move(ctx, tx, account, *adjustment)
+		if err != nil {
+			return err
+		}
+
+		adjustment.ID = entryId
+		description := fmt.Sprintf("Se concilia la cuenta %s", account.Name)
+		return app.logApp.Create(ctx, description, shared.User, userId, detail, tx)
+	})
+	if err != nil {
+		return Reconciliation{}, err
+	}
+
+	return Reconciliation{
+		Account:    account,
+		Adjustment: adjustment,
+	}, nil
+}
+
+// Sync sets in the budget an available per account with its balance, an available of the budget
+// with the name of an account is taken by the account instead of adding another one.
+func (app *accountApp) Sync(ctx context.Context, userId, budgetId uint) ([]domain.BudgetAvailable, error) {
+	budget, err := app.budgetRepo.Search(ctx, budgetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if *budget.UserId != userId {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return app.sync(ctx, budget)
+}
+
+// SyncEvent adds the availables of the accounts to a new budget, a budget deleted before the
+// event is delivered has nothing to do.
+func (app *accountApp) SyncEvent(ctx context.Context, event shared.Event) error {
+	budget, err := app.budgetRepo.Search(ctx, event.ResourceId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	_, err = app.sync(ctx, budget)
+	return err
+}
+
+func (app *accountApp) sync(ctx context.Context, budget domain.Budget) ([]domain.BudgetAvailable, error) {
+	accounts, err := app.FindByUserId(ctx, *budget.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	availables := []domain.BudgetAvailable{}
+	if len(accounts) == 0 {
+		return availables, nil
+	}
+
+	links, err := app.accountRepo.SearchAllAvailables(ctx, *budget.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	linked := map[uint]uint{}
+	taken := map[uint]bool{}
+	for _, link := range links {
+		linked[link.AccountId] = link.BudgetAvailableId
+		taken[link.BudgetAvailableId] = true
+	}
+
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		accountRepo := app.accountRepo.WithTransaction(tx)
+		budgetAvailableRepo := app.budgetAvailableRepo.WithTransaction(tx)
+		for _, account := range accounts {
+			account := account
+			available := domain.BudgetAvailable{
+				Name:     &account.Name,
+				Amount:   &account.Balance,
+				BudgetId: budget.ID,
+			}
+			availableId, ok := linked[account.ID]
+			if !ok {
+				availableId, ok = availableByName(budget.BudgetAvailables, account.Name, taken)
+			}
+
+			if ok {
+				available.ID = &availableId
+			}
+
+			id, err := budgetAvailableRepo.Save(ctx, available)
+			if err != nil {
+				return err
+			}
+
+			available.ID = &id
+			if _, isLinked := linked[account.ID]; !isLinked {
+				taken[id] = true
+				if err := accountRepo.SaveAvailable(ctx, domain.AccountAvailable{AccountId: account.ID, BudgetAvailableId: id}); err != nil {
+					return err
+				}
+			}
+
+			availables = append(availables, available)
+		}
+
+		detail := map[string]any{
+			"accounts": len(accounts),
+		}
+		if err := app.logApp.Create(ctx, "Se sincronizan los disponibles con las cuentas", shared.Budget, *budget.ID, detail, tx); err != nil {
+			return err
+		}
+
+		return saveTotals(ctx, app.budgetRepo.WithTransaction(tx), *budget.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return availables, nil
+}
+
+// move saves the new balance of the account with its entry and passes the balance to the
+// availables of the account in the budgets of the current month
+func (app *accountApp) move(ctx context.Context, tx persistent.Transaction, account domain.Account, entry domain.AccountEntry) (uint, error) {
+	accountRepo := app.accountRepo.WithTransaction(tx)
+	if _, err := accountRepo.Save(ctx, account); err != nil {
+		return 0, err
+	}
+
+	entryId, err := accountRepo.SaveEntry(ctx, entry)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	availables, err := accountRepo.SearchAvailablesByMonth(ctx, account.ID, uint16(now.Year()), uint8(now.Month()))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(availables) == 0 {
+		return entryId, nil
+	}
+
+	budgetIds := []uint{}
+	for i := range availables {
+		availables[i].Amount = &account.Balance
+		budgetIds = append(budgetIds, *availables[i].BudgetId)
+	}
+
+	if err := app.budgetAvailableRepo.WithTransaction(tx).SaveAll(ctx, availables); err != nil {
+		return 0, err
+	}
+
+	budgetRepo := app.budgetRepo.WithTransaction(tx)
+	for _, budgetId := range budgetIds {
+		if err := saveTotals(ctx, budgetRepo, budgetId); err != nil {
+			return 0, err
+		}
+	}
+
+	return entryId, nil
+}
+
+func (app *accountApp) findById(ctx context.Context, userId, id uint) (domain.Account, error) {
+	return app.accountRepo.SearchByExample(ctx, domain.Account{
+		ID:     id,
+		UserId: userId,
+	})
+}
+
+// PurgeEvent removes the links to the availables that no longer exist
+func (app *accountApp) PurgeEvent(ctx context.Context, event shared.Event) error {
+	return app.accountRepo.DeleteOrphans(ctx)
+}
+
+// DeleteUserEvent removes the accounts of a deleted user with their entries and links
+func (app *accountApp) DeleteUserEvent(ctx context.Context, event shared.Event) error {
+	return app.accountRepo.DeleteAllByUserId(ctx, event.ResourceId)
+}
+
+func NewAccountApp(
+	tm persistent.TransactionManager, accountRepo domain.AccountRepository, budgetRepo domain.BudgetRepository,
+	budgetAvailableRepo domain.BudgetAvailableRepository, logApp application.ILogApp,
+) IAccountApp {
+	return &accountApp{tm, accountRepo, budgetRepo, budgetAvailableRepo, logApp}
+}
+
+// availableByName finds an available of the budget with the name that no account has taken yet
+func availableByName(availables []domain.BudgetAvailable, name string, taken map[uint]bool) (uint, bool) {
+	for _, available := range availables {
+		if *available.Name == name && !taken[*available.ID] {
+			return *available.ID, true
+		}
+	}
+
+	return 0, false
+}
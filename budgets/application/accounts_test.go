@@ -0,0 +1,466 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestAccountSuite struct {
+	suite.Suite
+	userId                  uint
+	budgetId                uint
+	bank                    domain.Account
+	cash                    domain.Account
+	mockTransactionManager  *mocks_persistent.MockTransactionManager
+	mockAccountRepo         *mocks_domain.MockAccountRepository
+	mockBudgetRepo          *mocks_domain.MockBudgetRepository
+	mockBudgetAvailableRepo *mocks_domain.MockBudgetAvailableRepository
+	mockLogApp              *mocks_application.MockILogApp
+	app                     IAccountApp
+	ctx                     context.Context
+}
+
+func (suite *TestAccountSuite) SetupSuite() {
+	suite.userId = 1
+	suite.budgetId = 4
+	suite.bank = domain.Account{
+		ID:      2,
+		UserId:  suite.userId,
+		Name:    "Bank",
+		Type:    domain.Bank,
+		Balance: 1500,
+	}
+	suite.cash = domain.Account{
+		ID:      3,
+		UserId:  suite.userId,
+		Name:    "Cash",
+		Type:    domain.Cash,
+		Balance: 80,
+	}
+	suite.ctx = context.Background()
+}
+
+func (suite *TestAccountSuite) SetupTest() {
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockAccountRepo = mocks_domain.NewMockAccountRepository(suite.T())
+	suite.mockBudgetRepo = mocks_domain.NewMockBudgetRepository(suite.T())
+	suite.mockBudgetAvailableRepo = mocks_domain.NewMockBudgetAvailableRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.app = NewAccountApp(suite.mockTransactionManager, suite.mockAccountRepo, suite.mockBudgetRepo, suite.mockBudgetAvailableRepo, suite.mockLogApp)
+}
+
+func (suite *TestAccountSuite) mockTransaction() {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockAccountRepo.On("WithTransaction", nil).Return(suite.mockAccountRepo)
+}
+
+func (suite *TestAccountSuite) mockFindAccount(account domain.Account, err error) {
+	suite.mockAccountRepo.On("SearchByExample", suite.ctx, domain.Account{ID: account.ID, UserId: suite.userId}).Return(account, err)
+}
+
+func (suite *TestAccountSuite) mockLockAccount(account domain.Account) {
+	suite.mockAccountRepo.On("SearchForUpdate", suite.ctx, account.ID).Return(account, nil)
+}
+
+func (suite *TestAccountSuite) mockSaveTotals() {
+	budget := domain.Budget{ID: &suite.budgetId}
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget, nil).Once()
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(b domain.Budget) bool {
+		return b.TotalAvailable != nil
+	})).Return(suite.budgetId, nil).Once()
+}
+
+func (suite *TestAccountSuite) TestCreateSuccess() {
+	require := require.New(suite.T())
+	account := suite.bank
+	account.ID = 0
+	suite.mockTransaction()
+	suite.mockAccountRepo.On("Save", suite.ctx, mock.MatchedBy(func(a domain.Account) bool {
+		return a.ID == 0 && a.Balance == 1500
+	})).Return(suite.bank.ID, nil)
+	suite.mockAccountRepo.On("SaveEntry", suite.ctx, mock.MatchedBy(func(entry domain.AccountEntry) bool {
+		return entry.AccountId == suite.bank.ID && entry.Type == domain.Opening && entry.Amount == 1500 && entry.Balance == 1500
+	})).Return(uint(1), nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se crea una cuenta", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.Create(suite.ctx, account)
+
+	require.NoError(err)
+	require.Equal(suite.bank.ID, res.ID)
+	require.False(res.CreatedAt.IsZero())
+}
+
+func (suite *TestAccountSuite) TestCreateSuccessWithoutBalance() {
+	require := require.New(suite.T())
+	account := suite.cash
+	account.ID = 0
+	account.Balance = 0
+	suite.mockTransaction()
+	suite.mockAccountRepo.On("Save", suite.ctx, mock.Anything).Return(suite.cash.ID, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se crea una cuenta", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.Create(suite.ctx, account)
+
+	require.NoError(err)
+	require.Equal(suite.cash.ID, res.ID)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "SaveEntry", mock.Anything, mock.Anything)
+}
+
+func (suite *TestAccountSuite) TestFindEntriesErrorOtherUser() {
+	require := require.New(suite.T())
+	suite.mockFindAccount(suite.bank, gorm.ErrRecordNotFound)
+
+	_, err := suite.app.FindEntries(suite.ctx, suite.userId, suite.bank.ID)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "SearchAllEntries", mock.Anything, mock.Anything)
+}
+
+func (suite *TestAccountSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mockFindAccount(suite.bank, nil)
+	suite.mockTransaction()
+	suite.mockAccountRepo.On("Delete", suite.ctx, suite.bank.ID).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se elimina una cuenta", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.Delete(suite.ctx, suite.userId, suite.bank.ID)
+
+	require.NoError(err)
+}
+
+func (suite *TestAccountSuite) TestTransferSuccess() {
+	require := require.New(suite.T())
+	availableId := uint(10)
+	amount := 80.0
+	suite.mockFindAccount(suite.bank, nil)
+	suite.mockFindAccount(suite.cash, nil)
+	suite.mockTransaction()
+	suite.mockLockAccount(suite.bank)
+	suite.mockLockAccount(suite.cash)
+	suite.mockAccountRepo.On("Save", suite.ctx, mock.MatchedBy(func(a domain.Account) bool {
+		return a.ID == suite.bank.ID && a.Balance == 1400
+	})).Return(suite.bank.ID, nil)
+	suite.mockAccountRepo.On("Save", suite.ctx, mock.MatchedBy(func(a domain.Account) bool {
+		return a.ID == suite.cash.ID && a.Balance == 180
+	})).Return(suite.cash.ID, nil)
+	suite.mockAccountRepo.On("SaveEntry", suite.ctx, mock.MatchedBy(func(entry domain.AccountEntry) bool {
+		return entry.AccountId == suite.bank.ID && entry.Amount == -100 && *entry.CounterpartId == suite.cash.ID
+	})).Return(uint(5), nil)
+	suite.mockAccountRepo.On("SaveEntry", suite.ctx, mock.MatchedBy(func(entry domain.AccountEntry) bool {
+		return entry.AccountId == suite.cash.ID && entry.Amount == 100 && *entry.CounterpartId == suite.bank.ID
+	})).Return(uint(6), nil)
+	suite.mockAccountRepo.On("SearchAvailablesByMonth", suite.ctx, suite.bank.ID, mock.Anything, mock.Anything).Return([]domain.BudgetAvailable{}, nil)
+	suite.mockAccountRepo.On("SearchAvailablesByMonth", suite.ctx, suite.cash.ID, mock.Anything, mock.Anything).Return([]domain.BudgetAvailable{
+		{ID: &availableId, Amount: &amount, BudgetId: &suite.budgetId},
+	}, nil)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("SaveAll", suite.ctx, mock.MatchedBy(func(availables []domain.BudgetAvailable) bool {
+		return len(availables) == 1 && *availables[0].Amount == 180
+	})).Return(nil)
+	suite.mockSaveTotals()
+	suite.mockLogApp.On("Create", suite.ctx, "Se transfiere de la cuenta Bank a la cuenta Cash", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	entries, err := suite.app.Transfer(suite.ctx, suite.userId, suite.bank.ID, suite.cash.ID, 100, "")
+
+	require.NoError(err)
+	require.Len(entries, 2)
+	require.Equal(uint(5), entries[0].ID)
+	require.Equal(1400.0, entries[0].Balance)
+	require.Equal(180.0, entries[1].Balance)
+	require.Equal("Transferencia de Bank a Cash", entries[1].Description)
+}
+
+func (suite *TestAccountSuite) TestTransferSuccessConcurrent() {
+	require := require.New(suite.T())
+	// The rows locked by SearchForUpdate are released at the end of the transaction like in the
+	// database, the balances read before the transaction are stale
+	var mu sync.Mutex
+	stored := map[uint]domain.Account{suite.bank.ID: suite.bank, suite.cash.ID: suite.cash}
+	rows := map[uint]chan struct{}{suite.bank.ID: make(chan struct{}, 1), suite.cash.ID: make(chan struct{}, 1)}
+	suite.mockFindAccount(suite.bank, nil)
+	suite.mockFindAccount(suite.cash, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		defer func() {
+			for _, row := range rows {
+				select {
+				case <-row:
+				default:
+				}
+			}
+		}()
+		return fc(nil)
+	})
+	suite.mockAccountRepo.On("WithTransaction", nil).Return(suite.mockAccountRepo)
+	suite.mockAccountRepo.On("SearchForUpdate", suite.ctx, mock.Anything).Return(func(_ context.Context, id uint) domain.Account {
+		rows[id] <- struct{}{}
+		mu.Lock()
+		defer mu.Unlock()
+		return stored[id]
+	}, nil)
+	suite.mockAccountRepo.On("Save", suite.ctx, mock.Anything).Return(func(_ context.Context, account domain.Account) uint {
+		mu.Lock()
+		defer mu.Unlock()
+		stored[account.ID] = account
+		return account.ID
+	}, nil)
+	suite.mockAccountRepo.On("SaveEntry", suite.ctx, mock.Anything).Return(uint(1), nil)
+	suite.mockAccountRepo.On("SearchAvailablesByMonth", suite.ctx, mock.Anything, mock.Anything, mock.Anything).Return([]domain.BudgetAvailable{}, nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := suite.app.Transfer(suite.ctx, suite.userId, suite.bank.ID, suite.cash.ID, 10, "")
+			errs <- err
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := suite.app.Transfer(suite.ctx, suite.userId, suite.cash.ID, suite.bank.ID, 5, "")
+			errs <- err
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.FailNow("the opposite transfers are waiting for each other")
+	}
+
+	close(errs)
+	for err := range errs {
+		require.NoError(err)
+	}
+	require.Equal(suite.bank.Balance-50, stored[suite.bank.ID].Balance)
+	require.Equal(suite.cash.Balance+50, stored[suite.cash.ID].Balance)
+}
+
+func (suite *TestAccountSuite) TestTransferErrorLock() {
+	require := require.New(suite.T())
+	suite.mockFindAccount(suite.bank, nil)
+	suite.mockFindAccount(suite.cash, nil)
+	suite.mockTransaction()
+	suite.mockAccountRepo.On("SearchForUpdate", suite.ctx, suite.bank.ID).Return(domain.Account{}, gorm.ErrInvalidField)
+
+	_, err := suite.app.Transfer(suite.ctx, suite.userId, suite.bank.ID, suite.cash.ID, 100, "")
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+}
+
+func (suite *TestAccountSuite) TestTransferErrorSameAccount() {
+	require := require.New(suite.T())
+
+	_, err := suite.app.Transfer(suite.ctx, suite.userId, suite.bank.ID, suite.bank.ID, 100, "")
+
+	require.ErrorIs(err, ErrAccountTransferSame)
+}
+
+func (suite *TestAccountSuite) TestTransferErrorOtherUser() {
+	require := require.New(suite.T())
+	suite.mockFindAccount(suite.bank, nil)
+	suite.mockFindAccount(suite.cash, gorm.ErrRecordNotFound)
+
+	_, err := suite.app.Transfer(suite.ctx, suite.userId, suite.bank.ID, suite.cash.ID, 100, "")
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.mockTransactionManager.AssertNotCalled(suite.T(), "Transaction", mock.Anything)
+}
+
+func (suite *TestAccountSuite) TestReconcileSuccess() {
+	require := require.New(suite.T())
+	suite.mockFindAccount(suite.bank, nil)
+	suite.mockTransaction()
+	suite.mockLockAccount(suite.bank)
+	suite.mockAccountRepo.On("Save", suite.ctx, mock.MatchedBy(func(a domain.Account) bool {
+		return a.Balance == 1487.35
+	})).Return(suite.bank.ID, nil)
+	suite.mockAccountRepo.On("SaveEntry", suite.ctx, mock.MatchedBy(func(entry domain.AccountEntry) bool {
+		return entry.Type == domain.Adjustment && entry.Amount == -12.65 && entry.Balance == 1487.35
+	})).Return(uint(7), nil)
+	suite.mockAccountRepo.On("SearchAvailablesByMonth", suite.ctx, suite.bank.ID, mock.Anything, mock.Anything).Return([]domain.BudgetAvailable{}, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se concilia la cuenta Bank", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.Reconcile(suite.ctx, suite.userId, suite.bank.ID, 1487.35)
+
+	require.NoError(err)
+	require.Equal(1487.35, res.Account.Balance)
+	require.NotNil(res.Adjustment)
+	require.Equal(uint(7), res.Adjustment.ID)
+}
+
+func (suite *TestAccountSuite) TestReconcileSuccessWithoutDifference() {
+	require := require.New(suite.T())
+	suite.mockFindAccount(suite.bank, nil)
+	suite.mockTransaction()
+	suite.mockLockAccount(suite.bank)
+
+	res, err := suite.app.Reconcile(suite.ctx, suite.userId, suite.bank.ID, 1500)
+
+	require.NoError(err)
+	require.Nil(res.Adjustment)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "SaveEntry", mock.Anything, mock.Anything)
+}
+
+func (suite *TestAccountSuite) TestReconcileSuccessBalanceChanged() {
+	require := require.New(suite.T())
+	locked := suite.bank
+	locked.Balance = 1600
+	suite.mockFindAccount(suite.bank, nil)
+	suite.mockTransaction()
+	suite.mockLockAccount(locked)
+	suite.mockAccountRepo.On("Save", suite.ctx, mock.MatchedBy(func(a domain.Account) bool {
+		return a.Balance == 1487.35
+	})).Return(suite.bank.ID, nil)
+	suite.mockAccountRepo.On("SaveEntry", suite.ctx, mock.MatchedBy(func(entry domain.AccountEntry) bool {
+		return entry.Type == domain.Adjustment && entry.Amount == -112.65 && entry.Balance == 1487.35
+	})).Return(uint(7), nil)
+	suite.mockAccountRepo.On("SearchAvailablesByMonth", suite.ctx, suite.bank.ID, mock.Anything, mock.Anything).Return([]domain.BudgetAvailable{}, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se concilia la cuenta Bank", shared.User, suite.userId, mock.MatchedBy(func(detail map[string]any) bool {
+		return detail["before"] == 1600.0 && detail["adjustment"] == -112.65
+	}), nil).Return(nil)
+
+	res, err := suite.app.Reconcile(suite.ctx, suite.userId, suite.bank.ID, 1487.35)
+
+	require.NoError(err)
+	require.Equal(-112.65, res.Adjustment.Amount)
+}
+
+func (suite *TestAccountSuite) TestSyncSuccess() {
+	require := require.New(suite.T())
+	linkedId := uint(10)
+	namedId := uint(11)
+	newId := uint(12)
+	linkedName := "Old bank"
+	namedName := "Cash"
+	amount := 0.0
+	wallet := domain.Account{ID: 5, UserId: suite.userId, Name: "Wallet", Type: domain.Wallet, Balance: 20}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{
+		ID:     &suite.budgetId,
+		UserId: &suite.userId,
+		BudgetAvailables: []domain.BudgetAvailable{
+			{ID: &linkedId, Name: &linkedName, Amount: &amount},
+			{ID: &namedId, Name: &namedName, Amount: &amount},
+		},
+	}, nil).Once()
+	suite.mockAccountRepo.On("SearchAllByExample", suite.ctx, domain.Account{UserId: suite.userId}).Return([]domain.Account{suite.bank, suite.cash, wallet}, nil)
+	suite.mockAccountRepo.On("SearchAllAvailables", suite.ctx, suite.budgetId).Return([]domain.AccountAvailable{
+		{AccountId: suite.bank.ID, BudgetAvailableId: linkedId},
+	}, nil)
+	suite.mockTransaction()
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.MatchedBy(func(available domain.BudgetAvailable) bool {
+		return available.ID != nil && *available.ID == linkedId && *available.Amount == 1500
+	})).Return(linkedId, nil)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.MatchedBy(func(available domain.BudgetAvailable) bool {
+		return available.ID != nil && *available.ID == namedId && *available.Amount == 80
+	})).Return(namedId, nil)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.MatchedBy(func(available domain.BudgetAvailable) bool {
+		return available.ID == nil && *available.Name == "Wallet" && *available.BudgetId == suite.budgetId
+	})).Return(newId, nil)
+	suite.mockAccountRepo.On("SaveAvailable", suite.ctx, domain.AccountAvailable{AccountId: suite.cash.ID, BudgetAvailableId: namedId}).Return(nil)
+	suite.mockAccountRepo.On("SaveAvailable", suite.ctx, domain.AccountAvailable{AccountId: wallet.ID, BudgetAvailableId: newId}).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se sincronizan los disponibles con las cuentas", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockSaveTotals()
+
+	res, err := suite.app.Sync(suite.ctx, suite.userId, suite.budgetId)
+
+	require.NoError(err)
+	require.Len(res, 3)
+	require.Equal("Bank", *res[0].Name)
+	require.Equal(namedId, *res[1].ID)
+	require.Equal(newId, *res[2].ID)
+	require.Equal(20.0, *res[2].Amount)
+}
+
+func (suite *TestAccountSuite) TestSyncErrorOtherUser() {
+	require := require.New(suite.T())
+	otherId := uint(99)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{ID: &suite.budgetId, UserId: &otherId}, nil)
+
+	_, err := suite.app.Sync(suite.ctx, suite.userId, suite.budgetId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestAccountSuite) TestSyncEventSuccessWithoutAccounts() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{ID: &suite.budgetId, UserId: &suite.userId}, nil)
+	suite.mockAccountRepo.On("SearchAllByExample", suite.ctx, domain.Account{UserId: suite.userId}).Return([]domain.Account{}, nil)
+
+	err := suite.app.SyncEvent(suite.ctx, shared.Event{Name: shared.BudgetCreated, ResourceId: suite.budgetId})
+
+	require.NoError(err)
+	suite.mockTransactionManager.AssertNotCalled(suite.T(), "Transaction", mock.Anything)
+}
+
+func (suite *TestAccountSuite) TestSyncEventSuccessBudgetDeleted() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.SyncEvent(suite.ctx, shared.Event{Name: shared.BudgetCreated, ResourceId: suite.budgetId})
+
+	require.NoError(err)
+}
+
+func (suite *TestAccountSuite) TestPurgeEventSuccess() {
+	require := require.New(suite.T())
+	suite.mockAccountRepo.On("DeleteOrphans", suite.ctx).Return(nil)
+
+	err := suite.app.PurgeEvent(suite.ctx, shared.Event{Name: shared.TrashPurged, ResourceId: suite.userId})
+
+	require.NoError(err)
+}
+
+func (suite *TestAccountSuite) TestPurgeEventError() {
+	require := require.New(suite.T())
+	suite.mockAccountRepo.On("DeleteOrphans", suite.ctx).Return(gorm.ErrInvalidField)
+
+	err := suite.app.PurgeEvent(suite.ctx, shared.Event{Name: shared.TrashPurged, ResourceId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestAccountSuite) TestDeleteUserEventSuccess() {
+	require := require.New(suite.T())
+	suite.mockAccountRepo.On("DeleteAllByUserId", suite.ctx, suite.userId).Return(nil)
+
+	err := suite.app.DeleteUserEvent(suite.ctx, shared.Event{Name: shared.UserDeleted, ResourceId: suite.userId})
+
+	require.NoError(err)
+}
+
+func (suite *TestAccountSuite) TestDeleteUserEventError() {
+	require := require.New(suite.T())
+	suite.mockAccountRepo.On("DeleteAllByUserId", suite.ctx, suite.userId).Return(gorm.ErrInvalidField)
+
+	err := suite.app.DeleteUserEvent(suite.ctx, shared.Event{Name: shared.UserDeleted, ResourceId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestAccountSuite(t *testing.T) {
+	suite.Run(t, new(TestAccountSuite))
+}
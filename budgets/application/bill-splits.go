@@ -0,0 +1,525 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrSplitMember    = errors.New("the member does not belong to the budget")
+	ErrSplitShares    = errors.New("the shares do not match the method of the split")
+	ErrSplitPaid      = errors.New("the paid amounts exceed the amount of the bill")
+	ErrMemberInUse    = errors.New("the member has split bills or settlements")
+	ErrSettlementSame = errors.New("the settlement must be between different members")
+)
+
+// MemberBalance is the position of a member in a budget, a positive Balance is owed to the
+// member and a negative one is owed by the member
+type MemberBalance struct {
+	Member   domain.BudgetMember
+	Paid     float64
+	Owed     float64
+	Sent     float64
+	Received float64
+	Balance  float64
+}
+
+// SettlementSuggestion is a payment that settles part of the balances
+type SettlementSuggestion struct {
+	FromMemberId uint
+	ToMemberId   uint
+	Amount       float64
+}
+
+type SplitBalances struct {
+	Members     []MemberBalance
+	Suggestions []SettlementSuggestion
+}
+
+type IBillSplitApp interface {
+	CreateMember(ctx context.Context, userId, budgetId uint, name string) (domain.BudgetMember, error)
+	FindMembers(ctx context.Context, userId, budgetId uint) ([]domain.BudgetMember, error)
+	DeleteMember(ctx context.Context, userId, budgetId, memberId uint) error
+	SaveSplit(ctx context.Context, userId uint, split domain.BillSplit) (domain.BillSplit, error)
+	FindSplit(ctx context.Context, userId, billId uint) (domain.BillSplit, error)
+	DeleteSplit(ctx context.Context, userId, billId uint) error
+	Balances(ctx context.Context, userId, budgetId uint) (SplitBalances, error)
+	CreateSettlement(ctx context.Context, userId uint, settlement domain.BudgetSettlement) (domain.BudgetSettlement, error)
+	FindSettlements(ctx context.Context, userId, budgetId uint) ([]domain.BudgetSettlement, error)
+	PurgeEvent(ctx context.Context, event shared.Event) error
+}
+
+type billSplitApp struct {
+	tm             persistent.TransactionManager
+	billSplitRepo  domain.BillSplitRepository
+	budgetRepo     domain.BudgetRepository
+	budgetBillRepo domain.BudgetBillRepository
+	logApp         application.ILogApp
+}
+
+func (app *billSplitApp) CreateMember(ctx context.Context, userId, budgetId uint, name string) (domain.BudgetMember, error) {
+	if err := app.checkBudget(ctx, userId, budgetId); err != nil {
+		return domain.BudgetMember{}, err
+	}
+
+	member := domain.BudgetMember{
+		BudgetId:  budgetId,
+		Name:      name,
+		CreatedAt: time.Now(),
+	}
+	err := app.tm.Transaction(func(tx persistent.Transaction) error {
+		id, err := app.billSplitRepo.WithTransaction(tx).SaveMember(ctx, member)
+		if err != nil {
+			return err
+		}
+
+		member.ID = id
+		description := fmt.Sprintf("Se agrega el miembro %s", member.Name)
+		detail := map[string]any{
+			"id":   member.ID,
+			"name": member.Name,
+		}
+		return app.logApp.Create(ctx, description, shared.Budget, budgetId, detail, tx)
+	})
+	if err != nil {
+		return domain.BudgetMember{}, err
+	}
+
+	return member, nil
+}
+
+func (app *billSplitApp) FindMembers(ctx context.Context, userId, budgetId uint) ([]domain.BudgetMember, error) {
+	if err := app.checkBudget(ctx, userId, budgetId); err != nil {
+		return nil, err
+	}
+
+	return app.billSplitRepo.SearchAllMembers(ctx, budgetId)
+}
+
+// DeleteMember removes a member of the budget, a member with shares in the splits or with
+// settlements is kept so the balances do not change.
+func (app *billSplitApp) DeleteMember(ctx context.Context, userId, budgetId, memberId uint) error {
+	if err := app.checkBudget(ctx, userId, budgetId); err != nil {
+		return err
+	}
+
+	members, err := app.membersById(ctx, budgetId)
+	if err != nil {
+		return err
+	}
+
+	member, ok := members[memberId]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+
+	splits, err := app.billSplitRepo.SearchAllSplits(ctx, budgetId)
+	if err != nil {
+		return err
+	}
+
+	for _, split := range splits {
+		for _, share := range split.Shares {
+			if share.MemberId == memberId {
+				return ErrMemberInUse
+			}
+		}
+	}
+
+	settlements, err := app.billSplitRepo.SearchAllSettlements(ctx, budgetId)
+	if err != nil {
+		return err
+	}
+
+	for _, settlement := range settlements {
+		if settlement.FromMemberId == memberId || settlement.ToMemberId == memberId {
+			return ErrMemberInUse
+		}
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		if err := app.billSplitRepo.WithTransaction(tx).DeleteMember(ctx, memberId); err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("Se elimina el miembro %s", member.Name)
+		detail := map[string]any{
+			"id": memberId,
+		}
+		return app.logApp.Create(ctx, description, shared.Budget, budgetId, detail, tx)
+	})
+}
+
+// SaveSplit sets how the bill is shared between the members, the values must add up to 100
+// with the percentage method and to the amount of the bill with the fixed one.
+func (app *billSplitApp) SaveSplit(ctx context.Context, userId uint, split domain.BillSplit) (domain.BillSplit, error) {
+	bill, err := app.findBill(ctx, userId, split.BudgetBillId)
+	if err != nil {
+		return domain.BillSplit{}, err
+	}
+
+	members, err := app.membersById(ctx, *bill.BudgetId)
+	if err != nil {
+		return domain.BillSplit{}, err
+	}
+
+	if len(split.Shares) == 0 {
+		return domain.BillSplit{}, ErrSplitShares
+	}
+
+	seen := map[uint]bool{}
+	var values, paid float64
+	for i, share := range split.Shares {
+		if _, ok := members[share.MemberId]; !ok {
+			return domain.BillSplit{}, ErrSplitMember
+		}
+
+		if seen[share.MemberId] {
+			return domain.BillSplit{}, ErrSplitShares
+		}
+
+		seen[share.MemberId] = true
+		if split.Method == domain.Equal {
+			split.Shares[i].Value = 0
+		}
+
+		split.Shares[i].Value = roundCents(split.Shares[i].Value)
+		split.Shares[i].Paid = roundCents(share.Paid)
+		values += split.Shares[i].Value
+		paid += split.Shares[i].Paid
+	}
+
+	switch split.Method {
+	case domain.Percentage:
+		if roundCents(values) != 100 {
+			return domain.BillSplit{}, ErrSplitShares
+		}
+	case domain.FixedShare:
+		if roundCents(values) != roundCents(*bill.Amount) {
+			return domain.BillSplit{}, ErrSplitShares
+		}
+	}
+
+	if roundCents(paid) > roundCents(*bill.Amount) {
+		return domain.BillSplit{}, ErrSplitPaid
+	}
+
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		if err := app.billSplitRepo.WithTransaction(tx).SaveSplit(ctx, split); err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("Se divide el gasto %s", *bill.Description)
+		detail := map[string]any{
+			"billId": split.BudgetBillId,
+			"method": split.Method,
+			"shares": len(split.Shares),
+		}
+		return app.logApp.Create(ctx, description, shared.Budget, *bill.BudgetId, detail, tx)
+	})
+	if err != nil {
+		return domain.BillSplit{}, err
+	}
+
+	return split, nil
+}
+
+func (app *billSplitApp) FindSplit(ctx context.Context, userId, billId uint) (domain.BillSplit, error) {
+	if _, err := app.findBill(ctx, userId, billId); err != nil {
+		return domain.BillSplit{}, err
+	}
+
+	return app.billSplitRepo.SearchSplit(ctx, billId)
+}
+
+func (app *billSplitApp) DeleteSplit(ctx context.Context, userId, billId uint) error {
+	bill, err := app.findBill(ctx, userId, billId)
+	if err != nil {
+		return err
+	}
+
+	if _, err := app.billSplitRepo.SearchSplit(ctx, billId); err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		if err := app.billSplitRepo.WithTransaction(tx).DeleteSplit(ctx, billId); err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("Se quita la división del gasto %s", *bill.Description)
+		detail := map[string]any{
+			"billId": billId,
+		}
+		return app.logApp.Create(ctx, description, shared.Budget, *bill.BudgetId, detail, tx)
+	})
+}
+
+// Balances adds up per member what was paid of the split bills, the part of the paid total
+// that the member owes by the method of each split and the settlements sent and received,
+// with the payments that settle the balances.
+func (app *billSplitApp) Balances(ctx context.Context, userId, budgetId uint) (SplitBalances, error) {
+	if err := app.checkBudget(ctx, userId, budgetId); err != nil {
+		return SplitBalances{}, err
+	}
+
+	members, err := app.billSplitRepo.SearchAllMembers(ctx, budgetId)
+	if err != nil {
+		return SplitBalances{}, err
+	}
+
+	splits, err := app.billSplitRepo.SearchAllSplits(ctx, budgetId)
+	if err != nil {
+		return SplitBalances{}, err
+	}
+
+	settlements, err := app.billSplitRepo.SearchAllSettlements(ctx, budgetId)
+	if err != nil {
+		return SplitBalances{}, err
+	}
+
+	balances := []MemberBalance{}
+	indexes := map[uint]int{}
+	for i, member := range members {
+		indexes[member.ID] = i
+		balances = append(balances, MemberBalance{Member: member})
+	}
+
+	for _, split := range splits {
+		owed := splitOwed(split)
+		for i, share := range split.Shares {
+			if index, ok := indexes[share.MemberId]; ok {
+				balances[index].Paid += share.Paid
+				balances[index].Owed += owed[i]
+			}
+		}
+	}
+
+	for _, settlement := range settlements {
+		if index, ok := indexes[settlement.FromMemberId]; ok {
+			balances[index].Sent += settlement.Amount
+		}
+
+		if index, ok := indexes[settlement.ToMemberId]; ok {
+			balances[index].Received += settlement.Amount
+		}
+	}
+
+	for i := range balances {
+		balances[i].Paid = roundCents(balances[i].Paid)
+		balances[i].Owed = roundCents(balances[i].Owed)
+		balances[i].Sent = roundCents(balances[i].Sent)
+		balances[i].Received = roundCents(balances[i].Received)
+		balances[i].Balance = roundCents(balances[i].Paid - balances[i].Owed + balances[i].Sent - balances[i].Received)
+	}
+
+	return SplitBalances{
+		Members:     balances,
+		Suggestions: suggestSettlements(balances),
+	}, nil
+}
+
+func (app *billSplitApp) CreateSettlement(ctx context.Context, userId uint, settlement domain.BudgetSettlement) (domain.BudgetSettlement, error) {
+	if settlement.FromMemberId == settlement.ToMemberId {
+		return domain.BudgetSettlement{}, ErrSettlementSame
+	}
+
+	if err := app.checkBudget(ctx, userId, settlement.BudgetId); err != nil {
+		return domain.BudgetSettlement{}, err
+	}
+
+	members, err := app.membersById(ctx, settlement.BudgetId)
+	if err != nil {
+		return domain.BudgetSettlement{}, err
+	}
+
+	from, okFrom := members[settlement.FromMemberId]
+	to, okTo := members[settlement.ToMemberId]
+	if !okFrom || !okTo {
+		return domain.BudgetSettlement{}, ErrSplitMember
+	}
+
+	settlement.ID = 0
+	settlement.Amount = roundCents(settlement.Amount)
+	settlement.CreatedAt = time.Now()
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		id, err := app.billSplitRepo.WithTransaction(tx).SaveSettlement(ctx, settlement)
+		if err != nil {
+			return err
+		}
+
+		settlement.ID = id
+		description := fmt.Sprintf("Se registra un pago de %s a %s", from.Name, to.Name)
+		detail := map[string]any{
+			"id":     settlement.ID,
+			"amount": settlement.Amount,
+		}
+		return app.logApp.Create(ctx, description, shared.Budget, settlement.BudgetId, detail, tx)
+	})
+	if err != nil {
+		return domain.BudgetSettlement{}, err
+	}
+
+	return settlement, nil
+}
+
+func (app *billSplitApp) FindSettlements(ctx context.Context, userId, budgetId uint) ([]domain.BudgetSettlement, error) {
+	if err := app.checkBudget(ctx, userId, budgetId); err != nil {
+		return nil, err
+	}
+
+	return app.billSplitRepo.SearchAllSettlements(ctx, budgetId)
+}
+
+func (app *billSplitApp) checkBudget(ctx context.Context, userId, budgetId uint) error {
+	budget, err := app.budgetRepo.Search(ctx, budgetId)
+	if err != nil {
+		return err
+	}
+
+	if *budget.UserId != userId {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (app *billSplitApp) findBill(ctx context.Context, userId, billId uint) (domain.BudgetBill, error) {
+	bill, err := app.budgetBillRepo.Search(ctx, billId)
+	if err != nil {
+		return domain.BudgetBill{}, err
+	}
+
+	if err := app.checkBudget(ctx, userId, *bill.BudgetId); err != nil {
+		return domain.BudgetBill{}, err
+	}
+
+	return bill, nil
+}
+
+func (app *billSplitApp) membersById(ctx context.Context, budgetId uint) (map[uint]domain.BudgetMember, error) {
+	members, err := app.billSplitRepo.SearchAllMembers(ctx, budgetId)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := map[uint]domain.BudgetMember{}
+	for _, member := range members {
+		byId[member.ID] = member
+	}
+
+	return byId, nil
+}
+
+// PurgeEvent removes the splits of the purged bills and the members and settlements of the purged
+// budgets, it runs after the trash is purged and after the budgets of a deleted user are removed.
+func (app *billSplitApp) PurgeEvent(ctx context.Context, event shared.Event) error {
+	return app.billSplitRepo.DeleteOrphans(ctx)
+}
+
+func NewBillSplitApp(
+	tm persistent.TransactionManager, billSplitRepo domain.BillSplitRepository, budgetRepo domain.BudgetRepository,
+	budgetBillRepo domain.BudgetBillRepository, logApp application.ILogApp,
+) IBillSplitApp {
+	return &billSplitApp{tm, billSplitRepo, budgetRepo, budgetBillRepo, logApp}
+}
+
+// splitOwed returns the part of the paid total that each share owes, the last share takes
+// the cents left by the rounding
+func splitOwed(split domain.BillSplit) []float64 {
+	owed := make([]float64, len(split.Shares))
+	var total, values float64
+	for _, share := range split.Shares {
+		total += share.Paid
+		values += share.Value
+	}
+
+	var assigned float64
+	for i, share := range split.Shares {
+		if i == len(split.Shares)-1 {
+			owed[i] = roundCents(total - assigned)
+			break
+		}
+
+		var fraction float64
+		switch split.Method {
+		case domain.Percentage:
+			fraction = share.Value / 100
+		case domain.FixedShare:
+			if values != 0 {
+				fraction = share.Value / values
+			}
+		default:
+			fraction = 1 / float64(len(split.Shares))
+		}
+
+		owed[i] = roundCents(total * fraction)
+		assigned += owed[i]
+	}
+
+	return owed
+}
+
+// suggestSettlements pays the largest debts to the largest credits first
+func suggestSettlements(balances []MemberBalance) []SettlementSuggestion {
+	type position struct {
+		id     uint
+		amount float64
+	}
+
+	debtors := []position{}
+	creditors := []position{}
+	for _, balance := range balances {
+		if balance.Balance <= -0.01 {
+			debtors = append(debtors, position{balance.Member.ID, -balance.Balance})
+		} else if balance.Balance >= 0.01 {
+			creditors = append(creditors, position{balance.Member.ID, balance.Balance})
+		}
+	}
+
+	byAmount := func(positions []position) func(i, j int) bool {
+		return func(i, j int) bool {
+			if positions[i].amount != positions[j].amount {
+				return positions[i].amount > positions[j].amount
+			}
+
+			return positions[i].id < positions[j].id
+		}
+	}
+	sort.Slice(debtors, byAmount(debtors))
+	sort.Slice(creditors, byAmount(creditors))
+
+	suggestions := []SettlementSuggestion{}
+	for d, c := 0, 0; d < len(debtors) && c < len(creditors); {
+		amount := roundCents(math.Min(debtors[d].amount, creditors[c].amount))
+		if amount >= 0.01 {
+			suggestions = append(suggestions, SettlementSuggestion{
+				FromMemberId: debtors[d].id,
+				ToMemberId:   creditors[c].id,
+				Amount:       amount,
+			})
+		}
+
+		debtors[d].amount = roundCents(debtors[d].amount - amount)
+		creditors[c].amount = roundCents(creditors[c].amount - amount)
+		if debtors[d].amount < 0.01 {
+			d++
+		}
+
+		if creditors[c].amount < 0.01 {
+			c++
+		}
+	}
+
+	return suggestions
+}
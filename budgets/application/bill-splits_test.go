@@ -0,0 +1,324 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestBillSplitSuite struct {
+	suite.Suite
+	userId                 uint
+	budgetId               uint
+	bill                   domain.BudgetBill
+	ana                    domain.BudgetMember
+	luis                   domain.BudgetMember
+	mockTransactionManager *mocks_persistent.MockTransactionManager
+	mockBillSplitRepo      *mocks_domain.MockBillSplitRepository
+	mockBudgetRepo         *mocks_domain.MockBudgetRepository
+	mockBudgetBillRepo     *mocks_domain.MockBudgetBillRepository
+	mockLogApp             *mocks_application.MockILogApp
+	app                    IBillSplitApp
+	ctx                    context.Context
+}
+
+func (suite *TestBillSplitSuite) SetupSuite() {
+	suite.userId = 1
+	suite.budgetId = 4
+	id := uint(7)
+	description := "Rent"
+	amount := 1000.0
+	suite.bill = domain.BudgetBill{
+		ID:          &id,
+		Description: &description,
+		Amount:      &amount,
+		BudgetId:    &suite.budgetId,
+	}
+	suite.ana = domain.BudgetMember{ID: 1, BudgetId: suite.budgetId, Name: "Ana"}
+	suite.luis = domain.BudgetMember{ID: 2, BudgetId: suite.budgetId, Name: "Luis"}
+	suite.ctx = context.Background()
+}
+
+func (suite *TestBillSplitSuite) SetupTest() {
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockBillSplitRepo = mocks_domain.NewMockBillSplitRepository(suite.T())
+	suite.mockBudgetRepo = mocks_domain.NewMockBudgetRepository(suite.T())
+	suite.mockBudgetBillRepo = mocks_domain.NewMockBudgetBillRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.app = NewBillSplitApp(suite.mockTransactionManager, suite.mockBillSplitRepo, suite.mockBudgetRepo, suite.mockBudgetBillRepo, suite.mockLogApp)
+}
+
+func (suite *TestBillSplitSuite) mockTransaction() {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockBillSplitRepo.On("WithTransaction", nil).Return(suite.mockBillSplitRepo)
+}
+
+func (suite *TestBillSplitSuite) mockBudget(userId uint) {
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{ID: &suite.budgetId, UserId: &userId}, nil)
+}
+
+func (suite *TestBillSplitSuite) mockMembers() {
+	suite.mockBillSplitRepo.On("SearchAllMembers", suite.ctx, suite.budgetId).Return([]domain.BudgetMember{suite.ana, suite.luis}, nil)
+}
+
+func (suite *TestBillSplitSuite) TestCreateMemberSuccess() {
+	require := require.New(suite.T())
+	suite.mockBudget(suite.userId)
+	suite.mockTransaction()
+	suite.mockBillSplitRepo.On("SaveMember", suite.ctx, mock.MatchedBy(func(m domain.BudgetMember) bool {
+		return m.BudgetId == suite.budgetId && m.Name == "Ana"
+	})).Return(suite.ana.ID, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se agrega el miembro Ana", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.CreateMember(suite.ctx, suite.userId, suite.budgetId, "Ana")
+
+	require.NoError(err)
+	require.Equal(suite.ana.ID, res.ID)
+}
+
+func (suite *TestBillSplitSuite) TestCreateMemberErrorOtherUser() {
+	require := require.New(suite.T())
+	suite.mockBudget(9)
+
+	_, err := suite.app.CreateMember(suite.ctx, suite.userId, suite.budgetId, "Ana")
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.mockBillSplitRepo.AssertNotCalled(suite.T(), "SaveMember", mock.Anything, mock.Anything)
+}
+
+func (suite *TestBillSplitSuite) TestDeleteMemberSuccess() {
+	require := require.New(suite.T())
+	suite.mockBudget(suite.userId)
+	suite.mockMembers()
+	suite.mockBillSplitRepo.On("SearchAllSplits", suite.ctx, suite.budgetId).Return([]domain.BillSplit{
+		{BudgetBillId: *suite.bill.ID, Method: domain.Equal, Shares: []domain.BillShare{{MemberId: suite.ana.ID}}},
+	}, nil)
+	suite.mockBillSplitRepo.On("SearchAllSettlements", suite.ctx, suite.budgetId).Return([]domain.BudgetSettlement{}, nil)
+	suite.mockTransaction()
+	suite.mockBillSplitRepo.On("DeleteMember", suite.ctx, suite.luis.ID).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se elimina el miembro Luis", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.DeleteMember(suite.ctx, suite.userId, suite.budgetId, suite.luis.ID)
+
+	require.NoError(err)
+}
+
+func (suite *TestBillSplitSuite) TestDeleteMemberErrorInUse() {
+	require := require.New(suite.T())
+	suite.mockBudget(suite.userId)
+	suite.mockMembers()
+	suite.mockBillSplitRepo.On("SearchAllSplits", suite.ctx, suite.budgetId).Return([]domain.BillSplit{}, nil)
+	suite.mockBillSplitRepo.On("SearchAllSettlements", suite.ctx, suite.budgetId).Return([]domain.BudgetSettlement{
+		{ID: 1, BudgetId: suite.budgetId, FromMemberId: suite.luis.ID, ToMemberId: suite.ana.ID, Amount: 20},
+	}, nil)
+
+	err := suite.app.DeleteMember(suite.ctx, suite.userId, suite.budgetId, suite.luis.ID)
+
+	require.ErrorIs(err, ErrMemberInUse)
+	suite.mockBillSplitRepo.AssertNotCalled(suite.T(), "DeleteMember", mock.Anything, mock.Anything)
+}
+
+func (suite *TestBillSplitSuite) TestDeleteMemberErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockBudget(suite.userId)
+	suite.mockMembers()
+
+	err := suite.app.DeleteMember(suite.ctx, suite.userId, suite.budgetId, 99)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestBillSplitSuite) TestSaveSplitSuccess() {
+	require := require.New(suite.T())
+	suite.mockBudgetBillRepo.On("Search", suite.ctx, *suite.bill.ID).Return(suite.bill, nil)
+	suite.mockBudget(suite.userId)
+	suite.mockMembers()
+	suite.mockTransaction()
+	split := domain.BillSplit{
+		BudgetBillId: *suite.bill.ID,
+		Method:       domain.Percentage,
+		Shares: []domain.BillShare{
+			{MemberId: suite.ana.ID, Value: 60, Paid: 1000},
+			{MemberId: suite.luis.ID, Value: 40},
+		},
+	}
+	suite.mockBillSplitRepo.On("SaveSplit", suite.ctx, split).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se divide el gasto Rent", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.SaveSplit(suite.ctx, suite.userId, split)
+
+	require.NoError(err)
+	require.Equal(split, res)
+}
+
+func (suite *TestBillSplitSuite) TestSaveSplitErrorShares() {
+	require := require.New(suite.T())
+	suite.mockBudgetBillRepo.On("Search", suite.ctx, *suite.bill.ID).Return(suite.bill, nil)
+	suite.mockBudget(suite.userId)
+	suite.mockMembers()
+
+	_, err := suite.app.SaveSplit(suite.ctx, suite.userId, domain.BillSplit{
+		BudgetBillId: *suite.bill.ID,
+		Method:       domain.FixedShare,
+		Shares: []domain.BillShare{
+			{MemberId: suite.ana.ID, Value: 600},
+			{MemberId: suite.luis.ID, Value: 300},
+		},
+	})
+
+	require.ErrorIs(err, ErrSplitShares)
+}
+
+func (suite *TestBillSplitSuite) TestSaveSplitErrorMember() {
+	require := require.New(suite.T())
+	suite.mockBudgetBillRepo.On("Search", suite.ctx, *suite.bill.ID).Return(suite.bill, nil)
+	suite.mockBudget(suite.userId)
+	suite.mockMembers()
+
+	_, err := suite.app.SaveSplit(suite.ctx, suite.userId, domain.BillSplit{
+		BudgetBillId: *suite.bill.ID,
+		Method:       domain.Equal,
+		Shares:       []domain.BillShare{{MemberId: 99}},
+	})
+
+	require.ErrorIs(err, ErrSplitMember)
+}
+
+func (suite *TestBillSplitSuite) TestSaveSplitErrorPaid() {
+	require := require.New(suite.T())
+	suite.mockBudgetBillRepo.On("Search", suite.ctx, *suite.bill.ID).Return(suite.bill, nil)
+	suite.mockBudget(suite.userId)
+	suite.mockMembers()
+
+	_, err := suite.app.SaveSplit(suite.ctx, suite.userId, domain.BillSplit{
+		BudgetBillId: *suite.bill.ID,
+		Method:       domain.Equal,
+		Shares: []domain.BillShare{
+			{MemberId: suite.ana.ID, Paid: 800},
+			{MemberId: suite.luis.ID, Paid: 400},
+		},
+	})
+
+	require.ErrorIs(err, ErrSplitPaid)
+}
+
+func (suite *TestBillSplitSuite) TestDeleteSplitErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockBudgetBillRepo.On("Search", suite.ctx, *suite.bill.ID).Return(suite.bill, nil)
+	suite.mockBudget(suite.userId)
+	suite.mockBillSplitRepo.On("SearchSplit", suite.ctx, *suite.bill.ID).Return(domain.BillSplit{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.DeleteSplit(suite.ctx, suite.userId, *suite.bill.ID)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestBillSplitSuite) TestBalancesSuccess() {
+	require := require.New(suite.T())
+	eva := domain.BudgetMember{ID: 3, BudgetId: suite.budgetId, Name: "Eva"}
+	suite.mockBudget(suite.userId)
+	suite.mockBillSplitRepo.On("SearchAllMembers", suite.ctx, suite.budgetId).Return([]domain.BudgetMember{suite.ana, suite.luis, eva}, nil)
+	suite.mockBillSplitRepo.On("SearchAllSplits", suite.ctx, suite.budgetId).Return([]domain.BillSplit{
+		{
+			BudgetBillId: 7,
+			Method:       domain.Equal,
+			Shares: []domain.BillShare{
+				{MemberId: suite.ana.ID, Paid: 100},
+				{MemberId: suite.luis.ID},
+				{MemberId: eva.ID},
+			},
+		},
+		{
+			BudgetBillId: 8,
+			Method:       domain.Percentage,
+			Shares: []domain.BillShare{
+				{MemberId: suite.luis.ID, Value: 25, Paid: 40},
+				{MemberId: eva.ID, Value: 75},
+			},
+		},
+	}, nil)
+	suite.mockBillSplitRepo.On("SearchAllSettlements", suite.ctx, suite.budgetId).Return([]domain.BudgetSettlement{
+		{ID: 1, BudgetId: suite.budgetId, FromMemberId: eva.ID, ToMemberId: suite.ana.ID, Amount: 30},
+	}, nil)
+
+	res, err := suite.app.Balances(suite.ctx, suite.userId, suite.budgetId)
+
+	require.NoError(err)
+	require.Equal([]MemberBalance{
+		{Member: suite.ana, Paid: 100, Owed: 33.33, Received: 30, Balance: 36.67},
+		{Member: suite.luis, Paid: 40, Owed: 43.33, Balance: -3.33},
+		{Member: eva, Owed: 63.34, Sent: 30, Balance: -33.34},
+	}, res.Members)
+	require.Equal([]SettlementSuggestion{
+		{FromMemberId: eva.ID, ToMemberId: suite.ana.ID, Amount: 33.34},
+		{FromMemberId: suite.luis.ID, ToMemberId: suite.ana.ID, Amount: 3.33},
+	}, res.Suggestions)
+}
+
+func (suite *TestBillSplitSuite) TestCreateSettlementSuccess() {
+	require := require.New(suite.T())
+	suite.mockBudget(suite.userId)
+	suite.mockMembers()
+	suite.mockTransaction()
+	suite.mockBillSplitRepo.On("SaveSettlement", suite.ctx, mock.MatchedBy(func(s domain.BudgetSettlement) bool {
+		return s.FromMemberId == suite.luis.ID && s.ToMemberId == suite.ana.ID && s.Amount == 40.01
+	})).Return(uint(5), nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se registra un pago de Luis a Ana", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.CreateSettlement(suite.ctx, suite.userId, domain.BudgetSettlement{
+		BudgetId:     suite.budgetId,
+		FromMemberId: suite.luis.ID,
+		ToMemberId:   suite.ana.ID,
+		Amount:       40.006,
+	})
+
+	require.NoError(err)
+	require.Equal(uint(5), res.ID)
+}
+
+func (suite *TestBillSplitSuite) TestCreateSettlementErrorSame() {
+	require := require.New(suite.T())
+
+	_, err := suite.app.CreateSettlement(suite.ctx, suite.userId, domain.BudgetSettlement{
+		BudgetId:     suite.budgetId,
+		FromMemberId: suite.ana.ID,
+		ToMemberId:   suite.ana.ID,
+		Amount:       10,
+	})
+
+	require.ErrorIs(err, ErrSettlementSame)
+}
+
+func (suite *TestBillSplitSuite) TestPurgeEventSuccess() {
+	require := require.New(suite.T())
+	suite.mockBillSplitRepo.On("DeleteOrphans", suite.ctx).Return(nil)
+
+	err := suite.app.PurgeEvent(suite.ctx, shared.Event{Name: shared.TrashPurged, ResourceId: suite.userId})
+
+	require.NoError(err)
+}
+
+func (suite *TestBillSplitSuite) TestPurgeEventError() {
+	require := require.New(suite.T())
+	suite.mockBillSplitRepo.On("DeleteOrphans", suite.ctx).Return(gorm.ErrInvalidField)
+
+	err := suite.app.PurgeEvent(suite.ctx, shared.Event{Name: shared.TrashPurged, ResourceId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestBillSplitSuite(t *testing.T) {
+	suite.Run(t, new(TestBillSplitSuite))
+}
@@ -18,6 +18,7 @@ type budgetBillApp struct {
 	tm             persistent.TransactionManager
 	budgetBillRepo domain.BudgetBillRepository
 	logApp         application.ILogApp
+	eventApp       application.IEventApp
 }
 
 func (app *budgetBillApp) Create(ctx context.Context, description string, category domain.BudgetBillCategory, budgetId uint) (uint, error) {
@@ -37,7 +38,16 @@ func (app *budgetBillApp) Create(ctx context.Context, description string, catego
 		}
 		budgetBillRepo := app.budgetBillRepo.WithTransaction(tx)
 		id, err = budgetBillRepo.Save(ctx, newBill)
-		return err
+		if err != nil {
+			return err
+		}
+
+		payload := map[string]any{
+			"budgetId":    budgetId,
+			"description": description,
+			"category":    category,
+		}
+		return app.eventApp.Publish(ctx, shared.BillCreated, id, payload, tx)
 	})
 	if err != nil {
 		return 0, err
@@ -66,10 +76,23 @@ func (app *budgetBillApp) CreateTransaction(ctx context.Context, description str
 		bill.Payment = &payment
 		budgetBillRepo := app.budgetBillRepo.WithTransaction(tx)
 		_, err = budgetBillRepo.Save(ctx, bill)
-		return err
+		if err != nil {
+			return err
+		}
+
+		payload := map[string]any{
+			"budgetId":    bill.BudgetId,
+			"description": description,
+			"amount":      amount,
+			"payment":     payment,
+		}
+		return app.eventApp.Publish(ctx, shared.BillPaid, billId, payload, tx)
 	})
 }
 
-func NewBudgetBillApp(tm persistent.TransactionManager, budgetBillRepo domain.BudgetBillRepository, logApp application.ILogApp) IBudgetBillApp {
-	return &budgetBillApp{tm, budgetBillRepo, logApp}
+func NewBudgetBillApp(
+	tm persistent.TransactionManager, budgetBillRepo domain.BudgetBillRepository, logApp application.ILogApp,
+	eventApp application.IEventApp,
+) IBudgetBillApp {
+	return &budgetBillApp{tm, budgetBillRepo, logApp, eventApp}
 }
@@ -26,6 +26,7 @@ type TestBudgetBillSuite struct {
 	mockTransactionManager *mocks_persistent.MockTransactionManager
 	mockBudgetBillRepo     *mocks_domain.MockBudgetBillRepository
 	mockLogApp             *mocks_application.MockILogApp
+	mockEventApp           *mocks_application.MockIEventApp
 	app                    IBudgetBillApp
 	ctx                    context.Context
 }
@@ -42,7 +43,8 @@ func (suite *TestBudgetBillSuite) SetupTest() {
 	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
 	suite.mockBudgetBillRepo = mocks_domain.NewMockBudgetBillRepository(suite.T())
 	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
-	suite.app = NewBudgetBillApp(suite.mockTransactionManager, suite.mockBudgetBillRepo, suite.mockLogApp)
+	suite.mockEventApp = mocks_application.NewMockIEventApp(suite.T())
+	suite.app = NewBudgetBillApp(suite.mockTransactionManager, suite.mockBudgetBillRepo, suite.mockLogApp, suite.mockEventApp)
 }
 
 func (suite *TestBudgetBillSuite) TestCreateSuccess() {
@@ -53,6 +55,7 @@ func (suite *TestBudgetBillSuite) TestCreateSuccess() {
 	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
 	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
 	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.Anything).Return(suite.budgetId, nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BillCreated, suite.budgetId, map[string]any{"budgetId": suite.budgetId, "description": "Test", "category": domain.Education}, nil).Return(nil)
 
 	res, err := suite.app.Create(suite.ctx, "Test", domain.Education, suite.budgetId)
 
@@ -101,6 +104,23 @@ func (suite *TestBudgetBillSuite) TestCreateError() {
 	require.Zero(res)
 }
 
+func (suite *TestBudgetBillSuite) TestCreateErrorPublish() {
+	require := require.New(suite.T())
+	errExpected := errors.New("Error publishing event")
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.Anything).Return(suite.id, nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BillCreated, suite.id, mock.Anything, nil).Return(errExpected)
+
+	res, err := suite.app.Create(suite.ctx, "Test", domain.Education, suite.budgetId)
+
+	require.EqualError(errExpected, err.Error())
+	require.Zero(res)
+}
+
 func (suite *TestBudgetBillSuite) TestCreateTransactionSuccess() {
 	require := require.New(suite.T())
 	payment := float64(0)
@@ -118,6 +138,9 @@ func (suite *TestBudgetBillSuite) TestCreateTransactionSuccess() {
 	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.BudgetBill, suite.id, mock.Anything, nil).Return(nil)
 	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
 	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.Anything).Return(suite.id, nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BillPaid, suite.id, mock.MatchedBy(func(payload map[string]any) bool {
+		return payload["amount"] == float64(10000) && payload["payment"] == float64(10000)
+	}), nil).Return(nil)
 
 	err := suite.app.CreateTransaction(suite.ctx, "Trans 1", float64(10000), suite.id)
 
@@ -155,6 +178,31 @@ func (suite *TestBudgetBillSuite) TestCreateTransactionErrorCreateLog() {
 	require.EqualError(errExpected, err.Error())
 }
 
+func (suite *TestBudgetBillSuite) TestCreateTransactionErrorPublish() {
+	require := require.New(suite.T())
+	payment := float64(0)
+	billExpected := domain.BudgetBill{
+		ID:          &suite.id,
+		Description: &suite.description,
+		Payment:     &payment,
+		Category:    &suite.category,
+		BudgetId:    &suite.budgetId,
+	}
+	errExpected := errors.New("Error publishing event")
+	suite.mockBudgetBillRepo.On("Search", suite.ctx, suite.id).Return(billExpected, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.BudgetBill, suite.id, mock.Anything, nil).Return(nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.Anything).Return(suite.id, nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BillPaid, suite.id, mock.Anything, nil).Return(errExpected)
+
+	err := suite.app.CreateTransaction(suite.ctx, "Trans 1", float64(10000), suite.id)
+
+	require.EqualError(errExpected, err.Error())
+}
+
 func TestTestBudgetBillSuite(t *testing.T) {
 	suite.Run(t, new(TestBudgetBillSuite))
 }
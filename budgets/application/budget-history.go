@@ -0,0 +1,434 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"your-accounts-api/budgets/domain"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/domain/utils/convert"
+)
+
+var (
+	ErrNothingToUndo   = errors.New("nothing to undo")
+	ErrNothingToRedo   = errors.New("nothing to redo")
+	ErrHistoryConflict = errors.New("the changes were modified later")
+)
+
+// changeHistory is the state shared by the changes of a batch to record them in the logs
+type changeHistory struct {
+	batch  string
+	budget domain.Budget
+}
+
+func (h changeHistory) detail(change Change, before, after map[string]any) map[string]any {
+	detail := map[string]any{}
+	for key, value := range change.Detail {
+		detail[key] = value
+	}
+
+	detail["batch"] = h.batch
+	detail["section"] = change.Section
+	detail["action"] = change.Action
+	detail["id"] = change.ID
+	detail["before"] = before
+	detail["after"] = after
+	return detail
+}
+
+func (h changeHistory) availableFields(id uint) map[string]any {
+	if available := findAvailable(h.budget, id); available != nil {
+		return availableFields(*available)
+	}
+
+	return map[string]any{}
+}
+
+func (h changeHistory) billFields(id uint) map[string]any {
+	if bill := findBill(h.budget, id); bill != nil {
+		return billFields(*bill)
+	}
+
+	return map[string]any{}
+}
+
+type historyEntry struct {
+	section domain.BudgetSection
+	action  shared.Action
+	id      uint
+	before  map[string]any
+	after   map[string]any
+}
+
+type historyBatch struct {
+	id        string
+	operation string
+	entries   []historyEntry
+}
+
+func (app *budgetApp) Undo(ctx context.Context, id uint) error {
+	budget, err := app.budgetRepo.Search(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	undo, _, err := app.history(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(undo) == 0 {
+		return ErrNothingToUndo
+	}
+
+	return app.applyHistory(ctx, budget, undo[len(undo)-1], true)
+}
+
+func (app *budgetApp) Redo(ctx context.Context, id uint) error {
+	budget, err := app.budgetRepo.Search(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, redo, err := app.history(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(redo) == 0 {
+		return ErrNothingToRedo
+	}
+
+	return app.applyHistory(ctx, budget, redo[len(redo)-1], false)
+}
+
+// history rebuilds the undo and redo stacks of the budget from the logs of the batches
+func (app *budgetApp) history(ctx context.Context, id uint) ([]historyBatch, []historyBatch, error) {
+	logs, err := app.logApp.FindByProjectWithDetail(ctx, shared.Budget, id, "batch")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The logs come by id and the changes of concurrent requests are logged interleaved, so the
+	// logs are grouped by their batch and the batches keep the order of their first log
+	batches := []historyBatch{}
+	indexes := map[string]int{}
+	for _, log := range logs {
+		entry, err := entryFromDetail(log.Detail)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		batch := fmt.Sprint(log.Detail["batch"])
+		index, ok := indexes[batch]
+		if !ok {
+			newBatch := historyBatch{id: batch}
+			for _, operation := range []string{"undo", "redo"} {
+				if log.Detail[operation] != nil {
+					newBatch.id = fmt.Sprint(log.Detail[operation])
+					newBatch.operation = operation
+				}
+			}
+
+			index = len(batches)
+			indexes[batch] = index
+			batches = append(batches, newBatch)
+		}
+
+		batches[index].entries = append(batches[index].entries, entry)
+	}
+
+	undo := []historyBatch{}
+	redo := []historyBatch{}
+	for _, batch := range batches {
+		switch batch.operation {
+		case "undo":
+			undo = removeBatch(undo, batch.id)
+			redo = append(removeBatch(redo, batch.id), batch)
+		case "redo":
+			redo = removeBatch(redo, batch.id)
+			undo = append(removeBatch(undo, batch.id), batch)
+		default:
+			// A new change discards the undone batches, as in any editor
+			undo = append(undo, batch)
+			redo = []historyBatch{}
+		}
+	}
+
+	return undo, redo, nil
+}
+
+// applyHistory reverts (undo) or replays (redo) the entries of a batch when nothing changed them later
+func (app *budgetApp) applyHistory(ctx context.Context, budget domain.Budget, batch historyBatch, undo bool) error {
+	newBatch, err := batchGenerate()
+	if err != nil {
+		return err
+	}
+
+	key := "redo"
+	description := "Se rehace un cambio"
+	entries := batch.entries
+	if undo {
+		key = "undo"
+		description = "Se deshace un cambio"
+		entries = []historyEntry{}
+		for i := len(batch.entries) - 1; i >= 0; i-- {
+			entries = append(entries, batch.entries[i])
+		}
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		for _, entry := range entries {
+			from, to := entry.before, entry.after
+			if undo {
+				from, to = to, from
+			}
+
+			if err := app.applyEntry(ctx, tx, budget, entry, from, to); err != nil {
+				return err
+			}
+
+			detail := map[string]any{
+				"batch":   newBatch,
+				key:       batch.id,
+				"section": entry.section,
+				"action":  entry.action,
+				"id":      entry.id,
+				"before":  entry.before,
+				"after":   entry.after,
+			}
+			if err := app.logApp.Create(ctx, description, shared.Budget, *budget.ID, detail, tx); err != nil {
+				return err
+			}
+		}
+
+		budgetRepo := app.budgetRepo.WithTransaction(tx)
+		updated, err := budgetRepo.Search(ctx, *budget.ID)
+		if err != nil {
+			return err
+		}
+
+		calculateTotals(&updated)
+		_, err = budgetRepo.Save(ctx, updated)
+		return err
+	})
+}
+
+func (app *budgetApp) applyEntry(ctx context.Context, tx persistent.Transaction, budget domain.Budget, entry historyEntry, from, to map[string]any) error {
+	switch entry.section {
+	case domain.Main:
+		if !matchFields(budgetFields(budget), from) {
+			return ErrHistoryConflict
+		}
+
+		newBudget, err := budgetFromDetail(*budget.ID, to)
+		if err != nil {
+			return err
+		}
+
+		_, err = app.budgetRepo.WithTransaction(tx).Save(ctx, newBudget)
+		return err
+	case domain.Available:
+		budgetAvailableRepo := app.budgetAvailableRepo.WithTransaction(tx)
+		if entry.action == shared.Delete && len(from) == 0 {
+			available, err := budgetAvailableRepo.SearchTrashed(ctx, entry.id)
+			if err != nil || available.BudgetId == nil || *available.BudgetId != *budget.ID {
+				return ErrHistoryConflict
+			}
+
+			return budgetAvailableRepo.Restore(ctx, entry.id)
+		}
+
+		available := findAvailable(budget, entry.id)
+		if available == nil || !matchFields(availableFields(*available), from) {
+			return ErrHistoryConflict
+		}
+
+		if entry.action == shared.Delete {
+			return budgetAvailableRepo.Delete(ctx, entry.id)
+		}
+
+		newAvailable, err := availableFromDetail(entry.id, to)
+		if err != nil {
+			return err
+		}
+
+		_, err = budgetAvailableRepo.Save(ctx, newAvailable)
+		return err
+	case domain.Bill:
+		budgetBillRepo := app.budgetBillRepo.WithTransaction(tx)
+		if entry.action == shared.Delete && len(from) == 0 {
+			bill, err := budgetBillRepo.SearchTrashed(ctx, entry.id)
+			if err != nil || bill.BudgetId == nil || *bill.BudgetId != *budget.ID {
+				return ErrHistoryConflict
+			}
+
+			return budgetBillRepo.Restore(ctx, entry.id)
+		}
+
+		bill := findBill(budget, entry.id)
+		if bill == nil || !matchFields(billFields(*bill), from) {
+			return ErrHistoryConflict
+		}
+
+		if entry.action == shared.Delete {
+			return budgetBillRepo.Delete(ctx, entry.id)
+		}
+
+		newBill, err := billFromDetail(entry.id, to)
+		if err != nil {
+			return err
+		}
+
+		_, err = budgetBillRepo.Save(ctx, newBill)
+		return err
+	}
+
+	return ErrHistoryConflict
+}
+
+func entryFromDetail(detail map[string]any) (historyEntry, error) {
+	id, err := convert.AsUint64(detail["id"])
+	if err != nil {
+		return historyEntry{}, err
+	}
+
+	before, _ := detail["before"].(map[string]any)
+	after, _ := detail["after"].(map[string]any)
+	return historyEntry{
+		section: domain.BudgetSection(fmt.Sprint(detail["section"])),
+		action:  shared.Action(fmt.Sprint(detail["action"])),
+		id:      uint(id),
+		before:  before,
+		after:   after,
+	}, nil
+}
+
+func removeBatch(batches []historyBatch, id string) []historyBatch {
+	result := []historyBatch{}
+	for _, batch := range batches {
+		if batch.id != id {
+			result = append(result, batch)
+		}
+	}
+
+	return result
+}
+
+// matchFields compares the values as text because the logs bring back the numbers as float64
+func matchFields(current, expected map[string]any) bool {
+	for key, value := range expected {
+		if fmt.Sprint(current[key]) != fmt.Sprint(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pickFields keeps the fields of the source that are present in the keys
+func pickFields(source, keys map[string]any) map[string]any {
+	fields := map[string]any{}
+	for key := range keys {
+		if value, ok := source[key]; ok {
+			fields[key] = value
+		}
+	}
+
+	return fields
+}
+
+func budgetFields(budget domain.Budget) map[string]any {
+	fields := map[string]any{}
+	if budget.Name != nil {
+		fields["name"] = *budget.Name
+	}
+
+	if budget.Year != nil {
+		fields["year"] = *budget.Year
+	}
+
+	if budget.Month != nil {
+		fields["month"] = *budget.Month
+	}
+
+	if budget.FixedIncome != nil {
+		fields["fixedIncome"] = *budget.FixedIncome
+	}
+
+	if budget.AdditionalIncome != nil {
+		fields["additionalIncome"] = *budget.AdditionalIncome
+	}
+
+	return fields
+}
+
+func availableFields(available domain.BudgetAvailable) map[string]any {
+	fields := map[string]any{}
+	if available.Name != nil {
+		fields["name"] = *available.Name
+	}
+
+	if available.Amount != nil {
+		fields["amount"] = *available.Amount
+	}
+
+	return fields
+}
+
+func billFields(bill domain.BudgetBill) map[string]any {
+	fields := map[string]any{}
+	if bill.Description != nil {
+		fields["description"] = *bill.Description
+	}
+
+	if bill.Amount != nil {
+		fields["amount"] = *bill.Amount
+	}
+
+	if bill.DueDate != nil {
+		fields["dueDate"] = *bill.DueDate
+	}
+
+	if bill.Complete != nil {
+		fields["complete"] = *bill.Complete
+	}
+
+	if bill.Category != nil {
+		fields["category"] = string(*bill.Category)
+	}
+
+	return fields
+}
+
+func findAvailable(budget domain.Budget, id uint) *domain.BudgetAvailable {
+	for _, available := range budget.BudgetAvailables {
+		if available.ID != nil && *available.ID == id {
+			return &available
+		}
+	}
+
+	return nil
+}
+
+func findBill(budget domain.Budget, id uint) *domain.BudgetBill {
+	for _, bill := range budget.BudgetBills {
+		if bill.ID != nil && *bill.ID == id {
+			return &bill
+		}
+	}
+
+	return nil
+}
+
+var batchGenerate = func() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
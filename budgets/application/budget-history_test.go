@@ -0,0 +1,302 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_shared "your-accounts-api/mocks/shared/domain"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestBudgetHistorySuite struct {
+	suite.Suite
+	budgetId                uint
+	itemId                  uint
+	mockTransactionManager  *mocks_persistent.MockTransactionManager
+	mockBudgetRepo          *mocks_domain.MockBudgetRepository
+	mockBudgetAvailableRepo *mocks_domain.MockBudgetAvailableRepository
+	mockBudgetBillRepo      *mocks_domain.MockBudgetBillRepository
+	mockLogApp              *mocks_application.MockILogApp
+	app                     IBudgetApp
+	ctx                     context.Context
+}
+
+func (suite *TestBudgetHistorySuite) SetupSuite() {
+	suite.budgetId = 1
+	suite.itemId = 2
+	suite.ctx = context.Background()
+}
+
+func (suite *TestBudgetHistorySuite) SetupTest() {
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockBudgetRepo = mocks_domain.NewMockBudgetRepository(suite.T())
+	suite.mockBudgetAvailableRepo = mocks_domain.NewMockBudgetAvailableRepository(suite.T())
+	suite.mockBudgetBillRepo = mocks_domain.NewMockBudgetBillRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.app = NewBudgetApp(
+		suite.mockTransactionManager, suite.mockBudgetRepo, suite.mockBudgetAvailableRepo, suite.mockBudgetBillRepo, suite.mockLogApp,
+		mocks_shared.NewMockTimezoneReader(suite.T()), mocks_domain.NewMockBudgetSnapshotRepository(suite.T()),
+		mocks_domain.NewMockBudgetEnvelopeRepository(suite.T()), mocks_application.NewMockIEventApp(suite.T()),
+		NewBudgetStreamApp(suite.mockBudgetRepo, mocks_shared.NewMockPubSub(suite.T())),
+	)
+}
+
+func (suite *TestBudgetHistorySuite) budget(amount float64) domain.Budget {
+	name := "Test"
+	return domain.Budget{
+		ID: &suite.budgetId,
+		BudgetAvailables: []domain.BudgetAvailable{
+			{
+				ID:       &suite.itemId,
+				Name:     &name,
+				Amount:   &amount,
+				BudgetId: &suite.budgetId,
+			},
+		},
+	}
+}
+
+// log builds the detail of a change as it is read back from the database
+func (suite *TestBudgetHistorySuite) log(batch string, section domain.BudgetSection, action shared.Action, before, after map[string]any) shared.Log {
+	return shared.Log{
+		Detail: map[string]any{
+			"batch":   batch,
+			"section": string(section),
+			"action":  string(action),
+			"id":      float64(suite.itemId),
+			"before":  before,
+			"after":   after,
+		},
+	}
+}
+
+func (suite *TestBudgetHistorySuite) mockApply() {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(suite.budgetId, nil)
+}
+
+func (suite *TestBudgetHistorySuite) TestUndoSuccess() {
+	require := require.New(suite.T())
+	logs := []shared.Log{
+		suite.log("a", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 10000.0}),
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(10000), nil)
+	suite.mockLogApp.On("FindByProjectWithDetail", suite.ctx, shared.Budget, suite.budgetId, "batch").Return(logs, nil)
+	suite.mockApply()
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.MatchedBy(func(available domain.BudgetAvailable) bool {
+		return *available.ID == suite.itemId && *available.Amount == 500 && available.Name == nil
+	})).Return(suite.itemId, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se deshace un cambio", shared.Budget, suite.budgetId, mock.MatchedBy(func(detail map[string]any) bool {
+		return detail["undo"] == "a" && detail["batch"] != "a"
+	}), nil).Return(nil)
+
+	err := suite.app.Undo(suite.ctx, suite.budgetId)
+
+	require.NoError(err)
+}
+
+func (suite *TestBudgetHistorySuite) TestUndoSuccessInterleavedBatches() {
+	require := require.New(suite.T())
+	logs := []shared.Log{
+		suite.log("b", domain.Available, shared.Update, map[string]any{"amount": 100.0}, map[string]any{"amount": 500.0}),
+		suite.log("a", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 10000.0}),
+		suite.log("b", domain.Main, shared.Update, map[string]any{"fixedIncome": 1.0}, map[string]any{}),
+		suite.log("a", domain.Main, shared.Update, map[string]any{"name": "Old"}, map[string]any{}),
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(10000), nil)
+	suite.mockLogApp.On("FindByProjectWithDetail", suite.ctx, shared.Budget, suite.budgetId, "batch").Return(logs, nil)
+	suite.mockApply()
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.MatchedBy(func(available domain.BudgetAvailable) bool {
+		return *available.ID == suite.itemId && *available.Amount == 500
+	})).Return(suite.itemId, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se deshace un cambio", shared.Budget, suite.budgetId, mock.MatchedBy(func(detail map[string]any) bool {
+		return detail["undo"] == "a"
+	}), nil).Return(nil).Twice()
+
+	err := suite.app.Undo(suite.ctx, suite.budgetId)
+
+	require.NoError(err)
+}
+
+func (suite *TestBudgetHistorySuite) TestUndoSuccessRestore() {
+	require := require.New(suite.T())
+	logs := []shared.Log{
+		suite.log("a", domain.Bill, shared.Delete, map[string]any{"description": "Test", "amount": 100.0}, map[string]any{}),
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(0), nil)
+	suite.mockLogApp.On("FindByProjectWithDetail", suite.ctx, shared.Budget, suite.budgetId, "batch").Return(logs, nil)
+	suite.mockApply()
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("SearchTrashed", suite.ctx, suite.itemId).Return(domain.BudgetBill{ID: &suite.itemId, BudgetId: &suite.budgetId}, nil)
+	suite.mockBudgetBillRepo.On("Restore", suite.ctx, suite.itemId).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se deshace un cambio", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.Undo(suite.ctx, suite.budgetId)
+
+	require.NoError(err)
+}
+
+func (suite *TestBudgetHistorySuite) TestUndoErrorNothingToUndo() {
+	require := require.New(suite.T())
+	undoLog := suite.log("b", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 10000.0})
+	undoLog.Detail["undo"] = "a"
+	logs := []shared.Log{
+		suite.log("a", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 10000.0}),
+		undoLog,
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(500), nil)
+	suite.mockLogApp.On("FindByProjectWithDetail", suite.ctx, shared.Budget, suite.budgetId, "batch").Return(logs, nil)
+
+	err := suite.app.Undo(suite.ctx, suite.budgetId)
+
+	require.EqualError(ErrNothingToUndo, err.Error())
+}
+
+func (suite *TestBudgetHistorySuite) TestUndoErrorConflict() {
+	require := require.New(suite.T())
+	logs := []shared.Log{
+		suite.log("a", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 10000.0}),
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(700), nil)
+	suite.mockLogApp.On("FindByProjectWithDetail", suite.ctx, shared.Budget, suite.budgetId, "batch").Return(logs, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+
+	err := suite.app.Undo(suite.ctx, suite.budgetId)
+
+	require.EqualError(ErrHistoryConflict, err.Error())
+}
+
+func (suite *TestBudgetHistorySuite) TestUndoErrorLogs() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(0), nil)
+	suite.mockLogApp.On("FindByProjectWithDetail", suite.ctx, shared.Budget, suite.budgetId, "batch").Return(nil, gorm.ErrInvalidField)
+
+	err := suite.app.Undo(suite.ctx, suite.budgetId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestBudgetHistorySuite) TestUndoErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.Undo(suite.ctx, suite.budgetId)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+}
+
+func (suite *TestBudgetHistorySuite) TestRedoSuccess() {
+	require := require.New(suite.T())
+	undoLog := suite.log("b", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 10000.0})
+	undoLog.Detail["undo"] = "a"
+	logs := []shared.Log{
+		suite.log("a", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 10000.0}),
+		undoLog,
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(500), nil)
+	suite.mockLogApp.On("FindByProjectWithDetail", suite.ctx, shared.Budget, suite.budgetId, "batch").Return(logs, nil)
+	suite.mockApply()
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.MatchedBy(func(available domain.BudgetAvailable) bool {
+		return *available.ID == suite.itemId && *available.Amount == 10000
+	})).Return(suite.itemId, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se rehace un cambio", shared.Budget, suite.budgetId, mock.MatchedBy(func(detail map[string]any) bool {
+		return detail["redo"] == "a"
+	}), nil).Return(nil)
+
+	err := suite.app.Redo(suite.ctx, suite.budgetId)
+
+	require.NoError(err)
+}
+
+func (suite *TestBudgetHistorySuite) TestRedoSuccessDelete() {
+	require := require.New(suite.T())
+	undoLog := suite.log("b", domain.Available, shared.Delete, map[string]any{"name": "Test", "amount": 300.0}, map[string]any{})
+	undoLog.Detail["undo"] = "a"
+	logs := []shared.Log{
+		suite.log("a", domain.Available, shared.Delete, map[string]any{"name": "Test", "amount": 300.0}, map[string]any{}),
+		undoLog,
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(300), nil)
+	suite.mockLogApp.On("FindByProjectWithDetail", suite.ctx, shared.Budget, suite.budgetId, "batch").Return(logs, nil)
+	suite.mockApply()
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("Delete", suite.ctx, suite.itemId).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se rehace un cambio", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.Redo(suite.ctx, suite.budgetId)
+
+	require.NoError(err)
+}
+
+func (suite *TestBudgetHistorySuite) TestRedoErrorNothingToRedo() {
+	require := require.New(suite.T())
+	logs := []shared.Log{
+		suite.log("a", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 10000.0}),
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(10000), nil)
+	suite.mockLogApp.On("FindByProjectWithDetail", suite.ctx, shared.Budget, suite.budgetId, "batch").Return(logs, nil)
+
+	err := suite.app.Redo(suite.ctx, suite.budgetId)
+
+	require.EqualError(ErrNothingToRedo, err.Error())
+}
+
+func (suite *TestBudgetHistorySuite) TestRedoErrorConflict() {
+	require := require.New(suite.T())
+	undoLog := suite.log("b", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 10000.0})
+	undoLog.Detail["undo"] = "a"
+	logs := []shared.Log{
+		suite.log("a", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 10000.0}),
+		undoLog,
+	}
+	// Changed outside of the history, as a restored snapshot
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(700), nil)
+	suite.mockLogApp.On("FindByProjectWithDetail", suite.ctx, shared.Budget, suite.budgetId, "batch").Return(logs, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+
+	err := suite.app.Redo(suite.ctx, suite.budgetId)
+
+	require.EqualError(ErrHistoryConflict, err.Error())
+}
+
+func (suite *TestBudgetHistorySuite) TestRedoErrorAfterNewChange() {
+	require := require.New(suite.T())
+	undoLog := suite.log("b", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 10000.0})
+	undoLog.Detail["undo"] = "a"
+	logs := []shared.Log{
+		suite.log("a", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 10000.0}),
+		undoLog,
+		suite.log("c", domain.Available, shared.Update, map[string]any{"amount": 500.0}, map[string]any{"amount": 700.0}),
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(700), nil)
+	suite.mockLogApp.On("FindByProjectWithDetail", suite.ctx, shared.Budget, suite.budgetId, "batch").Return(logs, nil)
+
+	err := suite.app.Redo(suite.ctx, suite.budgetId)
+
+	require.EqualError(ErrNothingToRedo, err.Error())
+}
+
+func TestTestBudgetHistorySuite(t *testing.T) {
+	suite.Run(t, new(TestBudgetHistorySuite))
+}
@@ -0,0 +1,478 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/config"
+
+	"gorm.io/gorm"
+)
+
+const automaticSnapshotDescription = "Cambios en el presupuesto"
+
+var ErrSnapshotLinkedBills = errors.New("the bills created after the snapshot are linked to a loan, a credit card or a split")
+
+type FieldChange struct {
+	Field string
+	From  any
+	To    any
+}
+
+type ItemChange struct {
+	ID     uint
+	Fields []FieldChange
+}
+
+type SnapshotDiff struct {
+	From              domain.BudgetSnapshot
+	To                domain.BudgetSnapshot
+	Main              []FieldChange
+	AvailablesAdded   []domain.BudgetAvailable
+	AvailablesRemoved []domain.BudgetAvailable
+	AvailablesChanged []ItemChange
+	BillsAdded        []domain.BudgetBill
+	BillsRemoved      []domain.BudgetBill
+	BillsChanged      []ItemChange
+}
+
+type IBudgetSnapshotApp interface {
+	Create(ctx context.Context, budgetId uint, description string) (uint, error)
+	FindByBudgetId(ctx context.Context, budgetId uint) ([]domain.BudgetSnapshot, error)
+	FindById(ctx context.Context, budgetId, id uint) (domain.BudgetSnapshot, error)
+	Diff(ctx context.Context, budgetId, fromId, toId uint) (SnapshotDiff, error)
+	Restore(ctx context.Context, budgetId, id uint) error
+}
+
+type budgetSnapshotApp struct {
+	tm                  persistent.TransactionManager
+	budgetSnapshotRepo  domain.BudgetSnapshotRepository
+	budgetRepo          domain.BudgetRepository
+	budgetAvailableRepo domain.BudgetAvailableRepository
+	budgetBillRepo      domain.BudgetBillRepository
+	budgetEnvelopeRepo  domain.BudgetEnvelopeRepository
+	billSplitRepo       domain.BillSplitRepository
+	logApp              application.ILogApp
+}
+
+func (app *budgetSnapshotApp) Create(ctx context.Context, budgetId uint, description string) (uint, error) {
+	budget, err := app.budgetRepo.Search(ctx, budgetId)
+	if err != nil {
+		return 0, err
+	}
+
+	envelopes, err := app.budgetEnvelopeRepo.SearchAllByBudgetId(ctx, budgetId)
+	if err != nil {
+		return 0, err
+	}
+
+	var id uint
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		budgetSnapshotRepo := app.budgetSnapshotRepo.WithTransaction(tx)
+		var err error
+		id, err = budgetSnapshotRepo.Save(ctx, domain.BudgetSnapshot{
+			BudgetId:    budgetId,
+			Manual:      true,
+			Description: description,
+			Budget:      budget,
+			Envelopes:   envelopes,
+		})
+		if err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"snapshotId":  id,
+			"description": description,
+		}
+		return app.logApp.Create(ctx, "Se crea una instantánea del presupuesto", shared.Budget, budgetId, detail, tx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (app *budgetSnapshotApp) FindByBudgetId(ctx context.Context, budgetId uint) ([]domain.BudgetSnapshot, error) {
+	if _, err := app.budgetRepo.Search(ctx, budgetId); err != nil {
+		return nil, err
+	}
+
+	return app.budgetSnapshotRepo.SearchAllByBudgetId(ctx, budgetId)
+}
+
+func (app *budgetSnapshotApp) FindById(ctx context.Context, budgetId, id uint) (domain.BudgetSnapshot, error) {
+	return app.budgetSnapshotRepo.SearchByBudgetIdAndId(ctx, budgetId, id)
+}
+
+func (app *budgetSnapshotApp) Diff(ctx context.Context, budgetId, fromId, toId uint) (SnapshotDiff, error) {
+	from, err := app.budgetSnapshotRepo.SearchByBudgetIdAndId(ctx, budgetId, fromId)
+	if err != nil {
+		return SnapshotDiff{}, err
+	}
+
+	to, err := app.budgetSnapshotRepo.SearchByBudgetIdAndId(ctx, budgetId, toId)
+	if err != nil {
+		return SnapshotDiff{}, err
+	}
+
+	diff := SnapshotDiff{
+		From:              from,
+		To:                to,
+		Main:              diffMain(from.Budget, to.Budget),
+		AvailablesAdded:   []domain.BudgetAvailable{},
+		AvailablesRemoved: []domain.BudgetAvailable{},
+		AvailablesChanged: []ItemChange{},
+		BillsAdded:        []domain.BudgetBill{},
+		BillsRemoved:      []domain.BudgetBill{},
+		BillsChanged:      []ItemChange{},
+	}
+
+	fromAvailables := map[uint]domain.BudgetAvailable{}
+	for _, available := range from.Budget.BudgetAvailables {
+		fromAvailables[*available.ID] = available
+	}
+	for _, available := range to.Budget.BudgetAvailables {
+		previous, ok := fromAvailables[*available.ID]
+		if !ok {
+			diff.AvailablesAdded = append(diff.AvailablesAdded, available)
+			continue
+		}
+
+		delete(fromAvailables, *available.ID)
+		fields := []FieldChange{}
+		fields = appendFieldChange(fields, "name", *previous.Name, *available.Name)
+		fields = appendFieldChange(fields, "amount", *previous.Amount, *available.Amount)
+		if len(fields) > 0 {
+			diff.AvailablesChanged = append(diff.AvailablesChanged, ItemChange{*available.ID, fields})
+		}
+	}
+	for _, available := range from.Budget.BudgetAvailables {
+		if _, ok := fromAvailables[*available.ID]; ok {
+			diff.AvailablesRemoved = append(diff.AvailablesRemoved, available)
+		}
+	}
+
+	fromBills := map[uint]domain.BudgetBill{}
+	for _, bill := range from.Budget.BudgetBills {
+		fromBills[*bill.ID] = bill
+	}
+	for _, bill := range to.Budget.BudgetBills {
+		previous, ok := fromBills[*bill.ID]
+		if !ok {
+			diff.BillsAdded = append(diff.BillsAdded, bill)
+			continue
+		}
+
+		delete(fromBills, *bill.ID)
+		fields := []FieldChange{}
+		fields = appendFieldChange(fields, "description", *previous.Description, *bill.Description)
+		fields = appendFieldChange(fields, "amount", *previous.Amount, *bill.Amount)
+		fields = appendFieldChange(fields, "payment", *previous.Payment, *bill.Payment)
+		fields = appendFieldChange(fields, "dueDate", *previous.DueDate, *bill.DueDate)
+		fields = appendFieldChange(fields, "complete", *previous.Complete, *bill.Complete)
+		fields = appendFieldChange(fields, "category", *previous.Category, *bill.Category)
+		if len(fields) > 0 {
+			diff.BillsChanged = append(diff.BillsChanged, ItemChange{*bill.ID, fields})
+		}
+	}
+	for _, bill := range from.Budget.BudgetBills {
+		if _, ok := fromBills[*bill.ID]; ok {
+			diff.BillsRemoved = append(diff.BillsRemoved, bill)
+		}
+	}
+
+	return diff, nil
+}
+
+// Restore returns the budget to the state of the snapshot, the current state is kept
+// first in an automatic snapshot so the restoration can be undone. It's refused when a bill
+// created after the snapshot belongs to a loan, a credit card or a split.
+func (app *budgetSnapshotApp) Restore(ctx context.Context, budgetId, id uint) error {
+	snapshot, err := app.budgetSnapshotRepo.SearchByBudgetIdAndId(ctx, budgetId, id)
+	if err != nil {
+		return err
+	}
+
+	current, err := app.budgetRepo.Search(ctx, budgetId)
+	if err != nil {
+		return err
+	}
+
+	if err := app.checkLinkedBills(ctx, budgetId, current.BudgetBills, snapshot.Budget.BudgetBills); err != nil {
+		return err
+	}
+
+	envelopes, err := app.budgetEnvelopeRepo.SearchAllByBudgetId(ctx, budgetId)
+	if err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		budgetSnapshotRepo := app.budgetSnapshotRepo.WithTransaction(tx)
+		description := fmt.Sprintf("Antes de restaurar la instantánea %d", id)
+		if err := saveAutomaticSnapshot(ctx, budgetSnapshotRepo, current, envelopes, description); err != nil {
+			return err
+		}
+
+		budget := snapshot.Budget
+		budgetRepo := app.budgetRepo.WithTransaction(tx)
+		if _, err := budgetRepo.Save(ctx, domain.Budget{
+			ID:               &budgetId,
+			Name:             budget.Name,
+			Year:             budget.Year,
+			Month:            budget.Month,
+			FixedIncome:      budget.FixedIncome,
+			AdditionalIncome: budget.AdditionalIncome,
+			TotalPending:     budget.TotalPending,
+			TotalAvailable:   budget.TotalAvailable,
+			PendingBills:     budget.PendingBills,
+			ZeroBased:        budget.ZeroBased,
+			TotalAssigned:    budget.TotalAssigned,
+			ToBeAssigned:     budget.ToBeAssigned,
+		}); err != nil {
+			return err
+		}
+
+		if err := app.restoreAvailables(ctx, current.BudgetAvailables, budget.BudgetAvailables, tx); err != nil {
+			return err
+		}
+
+		billIds, err := app.restoreBills(ctx, current.BudgetBills, budget.BudgetBills, tx)
+		if err != nil {
+			return err
+		}
+
+		if err := app.restoreEnvelopes(ctx, envelopes, snapshot.Envelopes, billIds, tx); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"snapshotId": id,
+			"createdAt":  snapshot.CreatedAt,
+		}
+		return app.logApp.Create(ctx, "Se restaura una instantánea del presupuesto", shared.Budget, budgetId, detail, tx)
+	})
+}
+
+// saveAutomaticSnapshot isn't logged to not displace the changes from the log, only the
+// newest automatic snapshots are kept.
+func saveAutomaticSnapshot(
+	ctx context.Context, budgetSnapshotRepo domain.BudgetSnapshotRepository, budget domain.Budget, envelopes []domain.BudgetEnvelope, description string,
+) error {
+	if _, err := budgetSnapshotRepo.Save(ctx, domain.BudgetSnapshot{
+		BudgetId:    *budget.ID,
+		Description: description,
+		Budget:      budget,
+		Envelopes:   envelopes,
+	}); err != nil {
+		return err
+	}
+
+	return budgetSnapshotRepo.DeleteAutomaticByBudgetIdExceedingLimit(ctx, *budget.ID, config.BUDGET_SNAPSHOT_LIMIT)
+}
+
+// checkLinkedBills refuses to trash the bills created after the snapshot that a loan
+// installment, a credit card statement or a split points at
+func (app *budgetSnapshotApp) checkLinkedBills(ctx context.Context, budgetId uint, current, snapshot []domain.BudgetBill) error {
+	created := map[uint]bool{}
+	for _, bill := range current {
+		created[*bill.ID] = true
+	}
+
+	for _, bill := range snapshot {
+		delete(created, *bill.ID)
+	}
+
+	if len(created) == 0 {
+		return nil
+	}
+
+	linkedIds, err := app.budgetBillRepo.SearchAllLinkedIdsByBudgetId(ctx, budgetId)
+	if err != nil {
+		return err
+	}
+
+	for _, linkedId := range linkedIds {
+		if created[linkedId] {
+			return ErrSnapshotLinkedBills
+		}
+	}
+
+	splits, err := app.billSplitRepo.SearchAllSplits(ctx, budgetId)
+	if err != nil {
+		return err
+	}
+
+	for _, split := range splits {
+		if created[split.BudgetBillId] {
+			return ErrSnapshotLinkedBills
+		}
+	}
+
+	return nil
+}
+
+// restoreAvailables updates the availables that still exist, restores the soft deleted ones,
+// creates again the ones already purged and deletes the ones created after the snapshot.
+func (app *budgetSnapshotApp) restoreAvailables(ctx context.Context, current, snapshot []domain.BudgetAvailable, tx persistent.Transaction) error {
+	budgetAvailableRepo := app.budgetAvailableRepo.WithTransaction(tx)
+	existing := map[uint]bool{}
+	for _, available := range current {
+		existing[*available.ID] = true
+	}
+
+	for _, available := range snapshot {
+		if existing[*available.ID] {
+			delete(existing, *available.ID)
+		} else if _, err := budgetAvailableRepo.SearchTrashed(ctx, *available.ID); err == nil {
+			if err := budgetAvailableRepo.Restore(ctx, *available.ID); err != nil {
+				return err
+			}
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			available.ID = nil
+		} else {
+			return err
+		}
+
+		if _, err := budgetAvailableRepo.Save(ctx, available); err != nil {
+			return err
+		}
+	}
+
+	for _, available := range current {
+		if !existing[*available.ID] {
+			continue
+		}
+
+		if err := budgetAvailableRepo.Delete(ctx, *available.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreBills follows the same rules of restoreAvailables, it returns the new IDs of the
+// bills created again by their IDs in the snapshot.
+func (app *budgetSnapshotApp) restoreBills(ctx context.Context, current, snapshot []domain.BudgetBill, tx persistent.Transaction) (map[uint]uint, error) {
+	budgetBillRepo := app.budgetBillRepo.WithTransaction(tx)
+	existing := map[uint]bool{}
+	for _, bill := range current {
+		existing[*bill.ID] = true
+	}
+
+	created := map[uint]uint{}
+	for _, bill := range snapshot {
+		snapshotId := *bill.ID
+		if existing[snapshotId] {
+			delete(existing, snapshotId)
+		} else if _, err := budgetBillRepo.SearchTrashed(ctx, snapshotId); err == nil {
+			if err := budgetBillRepo.Restore(ctx, snapshotId); err != nil {
+				return nil, err
+			}
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			bill.ID = nil
+		} else {
+			return nil, err
+		}
+
+		id, err := budgetBillRepo.Save(ctx, bill)
+		if err != nil {
+			return nil, err
+		}
+
+		if id != snapshotId {
+			created[snapshotId] = id
+		}
+	}
+
+	for _, bill := range current {
+		if !existing[*bill.ID] {
+			continue
+		}
+
+		if err := budgetBillRepo.Delete(ctx, *bill.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return created, nil
+}
+
+// restoreEnvelopes updates the money of the envelopes that still exist, creates again the
+// deleted ones pointing at the new IDs of their bills and deletes the ones created after the
+// snapshot.
+func (app *budgetSnapshotApp) restoreEnvelopes(ctx context.Context, current, snapshot []domain.BudgetEnvelope, billIds map[uint]uint, tx persistent.Transaction) error {
+	budgetEnvelopeRepo := app.budgetEnvelopeRepo.WithTransaction(tx)
+	existing := map[uint]bool{}
+	for _, envelope := range current {
+		existing[envelope.ID] = true
+	}
+
+	for _, envelope := range snapshot {
+		if existing[envelope.ID] {
+			delete(existing, envelope.ID)
+		} else {
+			envelope.ID = 0
+			if envelope.BudgetBillId != nil {
+				if billId, ok := billIds[*envelope.BudgetBillId]; ok {
+					envelope.BudgetBillId = &billId
+				}
+			}
+		}
+
+		if _, err := budgetEnvelopeRepo.Save(ctx, envelope); err != nil {
+			return err
+		}
+	}
+
+	for _, envelope := range current {
+		if !existing[envelope.ID] {
+			continue
+		}
+
+		if err := budgetEnvelopeRepo.Delete(ctx, envelope.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func diffMain(from, to domain.Budget) []FieldChange {
+	fields := []FieldChange{}
+	fields = appendFieldChange(fields, "name", *from.Name, *to.Name)
+	fields = appendFieldChange(fields, "year", *from.Year, *to.Year)
+	fields = appendFieldChange(fields, "month", *from.Month, *to.Month)
+	fields = appendFieldChange(fields, "fixedIncome", *from.FixedIncome, *to.FixedIncome)
+	fields = appendFieldChange(fields, "additionalIncome", *from.AdditionalIncome, *to.AdditionalIncome)
+	fields = appendFieldChange(fields, "totalAvailable", *from.TotalAvailable, *to.TotalAvailable)
+	fields = appendFieldChange(fields, "totalPending", *from.TotalPending, *to.TotalPending)
+	fields = appendFieldChange(fields, "pendingBills", *from.PendingBills, *to.PendingBills)
+	fields = appendFieldChange(fields, "zeroBased", *from.ZeroBased, *to.ZeroBased)
+	fields = appendFieldChange(fields, "totalAssigned", *from.TotalAssigned, *to.TotalAssigned)
+	fields = appendFieldChange(fields, "toBeAssigned", *from.ToBeAssigned, *to.ToBeAssigned)
+	return fields
+}
+
+func appendFieldChange(fields []FieldChange, field string, from, to any) []FieldChange {
+	if from == to {
+		return fields
+	}
+
+	return append(fields, FieldChange{field, from, to})
+}
+
+func NewBudgetSnapshotApp(
+	tm persistent.TransactionManager, budgetSnapshotRepo domain.BudgetSnapshotRepository, budgetRepo domain.BudgetRepository,
+	budgetAvailableRepo domain.BudgetAvailableRepository, budgetBillRepo domain.BudgetBillRepository, budgetEnvelopeRepo domain.BudgetEnvelopeRepository,
+	billSplitRepo domain.BillSplitRepository, logApp application.ILogApp,
+) IBudgetSnapshotApp {
+	return &budgetSnapshotApp{tm, budgetSnapshotRepo, budgetRepo, budgetAvailableRepo, budgetBillRepo, budgetEnvelopeRepo, billSplitRepo, logApp}
+}
@@ -0,0 +1,476 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestBudgetSnapshotSuite struct {
+	suite.Suite
+	budgetId                uint
+	snapshotId              uint
+	mockTransactionManager  *mocks_persistent.MockTransactionManager
+	mockBudgetSnapshotRepo  *mocks_domain.MockBudgetSnapshotRepository
+	mockBudgetRepo          *mocks_domain.MockBudgetRepository
+	mockBudgetAvailableRepo *mocks_domain.MockBudgetAvailableRepository
+	mockBudgetBillRepo      *mocks_domain.MockBudgetBillRepository
+	mockBudgetEnvelopeRepo  *mocks_domain.MockBudgetEnvelopeRepository
+	mockBillSplitRepo       *mocks_domain.MockBillSplitRepository
+	mockLogApp              *mocks_application.MockILogApp
+	app                     IBudgetSnapshotApp
+	ctx                     context.Context
+}
+
+func (suite *TestBudgetSnapshotSuite) SetupSuite() {
+	suite.budgetId = 1
+	suite.snapshotId = 2
+	suite.ctx = context.Background()
+}
+
+func (suite *TestBudgetSnapshotSuite) SetupTest() {
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockBudgetSnapshotRepo = mocks_domain.NewMockBudgetSnapshotRepository(suite.T())
+	suite.mockBudgetRepo = mocks_domain.NewMockBudgetRepository(suite.T())
+	suite.mockBudgetAvailableRepo = mocks_domain.NewMockBudgetAvailableRepository(suite.T())
+	suite.mockBudgetBillRepo = mocks_domain.NewMockBudgetBillRepository(suite.T())
+	suite.mockBudgetEnvelopeRepo = mocks_domain.NewMockBudgetEnvelopeRepository(suite.T())
+	suite.mockBillSplitRepo = mocks_domain.NewMockBillSplitRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.app = NewBudgetSnapshotApp(
+		suite.mockTransactionManager, suite.mockBudgetSnapshotRepo, suite.mockBudgetRepo, suite.mockBudgetAvailableRepo, suite.mockBudgetBillRepo,
+		suite.mockBudgetEnvelopeRepo, suite.mockBillSplitRepo, suite.mockLogApp,
+	)
+}
+
+func (suite *TestBudgetSnapshotSuite) mockTransaction() {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+}
+
+// newBudget builds a budget with the availables amounts and the bills completion by ID,
+// the IDs identify the items between snapshots.
+func (suite *TestBudgetSnapshotSuite) newBudget(name string, fixedIncome float64, availables map[uint]float64, bills map[uint]bool) domain.Budget {
+	year, month := uint16(2024), uint8(5)
+	additionalIncome, totalPending, totalAvailable, pendingBills := 0.0, 0.0, 0.0, uint8(0)
+	zeroBased, totalAssigned, toBeAssigned := false, 0.0, 0.0
+	budget := domain.Budget{
+		ID:               &suite.budgetId,
+		Name:             &name,
+		Year:             &year,
+		Month:            &month,
+		FixedIncome:      &fixedIncome,
+		AdditionalIncome: &additionalIncome,
+		TotalPending:     &totalPending,
+		TotalAvailable:   &totalAvailable,
+		PendingBills:     &pendingBills,
+		ZeroBased:        &zeroBased,
+		TotalAssigned:    &totalAssigned,
+		ToBeAssigned:     &toBeAssigned,
+		BudgetAvailables: []domain.BudgetAvailable{},
+		BudgetBills:      []domain.BudgetBill{},
+	}
+
+	for _, id := range []uint{1, 2, 3} {
+		if amount, ok := availables[id]; ok {
+			availableId, availableName, availableAmount := id, "Available", amount
+			budget.BudgetAvailables = append(budget.BudgetAvailables, domain.BudgetAvailable{
+				ID:       &availableId,
+				Name:     &availableName,
+				Amount:   &availableAmount,
+				BudgetId: &suite.budgetId,
+			})
+		}
+
+		if complete, ok := bills[id]; ok {
+			billId, description, amount, payment, dueDate, billComplete, category := id, "Bill", 10.0, 0.0, uint8(1), complete, domain.House
+			budget.BudgetBills = append(budget.BudgetBills, domain.BudgetBill{
+				ID:          &billId,
+				Description: &description,
+				Amount:      &amount,
+				Payment:     &payment,
+				DueDate:     &dueDate,
+				Complete:    &billComplete,
+				BudgetId:    &suite.budgetId,
+				Category:    &category,
+			})
+		}
+	}
+
+	return budget
+}
+
+// newEnvelopes builds the envelopes of the bills with the assigned amounts by ID
+func (suite *TestBudgetSnapshotSuite) newEnvelopes(assigned map[uint]float64) []domain.BudgetEnvelope {
+	envelopes := []domain.BudgetEnvelope{}
+	for _, id := range []uint{1, 2, 3} {
+		if amount, ok := assigned[id]; ok {
+			billId := id
+			envelopes = append(envelopes, domain.BudgetEnvelope{
+				ID:           id,
+				BudgetId:     suite.budgetId,
+				Name:         "Bill",
+				BudgetBillId: &billId,
+				Assigned:     amount,
+			})
+		}
+	}
+
+	return envelopes
+}
+
+func (suite *TestBudgetSnapshotSuite) TestCreateSuccess() {
+	require := require.New(suite.T())
+	budget := suite.newBudget("Test", 100, nil, map[uint]bool{1: false})
+	envelopes := suite.newEnvelopes(map[uint]float64{1: 10})
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget, nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(envelopes, nil)
+	suite.mockTransaction()
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, domain.BudgetSnapshot{
+		BudgetId:    suite.budgetId,
+		Manual:      true,
+		Description: "Before moving",
+		Budget:      budget,
+		Envelopes:   envelopes,
+	}).Return(suite.snapshotId, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se crea una instantánea del presupuesto", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.Create(suite.ctx, suite.budgetId, "Before moving")
+
+	require.NoError(err)
+	require.Equal(suite.snapshotId, res)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestCreateErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	res, err := suite.app.Create(suite.ctx, suite.budgetId, "Before moving")
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+	require.Zero(res)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestCreateErrorSave() {
+	require := require.New(suite.T())
+	errExpected := errors.New("Error saving snapshot")
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.newBudget("Test", 100, nil, nil), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockTransaction()
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), errExpected)
+
+	res, err := suite.app.Create(suite.ctx, suite.budgetId, "")
+
+	require.EqualError(errExpected, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestCreateErrorSearchEnvelopes() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.newBudget("Test", 100, nil, nil), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(nil, gorm.ErrInvalidTransaction)
+
+	res, err := suite.app.Create(suite.ctx, suite.budgetId, "")
+
+	require.ErrorIs(err, gorm.ErrInvalidTransaction)
+	require.Zero(res)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestFindByBudgetIdSuccess() {
+	require := require.New(suite.T())
+	snapshots := []domain.BudgetSnapshot{
+		{ID: suite.snapshotId, BudgetId: suite.budgetId, Manual: true, CreatedAt: time.Now()},
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{}, nil)
+	suite.mockBudgetSnapshotRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(snapshots, nil)
+
+	res, err := suite.app.FindByBudgetId(suite.ctx, suite.budgetId)
+
+	require.NoError(err)
+	require.Equal(snapshots, res)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestFindByBudgetIdErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	res, err := suite.app.FindByBudgetId(suite.ctx, suite.budgetId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+	require.Nil(res)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestDiffSuccess() {
+	require := require.New(suite.T())
+	from := domain.BudgetSnapshot{
+		ID:     suite.snapshotId,
+		Budget: suite.newBudget("Test", 100, map[uint]float64{1: 10, 2: 20}, map[uint]bool{1: false, 2: false}),
+	}
+	to := domain.BudgetSnapshot{
+		ID:     suite.snapshotId + 1,
+		Budget: suite.newBudget("Test", 150, map[uint]float64{1: 15, 3: 30}, map[uint]bool{1: true, 2: false}),
+	}
+	suite.mockBudgetSnapshotRepo.On("SearchByBudgetIdAndId", suite.ctx, suite.budgetId, from.ID).Return(from, nil)
+	suite.mockBudgetSnapshotRepo.On("SearchByBudgetIdAndId", suite.ctx, suite.budgetId, to.ID).Return(to, nil)
+
+	res, err := suite.app.Diff(suite.ctx, suite.budgetId, from.ID, to.ID)
+
+	require.NoError(err)
+	require.Equal([]FieldChange{{"fixedIncome", 100.0, 150.0}}, res.Main)
+	require.Len(res.AvailablesAdded, 1)
+	require.Equal(uint(3), *res.AvailablesAdded[0].ID)
+	require.Len(res.AvailablesRemoved, 1)
+	require.Equal(uint(2), *res.AvailablesRemoved[0].ID)
+	require.Equal([]ItemChange{{1, []FieldChange{{"amount", 10.0, 15.0}}}}, res.AvailablesChanged)
+	require.Empty(res.BillsAdded)
+	require.Empty(res.BillsRemoved)
+	require.Equal([]ItemChange{{1, []FieldChange{{"complete", false, true}}}}, res.BillsChanged)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestDiffErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockBudgetSnapshotRepo.On("SearchByBudgetIdAndId", suite.ctx, suite.budgetId, suite.snapshotId).Return(domain.BudgetSnapshot{}, nil)
+	suite.mockBudgetSnapshotRepo.On("SearchByBudgetIdAndId", suite.ctx, suite.budgetId, suite.snapshotId+1).Return(domain.BudgetSnapshot{}, gorm.ErrRecordNotFound)
+
+	_, err := suite.app.Diff(suite.ctx, suite.budgetId, suite.snapshotId, suite.snapshotId+1)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestRestoreSuccess() {
+	require := require.New(suite.T())
+	oldBudget := suite.newBudget("Old", 100, map[uint]float64{1: 10, 2: 20}, map[uint]bool{1: false})
+	zeroBased, totalAssigned, toBeAssigned := true, 10.0, 90.0
+	oldBudget.ZeroBased, oldBudget.TotalAssigned, oldBudget.ToBeAssigned = &zeroBased, &totalAssigned, &toBeAssigned
+	snapshot := domain.BudgetSnapshot{
+		ID:        suite.snapshotId,
+		BudgetId:  suite.budgetId,
+		Budget:    oldBudget,
+		Envelopes: suite.newEnvelopes(map[uint]float64{1: 10}),
+	}
+	current := suite.newBudget("New", 150, map[uint]float64{1: 15, 3: 30}, map[uint]bool{1: true, 2: false})
+	envelopes := suite.newEnvelopes(map[uint]float64{1: 15, 2: 5})
+	suite.mockBudgetSnapshotRepo.On("SearchByBudgetIdAndId", suite.ctx, suite.budgetId, suite.snapshotId).Return(snapshot, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(current, nil)
+	suite.mockBudgetBillRepo.On("SearchAllLinkedIdsByBudgetId", suite.ctx, suite.budgetId).Return([]uint{1}, nil)
+	suite.mockBillSplitRepo.On("SearchAllSplits", suite.ctx, suite.budgetId).Return([]domain.BillSplit{{BudgetBillId: 1}}, nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(envelopes, nil)
+	suite.mockTransaction()
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, domain.BudgetSnapshot{
+		BudgetId:    suite.budgetId,
+		Description: "Antes de restaurar la instantánea 2",
+		Budget:      current,
+		Envelopes:   envelopes,
+	}).Return(uint(3), nil)
+	suite.mockBudgetSnapshotRepo.On("DeleteAutomaticByBudgetIdExceedingLimit", suite.ctx, suite.budgetId, mock.Anything).Return(nil)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(budget domain.Budget) bool {
+		return *budget.ID == suite.budgetId && *budget.Name == "Old" && *budget.FixedIncome == 100 &&
+			*budget.ZeroBased && *budget.TotalAssigned == 10 && *budget.ToBeAssigned == 90
+	})).Return(suite.budgetId, nil)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.MatchedBy(func(available domain.BudgetAvailable) bool {
+		return available.ID != nil && *available.ID == 1 && *available.Amount == 10
+	})).Return(uint(1), nil)
+	suite.mockBudgetAvailableRepo.On("SearchTrashed", suite.ctx, uint(2)).Return(domain.BudgetAvailable{}, gorm.ErrRecordNotFound)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.MatchedBy(func(available domain.BudgetAvailable) bool {
+		return available.ID == nil && *available.Amount == 20 && *available.BudgetId == suite.budgetId
+	})).Return(uint(4), nil)
+	suite.mockBudgetAvailableRepo.On("Delete", suite.ctx, uint(3)).Return(nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.MatchedBy(func(bill domain.BudgetBill) bool {
+		return bill.ID != nil && *bill.ID == 1 && !*bill.Complete
+	})).Return(uint(1), nil)
+	suite.mockBudgetBillRepo.On("Delete", suite.ctx, uint(2)).Return(nil)
+	suite.mockBudgetEnvelopeRepo.On("WithTransaction", nil).Return(suite.mockBudgetEnvelopeRepo)
+	suite.mockBudgetEnvelopeRepo.On("Save", suite.ctx, snapshot.Envelopes[0]).Return(uint(1), nil)
+	suite.mockBudgetEnvelopeRepo.On("Delete", suite.ctx, uint(2)).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se restaura una instantánea del presupuesto", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.Restore(suite.ctx, suite.budgetId, suite.snapshotId)
+
+	require.NoError(err)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestRestoreSuccessEnvelopeOfPurgedBill() {
+	require := require.New(suite.T())
+	snapshot := domain.BudgetSnapshot{
+		ID:        suite.snapshotId,
+		BudgetId:  suite.budgetId,
+		Budget:    suite.newBudget("Old", 100, nil, map[uint]bool{2: false}),
+		Envelopes: suite.newEnvelopes(map[uint]float64{2: 20}),
+	}
+	suite.mockBudgetSnapshotRepo.On("SearchByBudgetIdAndId", suite.ctx, suite.budgetId, suite.snapshotId).Return(snapshot, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.newBudget("New", 100, nil, nil), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockTransaction()
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, mock.Anything).Return(uint(3), nil)
+	suite.mockBudgetSnapshotRepo.On("DeleteAutomaticByBudgetIdExceedingLimit", suite.ctx, suite.budgetId, mock.Anything).Return(nil)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(suite.budgetId, nil)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("SearchTrashed", suite.ctx, uint(2)).Return(domain.BudgetBill{}, gorm.ErrRecordNotFound)
+	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.MatchedBy(func(bill domain.BudgetBill) bool {
+		return bill.ID == nil
+	})).Return(uint(5), nil)
+	suite.mockBudgetEnvelopeRepo.On("WithTransaction", nil).Return(suite.mockBudgetEnvelopeRepo)
+	suite.mockBudgetEnvelopeRepo.On("Save", suite.ctx, mock.MatchedBy(func(envelope domain.BudgetEnvelope) bool {
+		return envelope.ID == 0 && *envelope.BudgetBillId == 5 && envelope.Assigned == 20
+	})).Return(uint(6), nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se restaura una instantánea del presupuesto", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.Restore(suite.ctx, suite.budgetId, suite.snapshotId)
+
+	require.NoError(err)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestRestoreErrorLinkedBill() {
+	require := require.New(suite.T())
+	snapshot := domain.BudgetSnapshot{
+		ID:       suite.snapshotId,
+		BudgetId: suite.budgetId,
+		Budget:   suite.newBudget("Old", 100, nil, map[uint]bool{1: false}),
+	}
+	suite.mockBudgetSnapshotRepo.On("SearchByBudgetIdAndId", suite.ctx, suite.budgetId, suite.snapshotId).Return(snapshot, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.newBudget("New", 100, nil, map[uint]bool{1: false, 2: false}), nil)
+	suite.mockBudgetBillRepo.On("SearchAllLinkedIdsByBudgetId", suite.ctx, suite.budgetId).Return([]uint{2}, nil)
+
+	err := suite.app.Restore(suite.ctx, suite.budgetId, suite.snapshotId)
+
+	require.ErrorIs(err, ErrSnapshotLinkedBills)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestRestoreErrorSplitBill() {
+	require := require.New(suite.T())
+	snapshot := domain.BudgetSnapshot{
+		ID:       suite.snapshotId,
+		BudgetId: suite.budgetId,
+		Budget:   suite.newBudget("Old", 100, nil, nil),
+	}
+	suite.mockBudgetSnapshotRepo.On("SearchByBudgetIdAndId", suite.ctx, suite.budgetId, suite.snapshotId).Return(snapshot, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.newBudget("New", 100, nil, map[uint]bool{3: false}), nil)
+	suite.mockBudgetBillRepo.On("SearchAllLinkedIdsByBudgetId", suite.ctx, suite.budgetId).Return([]uint{}, nil)
+	suite.mockBillSplitRepo.On("SearchAllSplits", suite.ctx, suite.budgetId).Return([]domain.BillSplit{{BudgetBillId: 3}}, nil)
+
+	err := suite.app.Restore(suite.ctx, suite.budgetId, suite.snapshotId)
+
+	require.ErrorIs(err, ErrSnapshotLinkedBills)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestRestoreSuccessTrashed() {
+	require := require.New(suite.T())
+	snapshot := domain.BudgetSnapshot{
+		ID:       suite.snapshotId,
+		BudgetId: suite.budgetId,
+		Budget:   suite.newBudget("Old", 100, map[uint]float64{2: 20}, map[uint]bool{3: true}),
+	}
+	current := suite.newBudget("New", 100, nil, nil)
+	suite.mockBudgetSnapshotRepo.On("SearchByBudgetIdAndId", suite.ctx, suite.budgetId, suite.snapshotId).Return(snapshot, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(current, nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockTransaction()
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, mock.Anything).Return(uint(3), nil)
+	suite.mockBudgetSnapshotRepo.On("DeleteAutomaticByBudgetIdExceedingLimit", suite.ctx, suite.budgetId, mock.Anything).Return(nil)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(suite.budgetId, nil)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("SearchTrashed", suite.ctx, uint(2)).Return(domain.BudgetAvailable{ID: snapshot.Budget.BudgetAvailables[0].ID}, nil)
+	suite.mockBudgetAvailableRepo.On("Restore", suite.ctx, uint(2)).Return(nil)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.MatchedBy(func(available domain.BudgetAvailable) bool {
+		return available.ID != nil && *available.ID == 2 && *available.Amount == 20
+	})).Return(uint(2), nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("SearchTrashed", suite.ctx, uint(3)).Return(domain.BudgetBill{ID: snapshot.Budget.BudgetBills[0].ID}, nil)
+	suite.mockBudgetBillRepo.On("Restore", suite.ctx, uint(3)).Return(nil)
+	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.MatchedBy(func(bill domain.BudgetBill) bool {
+		return bill.ID != nil && *bill.ID == 3 && *bill.Complete
+	})).Return(uint(3), nil)
+	suite.mockBudgetEnvelopeRepo.On("WithTransaction", nil).Return(suite.mockBudgetEnvelopeRepo)
+	suite.mockLogApp.On("Create", suite.ctx, "Se restaura una instantánea del presupuesto", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.Restore(suite.ctx, suite.budgetId, suite.snapshotId)
+
+	require.NoError(err)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestRestoreErrorSearchTrashed() {
+	require := require.New(suite.T())
+	snapshot := domain.BudgetSnapshot{
+		ID:       suite.snapshotId,
+		BudgetId: suite.budgetId,
+		Budget:   suite.newBudget("Old", 100, map[uint]float64{2: 20}, nil),
+	}
+	suite.mockBudgetSnapshotRepo.On("SearchByBudgetIdAndId", suite.ctx, suite.budgetId, suite.snapshotId).Return(snapshot, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.newBudget("New", 100, nil, nil), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockTransaction()
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, mock.Anything).Return(uint(3), nil)
+	suite.mockBudgetSnapshotRepo.On("DeleteAutomaticByBudgetIdExceedingLimit", suite.ctx, suite.budgetId, mock.Anything).Return(nil)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(suite.budgetId, nil)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("SearchTrashed", suite.ctx, uint(2)).Return(domain.BudgetAvailable{}, gorm.ErrInvalidTransaction)
+
+	err := suite.app.Restore(suite.ctx, suite.budgetId, suite.snapshotId)
+
+	require.ErrorIs(err, gorm.ErrInvalidTransaction)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestRestoreErrorSearchSnapshot() {
+	require := require.New(suite.T())
+	suite.mockBudgetSnapshotRepo.On("SearchByBudgetIdAndId", suite.ctx, suite.budgetId, suite.snapshotId).Return(domain.BudgetSnapshot{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.Restore(suite.ctx, suite.budgetId, suite.snapshotId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestBudgetSnapshotSuite) TestRestoreErrorSaveAvailable() {
+	require := require.New(suite.T())
+	errExpected := errors.New("Error saving available")
+	snapshot := domain.BudgetSnapshot{
+		ID:       suite.snapshotId,
+		BudgetId: suite.budgetId,
+		Budget:   suite.newBudget("Old", 100, map[uint]float64{1: 10}, nil),
+	}
+	suite.mockBudgetSnapshotRepo.On("SearchByBudgetIdAndId", suite.ctx, suite.budgetId, suite.snapshotId).Return(snapshot, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.newBudget("New", 100, nil, nil), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockTransaction()
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, mock.Anything).Return(uint(3), nil)
+	suite.mockBudgetSnapshotRepo.On("DeleteAutomaticByBudgetIdExceedingLimit", suite.ctx, suite.budgetId, mock.Anything).Return(nil)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(suite.budgetId, nil)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("SearchTrashed", suite.ctx, uint(1)).Return(domain.BudgetAvailable{}, gorm.ErrRecordNotFound)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), errExpected)
+
+	err := suite.app.Restore(suite.ctx, suite.budgetId, suite.snapshotId)
+
+	require.EqualError(errExpected, err.Error())
+}
+
+func TestTestBudgetSnapshotSuite(t *testing.T) {
+	suite.Run(t, new(TestBudgetSnapshotSuite))
+}
@@ -0,0 +1,150 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"your-accounts-api/budgets/domain"
+	shared "your-accounts-api/shared/domain"
+
+	"gorm.io/gorm"
+)
+
+// BudgetUpdate is pushed to the clients following a budget after each batch of changes, Reload
+// asks them to read the budget again because the changes did not fit in the message.
+type BudgetUpdate struct {
+	BudgetId       uint
+	Changes        []Change
+	TotalAvailable float64
+	TotalPending   float64
+	PendingBills   uint8
+	Reload         bool
+	CreatedAt      time.Time
+}
+
+type IBudgetStreamApp interface {
+	Publish(ctx context.Context, budget domain.Budget, changes []Change) error
+	Subscribe(ctx context.Context, userId, id uint) (<-chan BudgetUpdate, error)
+}
+
+type budgetStreamApp struct {
+	budgetRepo domain.BudgetRepository
+	pubSub     shared.PubSub
+}
+
+type changeMessage struct {
+	ID      uint                 `json:"id"`
+	Section domain.BudgetSection `json:"section"`
+	Action  shared.Action        `json:"action"`
+	Detail  map[string]any       `json:"detail"`
+}
+
+type budgetUpdateMessage struct {
+	BudgetId       uint            `json:"budgetId"`
+	Changes        []changeMessage `json:"changes"`
+	TotalAvailable float64         `json:"totalAvailable"`
+	TotalPending   float64         `json:"totalPending"`
+	PendingBills   uint8           `json:"pendingBills"`
+	Reload         bool            `json:"reload"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+func (app *budgetStreamApp) Publish(ctx context.Context, budget domain.Budget, changes []Change) error {
+	message := budgetUpdateMessage{
+		BudgetId:  *budget.ID,
+		Changes:   []changeMessage{},
+		CreatedAt: time.Now(),
+	}
+	if budget.TotalAvailable != nil {
+		message.TotalAvailable = *budget.TotalAvailable
+	}
+	if budget.TotalPending != nil {
+		message.TotalPending = *budget.TotalPending
+	}
+	if budget.PendingBills != nil {
+		message.PendingBills = *budget.PendingBills
+	}
+	for _, change := range changes {
+		message.Changes = append(message.Changes, changeMessage(change))
+	}
+
+	err := app.publish(ctx, message)
+	if errors.Is(err, shared.ErrMessageTooLarge) {
+		message.Changes = []changeMessage{}
+		message.Reload = true
+		err = app.publish(ctx, message)
+	}
+
+	return err
+}
+
+// Subscribe returns the updates of a budget of the user until the context is done
+func (app *budgetStreamApp) Subscribe(ctx context.Context, userId, id uint) (<-chan BudgetUpdate, error) {
+	budget, err := app.budgetRepo.Search(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if budget.UserId == nil || *budget.UserId != userId {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	messages, err := app.pubSub.Subscribe(ctx, budgetTopic(id))
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan BudgetUpdate)
+	go func() {
+		defer close(updates)
+		for data := range messages {
+			var message budgetUpdateMessage
+			if err := json.Unmarshal(data, &message); err != nil {
+				continue
+			}
+
+			select {
+			case updates <- toBudgetUpdate(message):
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+func (app *budgetStreamApp) publish(ctx context.Context, message budgetUpdateMessage) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return app.pubSub.Publish(ctx, budgetTopic(message.BudgetId), data)
+}
+
+func NewBudgetStreamApp(budgetRepo domain.BudgetRepository, pubSub shared.PubSub) IBudgetStreamApp {
+	return &budgetStreamApp{budgetRepo, pubSub}
+}
+
+func budgetTopic(id uint) string {
+	return fmt.Sprintf("budget:%d", id)
+}
+
+func toBudgetUpdate(message budgetUpdateMessage) BudgetUpdate {
+	changes := []Change{}
+	for _, change := range message.Changes {
+		changes = append(changes, Change(change))
+	}
+
+	return BudgetUpdate{
+		BudgetId:       message.BudgetId,
+		Changes:        changes,
+		TotalAvailable: message.TotalAvailable,
+		TotalPending:   message.TotalPending,
+		PendingBills:   message.PendingBills,
+		Reload:         message.Reload,
+		CreatedAt:      message.CreatedAt,
+	}
+}
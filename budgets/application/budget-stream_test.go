@@ -0,0 +1,150 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+	mocks_shared "your-accounts-api/mocks/shared/domain"
+	shared "your-accounts-api/shared/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestBudgetStreamSuite struct {
+	suite.Suite
+	budgetId       uint
+	userId         uint
+	mockBudgetRepo *mocks_domain.MockBudgetRepository
+	mockPubSub     *mocks_shared.MockPubSub
+	app            IBudgetStreamApp
+	ctx            context.Context
+}
+
+func (suite *TestBudgetStreamSuite) SetupSuite() {
+	suite.budgetId = 1
+	suite.userId = 2
+	suite.ctx = context.Background()
+}
+
+func (suite *TestBudgetStreamSuite) SetupTest() {
+	suite.mockBudgetRepo = mocks_domain.NewMockBudgetRepository(suite.T())
+	suite.mockPubSub = mocks_shared.NewMockPubSub(suite.T())
+	suite.app = NewBudgetStreamApp(suite.mockBudgetRepo, suite.mockPubSub)
+}
+
+func (suite *TestBudgetStreamSuite) budget() domain.Budget {
+	totalAvailable := 100.0
+	totalPending := 40.0
+	pendingBills := uint8(2)
+	return domain.Budget{
+		ID:             &suite.budgetId,
+		UserId:         &suite.userId,
+		TotalAvailable: &totalAvailable,
+		TotalPending:   &totalPending,
+		PendingBills:   &pendingBills,
+	}
+}
+
+func (suite *TestBudgetStreamSuite) TestPublishSuccess() {
+	require := require.New(suite.T())
+	changes := []Change{
+		{ID: 3, Section: domain.Bill, Action: shared.Update, Detail: map[string]any{"payment": 10.0}},
+	}
+	suite.mockPubSub.On("Publish", suite.ctx, "budget:1", mock.MatchedBy(func(data []byte) bool {
+		var message budgetUpdateMessage
+		return json.Unmarshal(data, &message) == nil && message.BudgetId == suite.budgetId && len(message.Changes) == 1 &&
+			message.Changes[0].ID == 3 && message.TotalAvailable == 100 && message.TotalPending == 40 &&
+			message.PendingBills == 2 && !message.Reload
+	})).Return(nil)
+
+	err := suite.app.Publish(suite.ctx, suite.budget(), changes)
+
+	require.NoError(err)
+}
+
+func (suite *TestBudgetStreamSuite) TestPublishSuccessReload() {
+	require := require.New(suite.T())
+	changes := []Change{
+		{ID: 3, Section: domain.Bill, Action: shared.Update},
+	}
+	suite.mockPubSub.On("Publish", suite.ctx, "budget:1", mock.MatchedBy(func(data []byte) bool {
+		var message budgetUpdateMessage
+		return json.Unmarshal(data, &message) == nil && len(message.Changes) == 1
+	})).Return(shared.ErrMessageTooLarge).Once()
+	suite.mockPubSub.On("Publish", suite.ctx, "budget:1", mock.MatchedBy(func(data []byte) bool {
+		var message budgetUpdateMessage
+		return json.Unmarshal(data, &message) == nil && len(message.Changes) == 0 && message.Reload
+	})).Return(nil).Once()
+
+	err := suite.app.Publish(suite.ctx, suite.budget(), changes)
+
+	require.NoError(err)
+}
+
+func (suite *TestBudgetStreamSuite) TestPublishError() {
+	require := require.New(suite.T())
+	suite.mockPubSub.On("Publish", suite.ctx, "budget:1", mock.Anything).Return(gorm.ErrInvalidDB)
+
+	err := suite.app.Publish(suite.ctx, suite.budget(), nil)
+
+	require.ErrorIs(err, gorm.ErrInvalidDB)
+}
+
+func (suite *TestBudgetStreamSuite) TestSubscribeSuccess() {
+	require := require.New(suite.T())
+	ctx, cancel := context.WithCancel(suite.ctx)
+	defer cancel()
+	messages := make(chan []byte, 2)
+	data, err := json.Marshal(budgetUpdateMessage{
+		BudgetId:     suite.budgetId,
+		Changes:      []changeMessage{{ID: 3, Section: domain.Bill, Action: shared.Delete}},
+		TotalPending: 40,
+		CreatedAt:    time.Now(),
+	})
+	require.NoError(err)
+	messages <- []byte("invalid")
+	messages <- data
+	close(messages)
+	suite.mockBudgetRepo.On("Search", ctx, suite.budgetId).Return(suite.budget(), nil)
+	suite.mockPubSub.On("Subscribe", ctx, "budget:1").Return((<-chan []byte)(messages), nil)
+
+	updates, err := suite.app.Subscribe(ctx, suite.userId, suite.budgetId)
+
+	require.NoError(err)
+	update, ok := <-updates
+	require.True(ok)
+	require.Equal(suite.budgetId, update.BudgetId)
+	require.Equal(40.0, update.TotalPending)
+	require.Len(update.Changes, 1)
+	require.Equal(shared.Delete, update.Changes[0].Action)
+	_, ok = <-updates
+	require.False(ok)
+}
+
+func (suite *TestBudgetStreamSuite) TestSubscribeErrorOtherUser() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(), nil)
+
+	_, err := suite.app.Subscribe(suite.ctx, 99, suite.budgetId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestBudgetStreamSuite) TestSubscribeErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	_, err := suite.app.Subscribe(suite.ctx, suite.userId, suite.budgetId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestTestBudgetStreamSuite(t *testing.T) {
+	suite.Run(t, new(TestBudgetStreamSuite))
+}
@@ -11,6 +11,8 @@ import (
 	shared "your-accounts-api/shared/domain"
 	"your-accounts-api/shared/domain/persistent"
 	"your-accounts-api/shared/domain/utils/convert"
+
+	"github.com/gofiber/fiber/v2/log"
 )
 
 var ErrIncompleteData = errors.New("incomplete data")
@@ -34,6 +36,9 @@ type IBudgetApp interface {
 	FindByUserId(ctx context.Context, userId uint) ([]domain.Budget, error)
 	Changes(ctx context.Context, id uint, changes []Change) []ChangeResult
 	Delete(ctx context.Context, id uint) error
+	Undo(ctx context.Context, id uint) error
+	Redo(ctx context.Context, id uint) error
+	DeleteUserEvent(ctx context.Context, event shared.Event) error
 }
 
 type budgetApp struct {
@@ -42,13 +47,27 @@ type budgetApp struct {
 	budgetAvailableRepo domain.BudgetAvailableRepository
 	budgetBillRepo      domain.BudgetBillRepository
 	logApp              application.ILogApp
+	timezoneReader      shared.TimezoneReader
+	budgetSnapshotRepo  domain.BudgetSnapshotRepository
+	budgetEnvelopeRepo  domain.BudgetEnvelopeRepository
+	eventApp            application.IEventApp
+	budgetStreamApp     IBudgetStreamApp
 }
 
 func (app *budgetApp) Create(ctx context.Context, userId uint, name string) (uint, error) {
+	timezone, err := app.timezoneReader.FindTimezone(ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	if location, err := time.LoadLocation(timezone); err == nil {
+		now = now.In(location)
+	}
+
 	var id uint
-	err := app.tm.Transaction(func(tx persistent.Transaction) error {
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
 		budgetRepo := app.budgetRepo.WithTransaction(tx)
-		now := time.Now()
 		year := uint16(now.Year())
 		month := uint8(now.Month())
 		newBudget := domain.Budget{
@@ -64,7 +83,15 @@ func (app *budgetApp) Create(ctx context.Context, userId uint, name string) (uin
 			return err
 		}
 
-		return app.logApp.Create(ctx, "Creación", shared.Budget, id, nil, tx)
+		if err := app.logApp.Create(ctx, "Creación", shared.Budget, id, nil, tx); err != nil {
+			return err
+		}
+
+		payload := map[string]any{
+			"userId": userId,
+			"name":   name,
+		}
+		return app.eventApp.Publish(ctx, shared.BudgetCreated, id, payload, tx)
 	})
 	if err != nil {
 		return 0, err
@@ -79,6 +106,18 @@ func (app *budgetApp) Clone(ctx context.Context, userId uint, baseId uint) (uint
 		return 0, err
 	}
 
+	// The bills of the loan installments and the card statements stay linked only to the base
+	// budget, a copy would be paid without updating the installment or the statement
+	linkedIds, err := app.budgetBillRepo.SearchAllLinkedIdsByBudgetId(ctx, baseId)
+	if err != nil {
+		return 0, err
+	}
+
+	linked := map[uint]bool{}
+	for _, linkedId := range linkedIds {
+		linked[linkedId] = true
+	}
+
 	var id uint
 	err = app.tm.Transaction(func(tx persistent.Transaction) error {
 		budgetRepo := app.budgetRepo.WithTransaction(tx)
@@ -89,8 +128,14 @@ func (app *budgetApp) Clone(ctx context.Context, userId uint, baseId uint) (uint
 			Month:            baseBudget.Month,
 			FixedIncome:      baseBudget.FixedIncome,
 			AdditionalIncome: baseBudget.AdditionalIncome,
+			ZeroBased:        baseBudget.ZeroBased,
 			UserId:           &userId,
 		}
+		// The envelopes are not copied, all the income of the copy is still to be assigned
+		if isZeroBased(baseBudget) {
+			unassigned := toBeAssigned(baseBudget, 0)
+			newBudget.ToBeAssigned = &unassigned
+		}
 
 		var err error
 		id, err = budgetRepo.Save(ctx, newBudget)
@@ -116,6 +161,10 @@ func (app *budgetApp) Clone(ctx context.Context, userId uint, baseId uint) (uint
 		budgetBillRepo := app.budgetBillRepo.WithTransaction(tx)
 		newBills := []domain.BudgetBill{}
 		for _, bill := range baseBudget.BudgetBills {
+			if linked[*bill.ID] {
+				continue
+			}
+
 			newBill := domain.BudgetBill{
 				Description: bill.Description,
 				Amount:      bill.Amount,
@@ -135,7 +184,16 @@ func (app *budgetApp) Clone(ctx context.Context, userId uint, baseId uint) (uint
 			"cloneId":   baseId,
 			"cloneName": *baseBudget.Name,
 		}
-		return app.logApp.Create(ctx, description, shared.Budget, id, detail, tx)
+		if err := app.logApp.Create(ctx, description, shared.Budget, id, detail, tx); err != nil {
+			return err
+		}
+
+		payload := map[string]any{
+			"userId":  userId,
+			"name":    name,
+			"cloneId": baseId,
+		}
+		return app.eventApp.Publish(ctx, shared.BudgetCreated, id, payload, tx)
 	})
 	if err != nil {
 		return 0, err
@@ -161,13 +219,25 @@ func (app *budgetApp) FindByUserId(ctx context.Context, userId uint) ([]domain.B
 }
 
 func (app *budgetApp) Changes(ctx context.Context, id uint, changes []Change) []ChangeResult {
+	// The state before the changes is kept in the logs to undo them
+	budget, err := app.budgetRepo.Search(ctx, id)
+	if err != nil {
+		return failChanges(changes, err)
+	}
+
+	batch, err := batchGenerate()
+	if err != nil {
+		return failChanges(changes, err)
+	}
+
+	history := changeHistory{batch, budget}
 	changesChan := make(chan Change, len(changes))
 	resultsChan := make(chan ChangeResult)
 	var wg sync.WaitGroup
 
 	for i := 0; i < 2; i++ {
 		wg.Add(1)
-		go app.changeWorker(ctx, id, changesChan, resultsChan, &wg)
+		go app.changeWorker(ctx, history, changesChan, resultsChan, &wg)
 	}
 
 	for _, change := range changes {
@@ -190,7 +260,26 @@ func (app *budgetApp) Changes(ctx context.Context, id uint, changes []Change) []
 		}
 	}
 
-	return app.updateTotals(ctx, id, changeResults, resultsWithError)
+	updated, results := app.updateTotals(ctx, budget, changeResults, resultsWithError)
+	if len(changeResults) > resultsWithError && len(results) == len(changeResults) {
+		// The changes are already saved, a failed snapshot must not report them as failed
+		if err := app.createSnapshot(ctx, id); err != nil {
+			log.Error("Error creating budget snapshot:", err)
+		}
+
+		// The clients following the budget receive the changes applied with the new totals
+		applied := []Change{}
+		for _, result := range changeResults {
+			if result.Err == nil {
+				applied = append(applied, result.Change)
+			}
+		}
+		if err := app.budgetStreamApp.Publish(ctx, updated, applied); err != nil {
+			log.Error("Error publishing budget update:", err)
+		}
+	}
+
+	return results
 }
 
 func (app *budgetApp) Delete(ctx context.Context, id uint) error {
@@ -199,29 +288,45 @@ func (app *budgetApp) Delete(ctx context.Context, id uint) error {
 		return err
 	}
 
-	return app.budgetRepo.Delete(ctx, *budget.ID)
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		budgetRepo := app.budgetRepo.WithTransaction(tx)
+		if err := budgetRepo.Delete(ctx, *budget.ID); err != nil {
+			return err
+		}
+
+		if err := app.logApp.Create(ctx, "Se envía el presupuesto a la papelera", shared.Budget, *budget.ID, nil, tx); err != nil {
+			return err
+		}
+
+		payload := map[string]any{
+			"userId": budget.UserId,
+			"name":   budget.Name,
+		}
+		return app.eventApp.Publish(ctx, shared.BudgetDeleted, *budget.ID, payload, tx)
+	})
 }
 
-func (app *budgetApp) changeWorker(ctx context.Context, budgetId uint, changes <-chan Change, results chan<- ChangeResult, wg *sync.WaitGroup) {
+func (app *budgetApp) changeWorker(ctx context.Context, history changeHistory, changes <-chan Change, results chan<- ChangeResult, wg *sync.WaitGroup) {
 	defer wg.Done()
 
 	for change := range changes {
 		var changeResult ChangeResult
 		switch change.Section {
 		case domain.Main:
-			changeResult = app.changeMain(ctx, budgetId, change)
+			changeResult = app.changeMain(ctx, history, change)
 		case domain.Available:
-			changeResult = app.changeAvailable(ctx, budgetId, change)
+			changeResult = app.changeAvailable(ctx, history, change)
 		case domain.Bill:
-			changeResult = app.changeBill(ctx, budgetId, change)
+			changeResult = app.changeBill(ctx, history, change)
 		}
 
 		results <- changeResult
 	}
 }
 
-func (app *budgetApp) changeMain(ctx context.Context, budgetId uint, change Change) ChangeResult {
+func (app *budgetApp) changeMain(ctx context.Context, history changeHistory, change Change) ChangeResult {
 	var err error
+	budgetId := *history.budget.ID
 
 	switch change.Action {
 	case shared.Update:
@@ -229,61 +334,21 @@ func (app *budgetApp) changeMain(ctx context.Context, budgetId uint, change Chan
 			err = ErrIncompleteData
 		} else {
 			err = app.tm.Transaction(func(tx persistent.Transaction) error {
-				budget := domain.Budget{
-					ID: &change.ID,
-				}
-
-				if change.Detail["name"] != nil {
-					name := fmt.Sprint(change.Detail["name"])
-					budget.Name = &name
-				}
-
-				if change.Detail["year"] != nil {
-					value, err := convert.AsUint64(change.Detail["year"])
-					if err != nil {
-						return err
-					}
-
-					year := uint16(value)
-					budget.Year = &year
-				}
-
-				if change.Detail["month"] != nil {
-					value, err := convert.AsUint64(change.Detail["month"])
-					if err != nil {
-						return err
-					}
-
-					month := uint8(value)
-					budget.Month = &month
-				}
-
-				if change.Detail["fixedIncome"] != nil {
-					fixedIncome, err := convert.AsFloat64(change.Detail["fixedIncome"])
-					if err != nil {
-						return err
-					}
-
-					budget.FixedIncome = &fixedIncome
-				}
-
-				if change.Detail["additionalIncome"] != nil {
-					additionalIncome, err := convert.AsFloat64(change.Detail["additionalIncome"])
-					if err != nil {
-						return err
-					}
-
-					budget.AdditionalIncome = &additionalIncome
+				budget, err := budgetFromDetail(change.ID, change.Detail)
+				if err != nil {
+					return err
 				}
 
 				budgetRepo := app.budgetRepo.WithTransaction(tx)
-				_, err := budgetRepo.Save(ctx, budget)
+				_, err = budgetRepo.Save(ctx, budget)
 				if err != nil {
 					return err
 				}
 
+				after := budgetFields(budget)
+				before := pickFields(budgetFields(history.budget), after)
 				description := "Se actualiza la información principal"
-				return app.logApp.Create(ctx, description, shared.Budget, budgetId, change.Detail, tx)
+				return app.logApp.Create(ctx, description, shared.Budget, budgetId, history.detail(change, before, after), tx)
 			})
 		}
 	}
@@ -291,8 +356,9 @@ func (app *budgetApp) changeMain(ctx context.Context, budgetId uint, change Chan
 	return ChangeResult{change, err}
 }
 
-func (app *budgetApp) changeAvailable(ctx context.Context, budgetId uint, change Change) ChangeResult {
+func (app *budgetApp) changeAvailable(ctx context.Context, history changeHistory, change Change) ChangeResult {
 	var err error
+	budgetId := *history.budget.ID
 
 	switch change.Action {
 	case shared.Update:
@@ -300,33 +366,22 @@ func (app *budgetApp) changeAvailable(ctx context.Context, budgetId uint, change
 			err = ErrIncompleteData
 		} else {
 			err = app.tm.Transaction(func(tx persistent.Transaction) error {
-				available := domain.BudgetAvailable{
-					ID: &change.ID,
-				}
-
-				if change.Detail["name"] != nil {
-					name := fmt.Sprint(change.Detail["name"])
-					available.Name = &name
-				}
-
-				if change.Detail["amount"] != nil {
-					amount, err := convert.AsFloat64(change.Detail["amount"])
-					if err != nil {
-						return err
-					}
-
-					available.Amount = &amount
+				available, err := availableFromDetail(change.ID, change.Detail)
+				if err != nil {
+					return err
 				}
 
 				budgetAvailableRepo := app.budgetAvailableRepo.WithTransaction(tx)
-				_, err := budgetAvailableRepo.Save(ctx, available)
+				_, err = budgetAvailableRepo.Save(ctx, available)
 				if err != nil {
 					return err
 				}
 
+				after := availableFields(available)
+				before := pickFields(history.availableFields(change.ID), after)
 				description := "Se actualizaron los disponibles"
 				change.Detail["availableId"] = change.ID
-				return app.logApp.Create(ctx, description, shared.Budget, budgetId, change.Detail, tx)
+				return app.logApp.Create(ctx, description, shared.Budget, budgetId, history.detail(change, before, after), tx)
 			})
 		}
 	case shared.Delete:
@@ -341,7 +396,8 @@ func (app *budgetApp) changeAvailable(ctx context.Context, budgetId uint, change
 
 				description := fmt.Sprintf("Se elimino el disponible %s", change.Detail["name"])
 				change.Detail["availableId"] = change.ID
-				return app.logApp.Create(ctx, description, shared.Budget, budgetId, change.Detail, tx)
+				before := history.availableFields(change.ID)
+				return app.logApp.Create(ctx, description, shared.Budget, budgetId, history.detail(change, before, map[string]any{}), tx)
 			})
 		}
 	}
@@ -349,8 +405,9 @@ func (app *budgetApp) changeAvailable(ctx context.Context, budgetId uint, change
 	return ChangeResult{change, err}
 }
 
-func (app *budgetApp) changeBill(ctx context.Context, budgetId uint, change Change) ChangeResult {
+func (app *budgetApp) changeBill(ctx context.Context, history changeHistory, change Change) ChangeResult {
 	var err error
+	budgetId := *history.budget.ID
 
 	switch change.Action {
 	case shared.Update:
@@ -358,57 +415,34 @@ func (app *budgetApp) changeBill(ctx context.Context, budgetId uint, change Chan
 			err = ErrIncompleteData
 		} else {
 			err = app.tm.Transaction(func(tx persistent.Transaction) error {
-				budget := domain.BudgetBill{
-					ID: &change.ID,
-				}
-
-				if change.Detail["description"] != nil {
-					description := fmt.Sprint(change.Detail["description"])
-					budget.Description = &description
-				}
-
-				if change.Detail["amount"] != nil {
-					amount, err := convert.AsFloat64(change.Detail["amount"])
-					if err != nil {
-						return err
-					}
-
-					budget.Amount = &amount
-				}
-
-				if change.Detail["dueDate"] != nil {
-					value, err := convert.AsUint64(change.Detail["dueDate"])
-					if err != nil {
-						return err
-					}
-
-					dueDate := uint8(value)
-					budget.DueDate = &dueDate
-				}
-
-				if change.Detail["complete"] != nil {
-					complete, err := convert.AsBool(change.Detail["complete"])
-					if err != nil {
-						return err
-					}
-
-					budget.Complete = &complete
-				}
-
-				if change.Detail["category"] != nil {
-					category := domain.BudgetBillCategory(fmt.Sprint(change.Detail["category"]))
-					budget.Category = &category
+				bill, err := billFromDetail(change.ID, change.Detail)
+				if err != nil {
+					return err
 				}
 
 				budgetBillRepo := app.budgetBillRepo.WithTransaction(tx)
-				_, err := budgetBillRepo.Save(ctx, budget)
+				_, err = budgetBillRepo.Save(ctx, bill)
 				if err != nil {
 					return err
 				}
 
+				after := billFields(bill)
+				before := pickFields(history.billFields(change.ID), after)
 				description := "Se actualizaron los pagos"
 				change.Detail["billId"] = change.ID
-				return app.logApp.Create(ctx, description, shared.Budget, budgetId, change.Detail, tx)
+				if err := app.logApp.Create(ctx, description, shared.Budget, budgetId, history.detail(change, before, after), tx); err != nil {
+					return err
+				}
+
+				if after["complete"] != true || before["complete"] == true {
+					return nil
+				}
+
+				payload := map[string]any{
+					"budgetId":    budgetId,
+					"description": history.billFields(change.ID)["description"],
+				}
+				return app.eventApp.Publish(ctx, shared.BillCompleted, change.ID, payload, tx)
 			})
 		}
 	case shared.Delete:
@@ -425,7 +459,8 @@ func (app *budgetApp) changeBill(ctx context.Context, budgetId uint, change Chan
 
 				description := fmt.Sprintf("Se elimino el pago %s", change.Detail["description"])
 				change.Detail["billId"] = change.ID
-				return app.logApp.Create(ctx, description, shared.Budget, budgetId, change.Detail, tx)
+				before := history.billFields(change.ID)
+				return app.logApp.Create(ctx, description, shared.Budget, budgetId, history.detail(change, before, map[string]any{}), tx)
 			})
 		}
 	}
@@ -433,53 +468,249 @@ func (app *budgetApp) changeBill(ctx context.Context, budgetId uint, change Chan
 	return ChangeResult{change, err}
 }
 
-func (app *budgetApp) updateTotals(ctx context.Context, id uint, changeResults []ChangeResult, resultsWithError int) []ChangeResult {
+func budgetFromDetail(id uint, detail map[string]any) (domain.Budget, error) {
+	budget := domain.Budget{
+		ID: &id,
+	}
+
+	if detail["name"] != nil {
+		name := fmt.Sprint(detail["name"])
+		budget.Name = &name
+	}
+
+	if detail["year"] != nil {
+		value, err := convert.AsUint64(detail["year"])
+		if err != nil {
+			return domain.Budget{}, err
+		}
+
+		year := uint16(value)
+		budget.Year = &year
+	}
+
+	if detail["month"] != nil {
+		value, err := convert.AsUint64(detail["month"])
+		if err != nil {
+			return domain.Budget{}, err
+		}
+
+		month := uint8(value)
+		budget.Month = &month
+	}
+
+	if detail["fixedIncome"] != nil {
+		fixedIncome, err := convert.AsFloat64(detail["fixedIncome"])
+		if err != nil {
+			return domain.Budget{}, err
+		}
+
+		budget.FixedIncome = &fixedIncome
+	}
+
+	if detail["additionalIncome"] != nil {
+		additionalIncome, err := convert.AsFloat64(detail["additionalIncome"])
+		if err != nil {
+			return domain.Budget{}, err
+		}
+
+		budget.AdditionalIncome = &additionalIncome
+	}
+
+	return budget, nil
+}
+
+func availableFromDetail(id uint, detail map[string]any) (domain.BudgetAvailable, error) {
+	available := domain.BudgetAvailable{
+		ID: &id,
+	}
+
+	if detail["name"] != nil {
+		name := fmt.Sprint(detail["name"])
+		available.Name = &name
+	}
+
+	if detail["amount"] != nil {
+		amount, err := convert.AsFloat64(detail["amount"])
+		if err != nil {
+			return domain.BudgetAvailable{}, err
+		}
+
+		available.Amount = &amount
+	}
+
+	return available, nil
+}
+
+func billFromDetail(id uint, detail map[string]any) (domain.BudgetBill, error) {
+	bill := domain.BudgetBill{
+		ID: &id,
+	}
+
+	if detail["description"] != nil {
+		description := fmt.Sprint(detail["description"])
+		bill.Description = &description
+	}
+
+	if detail["amount"] != nil {
+		amount, err := convert.AsFloat64(detail["amount"])
+		if err != nil {
+			return domain.BudgetBill{}, err
+		}
+
+		bill.Amount = &amount
+	}
+
+	if detail["dueDate"] != nil {
+		value, err := convert.AsUint64(detail["dueDate"])
+		if err != nil {
+			return domain.BudgetBill{}, err
+		}
+
+		dueDate := uint8(value)
+		bill.DueDate = &dueDate
+	}
+
+	if detail["complete"] != nil {
+		complete, err := convert.AsBool(detail["complete"])
+		if err != nil {
+			return domain.BudgetBill{}, err
+		}
+
+		bill.Complete = &complete
+	}
+
+	if detail["category"] != nil {
+		category := domain.BudgetBillCategory(fmt.Sprint(detail["category"]))
+		bill.Category = &category
+	}
+
+	return bill, nil
+}
+
+func failChanges(changes []Change, err error) []ChangeResult {
+	results := []ChangeResult{}
+	for _, change := range changes {
+		results = append(results, ChangeResult{change, err})
+	}
+
+	return results
+}
+
+func (app *budgetApp) updateTotals(ctx context.Context, previous domain.Budget, changeResults []ChangeResult, resultsWithError int) (domain.Budget, []ChangeResult) {
+	var budget domain.Budget
 	if len(changeResults) > resultsWithError {
-		budget, err := app.budgetRepo.Search(ctx, id)
+		var err error
+		budget, err = app.budgetRepo.Search(ctx, *previous.ID)
+		if err == nil {
+			calculateTotals(&budget)
+			err = app.tm.Transaction(func(tx persistent.Transaction) error {
+				if _, err := app.budgetRepo.WithTransaction(tx).Save(ctx, budget); err != nil {
+					return err
+				}
+
+				if isOverspent(previous) || !isOverspent(budget) {
+					return nil
+				}
+
+				payload := map[string]any{
+					"userId":         budget.UserId,
+					"name":           budget.Name,
+					"totalAvailable": *budget.TotalAvailable,
+					"totalPending":   *budget.TotalPending,
+				}
+				return app.eventApp.Publish(ctx, shared.BudgetOverspent, *budget.ID, payload, tx)
+			})
+		}
+
 		if err != nil {
 			changeResults = append(changeResults, ChangeResult{
 				Err: err,
 			})
-		} else {
-			totalAvailable := 0.0
-			for _, available := range budget.BudgetAvailables {
-				totalAvailable += *available.Amount
-			}
-			budget.TotalAvailable = &totalAvailable
-
-			totalPending := 0.0
-			pendingBills := uint8(0)
-			for _, bill := range budget.BudgetBills {
-				pending := *bill.Amount - *bill.Payment
-				if !*bill.Complete {
-					if pending < 0 {
-						pending = 0
-					}
-					totalPending += pending
-				}
+		}
+	}
 
-				if *bill.Amount > *bill.Payment && !*bill.Complete {
-					pendingBills += 1
-				}
-			}
-			budget.TotalPending = &totalPending
-			budget.PendingBills = &pendingBills
-
-			_, err := app.budgetRepo.Save(ctx, budget)
-			if err != nil {
-				changeResults = append(changeResults, ChangeResult{
-					Err: err,
-				})
+	return budget, changeResults
+}
+
+// isOverspent reports when the pending bills need more money than the available
+func isOverspent(budget domain.Budget) bool {
+	return budget.TotalPending != nil && budget.TotalAvailable != nil && *budget.TotalPending > *budget.TotalAvailable
+}
+
+// calculateTotals sets the totals of the budget from its availables and bills
+func calculateTotals(budget *domain.Budget) {
+	totalAvailable := 0.0
+	for _, available := range budget.BudgetAvailables {
+		totalAvailable += *available.Amount
+	}
+	budget.TotalAvailable = &totalAvailable
+
+	totalPending := 0.0
+	pendingBills := uint8(0)
+	for _, bill := range budget.BudgetBills {
+		pending := *bill.Amount - *bill.Payment
+		if !*bill.Complete {
+			if pending < 0 {
+				pending = 0
 			}
+			totalPending += pending
 		}
+
+		if *bill.Amount > *bill.Payment && !*bill.Complete {
+			pendingBills += 1
+		}
+	}
+	budget.TotalPending = &totalPending
+	budget.PendingBills = &pendingBills
+
+	// A zero-based budget must assign every unit of the income to its envelopes
+	if budget.ZeroBased != nil && *budget.ZeroBased {
+		toBeAssigned := roundCents(*budget.FixedIncome + *budget.AdditionalIncome - *budget.TotalAssigned)
+		budget.ToBeAssigned = &toBeAssigned
 	}
+}
+
+// saveTotals stores the totals of the budget after a change made outside of Changes
+func saveTotals(ctx context.Context, budgetRepo domain.BudgetRepository, id uint) error {
+	budget, err := budgetRepo.Search(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	calculateTotals(&budget)
+	_, err = budgetRepo.Save(ctx, budget)
+	return err
+}
+
+// createSnapshot keeps the state of the budget after a batch of changes
+func (app *budgetApp) createSnapshot(ctx context.Context, id uint) error {
+	budget, err := app.budgetRepo.Search(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	envelopes, err := app.budgetEnvelopeRepo.SearchAllByBudgetId(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		budgetSnapshotRepo := app.budgetSnapshotRepo.WithTransaction(tx)
+		return saveAutomaticSnapshot(ctx, budgetSnapshotRepo, budget, envelopes, automaticSnapshotDescription)
+	})
+}
 
-	return changeResults
+// DeleteUserEvent removes the budgets of a deleted user, the trashed ones too, the other modules
+// remove the rows left without their budget.
+func (app *budgetApp) DeleteUserEvent(ctx context.Context, event shared.Event) error {
+	return app.budgetRepo.DeleteAllByUserId(ctx, event.ResourceId)
 }
 
 func NewBudgetApp(
 	tm persistent.TransactionManager, budgetRepo domain.BudgetRepository, budgetAvailableRepo domain.BudgetAvailableRepository,
-	budgetBillRepo domain.BudgetBillRepository, logApp application.ILogApp,
+	budgetBillRepo domain.BudgetBillRepository, logApp application.ILogApp, timezoneReader shared.TimezoneReader,
+	budgetSnapshotRepo domain.BudgetSnapshotRepository, budgetEnvelopeRepo domain.BudgetEnvelopeRepository, eventApp application.IEventApp,
+	budgetStreamApp IBudgetStreamApp,
 ) IBudgetApp {
-	return &budgetApp{tm, budgetRepo, budgetAvailableRepo, budgetBillRepo, logApp}
+	return &budgetApp{tm, budgetRepo, budgetAvailableRepo, budgetBillRepo, logApp, timezoneReader, budgetSnapshotRepo, budgetEnvelopeRepo, eventApp, budgetStreamApp}
 }
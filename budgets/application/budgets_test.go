@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 	"your-accounts-api/budgets/domain"
 	mocks_domain "your-accounts-api/mocks/budgets/domain"
 	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_shared "your-accounts-api/mocks/shared/domain"
 	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
 	shared "your-accounts-api/shared/domain"
 	"your-accounts-api/shared/domain/persistent"
 	"your-accounts-api/shared/domain/utils/convert"
+	"your-accounts-api/shared/infrastructure/config"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -26,7 +29,12 @@ type TestBudgetSuite struct {
 	mockBudgetRepo          *mocks_domain.MockBudgetRepository
 	mockBudgetAvailableRepo *mocks_domain.MockBudgetAvailableRepository
 	mockBudgetBillRepo      *mocks_domain.MockBudgetBillRepository
+	mockBudgetSnapshotRepo  *mocks_domain.MockBudgetSnapshotRepository
+	mockBudgetEnvelopeRepo  *mocks_domain.MockBudgetEnvelopeRepository
 	mockLogApp              *mocks_application.MockILogApp
+	mockEventApp            *mocks_application.MockIEventApp
+	mockPubSub              *mocks_shared.MockPubSub
+	mockTimezoneReader      *mocks_shared.MockTimezoneReader
 	app                     IBudgetApp
 	ctx                     context.Context
 }
@@ -42,18 +50,51 @@ func (suite *TestBudgetSuite) SetupTest() {
 	suite.mockBudgetRepo = mocks_domain.NewMockBudgetRepository(suite.T())
 	suite.mockBudgetAvailableRepo = mocks_domain.NewMockBudgetAvailableRepository(suite.T())
 	suite.mockBudgetBillRepo = mocks_domain.NewMockBudgetBillRepository(suite.T())
+	suite.mockBudgetSnapshotRepo = mocks_domain.NewMockBudgetSnapshotRepository(suite.T())
+	suite.mockBudgetEnvelopeRepo = mocks_domain.NewMockBudgetEnvelopeRepository(suite.T())
 	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
-	suite.app = NewBudgetApp(suite.mockTransactionManager, suite.mockBudgetRepo, suite.mockBudgetAvailableRepo, suite.mockBudgetBillRepo, suite.mockLogApp)
+	suite.mockEventApp = mocks_application.NewMockIEventApp(suite.T())
+	suite.mockPubSub = mocks_shared.NewMockPubSub(suite.T())
+	suite.mockTimezoneReader = mocks_shared.NewMockTimezoneReader(suite.T())
+	suite.app = NewBudgetApp(
+		suite.mockTransactionManager, suite.mockBudgetRepo, suite.mockBudgetAvailableRepo, suite.mockBudgetBillRepo, suite.mockLogApp,
+		suite.mockTimezoneReader, suite.mockBudgetSnapshotRepo, suite.mockBudgetEnvelopeRepo, suite.mockEventApp,
+		NewBudgetStreamApp(suite.mockBudgetRepo, suite.mockPubSub),
+	)
 }
 
 func (suite *TestBudgetSuite) TestCreateSuccess() {
 	require := require.New(suite.T())
+	location, err := time.LoadLocation("Pacific/Kiritimati")
+	require.NoError(err)
+	now := time.Now().In(location)
+	suite.mockTimezoneReader.On("FindTimezone", suite.ctx, suite.userId).Return(location.String(), nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(budget domain.Budget) bool {
+		return *budget.Year == uint16(now.Year()) && *budget.Month == uint8(now.Month())
+	})).Return(suite.budgetId, nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BudgetCreated, suite.budgetId, map[string]any{"userId": suite.userId, "name": "Test"}, nil).Return(nil)
+
+	res, err := suite.app.Create(suite.ctx, suite.userId, "Test")
+
+	require.NoError(err)
+	require.Equal(suite.budgetId, res)
+}
+
+func (suite *TestBudgetSuite) TestCreateSuccessInvalidTimezone() {
+	require := require.New(suite.T())
+	suite.mockTimezoneReader.On("FindTimezone", suite.ctx, suite.userId).Return("Invalid/Timezone", nil)
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
 		return fc(nil)
 	})
 	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
 	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(suite.budgetId, nil)
 	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BudgetCreated, suite.budgetId, mock.Anything, nil).Return(nil)
 
 	res, err := suite.app.Create(suite.ctx, suite.userId, "Test")
 
@@ -64,6 +105,7 @@ func (suite *TestBudgetSuite) TestCreateSuccess() {
 func (suite *TestBudgetSuite) TestCreateErrorCreateLog() {
 	require := require.New(suite.T())
 	errExpected := errors.New("Error in creation project log")
+	suite.mockTimezoneReader.On("FindTimezone", suite.ctx, suite.userId).Return("", nil)
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
 		return fc(nil)
 	})
@@ -80,6 +122,7 @@ func (suite *TestBudgetSuite) TestCreateErrorCreateLog() {
 func (suite *TestBudgetSuite) TestCreateErrorSave() {
 	require := require.New(suite.T())
 	errExpected := errors.New("Error in creation budget")
+	suite.mockTimezoneReader.On("FindTimezone", suite.ctx, suite.userId).Return("", nil)
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
 		return fc(nil)
 	})
@@ -95,6 +138,7 @@ func (suite *TestBudgetSuite) TestCreateErrorSave() {
 func (suite *TestBudgetSuite) TestCreateErrorTransaction() {
 	require := require.New(suite.T())
 	errExpected := errors.New("Error in transaction")
+	suite.mockTimezoneReader.On("FindTimezone", suite.ctx, suite.userId).Return("", nil)
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(errExpected)
 
 	res, err := suite.app.Create(suite.ctx, suite.userId, "Test")
@@ -103,6 +147,16 @@ func (suite *TestBudgetSuite) TestCreateErrorTransaction() {
 	require.Zero(res)
 }
 
+func (suite *TestBudgetSuite) TestCreateErrorFindUser() {
+	require := require.New(suite.T())
+	suite.mockTimezoneReader.On("FindTimezone", suite.ctx, suite.userId).Return("", gorm.ErrRecordNotFound)
+
+	res, err := suite.app.Create(suite.ctx, suite.userId, "Test")
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(res)
+}
+
 func (suite *TestBudgetSuite) TestCloneSuccess() {
 	require := require.New(suite.T())
 	baseId := uint(999)
@@ -133,6 +187,7 @@ func (suite *TestBudgetSuite) TestCloneSuccess() {
 		},
 	}
 	suite.mockBudgetRepo.On("Search", suite.ctx, baseId).Return(budgetExpected, nil)
+	suite.mockBudgetBillRepo.On("SearchAllLinkedIdsByBudgetId", suite.ctx, baseId).Return([]uint{}, nil)
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
 		return fc(nil)
 	})
@@ -143,6 +198,63 @@ func (suite *TestBudgetSuite) TestCloneSuccess() {
 	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
 	suite.mockBudgetBillRepo.On("SaveAll", suite.ctx, mock.Anything).Return(nil)
 	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BudgetCreated, suite.budgetId, map[string]any{"userId": suite.userId, "name": "Test Copia", "cloneId": baseId}, nil).Return(nil)
+
+	res, err := suite.app.Clone(suite.ctx, suite.userId, baseId)
+
+	require.NoError(err)
+	require.Equal(suite.budgetId, res)
+}
+
+func (suite *TestBudgetSuite) TestCloneSuccessWithoutLinkedBills() {
+	require := require.New(suite.T())
+	baseId := uint(999)
+	loanId := uint(1000)
+	statementId := uint(1001)
+	name := "Test"
+	loan := "Cuota préstamo"
+	statement := "Extracto tarjeta"
+	year := uint16(1)
+	month := uint8(1)
+	budgetExpected := domain.Budget{
+		ID:     &baseId,
+		Name:   &name,
+		Year:   &year,
+		Month:  &month,
+		UserId: &suite.userId,
+		BudgetBills: []domain.BudgetBill{
+			{
+				ID:          &baseId,
+				Description: &name,
+				BudgetId:    &baseId,
+			},
+			{
+				ID:          &loanId,
+				Description: &loan,
+				BudgetId:    &baseId,
+			},
+			{
+				ID:          &statementId,
+				Description: &statement,
+				BudgetId:    &baseId,
+			},
+		},
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, baseId).Return(budgetExpected, nil)
+	suite.mockBudgetBillRepo.On("SearchAllLinkedIdsByBudgetId", suite.ctx, baseId).Return([]uint{loanId, statementId}, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(suite.budgetId, nil)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("SaveAll", suite.ctx, mock.Anything).Return(nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("SaveAll", suite.ctx, mock.MatchedBy(func(bills []domain.BudgetBill) bool {
+		return len(bills) == 1 && *bills[0].Description == name && *bills[0].BudgetId == suite.budgetId
+	})).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BudgetCreated, suite.budgetId, mock.Anything, nil).Return(nil)
 
 	res, err := suite.app.Clone(suite.ctx, suite.userId, baseId)
 
@@ -150,6 +262,67 @@ func (suite *TestBudgetSuite) TestCloneSuccess() {
 	require.Equal(suite.budgetId, res)
 }
 
+func (suite *TestBudgetSuite) TestCloneSuccessZeroBased() {
+	require := require.New(suite.T())
+	baseId := uint(999)
+	name := "Test"
+	year := uint16(1)
+	month := uint8(1)
+	fixedIncome := 1000.0
+	additionalIncome := 200.0
+	zeroBased := true
+	totalAssigned := 700.0
+	budgetExpected := domain.Budget{
+		ID:               &baseId,
+		Name:             &name,
+		Year:             &year,
+		Month:            &month,
+		FixedIncome:      &fixedIncome,
+		AdditionalIncome: &additionalIncome,
+		ZeroBased:        &zeroBased,
+		TotalAssigned:    &totalAssigned,
+		UserId:           &suite.userId,
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, baseId).Return(budgetExpected, nil)
+	suite.mockBudgetBillRepo.On("SearchAllLinkedIdsByBudgetId", suite.ctx, baseId).Return([]uint{}, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(budget domain.Budget) bool {
+		return *budget.ZeroBased && *budget.ToBeAssigned == 1200 && budget.TotalAssigned == nil
+	})).Return(suite.budgetId, nil)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("SaveAll", suite.ctx, mock.Anything).Return(nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("SaveAll", suite.ctx, mock.Anything).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BudgetCreated, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.Clone(suite.ctx, suite.userId, baseId)
+
+	require.NoError(err)
+	require.Equal(suite.budgetId, res)
+}
+
+func (suite *TestBudgetSuite) TestCloneErrorSearchLinkedBills() {
+	require := require.New(suite.T())
+	baseId := uint(999)
+	name := "Test"
+	budgetExpected := domain.Budget{
+		ID:     &baseId,
+		Name:   &name,
+		UserId: &suite.userId,
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, baseId).Return(budgetExpected, nil)
+	suite.mockBudgetBillRepo.On("SearchAllLinkedIdsByBudgetId", suite.ctx, baseId).Return(nil, gorm.ErrInvalidField)
+
+	res, err := suite.app.Clone(suite.ctx, suite.userId, baseId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(res)
+}
+
 func (suite *TestBudgetSuite) TestCloneErrorSearch() {
 	require := require.New(suite.T())
 	baseId := uint(999)
@@ -177,6 +350,7 @@ func (suite *TestBudgetSuite) TestCloneErrorSaveAvailables() {
 	}
 	errExpected := errors.New("Error in creation availables")
 	suite.mockBudgetRepo.On("Search", suite.ctx, baseId).Return(budgetExpected, nil)
+	suite.mockBudgetBillRepo.On("SearchAllLinkedIdsByBudgetId", suite.ctx, baseId).Return([]uint{}, nil)
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
 		return fc(nil)
 	})
@@ -206,6 +380,7 @@ func (suite *TestBudgetSuite) TestCloneErrorSaveBills() {
 	}
 	errExpected := errors.New("Error in creation bills")
 	suite.mockBudgetRepo.On("Search", suite.ctx, baseId).Return(budgetExpected, nil)
+	suite.mockBudgetBillRepo.On("SearchAllLinkedIdsByBudgetId", suite.ctx, baseId).Return([]uint{}, nil)
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
 		return fc(nil)
 	})
@@ -237,6 +412,7 @@ func (suite *TestBudgetSuite) TestCloneErrorCreateLog() {
 	}
 	errExpected := errors.New("Error in creation project log")
 	suite.mockBudgetRepo.On("Search", suite.ctx, baseId).Return(budgetExpected, nil)
+	suite.mockBudgetBillRepo.On("SearchAllLinkedIdsByBudgetId", suite.ctx, baseId).Return([]uint{}, nil)
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
 		return fc(nil)
 	})
@@ -269,6 +445,7 @@ func (suite *TestBudgetSuite) TestCloneErrorSave() {
 	}
 	errExpected := errors.New("Error in creation budget")
 	suite.mockBudgetRepo.On("Search", suite.ctx, baseId).Return(budgetExpected, nil)
+	suite.mockBudgetBillRepo.On("SearchAllLinkedIdsByBudgetId", suite.ctx, baseId).Return([]uint{}, nil)
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
 		return fc(nil)
 	})
@@ -296,6 +473,7 @@ func (suite *TestBudgetSuite) TestCloneErrorTransaction() {
 	}
 	errExpected := errors.New("Error in transaction")
 	suite.mockBudgetRepo.On("Search", suite.ctx, baseId).Return(budgetExpected, nil)
+	suite.mockBudgetBillRepo.On("SearchAllLinkedIdsByBudgetId", suite.ctx, baseId).Return([]uint{}, nil)
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(errExpected)
 
 	res, err := suite.app.Clone(suite.ctx, suite.userId, baseId)
@@ -514,7 +692,7 @@ func (suite *TestBudgetSuite) TestChangesSuccess() {
 	}
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
 		return fc(nil)
-	}).Times(5)
+	}).Times(7)
 	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
 	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
 	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo).Times(2)
@@ -524,7 +702,14 @@ func (suite *TestBudgetSuite) TestChangesSuccess() {
 	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
 	suite.mockBudgetBillRepo.On("Delete", suite.ctx, mock.Anything).Return(nil)
 	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil).Times(5)
-	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budgetExpected, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budgetExpected, nil).Times(3)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, mock.MatchedBy(func(snapshot domain.BudgetSnapshot) bool {
+		return snapshot.BudgetId == suite.budgetId && !snapshot.Manual
+	})).Return(uint(1), nil)
+	suite.mockBudgetSnapshotRepo.On("DeleteAutomaticByBudgetIdExceedingLimit", suite.ctx, suite.budgetId, mock.Anything).Return(nil)
+	suite.mockPubSub.On("Publish", suite.ctx, "budget:1", mock.Anything).Return(nil)
 
 	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
 
@@ -536,6 +721,336 @@ func (suite *TestBudgetSuite) TestChangesSuccess() {
 	}
 }
 
+func (suite *TestBudgetSuite) TestChangesSuccessErrorSnapshot() {
+	require := require.New(suite.T())
+	errExpected := errors.New("Error saving snapshot")
+	changes := []Change{
+		{
+			ID:      uint(1),
+			Section: domain.Main,
+			Action:  shared.Update,
+			Detail: map[string]any{
+				"name": "Test 1",
+			},
+		},
+	}
+	name := "Test 1"
+	zeroFloat := 0.0
+	zeroUint := uint8(0)
+	budgetExpected := domain.Budget{
+		ID:               &suite.budgetId,
+		Name:             &name,
+		FixedIncome:      &zeroFloat,
+		AdditionalIncome: &zeroFloat,
+		TotalPending:     &zeroFloat,
+		TotalAvailable:   &zeroFloat,
+		PendingBills:     &zeroUint,
+	}
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	}).Times(3)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil).Times(2)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budgetExpected, nil).Times(3)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), errExpected)
+	suite.mockPubSub.On("Publish", suite.ctx, "budget:1", mock.Anything).Return(nil)
+
+	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
+
+	require.Len(results, 1)
+	require.NoError(results[0].Err)
+}
+
+func (suite *TestBudgetSuite) TestChangesSuccessErrorPublish() {
+	require := require.New(suite.T())
+	errExpected := errors.New("Error publishing update")
+	changes := []Change{
+		{
+			ID:      uint(1),
+			Section: domain.Main,
+			Action:  shared.Update,
+			Detail: map[string]any{
+				"name": "Test 1",
+			},
+		},
+	}
+	name := "Test 1"
+	zeroFloat := 0.0
+	zeroUint := uint8(0)
+	budgetExpected := domain.Budget{
+		ID:               &suite.budgetId,
+		Name:             &name,
+		FixedIncome:      &zeroFloat,
+		AdditionalIncome: &zeroFloat,
+		TotalPending:     &zeroFloat,
+		TotalAvailable:   &zeroFloat,
+		PendingBills:     &zeroUint,
+	}
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	}).Times(3)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil).Times(2)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budgetExpected, nil).Times(3)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, mock.Anything).Return(uint(1), nil)
+	suite.mockBudgetSnapshotRepo.On("DeleteAutomaticByBudgetIdExceedingLimit", suite.ctx, suite.budgetId, config.BUDGET_SNAPSHOT_LIMIT).Return(nil)
+	suite.mockPubSub.On("Publish", suite.ctx, "budget:1", mock.Anything).Return(errExpected)
+
+	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
+
+	require.Len(results, 1)
+	require.NoError(results[0].Err)
+}
+
+func (suite *TestBudgetSuite) TestChangesSuccessHistory() {
+	require := require.New(suite.T())
+	changes := []Change{
+		{
+			ID:      uint(1),
+			Section: domain.Available,
+			Action:  shared.Update,
+			Detail: map[string]any{
+				"amount": 10000,
+			},
+		},
+	}
+	id := uint(1)
+	name := "Test 1"
+	amount := 500.0
+	budgetExpected := domain.Budget{
+		ID: &suite.budgetId,
+		BudgetAvailables: []domain.BudgetAvailable{
+			{
+				ID:       &id,
+				Name:     &name,
+				Amount:   &amount,
+				BudgetId: &suite.budgetId,
+			},
+		},
+	}
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	}).Times(3)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.MatchedBy(func(detail map[string]any) bool {
+		before := detail["before"].(map[string]any)
+		after := detail["after"].(map[string]any)
+		return detail["batch"] != nil && detail["section"] == domain.Available && detail["id"] == id &&
+			len(before) == 1 && before["amount"] == amount && len(after) == 1 && after["amount"] == 10000.0
+	}), nil).Return(nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budgetExpected, nil).Times(3)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, mock.Anything).Return(uint(1), nil)
+	suite.mockBudgetSnapshotRepo.On("DeleteAutomaticByBudgetIdExceedingLimit", suite.ctx, suite.budgetId, mock.Anything).Return(nil)
+	suite.mockPubSub.On("Publish", suite.ctx, "budget:1", mock.Anything).Return(nil)
+
+	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
+
+	require.Len(results, 1)
+	require.NoError(results[0].Err)
+}
+
+func (suite *TestBudgetSuite) TestChangesSuccessBillCompleted() {
+	require := require.New(suite.T())
+	id := uint(3)
+	description := "Test"
+	amount := 100.0
+	complete := false
+	changes := []Change{
+		{
+			ID:      id,
+			Section: domain.Bill,
+			Action:  shared.Update,
+			Detail: map[string]any{
+				"complete": true,
+			},
+		},
+	}
+	budgetExpected := domain.Budget{
+		ID: &suite.budgetId,
+		BudgetBills: []domain.BudgetBill{
+			{
+				ID:          &id,
+				Description: &description,
+				Amount:      &amount,
+				Payment:     &amount,
+				Complete:    &complete,
+				BudgetId:    &suite.budgetId,
+			},
+		},
+	}
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	}).Times(3)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BillCompleted, id, map[string]any{"budgetId": suite.budgetId, "description": description}, nil).Return(nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budgetExpected, nil).Times(3)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, mock.Anything).Return(uint(1), nil)
+	suite.mockBudgetSnapshotRepo.On("DeleteAutomaticByBudgetIdExceedingLimit", suite.ctx, suite.budgetId, mock.Anything).Return(nil)
+	suite.mockPubSub.On("Publish", suite.ctx, "budget:1", mock.Anything).Return(nil)
+
+	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
+
+	require.Len(results, 1)
+	require.NoError(results[0].Err)
+}
+
+func (suite *TestBudgetSuite) TestChangesSuccessOverspent() {
+	require := require.New(suite.T())
+	id := uint(3)
+	name := "Test"
+	before := 100.0
+	after := 10.0
+	pending := 50.0
+	zero := 0.0
+	complete := false
+	changes := []Change{
+		{
+			ID:      id,
+			Section: domain.Available,
+			Action:  shared.Update,
+			Detail: map[string]any{
+				"amount": after,
+			},
+		},
+	}
+	budget := func(amount float64) domain.Budget {
+		return domain.Budget{
+			ID:             &suite.budgetId,
+			Name:           &name,
+			UserId:         &suite.userId,
+			TotalAvailable: &before,
+			TotalPending:   &pending,
+			BudgetAvailables: []domain.BudgetAvailable{
+				{ID: &id, Name: &name, Amount: &amount, BudgetId: &suite.budgetId},
+			},
+			BudgetBills: []domain.BudgetBill{
+				{ID: &id, Description: &name, Amount: &pending, Payment: &zero, Complete: &complete, BudgetId: &suite.budgetId},
+			},
+		}
+	}
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	}).Times(3)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget(before), nil).Once()
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget(after), nil).Times(2)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BudgetOverspent, suite.budgetId, mock.MatchedBy(func(payload map[string]any) bool {
+		return payload["totalAvailable"] == after && payload["totalPending"] == pending
+	}), nil).Return(nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, mock.Anything).Return(uint(1), nil)
+	suite.mockBudgetSnapshotRepo.On("DeleteAutomaticByBudgetIdExceedingLimit", suite.ctx, suite.budgetId, mock.Anything).Return(nil)
+	suite.mockPubSub.On("Publish", suite.ctx, "budget:1", mock.Anything).Return(nil)
+
+	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
+
+	require.Len(results, 1)
+	require.NoError(results[0].Err)
+}
+
+func (suite *TestBudgetSuite) TestChangesSuccessZeroBased() {
+	require := require.New(suite.T())
+	name := "Test"
+	zeroBased := true
+	fixedIncome := 3000.0
+	additionalIncome := 250.5
+	assigned := 2800.0
+	changes := []Change{
+		{
+			ID:      suite.budgetId,
+			Section: domain.Main,
+			Action:  shared.Update,
+			Detail: map[string]any{
+				"fixedIncome": fixedIncome,
+			},
+		},
+	}
+	budget := domain.Budget{
+		ID:               &suite.budgetId,
+		Name:             &name,
+		UserId:           &suite.userId,
+		FixedIncome:      &fixedIncome,
+		AdditionalIncome: &additionalIncome,
+		ZeroBased:        &zeroBased,
+		TotalAssigned:    &assigned,
+	}
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	}).Times(3)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget, nil)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(b domain.Budget) bool {
+		return b.ToBeAssigned == nil
+	})).Return(suite.budgetId, nil).Once()
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(b domain.Budget) bool {
+		return b.ToBeAssigned != nil && *b.ToBeAssigned == 450.5
+	})).Return(suite.budgetId, nil).Once()
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockBudgetSnapshotRepo.On("WithTransaction", nil).Return(suite.mockBudgetSnapshotRepo)
+	suite.mockBudgetSnapshotRepo.On("Save", suite.ctx, mock.Anything).Return(uint(1), nil)
+	suite.mockBudgetSnapshotRepo.On("DeleteAutomaticByBudgetIdExceedingLimit", suite.ctx, suite.budgetId, mock.Anything).Return(nil)
+	suite.mockPubSub.On("Publish", suite.ctx, "budget:1", mock.Anything).Return(nil)
+
+	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
+
+	require.Len(results, 1)
+	require.NoError(results[0].Err)
+}
+
+func (suite *TestBudgetSuite) TestChangesErrorSearch() {
+	require := require.New(suite.T())
+	changes := []Change{
+		{
+			ID:      uint(1),
+			Section: domain.Main,
+			Action:  shared.Update,
+			Detail: map[string]any{
+				"name": "Test 1",
+			},
+		},
+		{
+			ID:      uint(1),
+			Section: domain.Available,
+			Action:  shared.Update,
+			Detail: map[string]any{
+				"name": "Test 1",
+			},
+		},
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
+
+	require.Len(results, 2)
+
+	for _, result := range results {
+		require.EqualError(gorm.ErrRecordNotFound, result.Err.Error())
+	}
+}
+
 func (suite *TestBudgetSuite) TestChangesErrorData() {
 	require := require.New(suite.T())
 	changes := []Change{
@@ -566,6 +1081,8 @@ func (suite *TestBudgetSuite) TestChangesErrorData() {
 		},
 	}
 
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{ID: &suite.budgetId}, nil)
+
 	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
 
 	require.NotEmpty(results)
@@ -632,6 +1149,8 @@ func (suite *TestBudgetSuite) TestChangesErrorAction() {
 	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), gorm.ErrRecordNotFound)
 	suite.mockBudgetBillRepo.On("Delete", suite.ctx, mock.Anything).Return(gorm.ErrRecordNotFound)
 
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{ID: &suite.budgetId}, nil)
+
 	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
 
 	require.NotEmpty(results)
@@ -670,6 +1189,8 @@ func (suite *TestBudgetSuite) TestChangesErrorInvalidDataTypeMain() {
 		return fc(nil)
 	}).Times(2)
 
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{ID: &suite.budgetId}, nil)
+
 	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
 
 	require.NotEmpty(results)
@@ -698,6 +1219,8 @@ func (suite *TestBudgetSuite) TestChangesErrorInvalidDataTypeAvailable() {
 		return fc(nil)
 	})
 
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{ID: &suite.budgetId}, nil)
+
 	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
 
 	require.NotEmpty(results)
@@ -746,6 +1269,8 @@ func (suite *TestBudgetSuite) TestChangesErrorInvalidDataTypeBill() {
 		return fc(nil)
 	}).Times(3)
 
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{ID: &suite.budgetId}, nil)
+
 	results := suite.app.Changes(suite.ctx, suite.budgetId, changes)
 
 	require.NotEmpty(results)
@@ -769,13 +1294,42 @@ func (suite *TestBudgetSuite) TestDeleteSuccess() {
 		UserId: &suite.userId,
 	}
 	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budgetExpected, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
 	suite.mockBudgetRepo.On("Delete", suite.ctx, *budgetExpected.ID).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se envía el presupuesto a la papelera", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BudgetDeleted, suite.budgetId, mock.Anything, nil).Return(nil)
 
 	err := suite.app.Delete(suite.ctx, suite.budgetId)
 
 	require.NoError(err)
 }
 
+func (suite *TestBudgetSuite) TestDeleteErrorPublish() {
+	require := require.New(suite.T())
+	name := "Test"
+	budgetExpected := domain.Budget{
+		ID:     &suite.budgetId,
+		Name:   &name,
+		UserId: &suite.userId,
+	}
+	errExpected := errors.New("Error publishing event")
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budgetExpected, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Delete", suite.ctx, *budgetExpected.ID).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BudgetDeleted, suite.budgetId, mock.Anything, nil).Return(errExpected)
+
+	err := suite.app.Delete(suite.ctx, suite.budgetId)
+
+	require.EqualError(errExpected, err.Error())
+}
+
 func (suite *TestBudgetSuite) TestDeleteErrorSearch() {
 	require := require.New(suite.T())
 	errExpected := errors.New("Error find budget by id")
@@ -800,6 +1354,10 @@ func (suite *TestBudgetSuite) TestDeleteError() {
 	}
 	errExpected := errors.New("Error find budget by id")
 	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budgetExpected, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
 	suite.mockBudgetRepo.On("Delete", suite.ctx, *budgetExpected.ID).Return(errExpected)
 
 	err := suite.app.Delete(suite.ctx, suite.budgetId)
@@ -807,6 +1365,24 @@ func (suite *TestBudgetSuite) TestDeleteError() {
 	require.EqualError(errExpected, err.Error())
 }
 
+func (suite *TestBudgetSuite) TestDeleteUserEventSuccess() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("DeleteAllByUserId", suite.ctx, suite.userId).Return(nil)
+
+	err := suite.app.DeleteUserEvent(suite.ctx, shared.Event{Name: shared.UserDeleted, ResourceId: suite.userId})
+
+	require.NoError(err)
+}
+
+func (suite *TestBudgetSuite) TestDeleteUserEventError() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("DeleteAllByUserId", suite.ctx, suite.userId).Return(gorm.ErrInvalidField)
+
+	err := suite.app.DeleteUserEvent(suite.ctx, shared.Event{Name: shared.UserDeleted, ResourceId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
 func TestTestBudgetSuite(t *testing.T) {
 	suite.Run(t, new(TestBudgetSuite))
 }
@@ -0,0 +1,531 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCreditCardLimit                = errors.New("the charge exceeds the limit of the card")
+	ErrCreditCardStatementPaid        = errors.New("the statement is already paid")
+	ErrCreditCardStatementWithoutBill = errors.New("the statement is not a bill of a budget yet")
+	ErrCreditCardAvailable            = errors.New("the available is not in the budget of the statement")
+)
+
+type ICreditCardApp interface {
+	Create(ctx context.Context, card domain.CreditCard) (domain.CreditCard, error)
+	FindByUserId(ctx context.Context, userId uint) ([]domain.CreditCard, error)
+	Delete(ctx context.Context, userId, id uint) error
+	RecordCharge(ctx context.Context, userId, id uint, charge domain.CreditCardCharge) (domain.CreditCardStatement, error)
+	FindStatements(ctx context.Context, userId, id uint) ([]domain.CreditCardStatement, error)
+	FindCharges(ctx context.Context, userId, statementId uint) ([]domain.CreditCardCharge, error)
+	PayStatement(ctx context.Context, userId, statementId, availableId uint) (domain.CreditCardStatement, error)
+	MaterializeEvent(ctx context.Context, event shared.Event) error
+	PurgeEvent(ctx context.Context, event shared.Event) error
+	DeleteUserEvent(ctx context.Context, event shared.Event) error
+}
+
+type creditCardApp struct {
+	tm                  persistent.TransactionManager
+	creditCardRepo      domain.CreditCardRepository
+	budgetRepo          domain.BudgetRepository
+	budgetAvailableRepo domain.BudgetAvailableRepository
+	budgetBillRepo      domain.BudgetBillRepository
+	logApp              application.ILogApp
+	eventApp            application.IEventApp
+}
+
+func (app *creditCardApp) Create(ctx context.Context, card domain.CreditCard) (domain.CreditCard, error) {
+	card.ID = 0
+	card.CreatedAt = time.Now()
+	err := app.tm.Transaction(func(tx persistent.Transaction) error {
+		creditCardRepo := app.creditCardRepo.WithTransaction(tx)
+		id, err := creditCardRepo.Save(ctx, card)
+		if err != nil {
+			return err
+		}
+
+		card.ID = id
+		detail := map[string]any{
+			"id":                card.ID,
+			"name":              card.Name,
+			"limit":             card.Limit,
+			"closingDay":        card.ClosingDay,
+			"paymentDay":        card.PaymentDay,
+			"minimumPercentage": card.MinimumPercentage,
+		}
+		return app.logApp.Create(ctx, "Se crea una tarjeta de crédito", shared.User, card.UserId, detail, tx)
+	})
+	if err != nil {
+		return domain.CreditCard{}, err
+	}
+
+	return card, nil
+}
+
+func (app *creditCardApp) FindByUserId(ctx context.Context, userId uint) ([]domain.CreditCard, error) {
+	return app.creditCardRepo.SearchAllByExample(ctx, domain.CreditCard{
+		UserId: userId,
+	})
+}
+
+func (app *creditCardApp) Delete(ctx context.Context, userId, id uint) error {
+	card, err := app.findById(ctx, userId, id)
+	if err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		creditCardRepo := app.creditCardRepo.WithTransaction(tx)
+		if err := creditCardRepo.Delete(ctx, card.ID); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"id":   card.ID,
+			"name": card.Name,
+		}
+		return app.logApp.Create(ctx, "Se elimina una tarjeta de crédito", shared.User, userId, detail, tx)
+	})
+}
+
+// RecordCharge adds the charge to the statement of its cycle and keeps the bill of the statement
+// with its total, the bill is created when the budget of the month it is due already exists.
+func (app *creditCardApp) RecordCharge(ctx context.Context, userId, id uint, charge domain.CreditCardCharge) (domain.CreditCardStatement, error) {
+	card, err := app.findById(ctx, userId, id)
+	if err != nil {
+		return domain.CreditCardStatement{}, err
+	}
+
+	closing, due := statementPeriod(card, charge.Date)
+	var statement domain.CreditCardStatement
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		// The card stays locked until the charge is saved, so the totals of its statements read below
+		// are not changed by another charge before the limit is checked and the new total is saved
+		creditCardRepo := app.creditCardRepo.WithTransaction(tx)
+		card, err := creditCardRepo.SearchForUpdate(ctx, card.ID)
+		if err != nil {
+			return err
+		}
+
+		statement, err = creditCardRepo.SearchStatementByExample(ctx, domain.CreditCardStatement{
+			CardId: card.ID,
+			Year:   uint16(closing.Year()),
+			Month:  uint8(closing.Month()),
+		})
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			statement = domain.CreditCardStatement{
+				CardId:      card.ID,
+				Year:        uint16(closing.Year()),
+				Month:       uint8(closing.Month()),
+				ClosingDate: closing,
+				DueDate:     due,
+			}
+		} else if err != nil {
+			return err
+		}
+
+		if statement.PaidAt != nil {
+			return ErrCreditCardStatementPaid
+		}
+
+		if card.Limit > 0 {
+			statements, err := creditCardRepo.SearchAllStatements(ctx, card.ID)
+			if err != nil {
+				return err
+			}
+
+			used := charge.Amount
+			for _, current := range statements {
+				if current.PaidAt == nil {
+					used += current.Total
+				}
+			}
+
+			if roundCents(used) > card.Limit {
+				return ErrCreditCardLimit
+			}
+		}
+
+		var budgetId *uint
+		if statement.BudgetBillId == nil {
+			budget, err := app.budgetRepo.WithTransaction(tx).SearchLatestByMonth(ctx, userId, uint16(due.Year()), uint8(due.Month()))
+			if err == nil {
+				budgetId = budget.ID
+			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+		}
+
+		statement.Total = roundCents(statement.Total + charge.Amount)
+		statement.MinimumPayment = minimumPayment(card, statement.Total)
+		statementId, err := creditCardRepo.SaveStatement(ctx, statement)
+		if err != nil {
+			return err
+		}
+
+		statement.ID = statementId
+		charge.StatementId = statementId
+		if _, err := creditCardRepo.SaveCharge(ctx, charge); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"cardId":      card.ID,
+			"statementId": statement.ID,
+			"description": charge.Description,
+			"amount":      charge.Amount,
+		}
+		description := fmt.Sprintf("Se registra un cargo en la tarjeta %s", card.Name)
+		if err := app.logApp.Create(ctx, description, shared.User, userId, detail, tx); err != nil {
+			return err
+		}
+
+		budgetBillRepo := app.budgetBillRepo.WithTransaction(tx)
+		if statement.BudgetBillId != nil {
+			bill, err := budgetBillRepo.Search(ctx, *statement.BudgetBillId)
+			if err != nil {
+				return err
+			}
+
+			bill.Amount = &statement.Total
+			if _, err := budgetBillRepo.Save(ctx, bill); err != nil {
+				return err
+			}
+
+			budgetId = bill.BudgetId
+		} else if budgetId != nil {
+			if statement, err = app.statementBill(ctx, tx, card, statement, *budgetId); err != nil {
+				return err
+			}
+		}
+
+		if budgetId == nil {
+			return nil
+		}
+
+		return saveTotals(ctx, app.budgetRepo.WithTransaction(tx), *budgetId)
+	})
+	if err != nil {
+		return domain.CreditCardStatement{}, err
+	}
+
+	return statement, nil
+}
+
+func (app *creditCardApp) FindStatements(ctx context.Context, userId, id uint) ([]domain.CreditCardStatement, error) {
+	card, err := app.findById(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.creditCardRepo.SearchAllStatements(ctx, card.ID)
+}
+
+func (app *creditCardApp) FindCharges(ctx context.Context, userId, statementId uint) ([]domain.CreditCardCharge, error) {
+	statement, _, err := app.findStatement(ctx, userId, statementId)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.creditCardRepo.SearchAllCharges(ctx, statement.ID)
+}
+
+// PayStatement pays the total of the statement with an available of the budget of its bill, the
+// bill is completed and the available keeps the rest.
+func (app *creditCardApp) PayStatement(ctx context.Context, userId, statementId, availableId uint) (domain.CreditCardStatement, error) {
+	statement, card, err := app.findStatement(ctx, userId, statementId)
+	if err != nil {
+		return domain.CreditCardStatement{}, err
+	}
+
+	if statement.PaidAt != nil {
+		return domain.CreditCardStatement{}, ErrCreditCardStatementPaid
+	}
+
+	if statement.BudgetBillId == nil {
+		return domain.CreditCardStatement{}, ErrCreditCardStatementWithoutBill
+	}
+
+	bill, err := app.budgetBillRepo.Search(ctx, *statement.BudgetBillId)
+	if err != nil {
+		return domain.CreditCardStatement{}, err
+	}
+
+	budget, err := app.budgetRepo.Search(ctx, *bill.BudgetId)
+	if err != nil {
+		return domain.CreditCardStatement{}, err
+	}
+
+	var available *domain.BudgetAvailable
+	for _, current := range budget.BudgetAvailables {
+		if *current.ID == availableId {
+			currentC := current
+			available = &currentC
+		}
+	}
+
+	if available == nil {
+		return domain.CreditCardStatement{}, ErrCreditCardAvailable
+	}
+
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		// The total is read again under the lock of the card, so a charge recorded meanwhile is paid too
+		creditCardRepo := app.creditCardRepo.WithTransaction(tx)
+		if _, err := creditCardRepo.SearchForUpdate(ctx, card.ID); err != nil {
+			return err
+		}
+
+		statement, err = creditCardRepo.SearchStatementByExample(ctx, domain.CreditCardStatement{ID: statement.ID})
+		if err != nil {
+			return err
+		}
+
+		if statement.PaidAt != nil {
+			return ErrCreditCardStatementPaid
+		}
+
+		amount := roundCents(*available.Amount - statement.Total)
+		if _, err := app.budgetAvailableRepo.WithTransaction(tx).Save(ctx, domain.BudgetAvailable{
+			ID:     available.ID,
+			Amount: &amount,
+		}); err != nil {
+			return err
+		}
+
+		complete := true
+		bill.Payment = &statement.Total
+		bill.Complete = &complete
+		if _, err := app.budgetBillRepo.WithTransaction(tx).Save(ctx, bill); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		statement.PaidAt = &now
+		statement.AvailableId = &availableId
+		if _, err := creditCardRepo.SaveStatement(ctx, statement); err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("Se paga el extracto %02d/%d de la tarjeta %s", statement.Month, statement.Year, card.Name)
+		detail := map[string]any{
+			"statementId": statement.ID,
+			"billId":      *bill.ID,
+			"availableId": availableId,
+			"amount":      statement.Total,
+		}
+		if err := app.logApp.Create(ctx, description, shared.Budget, *budget.ID, detail, tx); err != nil {
+			return err
+		}
+
+		payload := map[string]any{
+			"budgetId":    *budget.ID,
+			"description": description,
+			"amount":      statement.Total,
+			"payment":     statement.Total,
+		}
+		if err := app.eventApp.Publish(ctx, shared.BillPaid, *bill.ID, payload, tx); err != nil {
+			return err
+		}
+
+		return saveTotals(ctx, app.budgetRepo.WithTransaction(tx), *budget.ID)
+	})
+	if err != nil {
+		return domain.CreditCardStatement{}, err
+	}
+
+	return statement, nil
+}
+
+// MaterializeEvent adds to a new budget the bills of the statements due in its month
+func (app *creditCardApp) MaterializeEvent(ctx context.Context, event shared.Event) error {
+	budget, err := app.budgetRepo.Search(ctx, event.ResourceId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	cards, err := app.FindByUserId(ctx, *budget.UserId)
+	if err != nil {
+		return err
+	}
+
+	type pending struct {
+		card      domain.CreditCard
+		statement domain.CreditCardStatement
+	}
+	pendings := []pending{}
+	for _, card := range cards {
+		closing := statementClosingMonth(card, *budget.Year, *budget.Month)
+		statement, err := app.creditCardRepo.SearchStatementByExample(ctx, domain.CreditCardStatement{
+			CardId: card.ID,
+			Year:   uint16(closing.Year()),
+			Month:  uint8(closing.Month()),
+		})
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if statement.BudgetBillId == nil {
+			pendings = append(pendings, pending{card, statement})
+		}
+	}
+
+	if len(pendings) == 0 {
+		return nil
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		for _, pending := range pendings {
+			if _, err := app.statementBill(ctx, tx, pending.card, pending.statement, *budget.ID); err != nil {
+				return err
+			}
+		}
+
+		return saveTotals(ctx, app.budgetRepo.WithTransaction(tx), *budget.ID)
+	})
+}
+
+// statementBill creates the bill of the statement in the budget and links them
+func (app *creditCardApp) statementBill(
+	ctx context.Context, tx persistent.Transaction, card domain.CreditCard, statement domain.CreditCardStatement, budgetId uint,
+) (domain.CreditCardStatement, error) {
+	description := fmt.Sprintf("%s %02d/%d", card.Name, statement.Month, statement.Year)
+	category := domain.Financial
+	payment := 0.0
+	complete := false
+	billId, err := app.budgetBillRepo.WithTransaction(tx).Save(ctx, domain.BudgetBill{
+		Description: &description,
+		Amount:      &statement.Total,
+		Payment:     &payment,
+		DueDate:     &card.PaymentDay,
+		Complete:    &complete,
+		BudgetId:    &budgetId,
+		Category:    &category,
+	})
+	if err != nil {
+		return domain.CreditCardStatement{}, err
+	}
+
+	statement.BudgetBillId = &billId
+	if _, err := app.creditCardRepo.WithTransaction(tx).SaveStatement(ctx, statement); err != nil {
+		return domain.CreditCardStatement{}, err
+	}
+
+	descriptionLog := fmt.Sprintf("Se crea el pago del extracto %02d/%d de la tarjeta %s", statement.Month, statement.Year, card.Name)
+	detail := map[string]any{
+		"cardId":      card.ID,
+		"statementId": statement.ID,
+		"billId":      billId,
+		"amount":      statement.Total,
+	}
+	if err := app.logApp.Create(ctx, descriptionLog, shared.Budget, budgetId, detail, tx); err != nil {
+		return domain.CreditCardStatement{}, err
+	}
+
+	payload := map[string]any{
+		"budgetId":    budgetId,
+		"description": description,
+		"category":    category,
+		"statementId": statement.ID,
+	}
+	if err := app.eventApp.Publish(ctx, shared.BillCreated, billId, payload, tx); err != nil {
+		return domain.CreditCardStatement{}, err
+	}
+
+	return statement, nil
+}
+
+func (app *creditCardApp) findById(ctx context.Context, userId, id uint) (domain.CreditCard, error) {
+	return app.creditCardRepo.SearchByExample(ctx, domain.CreditCard{
+		ID:     id,
+		UserId: userId,
+	})
+}
+
+// findStatement reads the statement with its card, the statements of other users are not found
+func (app *creditCardApp) findStatement(ctx context.Context, userId, statementId uint) (domain.CreditCardStatement, domain.CreditCard, error) {
+	statement, err := app.creditCardRepo.SearchStatementByExample(ctx, domain.CreditCardStatement{
+		ID: statementId,
+	})
+	if err != nil {
+		return domain.CreditCardStatement{}, domain.CreditCard{}, err
+	}
+
+	card, err := app.findById(ctx, userId, statement.CardId)
+	if err != nil {
+		return domain.CreditCardStatement{}, domain.CreditCard{}, err
+	}
+
+	return statement, card, nil
+}
+
+// PurgeEvent unlinks the statements from the purged bills and availables
+func (app *creditCardApp) PurgeEvent(ctx context.Context, event shared.Event) error {
+	return app.creditCardRepo.DeleteOrphans(ctx)
+}
+
+// DeleteUserEvent removes the cards of a deleted user with their statements and charges
+func (app *creditCardApp) DeleteUserEvent(ctx context.Context, event shared.Event) error {
+	return app.creditCardRepo.DeleteAllByUserId(ctx, event.ResourceId)
+}
+
+func NewCreditCardApp(
+	tm persistent.TransactionManager, creditCardRepo domain.CreditCardRepository, budgetRepo domain.BudgetRepository,
+	budgetAvailableRepo domain.BudgetAvailableRepository, budgetBillRepo domain.BudgetBillRepository,
+	logApp application.ILogApp, eventApp application.IEventApp,
+) ICreditCardApp {
+	return &creditCardApp{tm, creditCardRepo, budgetRepo, budgetAvailableRepo, budgetBillRepo, logApp, eventApp}
+}
+
+// statementPeriod returns the closing of the cycle of the charge and the date its statement is
+// due, the payment is in the month of the closing only when its day comes after the closing day.
+func statementPeriod(card domain.CreditCard, date time.Time) (time.Time, time.Time) {
+	closing := dayOfMonth(date.Year(), date.Month(), card.ClosingDay)
+	if date.Day() > closing.Day() {
+		closing = dayOfMonth(date.Year(), date.Month()+1, card.ClosingDay)
+	}
+
+	month := closing.Month()
+	if card.PaymentDay <= card.ClosingDay {
+		month += 1
+	}
+
+	return closing, dayOfMonth(closing.Year(), month, card.PaymentDay)
+}
+
+// statementClosingMonth returns the month of the closing of the statement due in the month
+func statementClosingMonth(card domain.CreditCard, year uint16, month uint8) time.Time {
+	closing := time.Date(int(year), time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	if card.PaymentDay <= card.ClosingDay {
+		closing = closing.AddDate(0, -1, 0)
+	}
+
+	return closing
+}
+
+// dayOfMonth builds the date of the day in the month, the days after its end move to its last day
+func dayOfMonth(year int, month time.Month, day uint8) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	if int(day) < last {
+		last = int(day)
+	}
+
+	return time.Date(first.Year(), first.Month(), last, 0, 0, 0, 0, time.UTC)
+}
+
+func minimumPayment(card domain.CreditCard, total float64) float64 {
+	return roundCents(total * card.MinimumPercentage / 100)
+}
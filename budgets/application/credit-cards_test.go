@@ -0,0 +1,491 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestCreditCardSuite struct {
+	suite.Suite
+	userId                  uint
+	id                      uint
+	statementId             uint
+	budgetId                uint
+	billId                  uint
+	availableId             uint
+	card                    domain.CreditCard
+	mockTransactionManager  *mocks_persistent.MockTransactionManager
+	mockCreditCardRepo      *mocks_domain.MockCreditCardRepository
+	mockBudgetRepo          *mocks_domain.MockBudgetRepository
+	mockBudgetAvailableRepo *mocks_domain.MockBudgetAvailableRepository
+	mockBudgetBillRepo      *mocks_domain.MockBudgetBillRepository
+	mockLogApp              *mocks_application.MockILogApp
+	mockEventApp            *mocks_application.MockIEventApp
+	app                     ICreditCardApp
+	ctx                     context.Context
+}
+
+func (suite *TestCreditCardSuite) SetupSuite() {
+	suite.userId = 1
+	suite.id = 2
+	suite.statementId = 3
+	suite.budgetId = 4
+	suite.billId = 5
+	suite.availableId = 6
+	suite.card = domain.CreditCard{
+		ID:                suite.id,
+		UserId:            suite.userId,
+		Name:              "Visa",
+		ClosingDay:        25,
+		PaymentDay:        10,
+		MinimumPercentage: 5,
+	}
+	suite.ctx = context.Background()
+}
+
+func (suite *TestCreditCardSuite) SetupTest() {
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockCreditCardRepo = mocks_domain.NewMockCreditCardRepository(suite.T())
+	suite.mockBudgetRepo = mocks_domain.NewMockBudgetRepository(suite.T())
+	suite.mockBudgetAvailableRepo = mocks_domain.NewMockBudgetAvailableRepository(suite.T())
+	suite.mockBudgetBillRepo = mocks_domain.NewMockBudgetBillRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.mockEventApp = mocks_application.NewMockIEventApp(suite.T())
+	suite.app = NewCreditCardApp(suite.mockTransactionManager, suite.mockCreditCardRepo, suite.mockBudgetRepo, suite.mockBudgetAvailableRepo, suite.mockBudgetBillRepo, suite.mockLogApp, suite.mockEventApp)
+}
+
+func (suite *TestCreditCardSuite) mockTransaction() {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockCreditCardRepo.On("WithTransaction", nil).Return(suite.mockCreditCardRepo)
+}
+
+func (suite *TestCreditCardSuite) mockFindCard(card domain.CreditCard, err error) {
+	suite.mockCreditCardRepo.On("SearchByExample", suite.ctx, domain.CreditCard{ID: suite.id, UserId: suite.userId}).Return(card, err)
+}
+
+func (suite *TestCreditCardSuite) mockLockCard(card domain.CreditCard, err error) {
+	suite.mockCreditCardRepo.On("SearchForUpdate", suite.ctx, suite.id).Return(card, err)
+}
+
+func (suite *TestCreditCardSuite) mockSaveTotals() {
+	budget := domain.Budget{ID: &suite.budgetId}
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget, nil).Once()
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(b domain.Budget) bool {
+		return b.TotalPending != nil
+	})).Return(suite.budgetId, nil)
+}
+
+func (suite *TestCreditCardSuite) statement() domain.CreditCardStatement {
+	return domain.CreditCardStatement{
+		ID:          suite.statementId,
+		CardId:      suite.id,
+		Year:        2024,
+		Month:       3,
+		ClosingDate: time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC),
+		DueDate:     time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC),
+		Total:       100,
+	}
+}
+
+func (suite *TestCreditCardSuite) charge(day int) domain.CreditCardCharge {
+	return domain.CreditCardCharge{
+		Description: "Groceries",
+		Amount:      50,
+		Date:        time.Date(2024, 3, day, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (suite *TestCreditCardSuite) TestStatementPeriod() {
+	require := require.New(suite.T())
+	card := suite.card
+
+	closing, due := statementPeriod(card, time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC))
+	require.Equal(time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC), closing)
+	require.Equal(time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), due)
+
+	closing, due = statementPeriod(card, time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC))
+	require.Equal(time.Date(2025, 1, 25, 0, 0, 0, 0, time.UTC), closing)
+	require.Equal(time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), due)
+
+	card.ClosingDay = 31
+	card.PaymentDay = 30
+	closing, due = statementPeriod(card, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+	require.Equal(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), closing)
+	require.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), due)
+
+	card.ClosingDay = 5
+	card.PaymentDay = 20
+	closing, due = statementPeriod(card, time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC))
+	require.Equal(time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC), closing)
+	require.Equal(time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC), due)
+	require.Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), statementClosingMonth(card, 2024, 4))
+}
+
+func (suite *TestCreditCardSuite) TestCreateSuccess() {
+	require := require.New(suite.T())
+	suite.mockTransaction()
+	suite.mockCreditCardRepo.On("Save", suite.ctx, mock.MatchedBy(func(card domain.CreditCard) bool {
+		return card.ID == 0 && card.Name == "Visa" && !card.CreatedAt.IsZero()
+	})).Return(suite.id, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se crea una tarjeta de crédito", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.Create(suite.ctx, suite.card)
+
+	require.NoError(err)
+	require.Equal(suite.id, res.ID)
+}
+
+func (suite *TestCreditCardSuite) TestDeleteErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockFindCard(domain.CreditCard{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.Delete(suite.ctx, suite.userId, suite.id)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestCreditCardSuite) TestRecordChargeSuccessNewStatement() {
+	require := require.New(suite.T())
+	suite.mockFindCard(suite.card, nil)
+	suite.mockTransaction()
+	suite.mockLockCard(suite.card, nil)
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, domain.CreditCardStatement{CardId: suite.id, Year: 2024, Month: 3}).Return(domain.CreditCardStatement{}, gorm.ErrRecordNotFound)
+	suite.mockBudgetRepo.On("SearchLatestByMonth", suite.ctx, suite.userId, uint16(2024), uint8(4)).Return(domain.Budget{ID: &suite.budgetId}, nil)
+	suite.mockCreditCardRepo.On("SaveStatement", suite.ctx, mock.MatchedBy(func(statement domain.CreditCardStatement) bool {
+		return statement.ID == 0 && statement.Total == 50 && statement.MinimumPayment == 2.5 && statement.DueDate.Equal(time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC))
+	})).Return(suite.statementId, nil)
+	suite.mockCreditCardRepo.On("SaveCharge", suite.ctx, mock.MatchedBy(func(charge domain.CreditCardCharge) bool {
+		return charge.StatementId == suite.statementId && charge.Amount == 50
+	})).Return(uint(1), nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se registra un cargo en la tarjeta Visa", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.MatchedBy(func(bill domain.BudgetBill) bool {
+		return *bill.Description == "Visa 03/2024" && *bill.Amount == 50 && *bill.DueDate == 10 && *bill.BudgetId == suite.budgetId
+	})).Return(suite.billId, nil)
+	suite.mockCreditCardRepo.On("SaveStatement", suite.ctx, mock.MatchedBy(func(statement domain.CreditCardStatement) bool {
+		return statement.ID == suite.statementId && *statement.BudgetBillId == suite.billId
+	})).Return(suite.statementId, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se crea el pago del extracto 03/2024 de la tarjeta Visa", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BillCreated, suite.billId, mock.Anything, nil).Return(nil)
+	suite.mockSaveTotals()
+
+	res, err := suite.app.RecordCharge(suite.ctx, suite.userId, suite.id, suite.charge(10))
+
+	require.NoError(err)
+	require.Equal(suite.statementId, res.ID)
+	require.Equal(suite.billId, *res.BudgetBillId)
+	require.Equal(50.0, res.Total)
+}
+
+func (suite *TestCreditCardSuite) TestRecordChargeSuccessExistingBill() {
+	require := require.New(suite.T())
+	statement := suite.statement()
+	statement.BudgetBillId = &suite.billId
+	suite.mockFindCard(suite.card, nil)
+	suite.mockTransaction()
+	suite.mockLockCard(suite.card, nil)
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, mock.Anything).Return(statement, nil)
+	suite.mockCreditCardRepo.On("SaveStatement", suite.ctx, mock.Anything).Return(suite.statementId, nil)
+	suite.mockCreditCardRepo.On("SaveCharge", suite.ctx, mock.Anything).Return(uint(1), nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, suite.userId, mock.Anything, nil).Return(nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("Search", suite.ctx, suite.billId).Return(domain.BudgetBill{ID: &suite.billId, BudgetId: &suite.budgetId}, nil)
+	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.MatchedBy(func(bill domain.BudgetBill) bool {
+		return *bill.ID == suite.billId && *bill.Amount == 150
+	})).Return(suite.billId, nil)
+	suite.mockSaveTotals()
+
+	res, err := suite.app.RecordCharge(suite.ctx, suite.userId, suite.id, suite.charge(20))
+
+	require.NoError(err)
+	require.Equal(150.0, res.Total)
+	require.Equal(7.5, res.MinimumPayment)
+}
+
+func (suite *TestCreditCardSuite) TestRecordChargeSuccessWithoutBudget() {
+	require := require.New(suite.T())
+	suite.mockFindCard(suite.card, nil)
+	suite.mockTransaction()
+	suite.mockLockCard(suite.card, nil)
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, mock.Anything).Return(domain.CreditCardStatement{}, gorm.ErrRecordNotFound)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("SearchLatestByMonth", suite.ctx, suite.userId, uint16(2024), uint8(4)).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+	suite.mockCreditCardRepo.On("SaveStatement", suite.ctx, mock.Anything).Return(suite.statementId, nil)
+	suite.mockCreditCardRepo.On("SaveCharge", suite.ctx, mock.Anything).Return(uint(1), nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, suite.userId, mock.Anything, nil).Return(nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+
+	res, err := suite.app.RecordCharge(suite.ctx, suite.userId, suite.id, suite.charge(10))
+
+	require.NoError(err)
+	require.Nil(res.BudgetBillId)
+}
+
+func (suite *TestCreditCardSuite) TestRecordChargeErrorLimit() {
+	require := require.New(suite.T())
+	card := suite.card
+	card.Limit = 120
+	paidAt := time.Now()
+	paid := suite.statement()
+	paid.PaidAt = &paidAt
+	suite.mockFindCard(suite.card, nil)
+	suite.mockTransaction()
+	suite.mockLockCard(card, nil)
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, mock.Anything).Return(suite.statement(), nil)
+	suite.mockCreditCardRepo.On("SearchAllStatements", suite.ctx, suite.id).Return([]domain.CreditCardStatement{suite.statement(), paid}, nil)
+
+	_, err := suite.app.RecordCharge(suite.ctx, suite.userId, suite.id, suite.charge(10))
+
+	require.ErrorIs(err, ErrCreditCardLimit)
+}
+
+func (suite *TestCreditCardSuite) TestRecordChargeErrorPaid() {
+	require := require.New(suite.T())
+	paidAt := time.Now()
+	statement := suite.statement()
+	statement.PaidAt = &paidAt
+	suite.mockFindCard(suite.card, nil)
+	suite.mockTransaction()
+	suite.mockLockCard(suite.card, nil)
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, mock.Anything).Return(statement, nil)
+
+	_, err := suite.app.RecordCharge(suite.ctx, suite.userId, suite.id, suite.charge(10))
+
+	require.ErrorIs(err, ErrCreditCardStatementPaid)
+}
+
+func (suite *TestCreditCardSuite) TestRecordChargeErrorLock() {
+	require := require.New(suite.T())
+	suite.mockFindCard(suite.card, nil)
+	suite.mockTransaction()
+	suite.mockLockCard(domain.CreditCard{}, gorm.ErrInvalidTransaction)
+
+	_, err := suite.app.RecordCharge(suite.ctx, suite.userId, suite.id, suite.charge(10))
+
+	require.ErrorIs(err, gorm.ErrInvalidTransaction)
+}
+
+func (suite *TestCreditCardSuite) TestFindChargesErrorOtherUser() {
+	require := require.New(suite.T())
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, domain.CreditCardStatement{ID: suite.statementId}).Return(suite.statement(), nil)
+	suite.mockFindCard(domain.CreditCard{}, gorm.ErrRecordNotFound)
+
+	_, err := suite.app.FindCharges(suite.ctx, suite.userId, suite.statementId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestCreditCardSuite) TestPayStatementSuccess() {
+	require := require.New(suite.T())
+	statement := suite.statement()
+	statement.BudgetBillId = &suite.billId
+	amount := 300.0
+	otherId := uint(99)
+	otherAmount := 10.0
+	bill := domain.BudgetBill{ID: &suite.billId, BudgetId: &suite.budgetId}
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, domain.CreditCardStatement{ID: suite.statementId}).Return(statement, nil)
+	suite.mockFindCard(suite.card, nil)
+	suite.mockBudgetBillRepo.On("Search", suite.ctx, suite.billId).Return(bill, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{
+		ID: &suite.budgetId,
+		BudgetAvailables: []domain.BudgetAvailable{
+			{ID: &otherId, Amount: &otherAmount},
+			{ID: &suite.availableId, Amount: &amount},
+		},
+	}, nil).Once()
+	suite.mockTransaction()
+	suite.mockLockCard(suite.card, nil)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.MatchedBy(func(available domain.BudgetAvailable) bool {
+		return *available.ID == suite.availableId && *available.Amount == 200
+	})).Return(suite.availableId, nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.MatchedBy(func(bill domain.BudgetBill) bool {
+		return *bill.Payment == 100 && *bill.Complete
+	})).Return(suite.billId, nil)
+	suite.mockCreditCardRepo.On("SaveStatement", suite.ctx, mock.MatchedBy(func(statement domain.CreditCardStatement) bool {
+		return statement.PaidAt != nil && *statement.AvailableId == suite.availableId
+	})).Return(suite.statementId, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se paga el extracto 03/2024 de la tarjeta Visa", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BillPaid, suite.billId, mock.Anything, nil).Return(nil)
+	suite.mockSaveTotals()
+
+	res, err := suite.app.PayStatement(suite.ctx, suite.userId, suite.statementId, suite.availableId)
+
+	require.NoError(err)
+	require.NotNil(res.PaidAt)
+}
+
+func (suite *TestCreditCardSuite) TestPayStatementSuccessChargeRecorded() {
+	require := require.New(suite.T())
+	statement := suite.statement()
+	statement.BudgetBillId = &suite.billId
+	charged := statement
+	charged.Total = 150
+	amount := 300.0
+	bill := domain.BudgetBill{ID: &suite.billId, BudgetId: &suite.budgetId}
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, domain.CreditCardStatement{ID: suite.statementId}).Return(statement, nil).Once()
+	suite.mockFindCard(suite.card, nil)
+	suite.mockBudgetBillRepo.On("Search", suite.ctx, suite.billId).Return(bill, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{
+		ID:               &suite.budgetId,
+		BudgetAvailables: []domain.BudgetAvailable{{ID: &suite.availableId, Amount: &amount}},
+	}, nil).Once()
+	suite.mockTransaction()
+	suite.mockLockCard(suite.card, nil)
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, domain.CreditCardStatement{ID: suite.statementId}).Return(charged, nil).Once()
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("Save", suite.ctx, mock.MatchedBy(func(available domain.BudgetAvailable) bool {
+		return *available.Amount == 150
+	})).Return(suite.availableId, nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.MatchedBy(func(bill domain.BudgetBill) bool {
+		return *bill.Payment == 150
+	})).Return(suite.billId, nil)
+	suite.mockCreditCardRepo.On("SaveStatement", suite.ctx, mock.MatchedBy(func(statement domain.CreditCardStatement) bool {
+		return statement.Total == 150 && statement.PaidAt != nil
+	})).Return(suite.statementId, nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BillPaid, suite.billId, mock.Anything, nil).Return(nil)
+	suite.mockSaveTotals()
+
+	res, err := suite.app.PayStatement(suite.ctx, suite.userId, suite.statementId, suite.availableId)
+
+	require.NoError(err)
+	require.Equal(150.0, res.Total)
+}
+
+func (suite *TestCreditCardSuite) TestPayStatementErrorPaidMeanwhile() {
+	require := require.New(suite.T())
+	statement := suite.statement()
+	statement.BudgetBillId = &suite.billId
+	paidAt := time.Now()
+	paid := statement
+	paid.PaidAt = &paidAt
+	amount := 300.0
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, domain.CreditCardStatement{ID: suite.statementId}).Return(statement, nil).Once()
+	suite.mockFindCard(suite.card, nil)
+	suite.mockBudgetBillRepo.On("Search", suite.ctx, suite.billId).Return(domain.BudgetBill{ID: &suite.billId, BudgetId: &suite.budgetId}, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{
+		ID:               &suite.budgetId,
+		BudgetAvailables: []domain.BudgetAvailable{{ID: &suite.availableId, Amount: &amount}},
+	}, nil)
+	suite.mockTransaction()
+	suite.mockLockCard(suite.card, nil)
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, domain.CreditCardStatement{ID: suite.statementId}).Return(paid, nil).Once()
+
+	_, err := suite.app.PayStatement(suite.ctx, suite.userId, suite.statementId, suite.availableId)
+
+	require.ErrorIs(err, ErrCreditCardStatementPaid)
+}
+
+func (suite *TestCreditCardSuite) TestPayStatementErrorWithoutBill() {
+	require := require.New(suite.T())
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, mock.Anything).Return(suite.statement(), nil)
+	suite.mockFindCard(suite.card, nil)
+
+	_, err := suite.app.PayStatement(suite.ctx, suite.userId, suite.statementId, suite.availableId)
+
+	require.ErrorIs(err, ErrCreditCardStatementWithoutBill)
+}
+
+func (suite *TestCreditCardSuite) TestPayStatementErrorAvailable() {
+	require := require.New(suite.T())
+	statement := suite.statement()
+	statement.BudgetBillId = &suite.billId
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, mock.Anything).Return(statement, nil)
+	suite.mockFindCard(suite.card, nil)
+	suite.mockBudgetBillRepo.On("Search", suite.ctx, suite.billId).Return(domain.BudgetBill{ID: &suite.billId, BudgetId: &suite.budgetId}, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{ID: &suite.budgetId}, nil)
+
+	_, err := suite.app.PayStatement(suite.ctx, suite.userId, suite.statementId, suite.availableId)
+
+	require.ErrorIs(err, ErrCreditCardAvailable)
+}
+
+func (suite *TestCreditCardSuite) TestMaterializeEventSuccess() {
+	require := require.New(suite.T())
+	year := uint16(2024)
+	month := uint8(4)
+	budget := domain.Budget{ID: &suite.budgetId, Year: &year, Month: &month, UserId: &suite.userId}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget, nil).Once()
+	suite.mockCreditCardRepo.On("SearchAllByExample", suite.ctx, domain.CreditCard{UserId: suite.userId}).Return([]domain.CreditCard{suite.card}, nil)
+	suite.mockCreditCardRepo.On("SearchStatementByExample", suite.ctx, domain.CreditCardStatement{CardId: suite.id, Year: 2024, Month: 3}).Return(suite.statement(), nil)
+	suite.mockTransaction()
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.MatchedBy(func(bill domain.BudgetBill) bool {
+		return *bill.Amount == 100 && *bill.BudgetId == suite.budgetId
+	})).Return(suite.billId, nil)
+	suite.mockCreditCardRepo.On("SaveStatement", suite.ctx, mock.Anything).Return(suite.statementId, nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BillCreated, suite.billId, mock.Anything, nil).Return(nil)
+	suite.mockSaveTotals()
+
+	err := suite.app.MaterializeEvent(suite.ctx, shared.Event{Name: shared.BudgetCreated, ResourceId: suite.budgetId})
+
+	require.NoError(err)
+}
+
+func (suite *TestCreditCardSuite) TestMaterializeEventSuccessBudgetDeleted() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.MaterializeEvent(suite.ctx, shared.Event{Name: shared.BudgetCreated, ResourceId: suite.budgetId})
+
+	require.NoError(err)
+}
+
+func (suite *TestCreditCardSuite) TestPurgeEventSuccess() {
+	require := require.New(suite.T())
+	suite.mockCreditCardRepo.On("DeleteOrphans", suite.ctx).Return(nil)
+
+	err := suite.app.PurgeEvent(suite.ctx, shared.Event{Name: shared.TrashPurged, ResourceId: suite.userId})
+
+	require.NoError(err)
+}
+
+func (suite *TestCreditCardSuite) TestPurgeEventError() {
+	require := require.New(suite.T())
+	suite.mockCreditCardRepo.On("DeleteOrphans", suite.ctx).Return(gorm.ErrInvalidField)
+
+	err := suite.app.PurgeEvent(suite.ctx, shared.Event{Name: shared.TrashPurged, ResourceId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestCreditCardSuite) TestDeleteUserEventSuccess() {
+	require := require.New(suite.T())
+	suite.mockCreditCardRepo.On("DeleteAllByUserId", suite.ctx, suite.userId).Return(nil)
+
+	err := suite.app.DeleteUserEvent(suite.ctx, shared.Event{Name: shared.UserDeleted, ResourceId: suite.userId})
+
+	require.NoError(err)
+}
+
+func (suite *TestCreditCardSuite) TestDeleteUserEventError() {
+	require := require.New(suite.T())
+	suite.mockCreditCardRepo.On("DeleteAllByUserId", suite.ctx, suite.userId).Return(gorm.ErrInvalidField)
+
+	err := suite.app.DeleteUserEvent(suite.ctx, shared.Event{Name: shared.UserDeleted, ResourceId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestCreditCardSuite(t *testing.T) {
+	suite.Run(t, new(TestCreditCardSuite))
+}
@@ -0,0 +1,598 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrEnvelopeMode         = errors.New("the budget is not zero-based")
+	ErrEnvelopeTarget       = errors.New("the envelope must be for a category or for a bill of the budget")
+	ErrEnvelopeExists       = errors.New("the budget already has an envelope for the category or the bill")
+	ErrEnvelopeOverAssigned = errors.New("the assigned amount exceeds the income to be assigned")
+	ErrEnvelopeFunds        = errors.New("the envelope does not have the amount available")
+	ErrEnvelopeSame         = errors.New("the money must be moved between different envelopes")
+)
+
+// EnvelopeStatus is an envelope with what was paid of its bills, a negative Available is the
+// overspending that the rollover carries into the next month
+type EnvelopeStatus struct {
+	Envelope  domain.BudgetEnvelope
+	Spent     float64
+	Available float64
+}
+
+type EnvelopeSummary struct {
+	BudgetId      uint
+	ZeroBased     bool
+	Income        float64
+	TotalAssigned float64
+	ToBeAssigned  float64
+	Envelopes     []EnvelopeStatus
+}
+
+type IEnvelopeApp interface {
+	SetMode(ctx context.Context, userId, budgetId uint, zeroBased bool) (EnvelopeSummary, error)
+	FindSummary(ctx context.Context, userId, budgetId uint) (EnvelopeSummary, error)
+	Create(ctx context.Context, userId uint, envelope domain.BudgetEnvelope) (domain.BudgetEnvelope, error)
+	Assign(ctx context.Context, userId, budgetId, id uint, assigned float64) (EnvelopeSummary, error)
+	Move(ctx context.Context, userId, budgetId, fromId, toId uint, amount float64) (EnvelopeSummary, error)
+	Delete(ctx context.Context, userId, budgetId, id uint) error
+	Rollover(ctx context.Context, userId, budgetId uint) (EnvelopeSummary, error)
+	CarryoverEvent(ctx context.Context, event shared.Event) error
+	PurgeEvent(ctx context.Context, event shared.Event) error
+}
+
+type envelopeApp struct {
+	tm                 persistent.TransactionManager
+	budgetEnvelopeRepo domain.BudgetEnvelopeRepository
+	budgetRepo         domain.BudgetRepository
+	logApp             application.ILogApp
+}
+
+func (app *envelopeApp) SetMode(ctx context.Context, userId, budgetId uint, zeroBased bool) (EnvelopeSummary, error) {
+	budget, err := app.findBudget(ctx, userId, budgetId)
+	if err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		budgetRepo := app.budgetRepo.WithTransaction(tx)
+		update := domain.Budget{
+			ID:        &budgetId,
+			ZeroBased: &zeroBased,
+		}
+		if !zeroBased {
+			zero := 0.0
+			update.ToBeAssigned = &zero
+		}
+
+		if _, err := budgetRepo.Save(ctx, update); err != nil {
+			return err
+		}
+
+		description := "Se desactiva el modo base cero"
+		if zeroBased {
+			description = "Se activa el modo base cero"
+		}
+		detail := map[string]any{
+			"before": isZeroBased(budget),
+			"after":  zeroBased,
+		}
+		if err := app.logApp.Create(ctx, description, shared.Budget, budgetId, detail, tx); err != nil {
+			return err
+		}
+
+		return saveTotals(ctx, budgetRepo, budgetId)
+	})
+	if err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	return app.summary(ctx, budgetId)
+}
+
+func (app *envelopeApp) FindSummary(ctx context.Context, userId, budgetId uint) (EnvelopeSummary, error) {
+	if _, err := app.findBudget(ctx, userId, budgetId); err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	return app.summary(ctx, budgetId)
+}
+
+// Create adds an envelope for a category or for a bill of a zero-based budget, its name is the
+// category or the description of the bill
+func (app *envelopeApp) Create(ctx context.Context, userId uint, envelope domain.BudgetEnvelope) (domain.BudgetEnvelope, error) {
+	budget, err := app.findBudget(ctx, userId, envelope.BudgetId)
+	if err != nil {
+		return domain.BudgetEnvelope{}, err
+	}
+
+	if !isZeroBased(budget) {
+		return domain.BudgetEnvelope{}, ErrEnvelopeMode
+	}
+
+	if (envelope.Category == nil) == (envelope.BudgetBillId == nil) {
+		return domain.BudgetEnvelope{}, ErrEnvelopeTarget
+	}
+
+	if envelope.Category != nil {
+		envelope.Name = string(*envelope.Category)
+	} else {
+		bill := findBill(budget, *envelope.BudgetBillId)
+		if bill == nil {
+			return domain.BudgetEnvelope{}, ErrEnvelopeTarget
+		}
+
+		envelope.Name = *bill.Description
+	}
+
+	envelopes, err := app.budgetEnvelopeRepo.SearchAllByBudgetId(ctx, envelope.BudgetId)
+	if err != nil {
+		return domain.BudgetEnvelope{}, err
+	}
+
+	if _, ok := matchEnvelope(envelopes, envelope.Category, envelope.BudgetBillId); ok {
+		return domain.BudgetEnvelope{}, ErrEnvelopeExists
+	}
+
+	envelope.ID = 0
+	envelope.Assigned = roundCents(envelope.Assigned)
+	envelope.Carryover = 0
+	if toBeAssigned(budget, totalAssigned(envelopes)+envelope.Assigned) < 0 {
+		return domain.BudgetEnvelope{}, ErrEnvelopeOverAssigned
+	}
+
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		id, err := app.budgetEnvelopeRepo.WithTransaction(tx).Save(ctx, envelope)
+		if err != nil {
+			return err
+		}
+
+		envelope.ID = id
+		description := fmt.Sprintf("Se crea el sobre %s", envelope.Name)
+		detail := map[string]any{
+			"id":       envelope.ID,
+			"assigned": envelope.Assigned,
+		}
+		if err := app.logApp.Create(ctx, description, shared.Budget, envelope.BudgetId, detail, tx); err != nil {
+			return err
+		}
+
+		return app.saveAssigned(ctx, tx, envelope.BudgetId)
+	})
+	if err != nil {
+		return domain.BudgetEnvelope{}, err
+	}
+
+	return envelope, nil
+}
+
+// Assign sets the money of the envelope, the income left to be assigned can not be negative
+func (app *envelopeApp) Assign(ctx context.Context, userId, budgetId, id uint, assigned float64) (EnvelopeSummary, error) {
+	budget, envelopes, err := app.findEnvelopes(ctx, userId, budgetId)
+	if err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	index, ok := envelopeIndex(envelopes, id)
+	if !ok {
+		return EnvelopeSummary{}, gorm.ErrRecordNotFound
+	}
+
+	envelope := envelopes[index]
+	before := envelope.Assigned
+	envelope.Assigned = roundCents(assigned)
+	if toBeAssigned(budget, totalAssigned(envelopes)-before+envelope.Assigned) < 0 {
+		return EnvelopeSummary{}, ErrEnvelopeOverAssigned
+	}
+
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		if _, err := app.budgetEnvelopeRepo.WithTransaction(tx).Save(ctx, envelope); err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("Se asigna dinero al sobre %s", envelope.Name)
+		detail := map[string]any{
+			"id":     envelope.ID,
+			"before": before,
+			"after":  envelope.Assigned,
+		}
+		if err := app.logApp.Create(ctx, description, shared.Budget, budgetId, detail, tx); err != nil {
+			return err
+		}
+
+		return app.saveAssigned(ctx, tx, budgetId)
+	})
+	if err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	return app.summary(ctx, budgetId)
+}
+
+// Move passes money between two envelopes of the budget, only the money not spent of the
+// envelope can be moved and the income to be assigned stays the same.
+func (app *envelopeApp) Move(ctx context.Context, userId, budgetId, fromId, toId uint, amount float64) (EnvelopeSummary, error) {
+	if fromId == toId {
+		return EnvelopeSummary{}, ErrEnvelopeSame
+	}
+
+	budget, envelopes, err := app.findEnvelopes(ctx, userId, budgetId)
+	if err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	fromIndex, okFrom := envelopeIndex(envelopes, fromId)
+	toIndex, okTo := envelopeIndex(envelopes, toId)
+	if !okFrom || !okTo {
+		return EnvelopeSummary{}, gorm.ErrRecordNotFound
+	}
+
+	amount = roundCents(amount)
+	from := envelopes[fromIndex]
+	to := envelopes[toIndex]
+	if envelopeStatus(from, budget.BudgetBills, envelopes).Available < amount {
+		return EnvelopeSummary{}, ErrEnvelopeFunds
+	}
+
+	from.Assigned = roundCents(from.Assigned - amount)
+	to.Assigned = roundCents(to.Assigned + amount)
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		budgetEnvelopeRepo := app.budgetEnvelopeRepo.WithTransaction(tx)
+		for _, envelope := range []domain.BudgetEnvelope{from, to} {
+			if _, err := budgetEnvelopeRepo.Save(ctx, envelope); err != nil {
+				return err
+			}
+		}
+
+		description := fmt.Sprintf("Se mueve dinero del sobre %s al sobre %s", from.Name, to.Name)
+		detail := map[string]any{
+			"fromId": from.ID,
+			"toId":   to.ID,
+			"amount": amount,
+		}
+		return app.logApp.Create(ctx, description, shared.Budget, budgetId, detail, tx)
+	})
+	if err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	return app.summary(ctx, budgetId)
+}
+
+// Delete removes the envelope, its money goes back to the income to be assigned
+func (app *envelopeApp) Delete(ctx context.Context, userId, budgetId, id uint) error {
+	_, envelopes, err := app.findEnvelopes(ctx, userId, budgetId)
+	if err != nil {
+		return err
+	}
+
+	index, ok := envelopeIndex(envelopes, id)
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+
+	envelope := envelopes[index]
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		if err := app.budgetEnvelopeRepo.WithTransaction(tx).Delete(ctx, envelope.ID); err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("Se elimina el sobre %s", envelope.Name)
+		detail := map[string]any{
+			"id":       envelope.ID,
+			"assigned": envelope.Assigned,
+		}
+		if err := app.logApp.Create(ctx, description, shared.Budget, budgetId, detail, tx); err != nil {
+			return err
+		}
+
+		return app.saveAssigned(ctx, tx, budgetId)
+	})
+}
+
+// Rollover brings the envelopes of the budget into the budget of the next month, the overspent
+// ones are carried as negative. The envelopes of a bill go to the bill with the same description
+// and are left out when the next month does not have it, running it again only updates what is
+// carried.
+func (app *envelopeApp) Rollover(ctx context.Context, userId, budgetId uint) (EnvelopeSummary, error) {
+	budget, envelopes, err := app.findEnvelopes(ctx, userId, budgetId)
+	if err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	year, month := *budget.Year, *budget.Month+1
+	if month > 12 {
+		year, month = year+1, 1
+	}
+
+	latest, err := app.budgetRepo.SearchLatestByMonth(ctx, userId, year, month)
+	if err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	next, err := app.budgetRepo.Search(ctx, *latest.ID)
+	if err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	if err := app.carry(ctx, budget, envelopes, next); err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	return app.summary(ctx, *next.ID)
+}
+
+// CarryoverEvent brings into a new budget the envelopes of the zero-based budget of the month
+// before as Rollover does, it runs after the bills of the loans and the cards are added so the
+// envelopes of those bills are carried too. A budget deleted before the event is delivered, or
+// without a zero-based budget the month before, has nothing to do.
+func (app *envelopeApp) CarryoverEvent(ctx context.Context, event shared.Event) error {
+	next, err := app.budgetRepo.Search(ctx, event.ResourceId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	year, month := *next.Year, *next.Month-1
+	if month == 0 {
+		year, month = year-1, 12
+	}
+
+	previous, err := app.budgetRepo.SearchLatestByMonth(ctx, *next.UserId, year, month)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	budget, envelopes, err := app.findEnvelopes(ctx, *next.UserId, *previous.ID)
+	if errors.Is(err, ErrEnvelopeMode) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return app.carry(ctx, budget, envelopes, next)
+}
+
+// carry saves in the next budget the envelopes of the budget with the overspending as carryover
+func (app *envelopeApp) carry(ctx context.Context, budget domain.Budget, envelopes []domain.BudgetEnvelope, next domain.Budget) error {
+	nextEnvelopes, err := app.budgetEnvelopeRepo.SearchAllByBudgetId(ctx, *next.ID)
+	if err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		budgetEnvelopeRepo := app.budgetEnvelopeRepo.WithTransaction(tx)
+		overspent := 0
+		for _, envelope := range envelopes {
+			carryover := envelopeStatus(envelope, budget.BudgetBills, envelopes).Available
+			if carryover >= 0 {
+				carryover = 0
+			} else {
+				overspent += 1
+			}
+
+			target := domain.BudgetEnvelope{
+				BudgetId: *next.ID,
+				Name:     envelope.Name,
+				Category: envelope.Category,
+			}
+			if envelope.BudgetBillId != nil {
+				bill, ok := findBillByDescription(next.BudgetBills, envelope.Name)
+				if !ok {
+					continue
+				}
+
+				target.BudgetBillId = bill.ID
+			}
+
+			if index, ok := matchEnvelope(nextEnvelopes, target.Category, target.BudgetBillId); ok {
+				target = nextEnvelopes[index]
+			}
+
+			target.Carryover = carryover
+			if _, err := budgetEnvelopeRepo.Save(ctx, target); err != nil {
+				return err
+			}
+		}
+
+		zeroBased := true
+		if _, err := app.budgetRepo.WithTransaction(tx).Save(ctx, domain.Budget{ID: next.ID, ZeroBased: &zeroBased}); err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("Se traspasan los sobres del presupuesto %s", *budget.Name)
+		detail := map[string]any{
+			"budgetId":  *budget.ID,
+			"envelopes": len(envelopes),
+			"overspent": overspent,
+		}
+		if err := app.logApp.Create(ctx, description, shared.Budget, *next.ID, detail, tx); err != nil {
+			return err
+		}
+
+		return app.saveAssigned(ctx, tx, *next.ID)
+	})
+}
+
+func (app *envelopeApp) findBudget(ctx context.Context, userId, budgetId uint) (domain.Budget, error) {
+	budget, err := app.budgetRepo.Search(ctx, budgetId)
+	if err != nil {
+		return domain.Budget{}, err
+	}
+
+	if *budget.UserId != userId {
+		return domain.Budget{}, gorm.ErrRecordNotFound
+	}
+
+	return budget, nil
+}
+
+// findEnvelopes returns the zero-based budget of the user with its envelopes
+func (app *envelopeApp) findEnvelopes(ctx context.Context, userId, budgetId uint) (domain.Budget, []domain.BudgetEnvelope, error) {
+	budget, err := app.findBudget(ctx, userId, budgetId)
+	if err != nil {
+		return domain.Budget{}, nil, err
+	}
+
+	if !isZeroBased(budget) {
+		return domain.Budget{}, nil, ErrEnvelopeMode
+	}
+
+	envelopes, err := app.budgetEnvelopeRepo.SearchAllByBudgetId(ctx, budgetId)
+	if err != nil {
+		return domain.Budget{}, nil, err
+	}
+
+	return budget, envelopes, nil
+}
+
+func (app *envelopeApp) summary(ctx context.Context, budgetId uint) (EnvelopeSummary, error) {
+	budget, err := app.budgetRepo.Search(ctx, budgetId)
+	if err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	envelopes, err := app.budgetEnvelopeRepo.SearchAllByBudgetId(ctx, budgetId)
+	if err != nil {
+		return EnvelopeSummary{}, err
+	}
+
+	summary := EnvelopeSummary{
+		BudgetId:      budgetId,
+		ZeroBased:     isZeroBased(budget),
+		Income:        roundCents(*budget.FixedIncome + *budget.AdditionalIncome),
+		TotalAssigned: totalAssigned(envelopes),
+		Envelopes:     []EnvelopeStatus{},
+	}
+	if summary.ZeroBased {
+		summary.ToBeAssigned = toBeAssigned(budget, summary.TotalAssigned)
+	}
+
+	for _, envelope := range envelopes {
+		summary.Envelopes = append(summary.Envelopes, envelopeStatus(envelope, budget.BudgetBills, envelopes))
+	}
+
+	return summary, nil
+}
+
+// saveAssigned stores in the budget the money assigned to its envelopes with the new totals
+func (app *envelopeApp) saveAssigned(ctx context.Context, tx persistent.Transaction, budgetId uint) error {
+	envelopes, err := app.budgetEnvelopeRepo.WithTransaction(tx).SearchAllByBudgetId(ctx, budgetId)
+	if err != nil {
+		return err
+	}
+
+	assigned := totalAssigned(envelopes)
+	budgetRepo := app.budgetRepo.WithTransaction(tx)
+	if _, err := budgetRepo.Save(ctx, domain.Budget{ID: &budgetId, TotalAssigned: &assigned}); err != nil {
+		return err
+	}
+
+	return saveTotals(ctx, budgetRepo, budgetId)
+}
+
+// PurgeEvent removes the envelopes whose budget or bill no longer exists
+func (app *envelopeApp) PurgeEvent(ctx context.Context, event shared.Event) error {
+	return app.budgetEnvelopeRepo.DeleteOrphans(ctx)
+}
+
+func NewEnvelopeApp(
+	tm persistent.TransactionManager, budgetEnvelopeRepo domain.BudgetEnvelopeRepository, budgetRepo domain.BudgetRepository,
+	logApp application.ILogApp,
+) IEnvelopeApp {
+	return &envelopeApp{tm, budgetEnvelopeRepo, budgetRepo, logApp}
+}
+
+func isZeroBased(budget domain.Budget) bool {
+	return budget.ZeroBased != nil && *budget.ZeroBased
+}
+
+func toBeAssigned(budget domain.Budget, assigned float64) float64 {
+	return roundCents(*budget.FixedIncome + *budget.AdditionalIncome - assigned)
+}
+
+func totalAssigned(envelopes []domain.BudgetEnvelope) float64 {
+	total := 0.0
+	for _, envelope := range envelopes {
+		total += envelope.Assigned
+	}
+
+	return roundCents(total)
+}
+
+// envelopeStatus adds up the payments of the bills of the envelope, the envelope of a category
+// leaves out the bills that have their own envelope
+func envelopeStatus(envelope domain.BudgetEnvelope, bills []domain.BudgetBill, envelopes []domain.BudgetEnvelope) EnvelopeStatus {
+	spent := 0.0
+	for _, bill := range bills {
+		if envelope.BudgetBillId != nil {
+			if *bill.ID == *envelope.BudgetBillId {
+				spent += *bill.Payment
+			}
+
+			continue
+		}
+
+		if *bill.Category != *envelope.Category {
+			continue
+		}
+
+		if _, ok := matchEnvelope(envelopes, nil, bill.ID); !ok {
+			spent += *bill.Payment
+		}
+	}
+
+	spent = roundCents(spent)
+	return EnvelopeStatus{
+		Envelope:  envelope,
+		Spent:     spent,
+		Available: roundCents(envelope.Carryover + envelope.Assigned - spent),
+	}
+}
+
+// matchEnvelope finds the envelope of the category or of the bill
+func matchEnvelope(envelopes []domain.BudgetEnvelope, category *domain.BudgetBillCategory, billId *uint) (int, bool) {
+	for i, envelope := range envelopes {
+		if category != nil && envelope.Category != nil && *envelope.Category == *category {
+			return i, true
+		}
+
+		if billId != nil && envelope.BudgetBillId != nil && *envelope.BudgetBillId == *billId {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func envelopeIndex(envelopes []domain.BudgetEnvelope, id uint) (int, bool) {
+	for i, envelope := range envelopes {
+		if envelope.ID == id {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func findBillByDescription(bills []domain.BudgetBill, description string) (domain.BudgetBill, bool) {
+	for _, bill := range bills {
+		if *bill.Description == description {
+			return bill, true
+		}
+	}
+
+	return domain.BudgetBill{}, false
+}
@@ -0,0 +1,437 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestEnvelopeSuite struct {
+	suite.Suite
+	userId                 uint
+	budgetId               uint
+	nextId                 uint
+	house                  domain.BudgetBillCategory
+	rentId                 uint
+	mockTransactionManager *mocks_persistent.MockTransactionManager
+	mockBudgetEnvelopeRepo *mocks_domain.MockBudgetEnvelopeRepository
+	mockBudgetRepo         *mocks_domain.MockBudgetRepository
+	mockLogApp             *mocks_application.MockILogApp
+	app                    IEnvelopeApp
+	ctx                    context.Context
+}
+
+func (suite *TestEnvelopeSuite) SetupSuite() {
+	suite.userId = 1
+	suite.budgetId = 4
+	suite.nextId = 5
+	suite.house = domain.House
+	suite.rentId = 7
+	suite.ctx = context.Background()
+}
+
+func (suite *TestEnvelopeSuite) SetupTest() {
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockBudgetEnvelopeRepo = mocks_domain.NewMockBudgetEnvelopeRepository(suite.T())
+	suite.mockBudgetRepo = mocks_domain.NewMockBudgetRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.app = NewEnvelopeApp(suite.mockTransactionManager, suite.mockBudgetEnvelopeRepo, suite.mockBudgetRepo, suite.mockLogApp)
+}
+
+func (suite *TestEnvelopeSuite) mockTransaction() {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockBudgetEnvelopeRepo.On("WithTransaction", nil).Return(suite.mockBudgetEnvelopeRepo).Maybe()
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo).Maybe()
+}
+
+// budget returns a zero-based budget with an income of 3000, a rent of 1000 fully paid and
+// 230 paid of the other bills of the house
+func (suite *TestEnvelopeSuite) budget(id uint, month uint8) domain.Budget {
+	name := "Budget"
+	year := uint16(2024)
+	zeroBased := true
+	fixedIncome := 2800.0
+	additionalIncome := 200.0
+	assigned := 0.0
+	rent := "Rent"
+	rentAmount := 1000.0
+	rentPayment := 1000.0
+	water := "Water"
+	waterAmount := 80.0
+	waterPayment := 230.0
+	complete := false
+	return domain.Budget{
+		ID:               &id,
+		Name:             &name,
+		Year:             &year,
+		Month:            &month,
+		FixedIncome:      &fixedIncome,
+		AdditionalIncome: &additionalIncome,
+		ZeroBased:        &zeroBased,
+		TotalAssigned:    &assigned,
+		UserId:           &suite.userId,
+		BudgetBills: []domain.BudgetBill{
+			{ID: &suite.rentId, Description: &rent, Amount: &rentAmount, Payment: &rentPayment, Complete: &complete, Category: &suite.house},
+			{ID: &id, Description: &water, Amount: &waterAmount, Payment: &waterPayment, Complete: &complete, Category: &suite.house},
+		},
+	}
+}
+
+func (suite *TestEnvelopeSuite) envelopes() []domain.BudgetEnvelope {
+	return []domain.BudgetEnvelope{
+		{ID: 1, BudgetId: suite.budgetId, Name: "house", Category: &suite.house, Assigned: 200},
+		{ID: 2, BudgetId: suite.budgetId, Name: "Rent", BudgetBillId: &suite.rentId, Assigned: 1000},
+	}
+}
+
+func (suite *TestEnvelopeSuite) mockSaveAssigned(budgetId uint, assigned float64) {
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(b domain.Budget) bool {
+		return *b.ID == budgetId && b.TotalAssigned != nil && *b.TotalAssigned == assigned && b.Name == nil
+	})).Return(budgetId, nil).Once()
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(b domain.Budget) bool {
+		return *b.ID == budgetId && b.Name != nil
+	})).Return(budgetId, nil).Once()
+}
+
+func (suite *TestEnvelopeSuite) TestSetModeSuccess() {
+	require := require.New(suite.T())
+	budget := suite.budget(suite.budgetId, 1)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget, nil)
+	suite.mockTransaction()
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(b domain.Budget) bool {
+		return b.ZeroBased != nil && *b.ZeroBased && b.Name == nil
+	})).Return(suite.budgetId, nil).Once()
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(b domain.Budget) bool {
+		return b.ToBeAssigned != nil && *b.ToBeAssigned == 3000
+	})).Return(suite.budgetId, nil).Once()
+	suite.mockLogApp.On("Create", suite.ctx, "Se activa el modo base cero", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil)
+
+	res, err := suite.app.SetMode(suite.ctx, suite.userId, suite.budgetId, true)
+
+	require.NoError(err)
+	require.True(res.ZeroBased)
+	require.Equal(3000.0, res.Income)
+	require.Equal(3000.0, res.ToBeAssigned)
+}
+
+func (suite *TestEnvelopeSuite) TestSetModeErrorOtherUser() {
+	require := require.New(suite.T())
+	budget := suite.budget(suite.budgetId, 1)
+	otherUser := uint(9)
+	budget.UserId = &otherUser
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget, nil)
+
+	_, err := suite.app.SetMode(suite.ctx, suite.userId, suite.budgetId, true)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestEnvelopeSuite) TestFindSummarySuccess() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.budgetId, 1), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(suite.envelopes(), nil)
+
+	res, err := suite.app.FindSummary(suite.ctx, suite.userId, suite.budgetId)
+
+	require.NoError(err)
+	require.Equal(1200.0, res.TotalAssigned)
+	require.Equal(1800.0, res.ToBeAssigned)
+	require.Equal([]EnvelopeStatus{
+		{Envelope: suite.envelopes()[0], Spent: 230, Available: -30},
+		{Envelope: suite.envelopes()[1], Spent: 1000, Available: 0},
+	}, res.Envelopes)
+}
+
+func (suite *TestEnvelopeSuite) TestCreateSuccess() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.budgetId, 1), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return([]domain.BudgetEnvelope{}, nil).Once()
+	suite.mockTransaction()
+	suite.mockBudgetEnvelopeRepo.On("Save", suite.ctx, mock.MatchedBy(func(e domain.BudgetEnvelope) bool {
+		return e.Name == "Rent" && *e.BudgetBillId == suite.rentId && e.Assigned == 1000
+	})).Return(uint(2), nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se crea el sobre Rent", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(suite.envelopes()[1:], nil).Once()
+	suite.mockSaveAssigned(suite.budgetId, 1000)
+
+	res, err := suite.app.Create(suite.ctx, suite.userId, domain.BudgetEnvelope{
+		BudgetId:     suite.budgetId,
+		BudgetBillId: &suite.rentId,
+		Assigned:     1000,
+	})
+
+	require.NoError(err)
+	require.Equal(uint(2), res.ID)
+	require.Equal("Rent", res.Name)
+}
+
+func (suite *TestEnvelopeSuite) TestCreateErrorMode() {
+	require := require.New(suite.T())
+	budget := suite.budget(suite.budgetId, 1)
+	zeroBased := false
+	budget.ZeroBased = &zeroBased
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget, nil)
+
+	_, err := suite.app.Create(suite.ctx, suite.userId, domain.BudgetEnvelope{BudgetId: suite.budgetId, Category: &suite.house})
+
+	require.ErrorIs(err, ErrEnvelopeMode)
+}
+
+func (suite *TestEnvelopeSuite) TestCreateErrorTarget() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.budgetId, 1), nil)
+
+	_, err := suite.app.Create(suite.ctx, suite.userId, domain.BudgetEnvelope{
+		BudgetId:     suite.budgetId,
+		Category:     &suite.house,
+		BudgetBillId: &suite.rentId,
+	})
+
+	require.ErrorIs(err, ErrEnvelopeTarget)
+}
+
+func (suite *TestEnvelopeSuite) TestCreateErrorExists() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.budgetId, 1), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(suite.envelopes(), nil)
+
+	_, err := suite.app.Create(suite.ctx, suite.userId, domain.BudgetEnvelope{BudgetId: suite.budgetId, Category: &suite.house})
+
+	require.ErrorIs(err, ErrEnvelopeExists)
+}
+
+func (suite *TestEnvelopeSuite) TestAssignErrorOverAssigned() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.budgetId, 1), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(suite.envelopes(), nil)
+
+	_, err := suite.app.Assign(suite.ctx, suite.userId, suite.budgetId, 1, 2000.01)
+
+	require.ErrorIs(err, ErrEnvelopeOverAssigned)
+	suite.mockBudgetEnvelopeRepo.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+}
+
+func (suite *TestEnvelopeSuite) TestAssignSuccess() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.budgetId, 1), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(suite.envelopes(), nil)
+	suite.mockTransaction()
+	suite.mockBudgetEnvelopeRepo.On("Save", suite.ctx, mock.MatchedBy(func(e domain.BudgetEnvelope) bool {
+		return e.ID == 1 && e.Assigned == 2000
+	})).Return(uint(1), nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se asigna dinero al sobre house", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockSaveAssigned(suite.budgetId, 1200)
+
+	_, err := suite.app.Assign(suite.ctx, suite.userId, suite.budgetId, 1, 2000)
+
+	require.NoError(err)
+}
+
+func (suite *TestEnvelopeSuite) TestMoveSuccess() {
+	require := require.New(suite.T())
+	envelopes := suite.envelopes()
+	envelopes[1].Assigned = 1100
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.budgetId, 1), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(envelopes, nil)
+	suite.mockTransaction()
+	suite.mockBudgetEnvelopeRepo.On("Save", suite.ctx, mock.MatchedBy(func(e domain.BudgetEnvelope) bool {
+		return e.ID == 2 && e.Assigned == 1000
+	})).Return(uint(2), nil)
+	suite.mockBudgetEnvelopeRepo.On("Save", suite.ctx, mock.MatchedBy(func(e domain.BudgetEnvelope) bool {
+		return e.ID == 1 && e.Assigned == 300
+	})).Return(uint(1), nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se mueve dinero del sobre Rent al sobre house", shared.Budget, suite.budgetId, mock.MatchedBy(func(detail map[string]any) bool {
+		return detail["amount"] == 100.0
+	}), nil).Return(nil)
+
+	_, err := suite.app.Move(suite.ctx, suite.userId, suite.budgetId, 2, 1, 100)
+
+	require.NoError(err)
+}
+
+func (suite *TestEnvelopeSuite) TestMoveErrorFunds() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.budgetId, 1), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(suite.envelopes(), nil)
+
+	_, err := suite.app.Move(suite.ctx, suite.userId, suite.budgetId, 1, 2, 10)
+
+	require.ErrorIs(err, ErrEnvelopeFunds)
+}
+
+func (suite *TestEnvelopeSuite) TestMoveErrorSame() {
+	require := require.New(suite.T())
+
+	_, err := suite.app.Move(suite.ctx, suite.userId, suite.budgetId, 1, 1, 10)
+
+	require.ErrorIs(err, ErrEnvelopeSame)
+}
+
+func (suite *TestEnvelopeSuite) TestDeleteErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.budgetId, 1), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(suite.envelopes(), nil)
+
+	err := suite.app.Delete(suite.ctx, suite.userId, suite.budgetId, 99)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestEnvelopeSuite) TestRolloverSuccess() {
+	require := require.New(suite.T())
+	next := suite.budget(suite.nextId, 1)
+	year := uint16(2025)
+	next.Year = &year
+	nextRentId := uint(17)
+	next.BudgetBills[0].ID = &nextRentId
+	nextEnvelopes := []domain.BudgetEnvelope{
+		{ID: 10, BudgetId: suite.nextId, Name: "house", Category: &suite.house, Assigned: 150, Carryover: -5},
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.budgetId, 12), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(suite.envelopes(), nil)
+	suite.mockBudgetRepo.On("SearchLatestByMonth", suite.ctx, suite.userId, uint16(2025), uint8(1)).Return(domain.Budget{ID: &suite.nextId}, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.nextId).Return(next, nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.nextId).Return(nextEnvelopes, nil)
+	suite.mockTransaction()
+	suite.mockBudgetEnvelopeRepo.On("Save", suite.ctx, domain.BudgetEnvelope{
+		ID: 10, BudgetId: suite.nextId, Name: "house", Category: &suite.house, Assigned: 150, Carryover: -30,
+	}).Return(uint(10), nil)
+	suite.mockBudgetEnvelopeRepo.On("Save", suite.ctx, domain.BudgetEnvelope{
+		BudgetId: suite.nextId, Name: "Rent", BudgetBillId: &nextRentId,
+	}).Return(uint(11), nil)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(b domain.Budget) bool {
+		return *b.ID == suite.nextId && b.ZeroBased != nil && *b.ZeroBased && b.Name == nil
+	})).Return(suite.nextId, nil).Once()
+	suite.mockLogApp.On("Create", suite.ctx, "Se traspasan los sobres del presupuesto Budget", shared.Budget, suite.nextId, mock.MatchedBy(func(detail map[string]any) bool {
+		return detail["overspent"] == 1
+	}), nil).Return(nil)
+	suite.mockSaveAssigned(suite.nextId, 150)
+
+	res, err := suite.app.Rollover(suite.ctx, suite.userId, suite.budgetId)
+
+	require.NoError(err)
+	require.Equal(suite.nextId, res.BudgetId)
+}
+
+func (suite *TestEnvelopeSuite) TestRolloverErrorNextMonth() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.budgetId, 3), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(suite.envelopes(), nil)
+	suite.mockBudgetRepo.On("SearchLatestByMonth", suite.ctx, suite.userId, uint16(2024), uint8(4)).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	_, err := suite.app.Rollover(suite.ctx, suite.userId, suite.budgetId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestEnvelopeSuite) TestCarryoverEventSuccess() {
+	require := require.New(suite.T())
+	next := suite.budget(suite.nextId, 1)
+	year := uint16(2025)
+	next.Year = &year
+	zeroBased := false
+	next.ZeroBased = &zeroBased
+	nextRentId := uint(17)
+	next.BudgetBills[0].ID = &nextRentId
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.nextId).Return(next, nil)
+	suite.mockBudgetRepo.On("SearchLatestByMonth", suite.ctx, suite.userId, uint16(2024), uint8(12)).Return(domain.Budget{ID: &suite.budgetId}, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.budgetId, 12), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.budgetId).Return(suite.envelopes(), nil)
+	suite.mockBudgetEnvelopeRepo.On("SearchAllByBudgetId", suite.ctx, suite.nextId).Return([]domain.BudgetEnvelope{}, nil)
+	suite.mockTransaction()
+	suite.mockBudgetEnvelopeRepo.On("Save", suite.ctx, domain.BudgetEnvelope{
+		BudgetId: suite.nextId, Name: "house", Category: &suite.house, Carryover: -30,
+	}).Return(uint(10), nil)
+	suite.mockBudgetEnvelopeRepo.On("Save", suite.ctx, domain.BudgetEnvelope{
+		BudgetId: suite.nextId, Name: "Rent", BudgetBillId: &nextRentId,
+	}).Return(uint(11), nil)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(b domain.Budget) bool {
+		return *b.ID == suite.nextId && b.ZeroBased != nil && *b.ZeroBased && b.Name == nil
+	})).Return(suite.nextId, nil).Once()
+	suite.mockLogApp.On("Create", suite.ctx, "Se traspasan los sobres del presupuesto Budget", shared.Budget, suite.nextId, mock.MatchedBy(func(detail map[string]any) bool {
+		return detail["budgetId"] == suite.budgetId && detail["overspent"] == 1
+	}), nil).Return(nil)
+	suite.mockSaveAssigned(suite.nextId, 0)
+
+	err := suite.app.CarryoverEvent(suite.ctx, shared.Event{Name: shared.BudgetCreated, ResourceId: suite.nextId})
+
+	require.NoError(err)
+}
+
+func (suite *TestEnvelopeSuite) TestCarryoverEventSuccessNotZeroBased() {
+	require := require.New(suite.T())
+	previous := suite.budget(suite.budgetId, 2)
+	zeroBased := false
+	previous.ZeroBased = &zeroBased
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.nextId).Return(suite.budget(suite.nextId, 3), nil)
+	suite.mockBudgetRepo.On("SearchLatestByMonth", suite.ctx, suite.userId, uint16(2024), uint8(2)).Return(domain.Budget{ID: &suite.budgetId}, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(previous, nil)
+
+	err := suite.app.CarryoverEvent(suite.ctx, shared.Event{Name: shared.BudgetCreated, ResourceId: suite.nextId})
+
+	require.NoError(err)
+}
+
+func (suite *TestEnvelopeSuite) TestCarryoverEventSuccessWithoutPreviousMonth() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.nextId).Return(suite.budget(suite.nextId, 3), nil)
+	suite.mockBudgetRepo.On("SearchLatestByMonth", suite.ctx, suite.userId, uint16(2024), uint8(2)).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.CarryoverEvent(suite.ctx, shared.Event{Name: shared.BudgetCreated, ResourceId: suite.nextId})
+
+	require.NoError(err)
+}
+
+func (suite *TestEnvelopeSuite) TestCarryoverEventSuccessDeleted() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.nextId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.CarryoverEvent(suite.ctx, shared.Event{Name: shared.BudgetCreated, ResourceId: suite.nextId})
+
+	require.NoError(err)
+}
+
+func (suite *TestEnvelopeSuite) TestCarryoverEventErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.nextId).Return(suite.budget(suite.nextId, 3), nil)
+	suite.mockBudgetRepo.On("SearchLatestByMonth", suite.ctx, suite.userId, uint16(2024), uint8(2)).Return(domain.Budget{}, gorm.ErrInvalidDB)
+
+	err := suite.app.CarryoverEvent(suite.ctx, shared.Event{Name: shared.BudgetCreated, ResourceId: suite.nextId})
+
+	require.ErrorIs(err, gorm.ErrInvalidDB)
+}
+
+func (suite *TestEnvelopeSuite) TestPurgeEventSuccess() {
+	require := require.New(suite.T())
+	suite.mockBudgetEnvelopeRepo.On("DeleteOrphans", suite.ctx).Return(nil)
+
+	err := suite.app.PurgeEvent(suite.ctx, shared.Event{Name: shared.TrashPurged, ResourceId: suite.userId})
+
+	require.NoError(err)
+}
+
+func (suite *TestEnvelopeSuite) TestPurgeEventError() {
+	require := require.New(suite.T())
+	suite.mockBudgetEnvelopeRepo.On("DeleteOrphans", suite.ctx).Return(gorm.ErrInvalidField)
+
+	err := suite.app.PurgeEvent(suite.ctx, shared.Event{Name: shared.TrashPurged, ResourceId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestEnvelopeSuite(t *testing.T) {
+	suite.Run(t, new(TestEnvelopeSuite))
+}
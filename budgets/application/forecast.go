@@ -0,0 +1,203 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"your-accounts-api/budgets/domain"
+)
+
+const (
+	// forecastHistory is the number of budgets read to find the recurring bills and the
+	// usual additional income
+	forecastHistory   = 6
+	forecastMaxMonths = 24
+)
+
+var (
+	ErrForecastWithoutBudgets = errors.New("the user has no budgets to forecast")
+	ErrInvalidForecastMonths  = errors.New("the forecast covers from 1 to 24 months")
+)
+
+type ForecastBill struct {
+	Description string
+	Category    domain.BudgetBillCategory
+	Amount      float64
+}
+
+// ForecastOverrides replace the values taken from the budgets only in the forecast, a bill
+// with the description of a recurring one replaces it and with amount zero removes it.
+type ForecastOverrides struct {
+	FixedIncome      *float64
+	AdditionalIncome *float64
+	Bills            []ForecastBill
+}
+
+// ForecastMonth projects a month, Shortfall marks the ones where the committed bills, the
+// pending total of a new budget, exceed the expected income.
+type ForecastMonth struct {
+	Year             uint16
+	Month            uint8
+	FixedIncome      float64
+	AdditionalIncome float64
+	Committed        float64
+	Leftover         float64
+	Shortfall        bool
+}
+
+type Forecast struct {
+	Bills  []ForecastBill
+	Months []ForecastMonth
+}
+
+type IForecastApp interface {
+	Project(ctx context.Context, userId uint, months int, overrides ForecastOverrides) (Forecast, error)
+}
+
+type forecastApp struct {
+	budgetRepo domain.BudgetRepository
+}
+
+// Project forecasts the months after the current one, the fixed income is the one of the
+// newest budget and the additional income the average of the recent budgets.
+func (app *forecastApp) Project(ctx context.Context, userId uint, months int, overrides ForecastOverrides) (Forecast, error) {
+	if months < 1 || months > forecastMaxMonths {
+		return Forecast{}, ErrInvalidForecastMonths
+	}
+
+	budgets, err := app.budgetRepo.SearchLatestByUserId(ctx, userId, forecastHistory)
+	if err != nil {
+		return Forecast{}, err
+	}
+
+	if len(budgets) == 0 {
+		return Forecast{}, ErrForecastWithoutBudgets
+	}
+
+	fixedIncome := *budgets[0].FixedIncome
+	if overrides.FixedIncome != nil {
+		fixedIncome = *overrides.FixedIncome
+	}
+
+	additionalIncome := 0.0
+	for _, budget := range budgets {
+		additionalIncome += *budget.AdditionalIncome
+	}
+	additionalIncome /= float64(len(budgets))
+	if overrides.AdditionalIncome != nil {
+		additionalIncome = *overrides.AdditionalIncome
+	}
+
+	bills := applyBillOverrides(recurringBills(budgets), overrides.Bills)
+	committed := 0.0
+	for _, bill := range bills {
+		committed += bill.Amount
+	}
+
+	forecast := Forecast{
+		Bills:  bills,
+		Months: []ForecastMonth{},
+	}
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for i := 1; i <= months; i++ {
+		month := start.AddDate(0, i, 0)
+		income := fixedIncome + additionalIncome
+		forecast.Months = append(forecast.Months, ForecastMonth{
+			Year:             uint16(month.Year()),
+			Month:            uint8(month.Month()),
+			FixedIncome:      fixedIncome,
+			AdditionalIncome: additionalIncome,
+			Committed:        committed,
+			Leftover:         income - committed,
+			Shortfall:        committed > income,
+		})
+	}
+
+	return forecast, nil
+}
+
+func NewForecastApp(budgetRepo domain.BudgetRepository) IForecastApp {
+	return &forecastApp{budgetRepo}
+}
+
+// recurringBills returns the bills repeated in at least two of the budgets, newest first, with
+// the amount of the newest one. With a single budget all its bills are taken.
+func recurringBills(budgets []domain.Budget) []ForecastBill {
+	minimum := 2
+	if len(budgets) == 1 {
+		minimum = 1
+	}
+
+	occurrences := map[string]int{}
+	newest := map[string]ForecastBill{}
+	keys := []string{}
+	for _, budget := range budgets {
+		// The bills with the same description in a budget are added up
+		amounts := map[string]ForecastBill{}
+		budgetKeys := []string{}
+		for _, bill := range budget.BudgetBills {
+			key := billKey(*bill.Description)
+			current, ok := amounts[key]
+			if !ok {
+				current = ForecastBill{Description: strings.TrimSpace(*bill.Description), Category: *bill.Category}
+				budgetKeys = append(budgetKeys, key)
+			}
+			current.Amount += *bill.Amount
+			amounts[key] = current
+		}
+
+		for _, key := range budgetKeys {
+			occurrences[key]++
+			if _, ok := newest[key]; !ok {
+				newest[key] = amounts[key]
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	bills := []ForecastBill{}
+	for _, key := range keys {
+		if occurrences[key] >= minimum {
+			bills = append(bills, newest[key])
+		}
+	}
+
+	return bills
+}
+
+func applyBillOverrides(bills []ForecastBill, overrides []ForecastBill) []ForecastBill {
+	for _, override := range overrides {
+		key := billKey(override.Description)
+		index := -1
+		for i, bill := range bills {
+			if billKey(bill.Description) == key {
+				index = i
+				break
+			}
+		}
+
+		switch {
+		case index >= 0 && override.Amount == 0:
+			bills = append(bills[:index], bills[index+1:]...)
+		case index >= 0:
+			bills[index].Amount = override.Amount
+			if override.Category != "" {
+				bills[index].Category = override.Category
+			}
+		case override.Amount != 0:
+			if override.Category == "" {
+				override.Category = domain.Others
+			}
+			override.Description = strings.TrimSpace(override.Description)
+			bills = append(bills, override)
+		}
+	}
+
+	return bills
+}
+
+func billKey(description string) string {
+	return strings.ToLower(strings.TrimSpace(description))
+}
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestForecastSuite struct {
+	suite.Suite
+	userId         uint
+	mockBudgetRepo *mocks_domain.MockBudgetRepository
+	app            IForecastApp
+	ctx            context.Context
+}
+
+func (suite *TestForecastSuite) SetupSuite() {
+	suite.userId = 1
+	suite.ctx = context.Background()
+}
+
+func (suite *TestForecastSuite) SetupTest() {
+	suite.mockBudgetRepo = mocks_domain.NewMockBudgetRepository(suite.T())
+	suite.app = NewForecastApp(suite.mockBudgetRepo)
+}
+
+func (suite *TestForecastSuite) budget(fixedIncome, additionalIncome float64, bills ...domain.BudgetBill) domain.Budget {
+	return domain.Budget{
+		FixedIncome:      &fixedIncome,
+		AdditionalIncome: &additionalIncome,
+		BudgetBills:      bills,
+	}
+}
+
+func (suite *TestForecastSuite) bill(description string, category domain.BudgetBillCategory, amount float64) domain.BudgetBill {
+	return domain.BudgetBill{
+		Description: &description,
+		Category:    &category,
+		Amount:      &amount,
+	}
+}
+
+func (suite *TestForecastSuite) TestProjectSuccess() {
+	require := require.New(suite.T())
+	budgets := []domain.Budget{
+		suite.budget(1000, 100,
+			suite.bill("Rent", domain.House, 700),
+			suite.bill("Internet", domain.Services, 30),
+			suite.bill("Internet", domain.Services, 10),
+			suite.bill("Trip", domain.Entertainment, 400)),
+		suite.budget(900, 300,
+			suite.bill("rent ", domain.House, 650),
+			suite.bill("Internet", domain.Services, 35)),
+	}
+	suite.mockBudgetRepo.On("SearchLatestByUserId", suite.ctx, suite.userId, forecastHistory).Return(budgets, nil)
+
+	res, err := suite.app.Project(suite.ctx, suite.userId, 3, ForecastOverrides{})
+
+	require.NoError(err)
+	require.Equal([]ForecastBill{
+		{Description: "Rent", Category: domain.House, Amount: 700},
+		{Description: "Internet", Category: domain.Services, Amount: 40},
+	}, res.Bills)
+	require.Len(res.Months, 3)
+	next := time.Now().AddDate(0, 0, -time.Now().Day()+1).AddDate(0, 1, 0)
+	require.Equal(uint16(next.Year()), res.Months[0].Year)
+	require.Equal(uint8(next.Month()), res.Months[0].Month)
+	for _, month := range res.Months {
+		require.Equal(1000.0, month.FixedIncome)
+		require.Equal(200.0, month.AdditionalIncome)
+		require.Equal(740.0, month.Committed)
+		require.Equal(460.0, month.Leftover)
+		require.False(month.Shortfall)
+	}
+}
+
+func (suite *TestForecastSuite) TestProjectSuccessOverrides() {
+	require := require.New(suite.T())
+	budgets := []domain.Budget{
+		suite.budget(1000, 0,
+			suite.bill("Rent", domain.House, 700),
+			suite.bill("Gym", domain.Personal, 50)),
+	}
+	suite.mockBudgetRepo.On("SearchLatestByUserId", suite.ctx, suite.userId, forecastHistory).Return(budgets, nil)
+	fixedIncome := 800.0
+	overrides := ForecastOverrides{
+		FixedIncome: &fixedIncome,
+		Bills: []ForecastBill{
+			{Description: "gym", Amount: 0},
+			{Description: "Rent", Amount: 750},
+			{Description: "Car", Amount: 200},
+		},
+	}
+
+	res, err := suite.app.Project(suite.ctx, suite.userId, 1, overrides)
+
+	require.NoError(err)
+	require.Equal([]ForecastBill{
+		{Description: "Rent", Category: domain.House, Amount: 750},
+		{Description: "Car", Category: domain.Others, Amount: 200},
+	}, res.Bills)
+	require.Len(res.Months, 1)
+	require.Equal(950.0, res.Months[0].Committed)
+	require.Equal(-150.0, res.Months[0].Leftover)
+	require.True(res.Months[0].Shortfall)
+}
+
+func (suite *TestForecastSuite) TestProjectErrorMonths() {
+	require := require.New(suite.T())
+
+	for _, months := range []int{0, 25} {
+		_, err := suite.app.Project(suite.ctx, suite.userId, months, ForecastOverrides{})
+
+		require.ErrorIs(err, ErrInvalidForecastMonths)
+	}
+}
+
+func (suite *TestForecastSuite) TestProjectErrorWithoutBudgets() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("SearchLatestByUserId", suite.ctx, suite.userId, forecastHistory).Return([]domain.Budget{}, nil)
+
+	_, err := suite.app.Project(suite.ctx, suite.userId, 6, ForecastOverrides{})
+
+	require.ErrorIs(err, ErrForecastWithoutBudgets)
+}
+
+func (suite *TestForecastSuite) TestProjectErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("SearchLatestByUserId", suite.ctx, suite.userId, forecastHistory).Return(nil, gorm.ErrInvalidDB)
+
+	_, err := suite.app.Project(suite.ctx, suite.userId, 6, ForecastOverrides{})
+
+	require.ErrorIs(err, gorm.ErrInvalidDB)
+}
+
+func TestTestForecastSuite(t *testing.T) {
+	suite.Run(t, new(TestForecastSuite))
+}
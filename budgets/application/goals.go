@@ -0,0 +1,190 @@
+package application
+
+import (
+	"context"
+	"math"
+	"time"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+// GoalProgress is the state of a goal, MonthlyRate is the average saved by month since the goal
+// was created and ProjectedDate the month it is reached at that rate, nil without progress.
+type GoalProgress struct {
+	Goal          domain.Goal
+	Saved         float64
+	Percentage    float64
+	MonthlyRate   float64
+	ProjectedDate *time.Time
+	Completed     bool
+	OnTrack       bool
+	Contributions []domain.GoalContribution
+}
+
+type IGoalApp interface {
+	Create(ctx context.Context, goal domain.Goal) (domain.Goal, error)
+	FindByUserId(ctx context.Context, userId uint) ([]domain.Goal, error)
+	FindById(ctx context.Context, userId, id uint) (domain.Goal, error)
+	Update(ctx context.Context, goal domain.Goal) (domain.Goal, error)
+	Delete(ctx context.Context, userId, id uint) error
+	Progress(ctx context.Context, userId, id uint) (GoalProgress, error)
+	DeleteUserEvent(ctx context.Context, event shared.Event) error
+}
+
+type goalApp struct {
+	tm       persistent.TransactionManager
+	goalRepo domain.GoalRepository
+	logApp   application.ILogApp
+}
+
+func (app *goalApp) Create(ctx context.Context, goal domain.Goal) (domain.Goal, error) {
+	goal.ID = 0
+	goal.CreatedAt = time.Now()
+	err := app.tm.Transaction(func(tx persistent.Transaction) error {
+		goalRepo := app.goalRepo.WithTransaction(tx)
+		id, err := goalRepo.Save(ctx, goal)
+		if err != nil {
+			return err
+		}
+
+		goal.ID = id
+		return app.logApp.Create(ctx, "Se crea una meta de ahorro", shared.User, goal.UserId, goalDetail(goal), tx)
+	})
+	if err != nil {
+		return domain.Goal{}, err
+	}
+
+	return goal, nil
+}
+
+func (app *goalApp) FindByUserId(ctx context.Context, userId uint) ([]domain.Goal, error) {
+	return app.goalRepo.SearchAllByExample(ctx, domain.Goal{
+		UserId: userId,
+	})
+}
+
+func (app *goalApp) FindById(ctx context.Context, userId, id uint) (domain.Goal, error) {
+	return app.goalRepo.SearchByExample(ctx, domain.Goal{
+		ID:     id,
+		UserId: userId,
+	})
+}
+
+func (app *goalApp) Update(ctx context.Context, goal domain.Goal) (domain.Goal, error) {
+	current, err := app.FindById(ctx, goal.UserId, goal.ID)
+	if err != nil {
+		return domain.Goal{}, err
+	}
+
+	goal.CreatedAt = current.CreatedAt
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		goalRepo := app.goalRepo.WithTransaction(tx)
+		if _, err := goalRepo.Save(ctx, goal); err != nil {
+			return err
+		}
+
+		return app.logApp.Create(ctx, "Se actualiza una meta de ahorro", shared.User, goal.UserId, goalDetail(goal), tx)
+	})
+	if err != nil {
+		return domain.Goal{}, err
+	}
+
+	return goal, nil
+}
+
+func (app *goalApp) Delete(ctx context.Context, userId, id uint) error {
+	goal, err := app.FindById(ctx, userId, id)
+	if err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		goalRepo := app.goalRepo.WithTransaction(tx)
+		if err := goalRepo.Delete(ctx, goal.ID); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"id":   goal.ID,
+			"name": goal.Name,
+		}
+		return app.logApp.Create(ctx, "Se elimina una meta de ahorro", shared.User, userId, detail, tx)
+	})
+}
+
+// Progress adds up the payments of the category or takes the last amount of the available,
+// the months without budgets after the creation of the goal count as months without savings.
+func (app *goalApp) Progress(ctx context.Context, userId, id uint) (GoalProgress, error) {
+	goal, err := app.FindById(ctx, userId, id)
+	if err != nil {
+		return GoalProgress{}, err
+	}
+
+	contributions, err := app.goalRepo.SearchContributions(ctx, goal)
+	if err != nil {
+		return GoalProgress{}, err
+	}
+
+	progress := GoalProgress{
+		Goal:          goal,
+		Contributions: contributions,
+	}
+	last := monthIndex(goal.CreatedAt.Year(), int(goal.CreatedAt.Month()))
+	if len(contributions) > 0 {
+		first := contributions[0]
+		latest := contributions[len(contributions)-1]
+		last = monthIndex(int(latest.Year), int(latest.Month))
+		if goal.Category != nil {
+			for _, contribution := range contributions {
+				progress.Saved += contribution.Amount
+			}
+			progress.MonthlyRate = progress.Saved / float64(last-monthIndex(goal.CreatedAt.Year(), int(goal.CreatedAt.Month()))+1)
+		} else {
+			progress.Saved = latest.Amount
+			if months := last - monthIndex(int(first.Year), int(first.Month)); months > 0 {
+				progress.MonthlyRate = (latest.Amount - first.Amount) / float64(months)
+			}
+		}
+	}
+
+	if goal.TargetAmount > 0 {
+		progress.Percentage = progress.Saved / goal.TargetAmount * 100
+	}
+
+	progress.Completed = progress.Saved >= goal.TargetAmount
+	if !progress.Completed && progress.MonthlyRate > 0 {
+		months := int(math.Ceil((goal.TargetAmount - progress.Saved) / progress.MonthlyRate))
+		projected := time.Date(last/12, time.Month(last%12+1), 1, 0, 0, 0, 0, time.UTC).AddDate(0, months, 0)
+		progress.ProjectedDate = &projected
+	}
+
+	progress.OnTrack = progress.Completed || (progress.ProjectedDate != nil && !progress.ProjectedDate.After(goal.TargetDate))
+	return progress, nil
+}
+
+// DeleteUserEvent removes the goals of a deleted user
+func (app *goalApp) DeleteUserEvent(ctx context.Context, event shared.Event) error {
+	return app.goalRepo.DeleteAllByUserId(ctx, event.ResourceId)
+}
+
+func NewGoalApp(tm persistent.TransactionManager, goalRepo domain.GoalRepository, logApp application.ILogApp) IGoalApp {
+	return &goalApp{tm, goalRepo, logApp}
+}
+
+func goalDetail(goal domain.Goal) map[string]any {
+	return map[string]any{
+		"id":           goal.ID,
+		"name":         goal.Name,
+		"targetAmount": goal.TargetAmount,
+		"targetDate":   goal.TargetDate.Format(time.DateOnly),
+		"category":     goal.Category,
+		"available":    goal.Available,
+	}
+}
+
+// monthIndex numbers the months from the year zero to count the months between two of them
+func monthIndex(year, month int) int {
+	return year*12 + month - 1
+}
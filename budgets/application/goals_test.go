@@ -0,0 +1,260 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestGoalSuite struct {
+	suite.Suite
+	userId                 uint
+	id                     uint
+	mockTransactionManager *mocks_persistent.MockTransactionManager
+	mockGoalRepo           *mocks_domain.MockGoalRepository
+	mockLogApp             *mocks_application.MockILogApp
+	app                    IGoalApp
+	ctx                    context.Context
+}
+
+func (suite *TestGoalSuite) SetupSuite() {
+	suite.userId = 1
+	suite.id = 2
+	suite.ctx = context.Background()
+}
+
+func (suite *TestGoalSuite) SetupTest() {
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockGoalRepo = mocks_domain.NewMockGoalRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.app = NewGoalApp(suite.mockTransactionManager, suite.mockGoalRepo, suite.mockLogApp)
+}
+
+func (suite *TestGoalSuite) mockTransaction() {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockGoalRepo.On("WithTransaction", nil).Return(suite.mockGoalRepo)
+}
+
+func (suite *TestGoalSuite) goal() domain.Goal {
+	category := domain.Saving
+	return domain.Goal{
+		ID:           suite.id,
+		UserId:       suite.userId,
+		Name:         "Trip",
+		TargetAmount: 1000,
+		TargetDate:   time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		Category:     &category,
+		CreatedAt:    time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (suite *TestGoalSuite) mockFindGoal(goal domain.Goal, err error) {
+	suite.mockGoalRepo.On("SearchByExample", suite.ctx, domain.Goal{ID: suite.id, UserId: suite.userId}).Return(goal, err)
+}
+
+func (suite *TestGoalSuite) TestCreateSuccess() {
+	require := require.New(suite.T())
+	goal := suite.goal()
+	goal.ID = 0
+	suite.mockTransaction()
+	suite.mockGoalRepo.On("Save", suite.ctx, mock.MatchedBy(func(g domain.Goal) bool {
+		return g.ID == 0 && g.UserId == suite.userId && g.Name == "Trip" && !g.CreatedAt.IsZero()
+	})).Return(suite.id, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se crea una meta de ahorro", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.Create(suite.ctx, goal)
+
+	require.NoError(err)
+	require.Equal(suite.id, res.ID)
+}
+
+func (suite *TestGoalSuite) TestCreateError() {
+	require := require.New(suite.T())
+	suite.mockTransaction()
+	suite.mockGoalRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), gorm.ErrInvalidTransaction)
+
+	_, err := suite.app.Create(suite.ctx, suite.goal())
+
+	require.ErrorIs(err, gorm.ErrInvalidTransaction)
+}
+
+func (suite *TestGoalSuite) TestFindByUserIdSuccess() {
+	require := require.New(suite.T())
+	goals := []domain.Goal{suite.goal()}
+	suite.mockGoalRepo.On("SearchAllByExample", suite.ctx, domain.Goal{UserId: suite.userId}).Return(goals, nil)
+
+	res, err := suite.app.FindByUserId(suite.ctx, suite.userId)
+
+	require.NoError(err)
+	require.Equal(goals, res)
+}
+
+func (suite *TestGoalSuite) TestUpdateSuccess() {
+	require := require.New(suite.T())
+	current := suite.goal()
+	goal := suite.goal()
+	goal.TargetAmount = 1500
+	goal.CreatedAt = time.Time{}
+	suite.mockFindGoal(current, nil)
+	suite.mockTransaction()
+	suite.mockGoalRepo.On("Save", suite.ctx, mock.MatchedBy(func(g domain.Goal) bool {
+		return g.ID == suite.id && g.TargetAmount == 1500 && g.CreatedAt.Equal(current.CreatedAt)
+	})).Return(suite.id, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se actualiza una meta de ahorro", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.Update(suite.ctx, goal)
+
+	require.NoError(err)
+	require.Equal(1500.0, res.TargetAmount)
+}
+
+func (suite *TestGoalSuite) TestUpdateErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockFindGoal(domain.Goal{}, gorm.ErrRecordNotFound)
+
+	_, err := suite.app.Update(suite.ctx, suite.goal())
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestGoalSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mockFindGoal(suite.goal(), nil)
+	suite.mockTransaction()
+	suite.mockGoalRepo.On("Delete", suite.ctx, suite.id).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se elimina una meta de ahorro", shared.User, suite.userId, map[string]any{"id": suite.id, "name": "Trip"}, nil).Return(nil)
+
+	err := suite.app.Delete(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+}
+
+func (suite *TestGoalSuite) TestDeleteErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockFindGoal(domain.Goal{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.Delete(suite.ctx, suite.userId, suite.id)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestGoalSuite) TestProgressSuccessCategory() {
+	require := require.New(suite.T())
+	goal := suite.goal()
+	contributions := []domain.GoalContribution{
+		{Year: 2024, Month: 1, Amount: 100},
+		{Year: 2024, Month: 3, Amount: 200},
+	}
+	suite.mockFindGoal(goal, nil)
+	suite.mockGoalRepo.On("SearchContributions", suite.ctx, goal).Return(contributions, nil)
+
+	res, err := suite.app.Progress(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+	require.Equal(300.0, res.Saved)
+	require.Equal(30.0, res.Percentage)
+	require.Equal(100.0, res.MonthlyRate)
+	require.False(res.Completed)
+	require.Equal(time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), *res.ProjectedDate)
+	require.True(res.OnTrack)
+	require.Equal(contributions, res.Contributions)
+}
+
+func (suite *TestGoalSuite) TestProgressSuccessAvailable() {
+	require := require.New(suite.T())
+	goal := suite.goal()
+	available := "Savings account"
+	goal.Category = nil
+	goal.Available = &available
+	goal.TargetDate = time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	contributions := []domain.GoalContribution{
+		{Year: 2024, Month: 1, Amount: 400},
+		{Year: 2024, Month: 3, Amount: 500},
+	}
+	suite.mockFindGoal(goal, nil)
+	suite.mockGoalRepo.On("SearchContributions", suite.ctx, goal).Return(contributions, nil)
+
+	res, err := suite.app.Progress(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+	require.Equal(500.0, res.Saved)
+	require.Equal(50.0, res.MonthlyRate)
+	require.Equal(time.Date(2024, 13, 1, 0, 0, 0, 0, time.UTC), *res.ProjectedDate)
+	require.False(res.OnTrack)
+}
+
+func (suite *TestGoalSuite) TestProgressSuccessCompleted() {
+	require := require.New(suite.T())
+	goal := suite.goal()
+	suite.mockFindGoal(goal, nil)
+	suite.mockGoalRepo.On("SearchContributions", suite.ctx, goal).Return([]domain.GoalContribution{{Year: 2024, Month: 1, Amount: 1200}}, nil)
+
+	res, err := suite.app.Progress(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+	require.True(res.Completed)
+	require.True(res.OnTrack)
+	require.Nil(res.ProjectedDate)
+	require.Equal(120.0, res.Percentage)
+}
+
+func (suite *TestGoalSuite) TestProgressSuccessWithoutContributions() {
+	require := require.New(suite.T())
+	goal := suite.goal()
+	suite.mockFindGoal(goal, nil)
+	suite.mockGoalRepo.On("SearchContributions", suite.ctx, goal).Return([]domain.GoalContribution{}, nil)
+
+	res, err := suite.app.Progress(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+	require.Zero(res.Saved)
+	require.Zero(res.MonthlyRate)
+	require.Nil(res.ProjectedDate)
+	require.False(res.OnTrack)
+}
+
+func (suite *TestGoalSuite) TestProgressErrorContributions() {
+	require := require.New(suite.T())
+	goal := suite.goal()
+	suite.mockFindGoal(goal, nil)
+	suite.mockGoalRepo.On("SearchContributions", suite.ctx, goal).Return(nil, gorm.ErrInvalidDB)
+
+	_, err := suite.app.Progress(suite.ctx, suite.userId, suite.id)
+
+	require.ErrorIs(err, gorm.ErrInvalidDB)
+}
+
+func (suite *TestGoalSuite) TestDeleteUserEventSuccess() {
+	require := require.New(suite.T())
+	suite.mockGoalRepo.On("DeleteAllByUserId", suite.ctx, suite.userId).Return(nil)
+
+	err := suite.app.DeleteUserEvent(suite.ctx, shared.Event{Name: shared.UserDeleted, ResourceId: suite.userId})
+
+	require.NoError(err)
+}
+
+func (suite *TestGoalSuite) TestDeleteUserEventError() {
+	require := require.New(suite.T())
+	suite.mockGoalRepo.On("DeleteAllByUserId", suite.ctx, suite.userId).Return(gorm.ErrInvalidField)
+
+	err := suite.app.DeleteUserEvent(suite.ctx, shared.Event{Name: shared.UserDeleted, ResourceId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestGoalSuite(t *testing.T) {
+	suite.Run(t, new(TestGoalSuite))
+}
@@ -0,0 +1,395 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"gorm.io/gorm"
+)
+
+// LoanSchedule is the full schedule of a loan, the installments added to the budgets carry
+// their bill and its payment
+type LoanSchedule struct {
+	Loan         domain.Loan
+	Installments []domain.LoanInstallment
+}
+
+type ILoanApp interface {
+	Create(ctx context.Context, loan domain.Loan) (domain.Loan, error)
+	FindByUserId(ctx context.Context, userId uint) ([]domain.Loan, error)
+	FindById(ctx context.Context, userId, id uint) (domain.Loan, error)
+	Schedule(ctx context.Context, userId, id uint) (LoanSchedule, error)
+	Delete(ctx context.Context, userId, id uint) error
+	Materialize(ctx context.Context, userId, budgetId uint) ([]domain.LoanInstallment, error)
+	MaterializeEvent(ctx context.Context, event shared.Event) error
+	RefreshBalance(ctx context.Context, event shared.Event) error
+	PurgeEvent(ctx context.Context, event shared.Event) error
+	DeleteUserEvent(ctx context.Context, event shared.Event) error
+}
+
+type loanApp struct {
+	tm             persistent.TransactionManager
+	loanRepo       domain.LoanRepository
+	budgetRepo     domain.BudgetRepository
+	budgetBillRepo domain.BudgetBillRepository
+	logApp         application.ILogApp
+	eventApp       application.IEventApp
+}
+
+func (app *loanApp) Create(ctx context.Context, loan domain.Loan) (domain.Loan, error) {
+	loan.ID = 0
+	loan.Balance = loan.Principal
+	loan.CreatedAt = time.Now()
+	err := app.tm.Transaction(func(tx persistent.Transaction) error {
+		loanRepo := app.loanRepo.WithTransaction(tx)
+		id, err := loanRepo.Save(ctx, loan)
+		if err != nil {
+			return err
+		}
+
+		loan.ID = id
+		detail := map[string]any{
+			"id":           loan.ID,
+			"name":         loan.Name,
+			"principal":    loan.Principal,
+			"rate":         loan.Rate,
+			"term":         loan.Term,
+			"startDate":    loan.StartDate.Format(time.DateOnly),
+			"paymentDay":   loan.PaymentDay,
+			"amortization": loan.Amortization,
+		}
+		return app.logApp.Create(ctx, "Se crea un préstamo", shared.User, loan.UserId, detail, tx)
+	})
+	if err != nil {
+		return domain.Loan{}, err
+	}
+
+	return loan, nil
+}
+
+func (app *loanApp) FindByUserId(ctx context.Context, userId uint) ([]domain.Loan, error) {
+	return app.loanRepo.SearchAllByExample(ctx, domain.Loan{
+		UserId: userId,
+	})
+}
+
+func (app *loanApp) FindById(ctx context.Context, userId, id uint) (domain.Loan, error) {
+	return app.loanRepo.SearchByExample(ctx, domain.Loan{
+		ID:     id,
+		UserId: userId,
+	})
+}
+
+func (app *loanApp) Schedule(ctx context.Context, userId, id uint) (LoanSchedule, error) {
+	loan, err := app.FindById(ctx, userId, id)
+	if err != nil {
+		return LoanSchedule{}, err
+	}
+
+	materialized, err := app.loanRepo.SearchInstallments(ctx, loan.ID)
+	if err != nil {
+		return LoanSchedule{}, err
+	}
+
+	byNumber := map[uint16]domain.LoanInstallment{}
+	for _, installment := range materialized {
+		byNumber[installment.Number] = installment
+	}
+
+	installments := loanSchedule(loan)
+	for i, installment := range installments {
+		if current, ok := byNumber[installment.Number]; ok {
+			installments[i].BudgetBillId = current.BudgetBillId
+			installments[i].Paid = current.Paid
+		}
+	}
+
+	return LoanSchedule{
+		Loan:         loan,
+		Installments: installments,
+	}, nil
+}
+
+func (app *loanApp) Delete(ctx context.Context, userId, id uint) error {
+	loan, err := app.FindById(ctx, userId, id)
+	if err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		loanRepo := app.loanRepo.WithTransaction(tx)
+		if err := loanRepo.Delete(ctx, loan.ID); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"id":   loan.ID,
+			"name": loan.Name,
+		}
+		return app.logApp.Create(ctx, "Se elimina un préstamo", shared.User, userId, detail, tx)
+	})
+}
+
+// Materialize adds to the budget the installments of the loans of the user due in its month,
+// the installments already added to another budget are skipped.
+func (app *loanApp) Materialize(ctx context.Context, userId, budgetId uint) ([]domain.LoanInstallment, error) {
+	budget, err := app.budgetRepo.Search(ctx, budgetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if *budget.UserId != userId {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return app.materialize(ctx, budget)
+}
+
+// MaterializeEvent adds the installments to a new budget, a budget deleted before the event
+// is delivered has nothing to do.
+func (app *loanApp) MaterializeEvent(ctx context.Context, event shared.Event) error {
+	budget, err := app.budgetRepo.Search(ctx, event.ResourceId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	_, err = app.materialize(ctx, budget)
+	return err
+}
+
+// RefreshBalance computes again the balance of the loan of a paid bill from the payments of
+// all its installments, so a repeated event leaves the same balance.
+func (app *loanApp) RefreshBalance(ctx context.Context, event shared.Event) error {
+	loan, err := app.loanRepo.SearchByBudgetBillId(ctx, event.ResourceId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	installments, err := app.loanRepo.SearchInstallments(ctx, loan.ID)
+	if err != nil {
+		return err
+	}
+
+	balance := loan.Principal
+	for _, installment := range installments {
+		principal := math.Min(math.Max(installment.Paid-installment.Interest, 0), installment.Principal)
+		balance -= principal
+	}
+	balance = math.Max(roundCents(balance), 0)
+	if balance == loan.Balance {
+		return nil
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		detail := map[string]any{
+			"id":      loan.ID,
+			"before":  loan.Balance,
+			"balance": balance,
+		}
+		loan.Balance = balance
+		loanRepo := app.loanRepo.WithTransaction(tx)
+		if _, err := loanRepo.Save(ctx, loan); err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("Se actualiza el saldo del préstamo %s", loan.Name)
+		return app.logApp.Create(ctx, description, shared.User, loan.UserId, detail, tx)
+	})
+}
+
+func (app *loanApp) materialize(ctx context.Context, budget domain.Budget) ([]domain.LoanInstallment, error) {
+	loans, err := app.FindByUserId(ctx, *budget.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	type pending struct {
+		loan        domain.Loan
+		installment domain.LoanInstallment
+	}
+	pendings := []pending{}
+	for _, loan := range loans {
+		installment, ok := installmentOf(loan, *budget.Year, *budget.Month)
+		if !ok {
+			continue
+		}
+
+		materialized, err := app.loanRepo.SearchInstallments(ctx, loan.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if containsInstallment(materialized, installment.Number) {
+			continue
+		}
+
+		pendings = append(pendings, pending{loan, installment})
+	}
+
+	installments := []domain.LoanInstallment{}
+	if len(pendings) == 0 {
+		return installments, nil
+	}
+
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		loanRepo := app.loanRepo.WithTransaction(tx)
+		budgetBillRepo := app.budgetBillRepo.WithTransaction(tx)
+		for _, pending := range pendings {
+			description := fmt.Sprintf("%s %d/%d", pending.loan.Name, pending.installment.Number, pending.loan.Term)
+			category := domain.Financial
+			payment := 0.0
+			complete := false
+			billId, err := budgetBillRepo.Save(ctx, domain.BudgetBill{
+				Description: &description,
+				Amount:      &pending.installment.Payment,
+				Payment:     &payment,
+				DueDate:     &pending.loan.PaymentDay,
+				Complete:    &complete,
+				BudgetId:    budget.ID,
+				Category:    &category,
+			})
+			if err != nil {
+				return err
+			}
+
+			installment := pending.installment
+			installment.BudgetBillId = &billId
+			if err := loanRepo.SaveInstallment(ctx, pending.loan.ID, installment); err != nil {
+				return err
+			}
+
+			descriptionLog := fmt.Sprintf("Se crea la cuota %d del préstamo %s", installment.Number, pending.loan.Name)
+			detail := map[string]any{
+				"loanId": pending.loan.ID,
+				"billId": billId,
+				"amount": installment.Payment,
+			}
+			if err := app.logApp.Create(ctx, descriptionLog, shared.Budget, *budget.ID, detail, tx); err != nil {
+				return err
+			}
+
+			payload := map[string]any{
+				"budgetId":    *budget.ID,
+				"description": description,
+				"category":    category,
+				"loanId":      pending.loan.ID,
+			}
+			if err := app.eventApp.Publish(ctx, shared.BillCreated, billId, payload, tx); err != nil {
+				return err
+			}
+
+			installments = append(installments, installment)
+		}
+
+		return saveTotals(ctx, app.budgetRepo.WithTransaction(tx), *budget.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return installments, nil
+}
+
+// PurgeEvent removes the installments left without their bill by the trash purge
+func (app *loanApp) PurgeEvent(ctx context.Context, event shared.Event) error {
+	return app.loanRepo.DeleteOrphans(ctx)
+}
+
+// DeleteUserEvent removes the loans of a deleted user with their installments
+func (app *loanApp) DeleteUserEvent(ctx context.Context, event shared.Event) error {
+	return app.loanRepo.DeleteAllByUserId(ctx, event.ResourceId)
+}
+
+func NewLoanApp(
+	tm persistent.TransactionManager, loanRepo domain.LoanRepository, budgetRepo domain.BudgetRepository,
+	budgetBillRepo domain.BudgetBillRepository, logApp application.ILogApp, eventApp application.IEventApp,
+) ILoanApp {
+	return &loanApp{tm, loanRepo, budgetRepo, budgetBillRepo, logApp, eventApp}
+}
+
+// loanSchedule splits the loan in monthly installments from the month after its start, the
+// amounts are rounded to cents and the last installment pays the rest of the principal.
+func loanSchedule(loan domain.Loan) []domain.LoanInstallment {
+	installments := []domain.LoanInstallment{}
+	if loan.Term == 0 {
+		return installments
+	}
+
+	term := float64(loan.Term)
+	rate := loan.Rate / 100 / 12
+	payment := loan.Principal / term
+	if loan.Amortization == domain.French && rate > 0 {
+		payment = loan.Principal * rate / (1 - math.Pow(1+rate, -term))
+	}
+
+	first := time.Date(loan.StartDate.Year(), loan.StartDate.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	balance := loan.Principal
+	for number := uint16(1); number <= loan.Term; number++ {
+		var interest, principal float64
+		switch loan.Amortization {
+		case domain.Fixed:
+			interest = roundCents(loan.Principal * rate)
+			principal = roundCents(loan.Principal / term)
+		case domain.German:
+			interest = roundCents(balance * rate)
+			principal = roundCents(loan.Principal / term)
+		default:
+			interest = roundCents(balance * rate)
+			principal = roundCents(payment - interest)
+		}
+
+		if number == loan.Term || principal > balance {
+			principal = balance
+		}
+		balance = roundCents(balance - principal)
+
+		date := first.AddDate(0, int(number)-1, 0)
+		installments = append(installments, domain.LoanInstallment{
+			Number:    number,
+			Year:      uint16(date.Year()),
+			Month:     uint8(date.Month()),
+			Payment:   roundCents(principal + interest),
+			Interest:  interest,
+			Principal: principal,
+			Balance:   balance,
+		})
+	}
+
+	return installments
+}
+
+// installmentOf finds the installment of the loan due in the month
+func installmentOf(loan domain.Loan, year uint16, month uint8) (domain.LoanInstallment, bool) {
+	first := time.Date(loan.StartDate.Year(), loan.StartDate.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	number := monthIndex(int(year), int(month)) - monthIndex(first.Year(), int(first.Month())) + 1
+	if number < 1 || number > int(loan.Term) {
+		return domain.LoanInstallment{}, false
+	}
+
+	return loanSchedule(loan)[number-1], true
+}
+
+func containsInstallment(installments []domain.LoanInstallment, number uint16) bool {
+	for _, installment := range installments {
+		if installment.Number == number {
+			return true
+		}
+	}
+
+	return false
+}
+
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,352 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestLoanSuite struct {
+	suite.Suite
+	userId                 uint
+	id                     uint
+	budgetId               uint
+	mockTransactionManager *mocks_persistent.MockTransactionManager
+	mockLoanRepo           *mocks_domain.MockLoanRepository
+	mockBudgetRepo         *mocks_domain.MockBudgetRepository
+	mockBudgetBillRepo     *mocks_domain.MockBudgetBillRepository
+	mockLogApp             *mocks_application.MockILogApp
+	mockEventApp           *mocks_application.MockIEventApp
+	app                    ILoanApp
+	ctx                    context.Context
+}
+
+func (suite *TestLoanSuite) SetupSuite() {
+	suite.userId = 1
+	suite.id = 2
+	suite.budgetId = 3
+	suite.ctx = context.Background()
+}
+
+func (suite *TestLoanSuite) SetupTest() {
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockLoanRepo = mocks_domain.NewMockLoanRepository(suite.T())
+	suite.mockBudgetRepo = mocks_domain.NewMockBudgetRepository(suite.T())
+	suite.mockBudgetBillRepo = mocks_domain.NewMockBudgetBillRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.mockEventApp = mocks_application.NewMockIEventApp(suite.T())
+	suite.app = NewLoanApp(suite.mockTransactionManager, suite.mockLoanRepo, suite.mockBudgetRepo, suite.mockBudgetBillRepo, suite.mockLogApp, suite.mockEventApp)
+}
+
+func (suite *TestLoanSuite) mockTransaction() {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockLoanRepo.On("WithTransaction", nil).Return(suite.mockLoanRepo)
+}
+
+func (suite *TestLoanSuite) loan(amortization domain.LoanAmortization, term uint16) domain.Loan {
+	return domain.Loan{
+		ID:           suite.id,
+		UserId:       suite.userId,
+		Name:         "Car",
+		Principal:    1000,
+		Rate:         12,
+		Term:         term,
+		StartDate:    time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		PaymentDay:   10,
+		Amortization: amortization,
+		Balance:      1000,
+	}
+}
+
+func (suite *TestLoanSuite) budget(userId uint) domain.Budget {
+	year := uint16(2024)
+	month := uint8(3)
+	return domain.Budget{
+		ID:     &suite.budgetId,
+		Year:   &year,
+		Month:  &month,
+		UserId: &userId,
+	}
+}
+
+func (suite *TestLoanSuite) TestCreateSuccess() {
+	require := require.New(suite.T())
+	loan := suite.loan(domain.French, 3)
+	loan.ID = 0
+	loan.Balance = 0
+	suite.mockTransaction()
+	suite.mockLoanRepo.On("Save", suite.ctx, mock.MatchedBy(func(l domain.Loan) bool {
+		return l.ID == 0 && l.Balance == 1000 && !l.CreatedAt.IsZero()
+	})).Return(suite.id, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se crea un préstamo", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.Create(suite.ctx, loan)
+
+	require.NoError(err)
+	require.Equal(suite.id, res.ID)
+	require.Equal(1000.0, res.Balance)
+}
+
+func (suite *TestLoanSuite) TestCreateError() {
+	require := require.New(suite.T())
+	suite.mockTransaction()
+	suite.mockLoanRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), gorm.ErrInvalidTransaction)
+
+	_, err := suite.app.Create(suite.ctx, suite.loan(domain.French, 3))
+
+	require.ErrorIs(err, gorm.ErrInvalidTransaction)
+}
+
+func (suite *TestLoanSuite) TestScheduleSuccessFrench() {
+	require := require.New(suite.T())
+	loan := suite.loan(domain.French, 3)
+	billId := uint(7)
+	suite.mockLoanRepo.On("SearchByExample", suite.ctx, domain.Loan{ID: suite.id, UserId: suite.userId}).Return(loan, nil)
+	suite.mockLoanRepo.On("SearchInstallments", suite.ctx, suite.id).Return([]domain.LoanInstallment{{Number: 1, BudgetBillId: &billId, Paid: 340.02}}, nil)
+
+	res, err := suite.app.Schedule(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+	require.Equal(loan, res.Loan)
+	require.Equal([]domain.LoanInstallment{
+		{Number: 1, Year: 2024, Month: 2, Payment: 340.02, Interest: 10, Principal: 330.02, Balance: 669.98, BudgetBillId: &billId, Paid: 340.02},
+		{Number: 2, Year: 2024, Month: 3, Payment: 340.02, Interest: 6.7, Principal: 333.32, Balance: 336.66},
+		{Number: 3, Year: 2024, Month: 4, Payment: 340.03, Interest: 3.37, Principal: 336.66, Balance: 0},
+	}, res.Installments)
+}
+
+func (suite *TestLoanSuite) TestScheduleSuccessGerman() {
+	require := require.New(suite.T())
+	suite.mockLoanRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(suite.loan(domain.German, 4), nil)
+	suite.mockLoanRepo.On("SearchInstallments", suite.ctx, suite.id).Return([]domain.LoanInstallment{}, nil)
+
+	res, err := suite.app.Schedule(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+	require.Len(res.Installments, 4)
+	payments := []float64{}
+	for _, installment := range res.Installments {
+		require.Equal(250.0, installment.Principal)
+		payments = append(payments, installment.Payment)
+	}
+	require.Equal([]float64{260, 257.5, 255, 252.5}, payments)
+	require.Zero(res.Installments[3].Balance)
+}
+
+func (suite *TestLoanSuite) TestScheduleSuccessFixed() {
+	require := require.New(suite.T())
+	suite.mockLoanRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(suite.loan(domain.Fixed, 4), nil)
+	suite.mockLoanRepo.On("SearchInstallments", suite.ctx, suite.id).Return([]domain.LoanInstallment{}, nil)
+
+	res, err := suite.app.Schedule(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+	for _, installment := range res.Installments {
+		require.Equal(260.0, installment.Payment)
+		require.Equal(10.0, installment.Interest)
+	}
+	require.Equal(uint8(5), res.Installments[3].Month)
+}
+
+func (suite *TestLoanSuite) TestScheduleErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockLoanRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.Loan{}, gorm.ErrRecordNotFound)
+
+	_, err := suite.app.Schedule(suite.ctx, suite.userId, suite.id)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestLoanSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mockLoanRepo.On("SearchByExample", suite.ctx, domain.Loan{ID: suite.id, UserId: suite.userId}).Return(suite.loan(domain.French, 3), nil)
+	suite.mockTransaction()
+	suite.mockLoanRepo.On("Delete", suite.ctx, suite.id).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se elimina un préstamo", shared.User, suite.userId, map[string]any{"id": suite.id, "name": "Car"}, nil).Return(nil)
+
+	err := suite.app.Delete(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+}
+
+func (suite *TestLoanSuite) TestDeleteErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockLoanRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.Loan{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.Delete(suite.ctx, suite.userId, suite.id)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestLoanSuite) TestMaterializeSuccess() {
+	require := require.New(suite.T())
+	budget := suite.budget(suite.userId)
+	billId := uint(7)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget, nil)
+	suite.mockLoanRepo.On("SearchAllByExample", suite.ctx, domain.Loan{UserId: suite.userId}).Return([]domain.Loan{suite.loan(domain.French, 3)}, nil)
+	suite.mockLoanRepo.On("SearchInstallments", suite.ctx, suite.id).Return([]domain.LoanInstallment{}, nil)
+	suite.mockTransaction()
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("Save", suite.ctx, mock.MatchedBy(func(bill domain.BudgetBill) bool {
+		return *bill.Description == "Car 2/3" && *bill.Amount == 340.02 && *bill.DueDate == 10 && *bill.Category == domain.Financial && *bill.BudgetId == suite.budgetId
+	})).Return(billId, nil)
+	suite.mockLoanRepo.On("SaveInstallment", suite.ctx, suite.id, mock.MatchedBy(func(installment domain.LoanInstallment) bool {
+		return installment.Number == 2 && *installment.BudgetBillId == billId
+	})).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se crea la cuota 2 del préstamo Car", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.BillCreated, billId, mock.Anything, nil).Return(nil)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget, nil)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(b domain.Budget) bool {
+		return b.TotalPending != nil && b.PendingBills != nil
+	})).Return(suite.budgetId, nil)
+
+	res, err := suite.app.Materialize(suite.ctx, suite.userId, suite.budgetId)
+
+	require.NoError(err)
+	require.Len(res, 1)
+	require.Equal(uint16(2), res[0].Number)
+}
+
+func (suite *TestLoanSuite) TestMaterializeSuccessAlreadyAdded() {
+	require := require.New(suite.T())
+	billId := uint(7)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.userId), nil)
+	suite.mockLoanRepo.On("SearchAllByExample", suite.ctx, domain.Loan{UserId: suite.userId}).Return([]domain.Loan{suite.loan(domain.French, 3)}, nil)
+	suite.mockLoanRepo.On("SearchInstallments", suite.ctx, suite.id).Return([]domain.LoanInstallment{{Number: 2, BudgetBillId: &billId}}, nil)
+
+	res, err := suite.app.Materialize(suite.ctx, suite.userId, suite.budgetId)
+
+	require.NoError(err)
+	require.Empty(res)
+}
+
+func (suite *TestLoanSuite) TestMaterializeSuccessOutOfTerm() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.userId), nil)
+	suite.mockLoanRepo.On("SearchAllByExample", suite.ctx, domain.Loan{UserId: suite.userId}).Return([]domain.Loan{suite.loan(domain.French, 1)}, nil)
+
+	res, err := suite.app.Materialize(suite.ctx, suite.userId, suite.budgetId)
+
+	require.NoError(err)
+	require.Empty(res)
+}
+
+func (suite *TestLoanSuite) TestMaterializeErrorOtherUser() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.budget(suite.userId+1), nil)
+
+	_, err := suite.app.Materialize(suite.ctx, suite.userId, suite.budgetId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestLoanSuite) TestMaterializeEventSuccessBudgetDeleted() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.MaterializeEvent(suite.ctx, shared.Event{Name: shared.BudgetCreated, ResourceId: suite.budgetId})
+
+	require.NoError(err)
+}
+
+func (suite *TestLoanSuite) TestRefreshBalanceSuccess() {
+	require := require.New(suite.T())
+	billId := uint(7)
+	suite.mockLoanRepo.On("SearchByBudgetBillId", suite.ctx, billId).Return(suite.loan(domain.French, 3), nil)
+	suite.mockLoanRepo.On("SearchInstallments", suite.ctx, suite.id).Return([]domain.LoanInstallment{
+		{Number: 1, Interest: 10, Principal: 330.02, BudgetBillId: &billId, Paid: 340.02},
+		{Number: 2, Interest: 6.7, Principal: 333.32, BudgetBillId: &billId, Paid: 5},
+	}, nil)
+	suite.mockTransaction()
+	suite.mockLoanRepo.On("Save", suite.ctx, mock.MatchedBy(func(loan domain.Loan) bool {
+		return loan.ID == suite.id && loan.Balance == 669.98
+	})).Return(suite.id, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se actualiza el saldo del préstamo Car", shared.User, suite.userId, map[string]any{"id": suite.id, "before": 1000.0, "balance": 669.98}, nil).Return(nil)
+
+	err := suite.app.RefreshBalance(suite.ctx, shared.Event{Name: shared.BillPaid, ResourceId: billId})
+
+	require.NoError(err)
+}
+
+func (suite *TestLoanSuite) TestRefreshBalanceSuccessUnchanged() {
+	require := require.New(suite.T())
+	suite.mockLoanRepo.On("SearchByBudgetBillId", suite.ctx, uint(7)).Return(suite.loan(domain.French, 3), nil)
+	suite.mockLoanRepo.On("SearchInstallments", suite.ctx, suite.id).Return([]domain.LoanInstallment{}, nil)
+
+	err := suite.app.RefreshBalance(suite.ctx, shared.Event{Name: shared.BillPaid, ResourceId: 7})
+
+	require.NoError(err)
+}
+
+func (suite *TestLoanSuite) TestRefreshBalanceSuccessWithoutLoan() {
+	require := require.New(suite.T())
+	suite.mockLoanRepo.On("SearchByBudgetBillId", suite.ctx, uint(7)).Return(domain.Loan{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.RefreshBalance(suite.ctx, shared.Event{Name: shared.BillPaid, ResourceId: 7})
+
+	require.NoError(err)
+}
+
+func (suite *TestLoanSuite) TestRefreshBalanceErrorInstallments() {
+	require := require.New(suite.T())
+	suite.mockLoanRepo.On("SearchByBudgetBillId", suite.ctx, uint(7)).Return(suite.loan(domain.French, 3), nil)
+	suite.mockLoanRepo.On("SearchInstallments", suite.ctx, suite.id).Return(nil, gorm.ErrInvalidDB)
+
+	err := suite.app.RefreshBalance(suite.ctx, shared.Event{Name: shared.BillPaid, ResourceId: 7})
+
+	require.ErrorIs(err, gorm.ErrInvalidDB)
+}
+
+func (suite *TestLoanSuite) TestPurgeEventSuccess() {
+	require := require.New(suite.T())
+	suite.mockLoanRepo.On("DeleteOrphans", suite.ctx).Return(nil)
+
+	err := suite.app.PurgeEvent(suite.ctx, shared.Event{Name: shared.TrashPurged, ResourceId: suite.userId})
+
+	require.NoError(err)
+}
+
+func (suite *TestLoanSuite) TestPurgeEventError() {
+	require := require.New(suite.T())
+	suite.mockLoanRepo.On("DeleteOrphans", suite.ctx).Return(gorm.ErrInvalidField)
+
+	err := suite.app.PurgeEvent(suite.ctx, shared.Event{Name: shared.TrashPurged, ResourceId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestLoanSuite) TestDeleteUserEventSuccess() {
+	require := require.New(suite.T())
+	suite.mockLoanRepo.On("DeleteAllByUserId", suite.ctx, suite.userId).Return(nil)
+
+	err := suite.app.DeleteUserEvent(suite.ctx, shared.Event{Name: shared.UserDeleted, ResourceId: suite.userId})
+
+	require.NoError(err)
+}
+
+func (suite *TestLoanSuite) TestDeleteUserEventError() {
+	require := require.New(suite.T())
+	suite.mockLoanRepo.On("DeleteAllByUserId", suite.ctx, suite.userId).Return(gorm.ErrInvalidField)
+
+	err := suite.app.DeleteUserEvent(suite.ctx, shared.Event{Name: shared.UserDeleted, ResourceId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestLoanSuite(t *testing.T) {
+	suite.Run(t, new(TestLoanSuite))
+}
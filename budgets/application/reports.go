@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"your-accounts-api/budgets/domain"
+)
+
+// reportTopBills limits the bills with the highest amounts of the annual report
+const reportTopBills = 10
+
+// AnnualCategory sums the bills of a category in a month or in the whole year
+type AnnualCategory struct {
+	Category domain.BudgetBillCategory
+	Amount   float64
+	Payment  float64
+}
+
+// AnnualTotals are the amounts of a month or of the year, the savings are the income that was
+// not paid in bills plus what was paid in bills of the saving category.
+type AnnualTotals struct {
+	Income     float64
+	Billed     float64
+	Paid       float64
+	Pending    float64
+	Savings    float64
+	Categories []AnnualCategory
+}
+
+type AnnualMonth struct {
+	Month   uint8
+	Budgets uint
+	AnnualTotals
+}
+
+type AnnualReport struct {
+	Year     uint16
+	Months   []AnnualMonth
+	Totals   AnnualTotals
+	TopBills []domain.ReportBill
+}
+
+type IReportApp interface {
+	FindAnnual(ctx context.Context, userId uint, year uint16) (AnnualReport, error)
+}
+
+type reportApp struct {
+	reportRepo domain.ReportRepository
+}
+
+// FindAnnual builds the report with the twelve months of the year, the months without budgets
+// are kept with zeros.
+func (app *reportApp) FindAnnual(ctx context.Context, userId uint, year uint16) (AnnualReport, error) {
+	months, err := app.reportRepo.SearchMonthsByYear(ctx, userId, year)
+	if err != nil {
+		return AnnualReport{}, err
+	}
+
+	categories, err := app.reportRepo.SearchCategoriesByYear(ctx, userId, year)
+	if err != nil {
+		return AnnualReport{}, err
+	}
+
+	topBills, err := app.reportRepo.SearchTopBillsByYear(ctx, userId, year, reportTopBills)
+	if err != nil {
+		return AnnualReport{}, err
+	}
+
+	report := AnnualReport{
+		Year:     year,
+		Months:   make([]AnnualMonth, 12),
+		TopBills: topBills,
+	}
+	for i := range report.Months {
+		report.Months[i] = AnnualMonth{Month: uint8(i + 1), AnnualTotals: AnnualTotals{Categories: []AnnualCategory{}}}
+	}
+
+	for _, month := range months {
+		annual := &report.Months[month.Month-1]
+		annual.Budgets = month.Budgets
+		annual.Income = month.Income
+		annual.Pending = month.Pending
+	}
+
+	for _, category := range categories {
+		annual := &report.Months[category.Month-1]
+		annual.Categories = append(annual.Categories, AnnualCategory{
+			Category: category.Category,
+			Amount:   category.Amount,
+			Payment:  category.Payment,
+		})
+	}
+
+	report.Totals.Categories = []AnnualCategory{}
+	for i := range report.Months {
+		month := &report.Months[i]
+		sumCategories(&month.AnnualTotals)
+		report.Totals.Income += month.Income
+		report.Totals.Pending += month.Pending
+		for _, category := range month.Categories {
+			addCategory(&report.Totals, category)
+		}
+	}
+
+	sumCategories(&report.Totals)
+	if report.TopBills == nil {
+		report.TopBills = []domain.ReportBill{}
+	}
+
+	return report, nil
+}
+
+func NewReportApp(reportRepo domain.ReportRepository) IReportApp {
+	return &reportApp{reportRepo}
+}
+
+// sumCategories sets the billed, the paid and the savings of the totals from their categories
+func sumCategories(totals *AnnualTotals) {
+	billed, paid, saved := 0.0, 0.0, 0.0
+	for _, category := range totals.Categories {
+		billed += category.Amount
+		paid += category.Payment
+		if category.Category == domain.Saving {
+			saved += category.Payment
+		}
+	}
+
+	totals.Income = roundCents(totals.Income)
+	totals.Pending = roundCents(totals.Pending)
+	totals.Billed = roundCents(billed)
+	totals.Paid = roundCents(paid)
+	totals.Savings = roundCents(totals.Income - paid + saved)
+}
+
+func addCategory(totals *AnnualTotals, category AnnualCategory) {
+	for i := range totals.Categories {
+		if totals.Categories[i].Category == category.Category {
+			totals.Categories[i].Amount = roundCents(totals.Categories[i].Amount + category.Amount)
+			totals.Categories[i].Payment = roundCents(totals.Categories[i].Payment + category.Payment)
+			return
+		}
+	}
+
+	totals.Categories = append(totals.Categories, category)
+}
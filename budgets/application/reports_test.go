@@ -0,0 +1,114 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestReportSuite struct {
+	suite.Suite
+	userId         uint
+	year           uint16
+	mockReportRepo *mocks_domain.MockReportRepository
+	app            IReportApp
+	ctx            context.Context
+}
+
+func (suite *TestReportSuite) SetupSuite() {
+	suite.userId = 1
+	suite.year = 2024
+	suite.ctx = context.Background()
+}
+
+func (suite *TestReportSuite) SetupTest() {
+	suite.mockReportRepo = mocks_domain.NewMockReportRepository(suite.T())
+	suite.app = NewReportApp(suite.mockReportRepo)
+}
+
+func (suite *TestReportSuite) TestFindAnnualSuccess() {
+	require := require.New(suite.T())
+	months := []domain.ReportMonth{
+		{Month: 1, Budgets: 1, Income: 3000, Pending: 0},
+		{Month: 3, Budgets: 2, Income: 3200.5, Pending: 20},
+	}
+	categories := []domain.ReportCategory{
+		{Month: 1, Category: domain.House, Amount: 1200, Payment: 1200, Bills: 2},
+		{Month: 1, Category: domain.Saving, Amount: 500, Payment: 500, Bills: 1},
+		{Month: 3, Category: domain.House, Amount: 1200.1, Payment: 1180.1, Bills: 2},
+	}
+	bills := []domain.ReportBill{
+		{ID: 7, BudgetId: 4, BudgetName: "January", Month: 1, Description: "Rent", Category: domain.House, Amount: 1000, Payment: 1000},
+	}
+	suite.mockReportRepo.On("SearchMonthsByYear", suite.ctx, suite.userId, suite.year).Return(months, nil)
+	suite.mockReportRepo.On("SearchCategoriesByYear", suite.ctx, suite.userId, suite.year).Return(categories, nil)
+	suite.mockReportRepo.On("SearchTopBillsByYear", suite.ctx, suite.userId, suite.year, 10).Return(bills, nil)
+
+	res, err := suite.app.FindAnnual(suite.ctx, suite.userId, suite.year)
+
+	require.NoError(err)
+	require.Equal(suite.year, res.Year)
+	require.Len(res.Months, 12)
+	require.Equal(AnnualMonth{
+		Month:   1,
+		Budgets: 1,
+		AnnualTotals: AnnualTotals{
+			Income:  3000,
+			Billed:  1700,
+			Paid:    1700,
+			Pending: 0,
+			Savings: 1800,
+			Categories: []AnnualCategory{
+				{Category: domain.House, Amount: 1200, Payment: 1200},
+				{Category: domain.Saving, Amount: 500, Payment: 500},
+			},
+		},
+	}, res.Months[0])
+	require.Equal(AnnualMonth{Month: 2, AnnualTotals: AnnualTotals{Categories: []AnnualCategory{}}}, res.Months[1])
+	require.Equal(2020.4, res.Months[2].Savings)
+	require.Equal(AnnualTotals{
+		Income:  6200.5,
+		Billed:  2900.1,
+		Paid:    2880.1,
+		Pending: 20,
+		Savings: 3820.4,
+		Categories: []AnnualCategory{
+			{Category: domain.House, Amount: 2400.1, Payment: 2380.1},
+			{Category: domain.Saving, Amount: 500, Payment: 500},
+		},
+	}, res.Totals)
+	require.Equal(bills, res.TopBills)
+}
+
+func (suite *TestReportSuite) TestFindAnnualSuccessEmpty() {
+	require := require.New(suite.T())
+	suite.mockReportRepo.On("SearchMonthsByYear", suite.ctx, suite.userId, suite.year).Return(nil, nil)
+	suite.mockReportRepo.On("SearchCategoriesByYear", suite.ctx, suite.userId, suite.year).Return(nil, nil)
+	suite.mockReportRepo.On("SearchTopBillsByYear", suite.ctx, suite.userId, suite.year, 10).Return(nil, nil)
+
+	res, err := suite.app.FindAnnual(suite.ctx, suite.userId, suite.year)
+
+	require.NoError(err)
+	require.Len(res.Months, 12)
+	require.Equal(AnnualTotals{Categories: []AnnualCategory{}}, res.Totals)
+	require.Equal([]domain.ReportBill{}, res.TopBills)
+}
+
+func (suite *TestReportSuite) TestFindAnnualError() {
+	require := require.New(suite.T())
+	suite.mockReportRepo.On("SearchMonthsByYear", suite.ctx, suite.userId, suite.year).Return(nil, nil)
+	suite.mockReportRepo.On("SearchCategoriesByYear", suite.ctx, suite.userId, suite.year).Return(nil, gorm.ErrInvalidField)
+
+	_, err := suite.app.FindAnnual(suite.ctx, suite.userId, suite.year)
+
+	require.ErrorIs(err, gorm.ErrInvalidField)
+}
+
+func TestTestReportSuite(t *testing.T) {
+	suite.Run(t, new(TestReportSuite))
+}
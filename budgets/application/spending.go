@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"your-accounts-api/budgets/domain"
+)
+
+// spendingMaxMonths limits the range of the analytics, the aggregations read every bill in it
+const spendingMaxMonths = 36
+
+var ErrInvalidSpendingRange = errors.New("the range must go forward and cover up to 36 months")
+
+type Spending struct {
+	Months     []domain.MonthlySpending
+	Categories []domain.CategorySpending
+}
+
+type ISpendingApp interface {
+	FindByFilter(ctx context.Context, filter domain.SpendingFilter) (Spending, error)
+}
+
+type spendingApp struct {
+	spendingRepo domain.SpendingRepository
+}
+
+func (app *spendingApp) FindByFilter(ctx context.Context, filter domain.SpendingFilter) (Spending, error) {
+	months := (int(filter.ToYear)*12 + int(filter.ToMonth)) - (int(filter.FromYear)*12 + int(filter.FromMonth)) + 1
+	if months < 1 || months > spendingMaxMonths {
+		return Spending{}, ErrInvalidSpendingRange
+	}
+
+	monthly, err := app.spendingRepo.SearchMonthlyByFilter(ctx, filter)
+	if err != nil {
+		return Spending{}, err
+	}
+
+	categories, err := app.spendingRepo.SearchCategoriesByFilter(ctx, filter)
+	if err != nil {
+		return Spending{}, err
+	}
+
+	return Spending{
+		Months:     monthly,
+		Categories: categories,
+	}, nil
+}
+
+func NewSpendingApp(spendingRepo domain.SpendingRepository) ISpendingApp {
+	return &spendingApp{spendingRepo}
+}
@@ -0,0 +1,91 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSpendingSuite struct {
+	suite.Suite
+	filter           domain.SpendingFilter
+	mockSpendingRepo *mocks_domain.MockSpendingRepository
+	app              ISpendingApp
+	ctx              context.Context
+}
+
+func (suite *TestSpendingSuite) SetupSuite() {
+	suite.filter = domain.SpendingFilter{
+		UserId:    1,
+		FromYear:  2023,
+		FromMonth: 11,
+		ToYear:    2024,
+		ToMonth:   2,
+	}
+	suite.ctx = context.Background()
+}
+
+func (suite *TestSpendingSuite) SetupTest() {
+	suite.mockSpendingRepo = mocks_domain.NewMockSpendingRepository(suite.T())
+	suite.app = NewSpendingApp(suite.mockSpendingRepo)
+}
+
+func (suite *TestSpendingSuite) TestFindByFilterSuccess() {
+	require := require.New(suite.T())
+	delta := 30.0
+	monthly := []domain.MonthlySpending{
+		{Year: 2024, Month: 1, Category: domain.House, Amount: 150, Payment: 150, Bills: 2, MonthOverMonth: &delta},
+	}
+	categories := []domain.CategorySpending{
+		{Category: domain.House, Amount: 150, Payment: 150, Average: 37.5, Median: 0},
+	}
+	suite.mockSpendingRepo.On("SearchMonthlyByFilter", suite.ctx, suite.filter).Return(monthly, nil)
+	suite.mockSpendingRepo.On("SearchCategoriesByFilter", suite.ctx, suite.filter).Return(categories, nil)
+
+	res, err := suite.app.FindByFilter(suite.ctx, suite.filter)
+
+	require.NoError(err)
+	require.Equal(Spending{Months: monthly, Categories: categories}, res)
+}
+
+func (suite *TestSpendingSuite) TestFindByFilterErrorRange() {
+	require := require.New(suite.T())
+	filters := []domain.SpendingFilter{
+		{UserId: 1, FromYear: 2024, FromMonth: 2, ToYear: 2024, ToMonth: 1},
+		{UserId: 1, FromYear: 2021, FromMonth: 1, ToYear: 2024, ToMonth: 1},
+	}
+
+	for _, filter := range filters {
+		_, err := suite.app.FindByFilter(suite.ctx, filter)
+
+		require.ErrorIs(err, ErrInvalidSpendingRange)
+	}
+}
+
+func (suite *TestSpendingSuite) TestFindByFilterErrorMonthly() {
+	require := require.New(suite.T())
+	suite.mockSpendingRepo.On("SearchMonthlyByFilter", suite.ctx, suite.filter).Return(nil, gorm.ErrInvalidDB)
+
+	_, err := suite.app.FindByFilter(suite.ctx, suite.filter)
+
+	require.ErrorIs(err, gorm.ErrInvalidDB)
+}
+
+func (suite *TestSpendingSuite) TestFindByFilterErrorCategories() {
+	require := require.New(suite.T())
+	suite.mockSpendingRepo.On("SearchMonthlyByFilter", suite.ctx, suite.filter).Return([]domain.MonthlySpending{}, nil)
+	suite.mockSpendingRepo.On("SearchCategoriesByFilter", suite.ctx, suite.filter).Return(nil, gorm.ErrInvalidDB)
+
+	_, err := suite.app.FindByFilter(suite.ctx, suite.filter)
+
+	require.ErrorIs(err, gorm.ErrInvalidDB)
+}
+
+func TestTestSpendingSuite(t *testing.T) {
+	suite.Run(t, new(TestSpendingSuite))
+}
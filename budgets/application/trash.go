@@ -0,0 +1,204 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/config"
+)
+
+var (
+	ErrTrashNotOwner = errors.New("trashed item of another user")
+	ErrBudgetTrashed = errors.New("budget in the trash")
+)
+
+type Trash struct {
+	Budgets    []domain.Budget
+	Availables []domain.BudgetAvailable
+	Bills      []domain.BudgetBill
+}
+
+type ITrashApp interface {
+	FindByUserId(ctx context.Context, userId uint) (Trash, error)
+	RestoreBudget(ctx context.Context, userId, id uint) error
+	RestoreAvailable(ctx context.Context, userId, id uint) error
+	RestoreBill(ctx context.Context, userId, id uint) error
+	Purge(ctx context.Context) error
+}
+
+type trashApp struct {
+	tm                  persistent.TransactionManager
+	budgetRepo          domain.BudgetRepository
+	budgetAvailableRepo domain.BudgetAvailableRepository
+	budgetBillRepo      domain.BudgetBillRepository
+	logApp              application.ILogApp
+	eventApp            application.IEventApp
+}
+
+func (app *trashApp) FindByUserId(ctx context.Context, userId uint) (Trash, error) {
+	budgets, err := app.budgetRepo.SearchAllTrashedByUserId(ctx, userId)
+	if err != nil {
+		return Trash{}, err
+	}
+
+	availables, err := app.budgetAvailableRepo.SearchAllTrashedByUserId(ctx, userId)
+	if err != nil {
+		return Trash{}, err
+	}
+
+	bills, err := app.budgetBillRepo.SearchAllTrashedByUserId(ctx, userId)
+	if err != nil {
+		return Trash{}, err
+	}
+
+	return Trash{
+		Budgets:    budgets,
+		Availables: availables,
+		Bills:      bills,
+	}, nil
+}
+
+func (app *trashApp) RestoreBudget(ctx context.Context, userId, id uint) error {
+	budget, err := app.budgetRepo.SearchTrashed(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if *budget.UserId != userId {
+		return ErrTrashNotOwner
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		budgetRepo := app.budgetRepo.WithTransaction(tx)
+		if err := budgetRepo.Restore(ctx, id); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"deletedAt": budget.DeletedAt,
+		}
+		return app.logApp.Create(ctx, "Se restaura el presupuesto desde la papelera", shared.Budget, id, detail, tx)
+	})
+}
+
+func (app *trashApp) RestoreAvailable(ctx context.Context, userId, id uint) error {
+	available, err := app.budgetAvailableRepo.SearchTrashed(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := app.checkBudget(ctx, userId, *available.BudgetId); err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		budgetAvailableRepo := app.budgetAvailableRepo.WithTransaction(tx)
+		if err := budgetAvailableRepo.Restore(ctx, id); err != nil {
+			return err
+		}
+
+		if err := app.updateTotals(ctx, *available.BudgetId, tx); err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("Se restaura el disponible %s desde la papelera", *available.Name)
+		detail := map[string]any{
+			"availableId": id,
+		}
+		return app.logApp.Create(ctx, description, shared.Budget, *available.BudgetId, detail, tx)
+	})
+}
+
+func (app *trashApp) RestoreBill(ctx context.Context, userId, id uint) error {
+	bill, err := app.budgetBillRepo.SearchTrashed(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := app.checkBudget(ctx, userId, *bill.BudgetId); err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		budgetBillRepo := app.budgetBillRepo.WithTransaction(tx)
+		if err := budgetBillRepo.Restore(ctx, id); err != nil {
+			return err
+		}
+
+		if err := app.updateTotals(ctx, *bill.BudgetId, tx); err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("Se restaura el pago %s desde la papelera", *bill.Description)
+		detail := map[string]any{
+			"billId": id,
+		}
+		return app.logApp.Create(ctx, description, shared.Budget, *bill.BudgetId, detail, tx)
+	})
+}
+
+func (app *trashApp) Purge(ctx context.Context) error {
+	before := time.Now().Add(-config.TRASH_RETENTION)
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		budgetRepo := app.budgetRepo.WithTransaction(tx)
+		if err := budgetRepo.DeleteTrashedBefore(ctx, before); err != nil {
+			return err
+		}
+
+		budgetAvailableRepo := app.budgetAvailableRepo.WithTransaction(tx)
+		if err := budgetAvailableRepo.DeleteTrashedBefore(ctx, before); err != nil {
+			return err
+		}
+
+		budgetBillRepo := app.budgetBillRepo.WithTransaction(tx)
+		if err := budgetBillRepo.DeleteTrashedBefore(ctx, before); err != nil {
+			return err
+		}
+
+		// The other modules remove their rows of the purged budgets, availables and bills
+		return app.eventApp.Publish(ctx, shared.TrashPurged, 0, map[string]any{"before": before}, tx)
+	})
+}
+
+// checkBudget validates that the budget of a trashed item is active and belongs to the user
+func (app *trashApp) checkBudget(ctx context.Context, userId, budgetId uint) error {
+	budget, err := app.budgetRepo.Search(ctx, budgetId)
+	if err != nil {
+		if _, errTrashed := app.budgetRepo.SearchTrashed(ctx, budgetId); errTrashed == nil {
+			return ErrBudgetTrashed
+		}
+
+		return err
+	}
+
+	if *budget.UserId != userId {
+		return ErrTrashNotOwner
+	}
+
+	return nil
+}
+
+// updateTotals recalculates the totals of the budget with the restored item
+func (app *trashApp) updateTotals(ctx context.Context, budgetId uint, tx persistent.Transaction) error {
+	budgetRepo := app.budgetRepo.WithTransaction(tx)
+	budget, err := budgetRepo.Search(ctx, budgetId)
+	if err != nil {
+		return err
+	}
+
+	calculateTotals(&budget)
+	_, err = budgetRepo.Save(ctx, budget)
+	return err
+}
+
+func NewTrashApp(
+	tm persistent.TransactionManager, budgetRepo domain.BudgetRepository, budgetAvailableRepo domain.BudgetAvailableRepository,
+	budgetBillRepo domain.BudgetBillRepository, logApp application.ILogApp, eventApp application.IEventApp,
+) ITrashApp {
+	return &trashApp{tm, budgetRepo, budgetAvailableRepo, budgetBillRepo, logApp, eventApp}
+}
@@ -0,0 +1,279 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_domain "your-accounts-api/mocks/budgets/domain"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/config"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestTrashSuite struct {
+	suite.Suite
+	budgetId                uint
+	itemId                  uint
+	userId                  uint
+	mockTransactionManager  *mocks_persistent.MockTransactionManager
+	mockBudgetRepo          *mocks_domain.MockBudgetRepository
+	mockBudgetAvailableRepo *mocks_domain.MockBudgetAvailableRepository
+	mockBudgetBillRepo      *mocks_domain.MockBudgetBillRepository
+	mockLogApp              *mocks_application.MockILogApp
+	mockEventApp            *mocks_application.MockIEventApp
+	app                     ITrashApp
+	ctx                     context.Context
+}
+
+func (suite *TestTrashSuite) SetupSuite() {
+	suite.budgetId = 1
+	suite.itemId = 2
+	suite.userId = 3
+	suite.ctx = context.Background()
+}
+
+func (suite *TestTrashSuite) SetupTest() {
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockBudgetRepo = mocks_domain.NewMockBudgetRepository(suite.T())
+	suite.mockBudgetAvailableRepo = mocks_domain.NewMockBudgetAvailableRepository(suite.T())
+	suite.mockBudgetBillRepo = mocks_domain.NewMockBudgetBillRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.mockEventApp = mocks_application.NewMockIEventApp(suite.T())
+	suite.app = NewTrashApp(suite.mockTransactionManager, suite.mockBudgetRepo, suite.mockBudgetAvailableRepo, suite.mockBudgetBillRepo, suite.mockLogApp, suite.mockEventApp)
+}
+
+func (suite *TestTrashSuite) mockTransaction() {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+}
+
+func (suite *TestTrashSuite) newBudget(userId uint) domain.Budget {
+	availableAmount, billAmount, payment, complete := 100.0, 50.0, 20.0, false
+	deletedAt := time.Now()
+	return domain.Budget{
+		ID:        &suite.budgetId,
+		UserId:    &userId,
+		DeletedAt: &deletedAt,
+		BudgetAvailables: []domain.BudgetAvailable{
+			{ID: &suite.itemId, Amount: &availableAmount},
+		},
+		BudgetBills: []domain.BudgetBill{
+			{ID: &suite.itemId, Amount: &billAmount, Payment: &payment, Complete: &complete},
+		},
+	}
+}
+
+func (suite *TestTrashSuite) TestFindByUserIdSuccess() {
+	require := require.New(suite.T())
+	budget := suite.newBudget(suite.userId)
+	name, description := "Available", "Bill"
+	suite.mockBudgetRepo.On("SearchAllTrashedByUserId", suite.ctx, suite.userId).Return([]domain.Budget{budget}, nil)
+	suite.mockBudgetAvailableRepo.On("SearchAllTrashedByUserId", suite.ctx, suite.userId).Return([]domain.BudgetAvailable{{ID: &suite.itemId, Name: &name}}, nil)
+	suite.mockBudgetBillRepo.On("SearchAllTrashedByUserId", suite.ctx, suite.userId).Return([]domain.BudgetBill{{ID: &suite.itemId, Description: &description}}, nil)
+
+	trash, err := suite.app.FindByUserId(suite.ctx, suite.userId)
+
+	require.NoError(err)
+	require.Len(trash.Budgets, 1)
+	require.Len(trash.Availables, 1)
+	require.Len(trash.Bills, 1)
+	require.Equal(name, *trash.Availables[0].Name)
+	require.Equal(description, *trash.Bills[0].Description)
+}
+
+func (suite *TestTrashSuite) TestFindByUserIdError() {
+	require := require.New(suite.T())
+	errExpected := errors.New("Error searching trashed availables")
+	suite.mockBudgetRepo.On("SearchAllTrashedByUserId", suite.ctx, suite.userId).Return([]domain.Budget{}, nil)
+	suite.mockBudgetAvailableRepo.On("SearchAllTrashedByUserId", suite.ctx, suite.userId).Return(nil, errExpected)
+
+	trash, err := suite.app.FindByUserId(suite.ctx, suite.userId)
+
+	require.EqualError(err, errExpected.Error())
+	require.Zero(trash)
+}
+
+func (suite *TestTrashSuite) TestRestoreBudgetSuccess() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("SearchTrashed", suite.ctx, suite.budgetId).Return(suite.newBudget(suite.userId), nil)
+	suite.mockTransaction()
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Restore", suite.ctx, suite.budgetId).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se restaura el presupuesto desde la papelera", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.RestoreBudget(suite.ctx, suite.userId, suite.budgetId)
+
+	require.NoError(err)
+}
+
+func (suite *TestTrashSuite) TestRestoreBudgetErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("SearchTrashed", suite.ctx, suite.budgetId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.RestoreBudget(suite.ctx, suite.userId, suite.budgetId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestTrashSuite) TestRestoreBudgetErrorNotOwner() {
+	require := require.New(suite.T())
+	suite.mockBudgetRepo.On("SearchTrashed", suite.ctx, suite.budgetId).Return(suite.newBudget(suite.userId+1), nil)
+
+	err := suite.app.RestoreBudget(suite.ctx, suite.userId, suite.budgetId)
+
+	require.ErrorIs(err, ErrTrashNotOwner)
+}
+
+func (suite *TestTrashSuite) TestRestoreAvailableSuccess() {
+	require := require.New(suite.T())
+	name := "Available"
+	budget := suite.newBudget(suite.userId)
+	suite.mockBudgetAvailableRepo.On("SearchTrashed", suite.ctx, suite.itemId).Return(domain.BudgetAvailable{ID: &suite.itemId, Name: &name, BudgetId: &suite.budgetId}, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget, nil)
+	suite.mockTransaction()
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("Restore", suite.ctx, suite.itemId).Return(nil)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.MatchedBy(func(budget domain.Budget) bool {
+		return *budget.TotalAvailable == 100 && *budget.TotalPending == 30 && *budget.PendingBills == 1
+	})).Return(suite.budgetId, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se restaura el disponible Available desde la papelera", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.RestoreAvailable(suite.ctx, suite.userId, suite.itemId)
+
+	require.NoError(err)
+}
+
+func (suite *TestTrashSuite) TestRestoreAvailableErrorBudgetTrashed() {
+	require := require.New(suite.T())
+	suite.mockBudgetAvailableRepo.On("SearchTrashed", suite.ctx, suite.itemId).Return(domain.BudgetAvailable{ID: &suite.itemId, BudgetId: &suite.budgetId}, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+	suite.mockBudgetRepo.On("SearchTrashed", suite.ctx, suite.budgetId).Return(suite.newBudget(suite.userId), nil)
+
+	err := suite.app.RestoreAvailable(suite.ctx, suite.userId, suite.itemId)
+
+	require.ErrorIs(err, ErrBudgetTrashed)
+}
+
+func (suite *TestTrashSuite) TestRestoreAvailableErrorBudgetNotFound() {
+	require := require.New(suite.T())
+	suite.mockBudgetAvailableRepo.On("SearchTrashed", suite.ctx, suite.itemId).Return(domain.BudgetAvailable{ID: &suite.itemId, BudgetId: &suite.budgetId}, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+	suite.mockBudgetRepo.On("SearchTrashed", suite.ctx, suite.budgetId).Return(domain.Budget{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.RestoreAvailable(suite.ctx, suite.userId, suite.itemId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestTrashSuite) TestRestoreBillSuccess() {
+	require := require.New(suite.T())
+	description := "Bill"
+	budget := suite.newBudget(suite.userId)
+	suite.mockBudgetBillRepo.On("SearchTrashed", suite.ctx, suite.itemId).Return(domain.BudgetBill{ID: &suite.itemId, Description: &description, BudgetId: &suite.budgetId}, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(budget, nil)
+	suite.mockTransaction()
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("Restore", suite.ctx, suite.itemId).Return(nil)
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("Save", suite.ctx, mock.Anything).Return(suite.budgetId, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se restaura el pago Bill desde la papelera", shared.Budget, suite.budgetId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.RestoreBill(suite.ctx, suite.userId, suite.itemId)
+
+	require.NoError(err)
+}
+
+func (suite *TestTrashSuite) TestRestoreBillErrorNotOwner() {
+	require := require.New(suite.T())
+	suite.mockBudgetBillRepo.On("SearchTrashed", suite.ctx, suite.itemId).Return(domain.BudgetBill{ID: &suite.itemId, BudgetId: &suite.budgetId}, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.newBudget(suite.userId+1), nil)
+
+	err := suite.app.RestoreBill(suite.ctx, suite.userId, suite.itemId)
+
+	require.ErrorIs(err, ErrTrashNotOwner)
+}
+
+func (suite *TestTrashSuite) TestRestoreBillError() {
+	require := require.New(suite.T())
+	errExpected := errors.New("Error restoring bill")
+	suite.mockBudgetBillRepo.On("SearchTrashed", suite.ctx, suite.itemId).Return(domain.BudgetBill{ID: &suite.itemId, BudgetId: &suite.budgetId}, nil)
+	suite.mockBudgetRepo.On("Search", suite.ctx, suite.budgetId).Return(suite.newBudget(suite.userId), nil)
+	suite.mockTransaction()
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("Restore", suite.ctx, suite.itemId).Return(errExpected)
+
+	err := suite.app.RestoreBill(suite.ctx, suite.userId, suite.itemId)
+
+	require.EqualError(err, errExpected.Error())
+}
+
+func (suite *TestTrashSuite) TestPurgeSuccess() {
+	require := require.New(suite.T())
+	retention := config.TRASH_RETENTION
+	config.TRASH_RETENTION = time.Hour
+	defer func() {
+		config.TRASH_RETENTION = retention
+	}()
+	isBefore := mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= time.Hour && time.Since(before) < time.Hour+time.Minute
+	})
+	suite.mockTransaction()
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("DeleteTrashedBefore", suite.ctx, isBefore).Return(nil)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("DeleteTrashedBefore", suite.ctx, isBefore).Return(nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("DeleteTrashedBefore", suite.ctx, isBefore).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.TrashPurged, uint(0), mock.MatchedBy(func(payload map[string]any) bool {
+		before, ok := payload["before"].(time.Time)
+		return ok && time.Since(before) >= time.Hour
+	}), nil).Return(nil)
+
+	err := suite.app.Purge(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestTrashSuite) TestPurgeError() {
+	require := require.New(suite.T())
+	errExpected := errors.New("Error purging budgets")
+	suite.mockTransaction()
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("DeleteTrashedBefore", suite.ctx, mock.Anything).Return(errExpected)
+
+	err := suite.app.Purge(suite.ctx)
+
+	require.EqualError(err, errExpected.Error())
+}
+
+func (suite *TestTrashSuite) TestPurgeErrorPublish() {
+	require := require.New(suite.T())
+	errExpected := errors.New("Error publishing the purge")
+	suite.mockTransaction()
+	suite.mockBudgetRepo.On("WithTransaction", nil).Return(suite.mockBudgetRepo)
+	suite.mockBudgetRepo.On("DeleteTrashedBefore", suite.ctx, mock.Anything).Return(nil)
+	suite.mockBudgetAvailableRepo.On("WithTransaction", nil).Return(suite.mockBudgetAvailableRepo)
+	suite.mockBudgetAvailableRepo.On("DeleteTrashedBefore", suite.ctx, mock.Anything).Return(nil)
+	suite.mockBudgetBillRepo.On("WithTransaction", nil).Return(suite.mockBudgetBillRepo)
+	suite.mockBudgetBillRepo.On("DeleteTrashedBefore", suite.ctx, mock.Anything).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.TrashPurged, uint(0), mock.Anything, nil).Return(errExpected)
+
+	err := suite.app.Purge(suite.ctx)
+
+	require.EqualError(err, errExpected.Error())
+}
+
+func TestTestTrashSuite(t *testing.T) {
+	suite.Run(t, new(TestTrashSuite))
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+// Account is a real account of the user, its balance flows into the available of the account
+// in each budget.
+type Account struct {
+	ID        uint
+	UserId    uint
+	Name      string
+	Type      AccountType
+	Balance   float64
+	CreatedAt time.Time
+}
+
+// AccountEntry is a movement of the balance of an account, Balance is the one after the movement
+// and the two entries of a transfer point to the other account with CounterpartId.
+type AccountEntry struct {
+	ID            uint
+	AccountId     uint
+	Type          AccountEntryType
+	Amount        float64
+	Balance       float64
+	Description   string
+	CounterpartId *uint
+	CreatedAt     time.Time
+}
+
+// AccountAvailable links an account with its available in a budget
+type AccountAvailable struct {
+	AccountId         uint
+	BudgetAvailableId uint
+}
+
+type AccountRepository interface {
+	persistent.TransactionRepository[AccountRepository]
+	persistent.SaveRepository[Account]
+	persistent.SearchByExampleRepository[Account]
+	persistent.SearchAllByExampleRepository[Account]
+	persistent.DeleteRepository
+	SearchForUpdate(ctx context.Context, id uint) (Account, error)
+	SaveEntry(ctx context.Context, entry AccountEntry) (uint, error)
+	SearchAllEntries(ctx context.Context, accountId uint) ([]AccountEntry, error)
+	SaveAvailable(ctx context.Context, link AccountAvailable) error
+	SearchAllAvailables(ctx context.Context, budgetId uint) ([]AccountAvailable, error)
+	SearchAvailablesByMonth(ctx context.Context, accountId uint, year uint16, month uint8) ([]BudgetAvailable, error)
+	DeleteAllByUserId(ctx context.Context, userId uint) error
+	DeleteOrphans(ctx context.Context) error
+}
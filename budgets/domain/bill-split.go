@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+// BudgetMember is a person of the household that shares the bills of a budget
+type BudgetMember struct {
+	ID        uint
+	BudgetId  uint
+	Name      string
+	CreatedAt time.Time
+}
+
+// BillSplit is how a bill is shared between the members of its budget
+type BillSplit struct {
+	BudgetBillId uint
+	Method       SplitMethod
+	Shares       []BillShare
+}
+
+// BillShare is the part of a member in a split bill, Value is the percentage or the amount of
+// the member by the method of the split and Paid is what the member paid of the bill.
+type BillShare struct {
+	MemberId uint
+	Value    float64
+	Paid     float64
+}
+
+// BudgetSettlement is a payment between two members to settle their balances in a budget
+type BudgetSettlement struct {
+	ID           uint
+	BudgetId     uint
+	FromMemberId uint
+	ToMemberId   uint
+	Amount       float64
+	CreatedAt    time.Time
+}
+
+type BillSplitRepository interface {
+	persistent.TransactionRepository[BillSplitRepository]
+	SaveMember(ctx context.Context, member BudgetMember) (uint, error)
+	SearchAllMembers(ctx context.Context, budgetId uint) ([]BudgetMember, error)
+	DeleteMember(ctx context.Context, id uint) error
+	SaveSplit(ctx context.Context, split BillSplit) error
+	SearchSplit(ctx context.Context, budgetBillId uint) (BillSplit, error)
+	SearchAllSplits(ctx context.Context, budgetId uint) ([]BillSplit, error)
+	DeleteSplit(ctx context.Context, budgetBillId uint) error
+	SaveSettlement(ctx context.Context, settlement BudgetSettlement) (uint, error)
+	SearchAllSettlements(ctx context.Context, budgetId uint) ([]BudgetSettlement, error)
+	DeleteOrphans(ctx context.Context) error
+}
@@ -1,14 +1,16 @@
 package domain
 
 import (
+	"time"
 	"your-accounts-api/shared/domain/persistent"
 )
 
 type BudgetAvailable struct {
-	ID       *uint
-	Name     *string
-	Amount   *float64
-	BudgetId *uint
+	ID        *uint
+	Name      *string
+	Amount    *float64
+	BudgetId  *uint
+	DeletedAt *time.Time
 }
 
 type BudgetAvailableRepository interface {
@@ -16,4 +18,5 @@ type BudgetAvailableRepository interface {
 	persistent.SaveRepository[BudgetAvailable]
 	persistent.SaveAllRepository[BudgetAvailable]
 	persistent.DeleteRepository
+	TrashRepository[BudgetAvailable]
 }
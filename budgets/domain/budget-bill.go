@@ -1,6 +1,10 @@
 package domain
 
-import "your-accounts-api/shared/domain/persistent"
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+)
 
 type BudgetBill struct {
 	ID          *uint
@@ -11,6 +15,7 @@ type BudgetBill struct {
 	Complete    *bool
 	BudgetId    *uint
 	Category    *BudgetBillCategory
+	DeletedAt   *time.Time
 }
 
 type BudgetBillRepository interface {
@@ -19,4 +24,6 @@ type BudgetBillRepository interface {
 	persistent.SaveRepository[BudgetBill]
 	persistent.SaveAllRepository[BudgetBill]
 	persistent.DeleteRepository
+	TrashRepository[BudgetBill]
+	SearchAllLinkedIdsByBudgetId(ctx context.Context, budgetId uint) ([]uint, error)
 }
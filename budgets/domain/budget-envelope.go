@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+// BudgetEnvelope holds the money of a zero-based budget assigned to a category or to a bill,
+// Carryover is the overspending brought from the envelope of the previous month.
+type BudgetEnvelope struct {
+	ID           uint
+	BudgetId     uint
+	Name         string
+	Category     *BudgetBillCategory
+	BudgetBillId *uint
+	Assigned     float64
+	Carryover    float64
+}
+
+type BudgetEnvelopeRepository interface {
+	persistent.TransactionRepository[BudgetEnvelopeRepository]
+	persistent.SaveRepository[BudgetEnvelope]
+	persistent.SearchRepository[BudgetEnvelope]
+	persistent.DeleteRepository
+	SearchAllByBudgetId(ctx context.Context, budgetId uint) ([]BudgetEnvelope, error)
+	DeleteOrphans(ctx context.Context) error
+}
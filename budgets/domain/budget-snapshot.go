@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+type BudgetSnapshot struct {
+	ID          uint
+	BudgetId    uint
+	Manual      bool
+	Description string
+	Budget      Budget
+	Envelopes   []BudgetEnvelope
+	CreatedAt   time.Time
+}
+
+type BudgetSnapshotRepository interface {
+	persistent.TransactionRepository[BudgetSnapshotRepository]
+	persistent.SaveRepository[BudgetSnapshot]
+	SearchAllByBudgetId(ctx context.Context, budgetId uint) ([]BudgetSnapshot, error)
+	SearchByBudgetIdAndId(ctx context.Context, budgetId, id uint) (BudgetSnapshot, error)
+	DeleteAutomaticByBudgetIdExceedingLimit(ctx context.Context, budgetId uint, limit int) error
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"context"
+	"time"
 	"your-accounts-api/shared/domain/persistent"
 )
 
@@ -14,7 +16,11 @@ type Budget struct {
 	TotalPending     *float64
 	TotalAvailable   *float64
 	PendingBills     *uint8
+	ZeroBased        *bool
+	TotalAssigned    *float64
+	ToBeAssigned     *float64
 	UserId           *uint
+	DeletedAt        *time.Time
 	BudgetAvailables []BudgetAvailable
 	BudgetBills      []BudgetBill
 }
@@ -25,4 +31,9 @@ type BudgetRepository interface {
 	persistent.SearchRepository[Budget]
 	persistent.SearchAllByExampleRepository[Budget]
 	persistent.DeleteRepository
+	SearchAllByUserId(ctx context.Context, userId uint) ([]Budget, error)
+	SearchLatestByUserId(ctx context.Context, userId uint, limit int) ([]Budget, error)
+	SearchLatestByMonth(ctx context.Context, userId uint, year uint16, month uint8) (Budget, error)
+	DeleteAllByUserId(ctx context.Context, userId uint) error
+	TrashRepository[Budget]
 }
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+// CreditCard is an account whose charges are paid by monthly statements, the charges up to
+// ClosingDay belong to the statement of the month and Limit zero means without limit.
+type CreditCard struct {
+	ID                uint
+	UserId            uint
+	Name              string
+	Limit             float64
+	ClosingDay        uint8
+	PaymentDay        uint8
+	MinimumPercentage float64
+	CreatedAt         time.Time
+}
+
+// CreditCardStatement groups the charges of a cycle, Year and Month are the ones of its closing
+// and BudgetBillId is set once it is a bill of the budget of the month it is due.
+type CreditCardStatement struct {
+	ID             uint
+	CardId         uint
+	Year           uint16
+	Month          uint8
+	ClosingDate    time.Time
+	DueDate        time.Time
+	Total          float64
+	MinimumPayment float64
+	BudgetBillId   *uint
+	AvailableId    *uint
+	PaidAt         *time.Time
+}
+
+type CreditCardCharge struct {
+	ID          uint
+	StatementId uint
+	Description string
+	Amount      float64
+	Date        time.Time
+	CreatedAt   time.Time
+}
+
+type CreditCardRepository interface {
+	persistent.TransactionRepository[CreditCardRepository]
+	persistent.SaveRepository[CreditCard]
+	persistent.SearchByExampleRepository[CreditCard]
+	persistent.SearchAllByExampleRepository[CreditCard]
+	persistent.DeleteRepository
+	SearchForUpdate(ctx context.Context, id uint) (CreditCard, error)
+	SaveStatement(ctx context.Context, statement CreditCardStatement) (uint, error)
+	SearchStatementByExample(ctx context.Context, example CreditCardStatement) (CreditCardStatement, error)
+	SearchAllStatements(ctx context.Context, cardId uint) ([]CreditCardStatement, error)
+	SaveCharge(ctx context.Context, charge CreditCardCharge) (uint, error)
+	SearchAllCharges(ctx context.Context, statementId uint) ([]CreditCardCharge, error)
+	DeleteAllByUserId(ctx context.Context, userId uint) error
+	DeleteOrphans(ctx context.Context) error
+}
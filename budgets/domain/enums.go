@@ -21,3 +21,44 @@ const (
 	Available BudgetSection = "available"
 	Bill      BudgetSection = "bill"
 )
+
+type LoanAmortization string
+
+const (
+	// Fixed charges the interest over the principal on every installment
+	Fixed LoanAmortization = "fixed"
+	// French keeps the same payment, the interest decreases with the balance
+	French LoanAmortization = "french"
+	// German keeps the same principal, the payment decreases with the balance
+	German LoanAmortization = "german"
+)
+
+type AccountType string
+
+const (
+	Cash   AccountType = "cash"
+	Bank   AccountType = "bank"
+	Wallet AccountType = "wallet"
+)
+
+type AccountEntryType string
+
+const (
+	// Opening is the balance of the account when it is created
+	Opening AccountEntryType = "opening"
+	// Transfer is one of the two entries of a transfer between accounts
+	Transfer AccountEntryType = "transfer"
+	// Adjustment is the difference recorded when the account is reconciled
+	Adjustment AccountEntryType = "adjustment"
+)
+
+type SplitMethod string
+
+const (
+	// Equal shares the bill in equal parts between the members of the split
+	Equal SplitMethod = "equal"
+	// Percentage shares the bill by the percentage of each member, they add up to 100
+	Percentage SplitMethod = "percentage"
+	// FixedShare shares the bill by the amount of each member, they add up to the amount of the bill
+	FixedShare SplitMethod = "fixed"
+)
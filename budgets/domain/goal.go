@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+// Goal is a savings target of the user, its progress comes from the payments of the bills of
+// Category or from the amount of the availables named Available, only one of them is set.
+type Goal struct {
+	ID           uint
+	UserId       uint
+	Name         string
+	TargetAmount float64
+	TargetDate   time.Time
+	Category     *BudgetBillCategory
+	Available    *string
+	CreatedAt    time.Time
+}
+
+// GoalContribution is the amount of a goal in the budgets of a month, the payments for a
+// category and the balance for an available.
+type GoalContribution struct {
+	Year   uint16
+	Month  uint8
+	Amount float64
+}
+
+type GoalRepository interface {
+	persistent.TransactionRepository[GoalRepository]
+	persistent.SaveRepository[Goal]
+	persistent.SearchByExampleRepository[Goal]
+	persistent.SearchAllByExampleRepository[Goal]
+	persistent.DeleteRepository
+	SearchContributions(ctx context.Context, goal Goal) ([]GoalContribution, error)
+	DeleteAllByUserId(ctx context.Context, userId uint) error
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+// Loan is a debt paid by monthly installments, Rate is the annual interest percentage, Term the
+// number of installments and Balance the principal not paid yet.
+type Loan struct {
+	ID           uint
+	UserId       uint
+	Name         string
+	Principal    float64
+	Rate         float64
+	Term         uint16
+	StartDate    time.Time
+	PaymentDay   uint8
+	Amortization LoanAmortization
+	Balance      float64
+	CreatedAt    time.Time
+}
+
+// LoanInstallment is an installment of the schedule of a loan, BudgetBillId is set once it is
+// added as a bill to the budget of its month and Paid is the payment of that bill.
+type LoanInstallment struct {
+	Number       uint16
+	Year         uint16
+	Month        uint8
+	Payment      float64
+	Interest     float64
+	Principal    float64
+	Balance      float64
+	BudgetBillId *uint
+	Paid         float64
+}
+
+type LoanRepository interface {
+	persistent.TransactionRepository[LoanRepository]
+	persistent.SaveRepository[Loan]
+	persistent.SearchByExampleRepository[Loan]
+	persistent.SearchAllByExampleRepository[Loan]
+	persistent.DeleteRepository
+	SearchByBudgetBillId(ctx context.Context, budgetBillId uint) (Loan, error)
+	SearchInstallments(ctx context.Context, loanId uint) ([]LoanInstallment, error)
+	SaveInstallment(ctx context.Context, loanId uint, installment LoanInstallment) error
+	DeleteAllByUserId(ctx context.Context, userId uint) error
+	DeleteOrphans(ctx context.Context) error
+}
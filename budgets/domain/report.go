@@ -0,0 +1,41 @@
+package domain
+
+import "context"
+
+// ReportMonth reads the latest budget of the user in a month, Budgets counts all of them with
+// its copies. The pending is the current total pending of the budget, it is not kept when the
+// month ends.
+type ReportMonth struct {
+	Month   uint8
+	Budgets uint
+	Income  float64
+	Pending float64
+}
+
+// ReportCategory sums the bills of a category in the latest budget of a month
+type ReportCategory struct {
+	Month    uint8
+	Category BudgetBillCategory
+	Amount   float64
+	Payment  float64
+	Bills    uint
+}
+
+type ReportBill struct {
+	ID          uint
+	BudgetId    uint
+	BudgetName  string
+	Month       uint8
+	Description string
+	Category    BudgetBillCategory
+	Amount      float64
+	Payment     float64
+}
+
+// ReportRepository reads the aggregations of the reports, it is kept apart of the repositories
+// of the budgets because it only reads and never runs inside their transactions.
+type ReportRepository interface {
+	SearchMonthsByYear(ctx context.Context, userId uint, year uint16) ([]ReportMonth, error)
+	SearchCategoriesByYear(ctx context.Context, userId uint, year uint16) ([]ReportCategory, error)
+	SearchTopBillsByYear(ctx context.Context, userId uint, year uint16, limit int) ([]ReportBill, error)
+}
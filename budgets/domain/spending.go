@@ -0,0 +1,40 @@
+package domain
+
+import "context"
+
+// SpendingFilter selects the budgets of the user between two months, both included
+type SpendingFilter struct {
+	UserId    uint
+	FromYear  uint16
+	FromMonth uint8
+	ToYear    uint16
+	ToMonth   uint8
+}
+
+// MonthlySpending sums the bills of a category in the budgets of a month, the deltas compare
+// the amount with the month and the year before and are nil when the user had no budget then.
+type MonthlySpending struct {
+	Year           uint16
+	Month          uint8
+	Category       BudgetBillCategory
+	Amount         float64
+	Payment        float64
+	Bills          uint
+	MonthOverMonth *float64
+	YearOverYear   *float64
+}
+
+// CategorySpending summarizes a category in the range, the average and the median are taken
+// over the months with budget counting as zero the ones without bills of the category.
+type CategorySpending struct {
+	Category BudgetBillCategory
+	Amount   float64
+	Payment  float64
+	Average  float64
+	Median   float64
+}
+
+type SpendingRepository interface {
+	SearchMonthlyByFilter(ctx context.Context, filter SpendingFilter) ([]MonthlySpending, error)
+	SearchCategoriesByFilter(ctx context.Context, filter SpendingFilter) ([]CategorySpending, error)
+}
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// TrashRepository manages the soft deleted rows, the rest of the repository methods
+// ignore them.
+type TrashRepository[D any] interface {
+	SearchAllTrashedByUserId(ctx context.Context, userId uint) ([]D, error)
+	SearchTrashed(ctx context.Context, id uint) (D, error)
+	Restore(ctx context.Context, id uint) error
+	DeleteTrashedBefore(ctx context.Context, before time.Time) error
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"time"
 	"your-accounts-api/budgets/domain"
 	"your-accounts-api/shared/infrastructure/db/entity"
 )
@@ -8,6 +9,7 @@ import (
 type Budget struct {
 	entity.BaseModel
 	entity.BaseUpdateModel
+	entity.BaseDeleteModel
 	Name             string            `gorm:"not null;size:40"`
 	Year             uint16            `gorm:"not null"`
 	Month            uint8             `gorm:"not null"`
@@ -16,14 +18,19 @@ type Budget struct {
 	TotalPending     float64           `gorm:"not null;default:0"`
 	TotalAvailable   float64           `gorm:"not null;default:0"`
 	PendingBills     uint8             `gorm:"not null;default:0"`
+	ZeroBased        bool              `gorm:"not null;default:false"`
+	TotalAssigned    float64           `gorm:"not null;default:0"`
+	ToBeAssigned     float64           `gorm:"not null;default:0"`
 	UserId           uint              `gorm:"not null"`
 	BudgetAvailables []BudgetAvailable `gorm:"foreignKey:BudgetId"`
 	BudgetBills      []BudgetBill      `gorm:"foreignKey:BudgetId"`
+	BudgetSnapshots  []BudgetSnapshot  `gorm:"foreignKey:BudgetId"`
 }
 
 type BudgetAvailable struct {
 	entity.BaseModel
 	entity.BaseUpdateModel
+	entity.BaseDeleteModel
 	Name     string  `gorm:"not null;size:40"`
 	Amount   float64 `gorm:"not null;default:0"`
 	BudgetId uint    `gorm:"not null"`
@@ -32,6 +39,7 @@ type BudgetAvailable struct {
 type BudgetBill struct {
 	entity.BaseModel
 	entity.BaseUpdateModel
+	entity.BaseDeleteModel
 	Description string                    `gorm:"not null;size:200"`
 	Amount      float64                   `gorm:"not null;default:0"`
 	Payment     float64                   `gorm:"not null;default:0"`
@@ -40,3 +48,195 @@ type BudgetBill struct {
 	BudgetId    uint                      `gorm:"not null"`
 	Category    domain.BudgetBillCategory `gorm:"not null"`
 }
+
+type BudgetSnapshot struct {
+	entity.BaseModel
+	BudgetId    uint            `gorm:"not null;index"`
+	Manual      bool            `gorm:"not null;default:false"`
+	Description string          `gorm:"not null;size:200"`
+	Content     SnapshotContent `gorm:"not null;type:json;serializer:json"`
+}
+
+type SnapshotContent struct {
+	Name             string              `json:"name"`
+	Year             uint16              `json:"year"`
+	Month            uint8               `json:"month"`
+	FixedIncome      float64             `json:"fixedIncome"`
+	AdditionalIncome float64             `json:"additionalIncome"`
+	TotalPending     float64             `json:"totalPending"`
+	TotalAvailable   float64             `json:"totalAvailable"`
+	PendingBills     uint8               `json:"pendingBills"`
+	ZeroBased        bool                `json:"zeroBased"`
+	TotalAssigned    float64             `json:"totalAssigned"`
+	ToBeAssigned     float64             `json:"toBeAssigned"`
+	Availables       []SnapshotAvailable `json:"availables"`
+	Bills            []SnapshotBill      `json:"bills"`
+	Envelopes        []SnapshotEnvelope  `json:"envelopes"`
+}
+
+type SnapshotAvailable struct {
+	ID     uint    `json:"id"`
+	Name   string  `json:"name"`
+	Amount float64 `json:"amount"`
+}
+
+type SnapshotBill struct {
+	ID          uint                      `json:"id"`
+	Description string                    `json:"description"`
+	Amount      float64                   `json:"amount"`
+	Payment     float64                   `json:"payment"`
+	DueDate     uint8                     `json:"dueDate"`
+	Complete    bool                      `json:"complete"`
+	Category    domain.BudgetBillCategory `json:"category"`
+}
+
+type SnapshotEnvelope struct {
+	ID           uint                       `json:"id"`
+	Name         string                     `json:"name"`
+	Category     *domain.BudgetBillCategory `json:"category"`
+	BudgetBillId *uint                      `json:"budgetBillId"`
+	Assigned     float64                    `json:"assigned"`
+	Carryover    float64                    `json:"carryover"`
+}
+
+type Goal struct {
+	entity.BaseModel
+	entity.BaseUpdateModel
+	UserId       uint                       `gorm:"not null;index"`
+	Name         string                     `gorm:"not null;size:40"`
+	TargetAmount float64                    `gorm:"not null"`
+	TargetDate   time.Time                  `gorm:"not null;type:date"`
+	Category     *domain.BudgetBillCategory `gorm:"size:40"`
+	Available    *string                    `gorm:"size:40"`
+}
+
+type Loan struct {
+	entity.BaseModel
+	entity.BaseUpdateModel
+	UserId       uint                    `gorm:"not null;index"`
+	Name         string                  `gorm:"not null;size:40"`
+	Principal    float64                 `gorm:"not null"`
+	Rate         float64                 `gorm:"not null;default:0"`
+	Term         uint16                  `gorm:"not null"`
+	StartDate    time.Time               `gorm:"not null;type:date"`
+	PaymentDay   uint8                   `gorm:"not null"`
+	Amortization domain.LoanAmortization `gorm:"not null;size:10"`
+	Balance      float64                 `gorm:"not null"`
+	Installments []LoanInstallment       `gorm:"foreignKey:LoanId"`
+}
+
+// LoanInstallment links an installment of the schedule with the bill that pays it
+type LoanInstallment struct {
+	entity.BaseModel
+	LoanId       uint    `gorm:"not null;uniqueIndex:idx_loan_installments_number"`
+	Number       uint16  `gorm:"not null;uniqueIndex:idx_loan_installments_number"`
+	BudgetBillId uint    `gorm:"not null;index"`
+	Payment      float64 `gorm:"not null"`
+	Interest     float64 `gorm:"not null"`
+	Principal    float64 `gorm:"not null"`
+	Balance      float64 `gorm:"not null"`
+}
+
+type CreditCard struct {
+	entity.BaseModel
+	entity.BaseUpdateModel
+	UserId            uint                  `gorm:"not null;index"`
+	Name              string                `gorm:"not null;size:40"`
+	Limit             float64               `gorm:"not null;default:0"`
+	ClosingDay        uint8                 `gorm:"not null"`
+	PaymentDay        uint8                 `gorm:"not null"`
+	MinimumPercentage float64               `gorm:"not null"`
+	Statements        []CreditCardStatement `gorm:"foreignKey:CardId"`
+}
+
+type CreditCardStatement struct {
+	entity.BaseModel
+	entity.BaseUpdateModel
+	CardId         uint      `gorm:"not null;uniqueIndex:idx_credit_card_statements_period"`
+	Year           uint16    `gorm:"not null;uniqueIndex:idx_credit_card_statements_period"`
+	Month          uint8     `gorm:"not null;uniqueIndex:idx_credit_card_statements_period"`
+	ClosingDate    time.Time `gorm:"not null;type:date"`
+	DueDate        time.Time `gorm:"not null;type:date"`
+	Total          float64   `gorm:"not null;default:0"`
+	MinimumPayment float64   `gorm:"not null;default:0"`
+	BudgetBillId   *uint     `gorm:"index"`
+	AvailableId    *uint
+	PaidAt         *time.Time
+	Charges        []CreditCardCharge `gorm:"foreignKey:StatementId"`
+}
+
+type CreditCardCharge struct {
+	entity.BaseModel
+	StatementId uint      `gorm:"not null;index"`
+	Description string    `gorm:"not null;size:200"`
+	Amount      float64   `gorm:"not null"`
+	Date        time.Time `gorm:"not null;type:date"`
+}
+
+type Account struct {
+	entity.BaseModel
+	entity.BaseUpdateModel
+	UserId  uint               `gorm:"not null;index"`
+	Name    string             `gorm:"not null;size:40"`
+	Type    domain.AccountType `gorm:"not null;size:10"`
+	Balance float64            `gorm:"not null;default:0"`
+	Entries []AccountEntry     `gorm:"foreignKey:AccountId"`
+}
+
+type AccountEntry struct {
+	entity.BaseModel
+	AccountId     uint                    `gorm:"not null;index"`
+	Type          domain.AccountEntryType `gorm:"not null;size:10"`
+	Amount        float64                 `gorm:"not null"`
+	Balance       float64                 `gorm:"not null"`
+	Description   string                  `gorm:"not null;size:200"`
+	CounterpartId *uint
+}
+
+// AccountAvailable links an account with the available that shows its balance in a budget
+type AccountAvailable struct {
+	entity.BaseModel
+	AccountId         uint `gorm:"not null;index"`
+	BudgetAvailableId uint `gorm:"not null;uniqueIndex"`
+}
+
+type BudgetMember struct {
+	entity.BaseModel
+	BudgetId uint   `gorm:"not null;index"`
+	Name     string `gorm:"not null;size:40"`
+}
+
+type BillSplit struct {
+	entity.BaseModel
+	entity.BaseUpdateModel
+	BudgetBillId uint               `gorm:"not null;uniqueIndex"`
+	Method       domain.SplitMethod `gorm:"not null;size:10"`
+	Shares       []BillShare        `gorm:"foreignKey:SplitId"`
+}
+
+type BillShare struct {
+	entity.BaseModel
+	SplitId  uint    `gorm:"not null;index"`
+	MemberId uint    `gorm:"not null;index"`
+	Value    float64 `gorm:"not null;default:0"`
+	Paid     float64 `gorm:"not null;default:0"`
+}
+
+type BudgetSettlement struct {
+	entity.BaseModel
+	BudgetId     uint    `gorm:"not null;index"`
+	FromMemberId uint    `gorm:"not null"`
+	ToMemberId   uint    `gorm:"not null"`
+	Amount       float64 `gorm:"not null"`
+}
+
+type BudgetEnvelope struct {
+	entity.BaseModel
+	entity.BaseUpdateModel
+	BudgetId     uint                       `gorm:"not null;index"`
+	Name         string                     `gorm:"not null;size:200"`
+	Category     *domain.BudgetBillCategory `gorm:"size:40"`
+	BudgetBillId *uint
+	Assigned     float64 `gorm:"not null;default:0"`
+	Carryover    float64 `gorm:"not null;default:0"`
+}
@@ -0,0 +1,255 @@
+package account
+
+import (
+	"context"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/db/entity"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	shared_ent "your-accounts-api/shared/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.AccountRepository {
+	return db.DefaultWithTransaction[domain.AccountRepository](tx, NewRepository, r)
+}
+
+// Save creates the account, an existing account only changes its name and its balance because
+// the type is fixed and the balance history is in the entries
+func (r *gormRepository) Save(ctx context.Context, account domain.Account) (uint, error) {
+	model := &entity.Account{
+		Name:    account.Name,
+		Balance: account.Balance,
+	}
+	if account.ID != 0 {
+		model.ID = account.ID
+		if err := r.db.WithContext(ctx).Model(model).Select("Name", "Balance").Updates(model).Error; err != nil {
+			return 0, err
+		}
+
+		return model.ID, nil
+	}
+
+	model.UserId = account.UserId
+	model.Type = account.Type
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchByExample(ctx context.Context, example domain.Account) (domain.Account, error) {
+	where := entity.Account{
+		BaseModel: shared_ent.BaseModel{
+			ID: example.ID,
+		},
+		UserId: example.UserId,
+	}
+	model := new(entity.Account)
+	if err := r.db.WithContext(ctx).Where(where).First(model).Error; err != nil {
+		return domain.Account{}, err
+	}
+
+	return toDomain(*model), nil
+}
+
+// SearchForUpdate locks the account until the end of the transaction, so a balance computed from
+// the one read is not overwritten by another transaction
+func (r *gormRepository) SearchForUpdate(ctx context.Context, id uint) (domain.Account, error) {
+	model := new(entity.Account)
+	if err := r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(model, id).Error; err != nil {
+		return domain.Account{}, err
+	}
+
+	return toDomain(*model), nil
+}
+
+func (r *gormRepository) SearchAllByExample(ctx context.Context, example domain.Account) ([]domain.Account, error) {
+	where := entity.Account{
+		UserId: example.UserId,
+	}
+	var models []entity.Account
+	if err := r.db.WithContext(ctx).Where(where).Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	accounts := []domain.Account{}
+	for _, model := range models {
+		accounts = append(accounts, toDomain(model))
+	}
+
+	return accounts, nil
+}
+
+// Delete removes the account with its entries and its links, the availables stay in the budgets
+func (r *gormRepository) Delete(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Where("account_id = ?", id).Delete(&entity.AccountAvailable{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("account_id = ?", id).Delete(&entity.AccountEntry{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Delete(&entity.Account{
+		BaseModel: shared_ent.BaseModel{
+			ID: id,
+		},
+	}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormRepository) SaveEntry(ctx context.Context, entry domain.AccountEntry) (uint, error) {
+	model := &entity.AccountEntry{
+		AccountId:     entry.AccountId,
+		Type:          entry.Type,
+		Amount:        entry.Amount,
+		Balance:       entry.Balance,
+		Description:   entry.Description,
+		CounterpartId: entry.CounterpartId,
+	}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+// SearchAllEntries returns the entries of the account from the newest
+func (r *gormRepository) SearchAllEntries(ctx context.Context, accountId uint) ([]domain.AccountEntry, error) {
+	var models []entity.AccountEntry
+	if err := r.db.WithContext(ctx).Where("account_id = ?", accountId).Order("id desc").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	entries := []domain.AccountEntry{}
+	for _, model := range models {
+		entries = append(entries, domain.AccountEntry{
+			ID:            model.ID,
+			AccountId:     model.AccountId,
+			Type:          model.Type,
+			Amount:        model.Amount,
+			Balance:       model.Balance,
+			Description:   model.Description,
+			CounterpartId: model.CounterpartId,
+			CreatedAt:     model.CreatedAt,
+		})
+	}
+
+	return entries, nil
+}
+
+func (r *gormRepository) SaveAvailable(ctx context.Context, link domain.AccountAvailable) error {
+	model := &entity.AccountAvailable{
+		AccountId:         link.AccountId,
+		BudgetAvailableId: link.BudgetAvailableId,
+	}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SearchAllAvailables returns the links of the availables of the budget, the ones in the trash
+// are left out
+func (r *gormRepository) SearchAllAvailables(ctx context.Context, budgetId uint) ([]domain.AccountAvailable, error) {
+	var models []entity.AccountAvailable
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN budget_availables ON budget_availables.id = account_availables.budget_available_id").
+		Where("budget_availables.budget_id = ? AND budget_availables.deleted_at IS NULL", budgetId).
+		Order("account_availables.id").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	links := []domain.AccountAvailable{}
+	for _, model := range models {
+		links = append(links, domain.AccountAvailable{
+			AccountId:         model.AccountId,
+			BudgetAvailableId: model.BudgetAvailableId,
+		})
+	}
+
+	return links, nil
+}
+
+// SearchAvailablesByMonth returns the availables of the account in the budgets of the month
+func (r *gormRepository) SearchAvailablesByMonth(ctx context.Context, accountId uint, year uint16, month uint8) ([]domain.BudgetAvailable, error) {
+	var models []entity.BudgetAvailable
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN account_availables ON account_availables.budget_available_id = budget_availables.id").
+		Joins("JOIN budgets ON budgets.id = budget_availables.budget_id").
+		Where("account_availables.account_id = ? AND budgets.year = ? AND budgets.month = ? AND budgets.deleted_at IS NULL", accountId, year, month).
+		Order("budget_availables.id").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	availables := []domain.BudgetAvailable{}
+	for _, model := range models {
+		modelC := model
+		availables = append(availables, domain.BudgetAvailable{
+			ID:       &modelC.ID,
+			Name:     &modelC.Name,
+			Amount:   &modelC.Amount,
+			BudgetId: &modelC.BudgetId,
+		})
+	}
+
+	return availables, nil
+}
+
+// DeleteAllByUserId removes the accounts of the user with their entries and their links
+func (r *gormRepository) DeleteAllByUserId(ctx context.Context, userId uint) error {
+	accountIds := r.db.Model(&entity.Account{}).Select("id").Where("user_id = ?", userId)
+	if err := r.db.WithContext(ctx).Where("account_id IN (?)", accountIds).Delete(&entity.AccountAvailable{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("account_id IN (?)", accountIds).Delete(&entity.AccountEntry{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userId).Delete(&entity.Account{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteOrphans removes the links to the purged availables
+func (r *gormRepository) DeleteOrphans(ctx context.Context) error {
+	// Unscoped to keep the links to the availables in the trash
+	availableIds := r.db.Unscoped().Model(&entity.BudgetAvailable{}).Select("id")
+	if err := r.db.WithContext(ctx).Where("budget_available_id NOT IN (?)", availableIds).Delete(&entity.AccountAvailable{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toDomain(model entity.Account) domain.Account {
+	return domain.Account{
+		ID:        model.ID,
+		UserId:    model.UserId,
+		Name:      model.Name,
+		Type:      model.Type,
+		Balance:   model.Balance,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+func NewRepository(db *gorm.DB) domain.AccountRepository {
+	return &gormRepository{db}
+}
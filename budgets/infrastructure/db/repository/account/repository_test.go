@@ -0,0 +1,456 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain/test_utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId     uint
+	createdAt  time.Time
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.AccountRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 999
+	suite.createdAt = time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveSuccessNew() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "accounts" ("created_at","updated_at","user_id","name","type","balance") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.userId, "Bank", domain.Bank, 1500.0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repository.Save(context.Background(), domain.Account{
+		UserId:  suite.userId,
+		Name:    "Bank",
+		Type:    domain.Bank,
+		Balance: 1500,
+	})
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSaveSuccessExists() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "accounts" SET "updated_at"=$1,"name"=$2,"balance"=$3 WHERE "id" = $4`)).
+		WithArgs(test_utils.AnyTime{}, "Bank", 1200.0, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repository.Save(context.Background(), domain.Account{
+		ID:      1,
+		UserId:  suite.userId,
+		Name:    "Bank",
+		Type:    domain.Bank,
+		Balance: 1200,
+	})
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "accounts"`)).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repository.Save(context.Background(), domain.Account{UserId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchByExampleSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "accounts" WHERE "accounts"."id" = $1 AND "accounts"."user_id" = $2 ORDER BY "accounts"."id" LIMIT 1`)).
+		WithArgs(1, suite.userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "user_id", "name", "type", "balance"}).
+			AddRow(1, suite.createdAt, suite.userId, "Bank", domain.Bank, 1500.0))
+
+	account, err := suite.repository.SearchByExample(context.Background(), domain.Account{ID: 1, UserId: suite.userId})
+
+	require.NoError(err)
+	require.Equal(domain.Account{
+		ID:        1,
+		UserId:    suite.userId,
+		Name:      "Bank",
+		Type:      domain.Bank,
+		Balance:   1500,
+		CreatedAt: suite.createdAt,
+	}, account)
+}
+
+func (suite *TestSuite) TestSearchByExampleError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "accounts" WHERE "accounts"."id" = $1 AND "accounts"."user_id" = $2 ORDER BY "accounts"."id" LIMIT 1`)).
+		WithArgs(1, suite.userId).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err := suite.repository.SearchByExample(context.Background(), domain.Account{ID: 1, UserId: suite.userId})
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestSuite) TestSearchForUpdateSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "accounts" WHERE "accounts"."id" = $1 ORDER BY "accounts"."id" LIMIT 1 FOR UPDATE`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "user_id", "name", "type", "balance"}).
+			AddRow(1, suite.createdAt, suite.userId, "Bank", domain.Bank, 1500.0))
+
+	account, err := suite.repository.SearchForUpdate(context.Background(), 1)
+
+	require.NoError(err)
+	require.Equal(1500.0, account.Balance)
+	require.Equal(uint(1), account.ID)
+}
+
+func (suite *TestSuite) TestSearchForUpdateError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "accounts" WHERE "accounts"."id" = $1 ORDER BY "accounts"."id" LIMIT 1 FOR UPDATE`)).
+		WithArgs(1).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err := suite.repository.SearchForUpdate(context.Background(), 1)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "accounts" WHERE "accounts"."user_id" = $1 ORDER BY id`)).
+		WithArgs(suite.userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name", "type", "balance"}).
+			AddRow(1, suite.userId, "Bank", domain.Bank, 1500.0).
+			AddRow(2, suite.userId, "Cash", domain.Cash, 80.0))
+
+	accounts, err := suite.repository.SearchAllByExample(context.Background(), domain.Account{UserId: suite.userId})
+
+	require.NoError(err)
+	require.Len(accounts, 2)
+	require.Equal(domain.Cash, accounts[1].Type)
+}
+
+func (suite *TestSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "account_availables" WHERE account_id = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "account_entries" WHERE account_id = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "accounts" WHERE "accounts"."id" = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.Delete(context.Background(), 1)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "account_availables" WHERE account_id = $1`)).
+		WithArgs(1).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.Delete(context.Background(), 1)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSaveEntrySuccess() {
+	require := require.New(suite.T())
+	counterpartId := uint(2)
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "account_entries" ("created_at","account_id","type","amount","balance","description","counterpart_id") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, 1, domain.Transfer, -100.0, 1400.0, "Savings", counterpartId).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(5)))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repository.SaveEntry(context.Background(), domain.AccountEntry{
+		AccountId:     1,
+		Type:          domain.Transfer,
+		Amount:        -100,
+		Balance:       1400,
+		Description:   "Savings",
+		CounterpartId: &counterpartId,
+	})
+
+	require.NoError(err)
+	require.Equal(uint(5), res)
+}
+
+func (suite *TestSuite) TestSearchAllEntriesSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "account_entries" WHERE account_id = $1 ORDER BY id desc`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "account_id", "type", "amount", "balance", "description", "counterpart_id"}).
+			AddRow(6, suite.createdAt, 1, domain.Adjustment, -5.5, 1394.5, "Conciliación", nil).
+			AddRow(5, suite.createdAt, 1, domain.Transfer, -100.0, 1400.0, "Savings", 2))
+
+	entries, err := suite.repository.SearchAllEntries(context.Background(), 1)
+
+	require.NoError(err)
+	require.Len(entries, 2)
+	require.Nil(entries[0].CounterpartId)
+	require.Equal(uint(2), *entries[1].CounterpartId)
+	require.Equal(domain.AccountEntry{
+		ID:          6,
+		AccountId:   1,
+		Type:        domain.Adjustment,
+		Amount:      -5.5,
+		Balance:     1394.5,
+		Description: "Conciliación",
+		CreatedAt:   suite.createdAt,
+	}, entries[0])
+}
+
+func (suite *TestSuite) TestSearchAllEntriesError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "account_entries" WHERE account_id = $1 ORDER BY id desc`)).
+		WithArgs(1).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchAllEntries(context.Background(), 1)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSaveAvailableSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "account_availables" ("created_at","account_id","budget_available_id") VALUES ($1,$2,$3) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, 1, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.SaveAvailable(context.Background(), domain.AccountAvailable{AccountId: 1, BudgetAvailableId: 10})
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestSaveAvailableError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "account_availables"`)).
+		WillReturnError(gorm.ErrDuplicatedKey)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.SaveAvailable(context.Background(), domain.AccountAvailable{AccountId: 1, BudgetAvailableId: 10})
+
+	require.ErrorIs(err, gorm.ErrDuplicatedKey)
+}
+
+func (suite *TestSuite) TestSearchAllAvailablesSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT "account_availables"."id","account_availables"."created_at","account_availables"."account_id","account_availables"."budget_available_id" FROM "account_availables" JOIN budget_availables ON budget_availables.id = account_availables.budget_available_id WHERE budget_availables.budget_id = $1 AND budget_availables.deleted_at IS NULL ORDER BY account_availables.id`)).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "account_id", "budget_available_id"}).
+			AddRow(1, 1, 10).
+			AddRow(2, 2, 11))
+
+	links, err := suite.repository.SearchAllAvailables(context.Background(), 3)
+
+	require.NoError(err)
+	require.Equal([]domain.AccountAvailable{
+		{AccountId: 1, BudgetAvailableId: 10},
+		{AccountId: 2, BudgetAvailableId: 11},
+	}, links)
+}
+
+func (suite *TestSuite) TestSearchAvailablesByMonthSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT "budget_availables"."id","budget_availables"."created_at","budget_availables"."updated_at","budget_availables"."deleted_at","budget_availables"."name","budget_availables"."amount","budget_availables"."budget_id" FROM "budget_availables" JOIN account_availables ON account_availables.budget_available_id = budget_availables.id JOIN budgets ON budgets.id = budget_availables.budget_id WHERE (account_availables.account_id = $1 AND budgets.year = $2 AND budgets.month = $3 AND budgets.deleted_at IS NULL) AND "budget_availables"."deleted_at" IS NULL ORDER BY budget_availables.id`)).
+		WithArgs(1, 2024, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "amount", "budget_id"}).
+			AddRow(10, "Bank", 1500.0, 3))
+
+	availables, err := suite.repository.SearchAvailablesByMonth(context.Background(), 1, 2024, 3)
+
+	require.NoError(err)
+	require.Len(availables, 1)
+	require.Equal(uint(10), *availables[0].ID)
+	require.Equal(1500.0, *availables[0].Amount)
+	require.Equal(uint(3), *availables[0].BudgetId)
+}
+
+func (suite *TestSuite) TestSearchAvailablesByMonthError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`FROM "budget_availables" JOIN account_availables`)).
+		WithArgs(1, 2024, 3).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchAvailablesByMonth(context.Background(), 1, 2024, 3)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteAllByUserIdSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "account_availables" WHERE account_id IN (SELECT "id" FROM "accounts" WHERE user_id = $1)`)).
+		WithArgs(suite.userId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "account_entries" WHERE account_id IN (SELECT "id" FROM "accounts" WHERE user_id = $1)`)).
+		WithArgs(suite.userId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "accounts" WHERE user_id = $1`)).
+		WithArgs(suite.userId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteAllByUserId(context.Background(), suite.userId)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteAllByUserIdError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "account_availables" WHERE account_id IN (SELECT "id" FROM "accounts" WHERE user_id = $1)`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteAllByUserId(context.Background(), suite.userId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteOrphansSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "account_availables" WHERE budget_available_id NOT IN (SELECT "id" FROM "budget_availables")`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteOrphans(context.Background())
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteOrphansError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "account_availables" WHERE budget_available_id NOT IN (SELECT "id" FROM "budget_availables")`)).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteOrphans(context.Background())
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,231 @@
+package bill_split
+
+import (
+	"context"
+	"errors"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/db/entity"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	shared_ent "your-accounts-api/shared/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.BillSplitRepository {
+	return db.DefaultWithTransaction[domain.BillSplitRepository](tx, NewRepository, r)
+}
+
+func (r *gormRepository) SaveMember(ctx context.Context, member domain.BudgetMember) (uint, error) {
+	model := &entity.BudgetMember{
+		BudgetId: member.BudgetId,
+		Name:     member.Name,
+	}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchAllMembers(ctx context.Context, budgetId uint) ([]domain.BudgetMember, error) {
+	var models []entity.BudgetMember
+	if err := r.db.WithContext(ctx).Where("budget_id = ?", budgetId).Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	members := []domain.BudgetMember{}
+	for _, model := range models {
+		members = append(members, domain.BudgetMember{
+			ID:        model.ID,
+			BudgetId:  model.BudgetId,
+			Name:      model.Name,
+			CreatedAt: model.CreatedAt,
+		})
+	}
+
+	return members, nil
+}
+
+func (r *gormRepository) DeleteMember(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Delete(&entity.BudgetMember{
+		BaseModel: shared_ent.BaseModel{
+			ID: id,
+		},
+	}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SaveSplit creates the split of the bill or replaces the method and the shares of the existing one
+func (r *gormRepository) SaveSplit(ctx context.Context, split domain.BillSplit) error {
+	model := new(entity.BillSplit)
+	err := r.db.WithContext(ctx).Where("budget_bill_id = ?", split.BudgetBillId).First(model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		model.BudgetBillId = split.BudgetBillId
+	} else if err != nil {
+		return err
+	}
+
+	model.Method = split.Method
+	if err := r.db.WithContext(ctx).Omit("Shares").Save(model).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("split_id = ?", model.ID).Delete(&entity.BillShare{}).Error; err != nil {
+		return err
+	}
+
+	shares := []entity.BillShare{}
+	for _, share := range split.Shares {
+		shares = append(shares, entity.BillShare{
+			SplitId:  model.ID,
+			MemberId: share.MemberId,
+			Value:    share.Value,
+			Paid:     share.Paid,
+		})
+	}
+
+	if len(shares) == 0 {
+		return nil
+	}
+
+	if err := r.db.WithContext(ctx).Create(&shares).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormRepository) SearchSplit(ctx context.Context, budgetBillId uint) (domain.BillSplit, error) {
+	model := new(entity.BillSplit)
+	if err := r.db.WithContext(ctx).Preload("Shares", func(db *gorm.DB) *gorm.DB {
+		return db.Order("bill_shares.id ASC")
+	}).Where("budget_bill_id = ?", budgetBillId).First(model).Error; err != nil {
+		return domain.BillSplit{}, err
+	}
+
+	return toSplitDomain(*model), nil
+}
+
+// SearchAllSplits returns the splits of the bills of the budget, the bills in the trash are left out
+func (r *gormRepository) SearchAllSplits(ctx context.Context, budgetId uint) ([]domain.BillSplit, error) {
+	var models []entity.BillSplit
+	if err := r.db.WithContext(ctx).Preload("Shares", func(db *gorm.DB) *gorm.DB {
+		return db.Order("bill_shares.id ASC")
+	}).
+		Joins("JOIN budget_bills ON budget_bills.id = bill_splits.budget_bill_id").
+		Where("budget_bills.budget_id = ? AND budget_bills.deleted_at IS NULL", budgetId).
+		Order("bill_splits.budget_bill_id").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	splits := []domain.BillSplit{}
+	for _, model := range models {
+		splits = append(splits, toSplitDomain(model))
+	}
+
+	return splits, nil
+}
+
+func (r *gormRepository) DeleteSplit(ctx context.Context, budgetBillId uint) error {
+	splitIds := r.db.Model(&entity.BillSplit{}).Select("id").Where("budget_bill_id = ?", budgetBillId)
+	if err := r.db.WithContext(ctx).Where("split_id IN (?)", splitIds).Delete(&entity.BillShare{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("budget_bill_id = ?", budgetBillId).Delete(&entity.BillSplit{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormRepository) SaveSettlement(ctx context.Context, settlement domain.BudgetSettlement) (uint, error) {
+	model := &entity.BudgetSettlement{
+		BudgetId:     settlement.BudgetId,
+		FromMemberId: settlement.FromMemberId,
+		ToMemberId:   settlement.ToMemberId,
+		Amount:       settlement.Amount,
+	}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchAllSettlements(ctx context.Context, budgetId uint) ([]domain.BudgetSettlement, error) {
+	var models []entity.BudgetSettlement
+	if err := r.db.WithContext(ctx).Where("budget_id = ?", budgetId).Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	settlements := []domain.BudgetSettlement{}
+	for _, model := range models {
+		settlements = append(settlements, domain.BudgetSettlement{
+			ID:           model.ID,
+			BudgetId:     model.BudgetId,
+			FromMemberId: model.FromMemberId,
+			ToMemberId:   model.ToMemberId,
+			Amount:       model.Amount,
+			CreatedAt:    model.CreatedAt,
+		})
+	}
+
+	return settlements, nil
+}
+
+// DeleteOrphans removes the splits of the purged bills and the members and settlements of the
+// purged budgets
+func (r *gormRepository) DeleteOrphans(ctx context.Context) error {
+	// Unscoped to keep the rows of the budgets and the bills in the trash
+	budgetIds := r.db.Unscoped().Model(&entity.Budget{}).Select("id")
+	billIds := r.db.Unscoped().Model(&entity.BudgetBill{}).Select("id")
+	splitIds := r.db.Model(&entity.BillSplit{}).Select("id").Where("budget_bill_id NOT IN (?)", billIds)
+	if err := r.db.WithContext(ctx).Where("split_id IN (?)", splitIds).Delete(&entity.BillShare{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("budget_bill_id NOT IN (?)", billIds).Delete(&entity.BillSplit{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("budget_id NOT IN (?)", budgetIds).Delete(&entity.BudgetSettlement{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("budget_id NOT IN (?)", budgetIds).Delete(&entity.BudgetMember{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toSplitDomain(model entity.BillSplit) domain.BillSplit {
+	shares := []domain.BillShare{}
+	for _, share := range model.Shares {
+		shares = append(shares, domain.BillShare{
+			MemberId: share.MemberId,
+			Value:    share.Value,
+			Paid:     share.Paid,
+		})
+	}
+
+	return domain.BillSplit{
+		BudgetBillId: model.BudgetBillId,
+		Method:       model.Method,
+		Shares:       shares,
+	}
+}
+
+func NewRepository(db *gorm.DB) domain.BillSplitRepository {
+	return &gormRepository{db}
+}
@@ -0,0 +1,392 @@
+package bill_split
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain/test_utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	budgetId   uint
+	billId     uint
+	createdAt  time.Time
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.BillSplitRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.budgetId = 4
+	suite.billId = 7
+	suite.createdAt = time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveMemberSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_members" ("created_at","budget_id","name") VALUES ($1,$2,$3) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.budgetId, "Ana").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repository.SaveMember(context.Background(), domain.BudgetMember{BudgetId: suite.budgetId, Name: "Ana"})
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSaveMemberError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_members"`)).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repository.SaveMember(context.Background(), domain.BudgetMember{BudgetId: suite.budgetId, Name: "Ana"})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchAllMembersSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_members" WHERE budget_id = $1 ORDER BY id`)).
+		WithArgs(suite.budgetId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "budget_id", "name"}).
+			AddRow(1, suite.createdAt, suite.budgetId, "Ana").
+			AddRow(2, suite.createdAt, suite.budgetId, "Luis"))
+
+	members, err := suite.repository.SearchAllMembers(context.Background(), suite.budgetId)
+
+	require.NoError(err)
+	require.Equal([]domain.BudgetMember{
+		{ID: 1, BudgetId: suite.budgetId, Name: "Ana", CreatedAt: suite.createdAt},
+		{ID: 2, BudgetId: suite.budgetId, Name: "Luis", CreatedAt: suite.createdAt},
+	}, members)
+}
+
+func (suite *TestSuite) TestDeleteMemberSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_members" WHERE "budget_members"."id" = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteMember(context.Background(), 1)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestSaveSplitSuccessNew() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "bill_splits" WHERE budget_bill_id = $1 ORDER BY "bill_splits"."id" LIMIT 1`)).
+		WithArgs(suite.billId).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "bill_splits" ("created_at","updated_at","budget_bill_id","method") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.billId, domain.Percentage).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(3)))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "bill_shares" WHERE split_id = $1`)).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "bill_shares" ("created_at","split_id","member_id","value","paid") VALUES ($1,$2,$3,$4,$5),($6,$7,$8,$9,$10) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, 3, 1, 60.0, 100.0, test_utils.AnyTime{}, 3, 2, 40.0, 0.0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)).AddRow(int64(2)))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.SaveSplit(context.Background(), domain.BillSplit{
+		BudgetBillId: suite.billId,
+		Method:       domain.Percentage,
+		Shares: []domain.BillShare{
+			{MemberId: 1, Value: 60, Paid: 100},
+			{MemberId: 2, Value: 40},
+		},
+	})
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestSaveSplitSuccessExists() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "bill_splits" WHERE budget_bill_id = $1 ORDER BY "bill_splits"."id" LIMIT 1`)).
+		WithArgs(suite.billId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "budget_bill_id", "method"}).
+			AddRow(3, suite.createdAt, suite.billId, domain.Percentage))
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "bill_splits" SET "created_at"=$1,"updated_at"=$2,"budget_bill_id"=$3,"method"=$4 WHERE "id" = $5`)).
+		WithArgs(suite.createdAt, test_utils.AnyTime{}, suite.billId, domain.Equal, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "bill_shares" WHERE split_id = $1`)).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.SaveSplit(context.Background(), domain.BillSplit{
+		BudgetBillId: suite.billId,
+		Method:       domain.Equal,
+	})
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestSaveSplitError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "bill_splits" WHERE budget_bill_id = $1 ORDER BY "bill_splits"."id" LIMIT 1`)).
+		WithArgs(suite.billId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	err := suite.repository.SaveSplit(context.Background(), domain.BillSplit{BudgetBillId: suite.billId, Method: domain.Equal})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchSplitSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "bill_splits" WHERE budget_bill_id = $1 ORDER BY "bill_splits"."id" LIMIT 1`)).
+		WithArgs(suite.billId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "budget_bill_id", "method"}).
+			AddRow(3, suite.billId, domain.FixedShare))
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "bill_shares" WHERE "bill_shares"."split_id" = $1 ORDER BY bill_shares.id ASC`)).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "split_id", "member_id", "value", "paid"}).
+			AddRow(1, 3, 1, 70.0, 100.0).
+			AddRow(2, 3, 2, 30.0, 0.0))
+
+	split, err := suite.repository.SearchSplit(context.Background(), suite.billId)
+
+	require.NoError(err)
+	require.Equal(domain.BillSplit{
+		BudgetBillId: suite.billId,
+		Method:       domain.FixedShare,
+		Shares: []domain.BillShare{
+			{MemberId: 1, Value: 70, Paid: 100},
+			{MemberId: 2, Value: 30},
+		},
+	}, split)
+}
+
+func (suite *TestSuite) TestSearchSplitError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "bill_splits" WHERE budget_bill_id = $1 ORDER BY "bill_splits"."id" LIMIT 1`)).
+		WithArgs(suite.billId).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err := suite.repository.SearchSplit(context.Background(), suite.billId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestSuite) TestSearchAllSplitsSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT "bill_splits"."id","bill_splits"."created_at","bill_splits"."updated_at","bill_splits"."budget_bill_id","bill_splits"."method" FROM "bill_splits" JOIN budget_bills ON budget_bills.id = bill_splits.budget_bill_id WHERE budget_bills.budget_id = $1 AND budget_bills.deleted_at IS NULL ORDER BY bill_splits.budget_bill_id`)).
+		WithArgs(suite.budgetId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "budget_bill_id", "method"}).
+			AddRow(3, suite.billId, domain.Equal))
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "bill_shares" WHERE "bill_shares"."split_id" = $1 ORDER BY bill_shares.id ASC`)).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "split_id", "member_id", "value", "paid"}).
+			AddRow(1, 3, 1, 0.0, 50.0))
+
+	splits, err := suite.repository.SearchAllSplits(context.Background(), suite.budgetId)
+
+	require.NoError(err)
+	require.Len(splits, 1)
+	require.Equal([]domain.BillShare{{MemberId: 1, Paid: 50}}, splits[0].Shares)
+}
+
+func (suite *TestSuite) TestDeleteSplitSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "bill_shares" WHERE split_id IN (SELECT "id" FROM "bill_splits" WHERE budget_bill_id = $1)`)).
+		WithArgs(suite.billId).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "bill_splits" WHERE budget_bill_id = $1`)).
+		WithArgs(suite.billId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteSplit(context.Background(), suite.billId)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestSaveSettlementSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_settlements" ("created_at","budget_id","from_member_id","to_member_id","amount") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.budgetId, 2, 1, 40.0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repository.SaveSettlement(context.Background(), domain.BudgetSettlement{
+		BudgetId:     suite.budgetId,
+		FromMemberId: 2,
+		ToMemberId:   1,
+		Amount:       40,
+	})
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSearchAllSettlementsSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_settlements" WHERE budget_id = $1 ORDER BY id`)).
+		WithArgs(suite.budgetId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "budget_id", "from_member_id", "to_member_id", "amount"}).
+			AddRow(1, suite.createdAt, suite.budgetId, 2, 1, 40.0))
+
+	settlements, err := suite.repository.SearchAllSettlements(context.Background(), suite.budgetId)
+
+	require.NoError(err)
+	require.Equal([]domain.BudgetSettlement{
+		{ID: 1, BudgetId: suite.budgetId, FromMemberId: 2, ToMemberId: 1, Amount: 40, CreatedAt: suite.createdAt},
+	}, settlements)
+}
+
+func (suite *TestSuite) TestSearchAllSettlementsError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_settlements" WHERE budget_id = $1 ORDER BY id`)).
+		WithArgs(suite.budgetId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchAllSettlements(context.Background(), suite.budgetId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteOrphansSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "bill_shares" WHERE split_id IN (SELECT "id" FROM "bill_splits" WHERE budget_bill_id NOT IN (SELECT "id" FROM "budget_bills"))`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "bill_splits" WHERE budget_bill_id NOT IN (SELECT "id" FROM "budget_bills")`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_settlements" WHERE budget_id NOT IN (SELECT "id" FROM "budgets")`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_members" WHERE budget_id NOT IN (SELECT "id" FROM "budgets")`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteOrphans(context.Background())
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteOrphansError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "bill_shares" WHERE split_id IN (SELECT "id" FROM "bill_splits" WHERE budget_bill_id NOT IN (SELECT "id" FROM "budget_bills"))`)).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteOrphans(context.Background())
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
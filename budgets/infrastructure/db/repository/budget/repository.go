@@ -2,14 +2,13 @@ package budget
 
 import (
 	"context"
+	"time"
 	"your-accounts-api/budgets/domain"
 	"your-accounts-api/budgets/infrastructure/db/entity"
 	"your-accounts-api/shared/domain/persistent"
 	"your-accounts-api/shared/infrastructure/db"
-	shared_ent "your-accounts-api/shared/infrastructure/db/entity"
 
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 )
 
 type gormRepository struct {
@@ -62,6 +61,18 @@ func (r *gormRepository) Save(ctx context.Context, budget domain.Budget) (uint,
 		model.PendingBills = *budget.PendingBills
 	}
 
+	if budget.ZeroBased != nil {
+		model.ZeroBased = *budget.ZeroBased
+	}
+
+	if budget.TotalAssigned != nil {
+		model.TotalAssigned = *budget.TotalAssigned
+	}
+
+	if budget.ToBeAssigned != nil {
+		model.ToBeAssigned = *budget.ToBeAssigned
+	}
+
 	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
 		return 0, err
 	}
@@ -71,14 +82,205 @@ func (r *gormRepository) Save(ctx context.Context, budget domain.Budget) (uint,
 
 func (r *gormRepository) Search(ctx context.Context, id uint) (domain.Budget, error) {
 	model := new(entity.Budget)
-	if err := r.db.WithContext(ctx).Preload("BudgetAvailables", func(db *gorm.DB) *gorm.DB {
+	if err := r.preloadDetails(ctx).First(model, id).Error; err != nil {
+		return domain.Budget{}, err
+	}
+
+	return toDomainWithDetails(model), nil
+}
+
+func (r *gormRepository) SearchAllByExample(ctx context.Context, example domain.Budget) ([]domain.Budget, error) {
+	where := entity.Budget{
+		UserId: *example.UserId,
+	}
+	var models []entity.Budget
+	if err := r.db.WithContext(ctx).Where(where).Order("created_at desc").Limit(12).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	var budgets []domain.Budget
+	for _, model := range models {
+		modelC := model
+		budgets = append(budgets, domain.Budget{
+			ID:               &modelC.ID,
+			Name:             &modelC.Name,
+			Year:             &modelC.Year,
+			Month:            &modelC.Month,
+			FixedIncome:      &modelC.FixedIncome,
+			AdditionalIncome: &modelC.AdditionalIncome,
+			TotalPending:     &modelC.TotalPending,
+			TotalAvailable:   &modelC.TotalAvailable,
+			PendingBills:     &modelC.PendingBills,
+			ZeroBased:        &modelC.ZeroBased,
+			TotalAssigned:    &modelC.TotalAssigned,
+			ToBeAssigned:     &modelC.ToBeAssigned,
+			UserId:           &modelC.UserId,
+		})
+	}
+
+	return budgets, nil
+}
+
+func (r *gormRepository) SearchAllByUserId(ctx context.Context, userId uint) ([]domain.Budget, error) {
+	where := entity.Budget{
+		UserId: userId,
+	}
+	var models []entity.Budget
+	if err := r.preloadDetails(ctx).Where(where).Order("id ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	var budgets []domain.Budget
+	for _, model := range models {
+		modelC := model
+		budgets = append(budgets, toDomainWithDetails(&modelC))
+	}
+
+	return budgets, nil
+}
+
+// SearchLatestByUserId returns the budgets of the newest months first
+func (r *gormRepository) SearchLatestByUserId(ctx context.Context, userId uint, limit int) ([]domain.Budget, error) {
+	where := entity.Budget{
+		UserId: userId,
+	}
+	var models []entity.Budget
+	if err := r.preloadDetails(ctx).Where(where).Order("year desc, month desc, id desc").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	budgets := []domain.Budget{}
+	for _, model := range models {
+		modelC := model
+		budgets = append(budgets, toDomainWithDetails(&modelC))
+	}
+
+	return budgets, nil
+}
+
+// SearchLatestByMonth returns the newest budget of the month without its details
+func (r *gormRepository) SearchLatestByMonth(ctx context.Context, userId uint, year uint16, month uint8) (domain.Budget, error) {
+	where := entity.Budget{
+		UserId: userId,
+		Year:   year,
+		Month:  month,
+	}
+	model := new(entity.Budget)
+	if err := r.db.WithContext(ctx).Where(where).Order("id desc").First(model).Error; err != nil {
+		return domain.Budget{}, err
+	}
+
+	return toDomainWithDetails(model), nil
+}
+
+func (r *gormRepository) Delete(ctx context.Context, id uint) error {
+	// The details share the deletion time of the budget, so they can be restored with it
+	deletedAt := time.Now()
+	for _, model := range []any{&entity.BudgetAvailable{}, &entity.BudgetBill{}} {
+		if err := r.db.WithContext(ctx).Model(model).Where("budget_id = ?", id).UpdateColumn("deleted_at", deletedAt).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := r.db.WithContext(ctx).Model(&entity.Budget{}).Where("id = ?", id).UpdateColumn("deleted_at", deletedAt).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormRepository) SearchAllTrashedByUserId(ctx context.Context, userId uint) ([]domain.Budget, error) {
+	var models []entity.Budget
+	if err := r.db.WithContext(ctx).Unscoped().Where("user_id = ? AND deleted_at IS NOT NULL", userId).Order("deleted_at desc").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	var budgets []domain.Budget
+	for _, model := range models {
+		modelC := model
+		budgets = append(budgets, toTrashedDomain(&modelC))
+	}
+
+	return budgets, nil
+}
+
+func (r *gormRepository) SearchTrashed(ctx context.Context, id uint) (domain.Budget, error) {
+	model := new(entity.Budget)
+	if err := r.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").First(model, id).Error; err != nil {
+		return domain.Budget{}, err
+	}
+
+	return toTrashedDomain(model), nil
+}
+
+func (r *gormRepository) Restore(ctx context.Context, id uint) error {
+	model := new(entity.Budget)
+	if err := r.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").First(model, id).Error; err != nil {
+		return err
+	}
+
+	// Only the details deleted with the budget, the ones deleted before remain in the trash
+	for _, detail := range []any{&entity.BudgetAvailable{}, &entity.BudgetBill{}} {
+		if err := r.db.WithContext(ctx).Unscoped().Model(detail).Where("budget_id = ? AND deleted_at = ?", id, model.DeletedAt.Time).UpdateColumn("deleted_at", nil).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := r.db.WithContext(ctx).Unscoped().Model(&entity.Budget{}).Where("id = ?", id).UpdateColumn("deleted_at", nil).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteTrashedBefore removes the budgets with their availables, bills and snapshots, the other
+// rows of the budgets are removed by the subscribers of the TrashPurged event
+func (r *gormRepository) DeleteTrashedBefore(ctx context.Context, before time.Time) error {
+	budgetIds := r.db.Unscoped().Model(&entity.Budget{}).Select("id").Where("deleted_at < ?", before)
+	if err := r.deleteDetails(ctx, budgetIds); err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Unscoped().Where("deleted_at < ?", before).Delete(&entity.Budget{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAllByUserId removes the budgets of the user like DeleteTrashedBefore, the ones in the trash too
+func (r *gormRepository) DeleteAllByUserId(ctx context.Context, userId uint) error {
+	budgetIds := r.db.Unscoped().Model(&entity.Budget{}).Select("id").Where("user_id = ?", userId)
+	if err := r.deleteDetails(ctx, budgetIds); err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Unscoped().Where("user_id = ?", userId).Delete(&entity.Budget{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormRepository) deleteDetails(ctx context.Context, budgetIds *gorm.DB) error {
+	for _, detail := range []any{&entity.BudgetAvailable{}, &entity.BudgetBill{}, &entity.BudgetSnapshot{}} {
+		if err := r.db.WithContext(ctx).Unscoped().Where("budget_id IN (?)", budgetIds).Delete(detail).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *gormRepository) preloadDetails(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Preload("BudgetAvailables", func(db *gorm.DB) *gorm.DB {
 		return db.Order("budget_availables.id ASC")
 	}).Preload("BudgetBills", func(db *gorm.DB) *gorm.DB {
 		return db.Order("budget_bills.id ASC")
-	}).First(model, id).Error; err != nil {
-		return domain.Budget{}, err
-	}
+	})
+}
 
+func toDomainWithDetails(model *entity.Budget) domain.Budget {
 	availables := []domain.BudgetAvailable{}
 	for _, available := range model.BudgetAvailables {
 		availableC := available
@@ -115,51 +317,32 @@ func (r *gormRepository) Search(ctx context.Context, id uint) (domain.Budget, er
 		TotalPending:     &model.TotalPending,
 		TotalAvailable:   &model.TotalAvailable,
 		PendingBills:     &model.PendingBills,
+		ZeroBased:        &model.ZeroBased,
+		TotalAssigned:    &model.TotalAssigned,
+		ToBeAssigned:     &model.ToBeAssigned,
 		UserId:           &model.UserId,
 		BudgetAvailables: availables,
 		BudgetBills:      bills,
-	}, nil
-}
-
-func (r *gormRepository) SearchAllByExample(ctx context.Context, example domain.Budget) ([]domain.Budget, error) {
-	where := entity.Budget{
-		UserId: *example.UserId,
-	}
-	var models []entity.Budget
-	if err := r.db.WithContext(ctx).Where(where).Order("created_at desc").Limit(12).Find(&models).Error; err != nil {
-		return nil, err
-	}
-
-	var budgets []domain.Budget
-	for _, model := range models {
-		modelC := model
-		budgets = append(budgets, domain.Budget{
-			ID:               &modelC.ID,
-			Name:             &modelC.Name,
-			Year:             &modelC.Year,
-			Month:            &modelC.Month,
-			FixedIncome:      &modelC.FixedIncome,
-			AdditionalIncome: &modelC.AdditionalIncome,
-			TotalPending:     &modelC.TotalPending,
-			TotalAvailable:   &modelC.TotalAvailable,
-			PendingBills:     &modelC.PendingBills,
-			UserId:           &modelC.UserId,
-		})
 	}
-
-	return budgets, nil
 }
 
-func (r *gormRepository) Delete(ctx context.Context, id uint) error {
-	if err := r.db.WithContext(ctx).Select(clause.Associations).Delete(&entity.Budget{
-		BaseModel: shared_ent.BaseModel{
-			ID: id,
-		},
-	}).Error; err != nil {
-		return err
+func toTrashedDomain(model *entity.Budget) domain.Budget {
+	return domain.Budget{
+		ID:               &model.ID,
+		Name:             &model.Name,
+		Year:             &model.Year,
+		Month:            &model.Month,
+		FixedIncome:      &model.FixedIncome,
+		AdditionalIncome: &model.AdditionalIncome,
+		TotalPending:     &model.TotalPending,
+		TotalAvailable:   &model.TotalAvailable,
+		PendingBills:     &model.PendingBills,
+		ZeroBased:        &model.ZeroBased,
+		TotalAssigned:    &model.TotalAssigned,
+		ToBeAssigned:     &model.ToBeAssigned,
+		UserId:           &model.UserId,
+		DeletedAt:        &model.DeletedAt.Time,
 	}
-
-	return nil
 }
 
 func NewRepository(db *gorm.DB) domain.BudgetRepository {
@@ -93,8 +93,8 @@ func (suite *TestSuite) TestSaveNewSuccess() {
 
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budgets" ("created_at","updated_at","name","year","month","fixed_income","additional_income","total_pending","total_available","pending_bills","user_id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.year, suite.month, float64(0), float64(0), float64(0), float64(0), 0, suite.userId).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budgets" ("created_at","updated_at","deleted_at","name","year","month","fixed_income","additional_income","total_pending","total_available","pending_bills","zero_based","total_assigned","to_be_assigned","user_id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.year, suite.month, float64(0), float64(0), float64(0), float64(0), 0, false, float64(0), float64(0), suite.userId).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uint(999)))
 	suite.mock.ExpectCommit()
 	budget := domain.Budget{
@@ -124,7 +124,7 @@ func (suite *TestSuite) TestSaveExistsSuccess() {
 		UserId: &suite.userId,
 	}
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."id" = $1 ORDER BY "budgets"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."id" = $1 AND "budgets"."deleted_at" IS NULL ORDER BY "budgets"."id" LIMIT 1`)).
 		WithArgs(suite.id).
 		WillReturnRows(sqlmock.
 			NewRows([]string{"id", "created_at", "updated_at", "name", "year", "month", "fixed_income", "additional_income", "total_pending", "total_available", "pending_bills", "user_id"}).
@@ -132,8 +132,8 @@ func (suite *TestSuite) TestSaveExistsSuccess() {
 		)
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`UPDATE "budgets" SET "created_at"=$1,"updated_at"=$2,"name"=$3,"year"=$4,"month"=$5,"fixed_income"=$6,"additional_income"=$7,"total_pending"=$8,"total_available"=$9,"pending_bills"=$10,"user_id"=$11 WHERE "id" = $12`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.year, suite.month, zeroFloat, zeroFloat, zeroFloat, zeroFloat, zeroUInt, suite.userId, suite.id).
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budgets" SET "created_at"=$1,"updated_at"=$2,"deleted_at"=$3,"name"=$4,"year"=$5,"month"=$6,"fixed_income"=$7,"additional_income"=$8,"total_pending"=$9,"total_available"=$10,"pending_bills"=$11,"zero_based"=$12,"total_assigned"=$13,"to_be_assigned"=$14,"user_id"=$15 WHERE "budgets"."deleted_at" IS NULL AND "id" = $16`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.year, suite.month, zeroFloat, zeroFloat, zeroFloat, zeroFloat, zeroUInt, false, zeroFloat, zeroFloat, suite.userId, suite.id).
 		WillReturnResult(sqlmock.NewResult(int64(999), 1))
 	suite.mock.ExpectCommit()
 	budget := domain.Budget{
@@ -159,7 +159,7 @@ func (suite *TestSuite) TestSaveExistsSuccess() {
 func (suite *TestSuite) TestSaveExistsErrorFind() {
 	require := require.New(suite.T())
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."id" = $1 ORDER BY "budgets"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."id" = $1 AND "budgets"."deleted_at" IS NULL ORDER BY "budgets"."id" LIMIT 1`)).
 		WithArgs(suite.id).
 		WillReturnError(gorm.ErrInvalidField)
 	pendingBills := uint8(2)
@@ -183,8 +183,8 @@ func (suite *TestSuite) TestSaveError() {
 
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budgets" ("created_at","updated_at","name","year","month","fixed_income","additional_income","total_pending","total_available","pending_bills","user_id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.year, suite.month, float64(0), float64(0), float64(0), float64(0), 0, suite.userId).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budgets" ("created_at","updated_at","deleted_at","name","year","month","fixed_income","additional_income","total_pending","total_available","pending_bills","zero_based","total_assigned","to_be_assigned","user_id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.year, suite.month, float64(0), float64(0), float64(0), float64(0), 0, false, float64(0), float64(0), suite.userId).
 		WillReturnError(gorm.ErrInvalidField)
 	suite.mock.ExpectRollback()
 	budget := domain.Budget{
@@ -240,19 +240,19 @@ func (suite *TestSuite) TestSearchSuccess() {
 		},
 	}
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."budget_id" = $1 ORDER BY budget_availables.id ASC`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."budget_id" = $1 AND "budget_availables"."deleted_at" IS NULL ORDER BY budget_availables.id ASC`)).
 		WithArgs(budgetExpected.ID).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "amount", "budget_id"}).
 			AddRow(budgetExpected.BudgetAvailables[0].ID, now, now, budgetExpected.BudgetAvailables[0].Name, budgetExpected.BudgetAvailables[0].Amount, budgetExpected.BudgetAvailables[0].BudgetId).
 			AddRow(budgetExpected.BudgetAvailables[1].ID, now, now, budgetExpected.BudgetAvailables[1].Name, budgetExpected.BudgetAvailables[1].Amount, budgetExpected.BudgetAvailables[1].BudgetId))
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."budget_id" = $1 ORDER BY budget_bills.id ASC`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."budget_id" = $1 AND "budget_bills"."deleted_at" IS NULL ORDER BY budget_bills.id ASC`)).
 		WithArgs(budgetExpected.ID).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "description", "amount", "payment", "due_date", "complete", "budget_id", "category"}).
 			AddRow(budgetExpected.BudgetBills[0].ID, now, now, budgetExpected.BudgetBills[0].Description, budgetExpected.BudgetBills[0].Amount, budgetExpected.BudgetBills[0].Payment, budgetExpected.BudgetBills[0].DueDate, budgetExpected.BudgetBills[0].Complete, budgetExpected.BudgetAvailables[0].BudgetId, budgetExpected.BudgetBills[0].Category).
 			AddRow(budgetExpected.BudgetBills[1].ID, now, now, budgetExpected.BudgetBills[1].Description, budgetExpected.BudgetBills[1].Amount, budgetExpected.BudgetBills[1].Payment, budgetExpected.BudgetBills[1].DueDate, budgetExpected.BudgetBills[1].Complete, budgetExpected.BudgetAvailables[1].BudgetId, budgetExpected.BudgetBills[1].Category))
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."id" = $1 ORDER BY "budgets"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."id" = $1 AND "budgets"."deleted_at" IS NULL ORDER BY "budgets"."id" LIMIT 1`)).
 		WithArgs(budgetExpected.ID).
 		WillReturnRows(sqlmock.
 			NewRows([]string{"id", "created_at", "updated_at", "name", "year", "month", "fixed_income", "additional_income", "total_pending", "total_available", "pending_bills", "user_id"}).
@@ -285,7 +285,7 @@ func (suite *TestSuite) TestSearchSuccess() {
 func (suite *TestSuite) TestSearchError() {
 	require := require.New(suite.T())
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."id" = $1 ORDER BY "budgets"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."id" = $1 AND "budgets"."deleted_at" IS NULL ORDER BY "budgets"."id" LIMIT 1`)).
 		WithArgs(999).
 		WillReturnError(gorm.ErrInvalidField)
 
@@ -342,21 +342,141 @@ func (suite *TestSuite) TestSearchAllByExampleError() {
 	require.Empty(projects)
 }
 
+func (suite *TestSuite) TestSearchAllByUserIdSuccess() {
+	require := require.New(suite.T())
+	now := time.Now()
+	category := domain.Education
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."user_id" = $1 AND "budgets"."deleted_at" IS NULL ORDER BY id ASC`)).
+		WithArgs(suite.userId).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "updated_at", "name", "year", "month", "fixed_income", "additional_income", "total_pending", "total_available", "pending_bills", "user_id"}).
+			AddRow(suite.id, now, now, suite.name, suite.year, suite.month, float64(0), float64(0), float64(0), float64(0), 0, suite.userId),
+		)
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."budget_id" = $1 AND "budget_availables"."deleted_at" IS NULL ORDER BY budget_availables.id ASC`)).
+		WithArgs(suite.id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "amount", "budget_id"}).
+			AddRow(1, now, now, suite.name, float64(0), suite.id))
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."budget_id" = $1 AND "budget_bills"."deleted_at" IS NULL ORDER BY budget_bills.id ASC`)).
+		WithArgs(suite.id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "description", "amount", "payment", "due_date", "complete", "budget_id", "category"}).
+			AddRow(2, now, now, suite.name, float64(0), float64(0), 1, false, suite.id, category))
+
+	budgets, err := suite.repository.SearchAllByUserId(context.Background(), suite.userId)
+
+	require.NoError(err)
+	require.Len(budgets, 1)
+	require.Equal(suite.id, *budgets[0].ID)
+	require.Equal(suite.userId, *budgets[0].UserId)
+	require.Len(budgets[0].BudgetAvailables, 1)
+	require.Equal(uint(1), *budgets[0].BudgetAvailables[0].ID)
+	require.Len(budgets[0].BudgetBills, 1)
+	require.Equal(uint(2), *budgets[0].BudgetBills[0].ID)
+	require.Equal(category, *budgets[0].BudgetBills[0].Category)
+}
+
+func (suite *TestSuite) TestSearchAllByUserIdError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."user_id" = $1 AND "budgets"."deleted_at" IS NULL ORDER BY id ASC`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	budgets, err := suite.repository.SearchAllByUserId(context.Background(), suite.userId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Empty(budgets)
+}
+
+func (suite *TestSuite) TestSearchLatestByUserIdSuccess() {
+	require := require.New(suite.T())
+	now := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."user_id" = $1 AND "budgets"."deleted_at" IS NULL ORDER BY year desc, month desc, id desc LIMIT 6`)).
+		WithArgs(suite.userId).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "updated_at", "name", "year", "month", "fixed_income", "additional_income", "total_pending", "total_available", "pending_bills", "user_id"}).
+			AddRow(suite.id, now, now, suite.name, suite.year, suite.month, float64(0), float64(0), float64(0), float64(0), 0, suite.userId),
+		)
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."budget_id" = $1 AND "budget_availables"."deleted_at" IS NULL ORDER BY budget_availables.id ASC`)).
+		WithArgs(suite.id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "amount", "budget_id"}))
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."budget_id" = $1 AND "budget_bills"."deleted_at" IS NULL ORDER BY budget_bills.id ASC`)).
+		WithArgs(suite.id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "description", "amount", "payment", "due_date", "complete", "budget_id", "category"}).
+			AddRow(2, now, now, suite.name, float64(10), float64(0), 1, false, suite.id, domain.House))
+
+	budgets, err := suite.repository.SearchLatestByUserId(context.Background(), suite.userId, 6)
+
+	require.NoError(err)
+	require.Len(budgets, 1)
+	require.Equal(suite.id, *budgets[0].ID)
+	require.Len(budgets[0].BudgetBills, 1)
+	require.Equal(10.0, *budgets[0].BudgetBills[0].Amount)
+}
+
+func (suite *TestSuite) TestSearchLatestByUserIdError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."user_id" = $1 AND "budgets"."deleted_at" IS NULL ORDER BY year desc, month desc, id desc LIMIT 6`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	budgets, err := suite.repository.SearchLatestByUserId(context.Background(), suite.userId, 6)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Empty(budgets)
+}
+
+func (suite *TestSuite) TestSearchLatestByMonthSuccess() {
+	require := require.New(suite.T())
+	now := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."year" = $1 AND "budgets"."month" = $2 AND "budgets"."user_id" = $3 AND "budgets"."deleted_at" IS NULL ORDER BY id desc,"budgets"."id" LIMIT 1`)).
+		WithArgs(suite.year, suite.month, suite.userId).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "updated_at", "name", "year", "month", "fixed_income", "additional_income", "total_pending", "total_available", "pending_bills", "user_id"}).
+			AddRow(suite.id, now, now, suite.name, suite.year, suite.month, float64(0), float64(0), float64(0), float64(0), 0, suite.userId),
+		)
+
+	budget, err := suite.repository.SearchLatestByMonth(context.Background(), suite.userId, suite.year, suite.month)
+
+	require.NoError(err)
+	require.Equal(suite.id, *budget.ID)
+	require.Equal(suite.month, *budget.Month)
+}
+
+func (suite *TestSuite) TestSearchLatestByMonthError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE "budgets"."year" = $1 AND "budgets"."month" = $2 AND "budgets"."user_id" = $3`)).
+		WithArgs(suite.year, suite.month, suite.userId).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err := suite.repository.SearchLatestByMonth(context.Background(), suite.userId, suite.year, suite.month)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
 func (suite *TestSuite) TestDeleteSuccess() {
 	require := require.New(suite.T())
 	id := uint(999)
+	for _, table := range []string{"budget_availables", "budget_bills"} {
+		suite.mock.ExpectBegin()
+		suite.mock.
+			ExpectExec(regexp.QuoteMeta(`UPDATE "`+table+`" SET "deleted_at"=$1 WHERE budget_id = $2 AND "`+table+`"."deleted_at" IS NULL`)).
+			WithArgs(test_utils.AnyTime{}, id).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		suite.mock.ExpectCommit()
+	}
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_availables" WHERE "budget_availables"."budget_id" = $1`)).
-		WithArgs(id).
-		WillReturnResult(sqlmock.NewResult(1, 1))
-	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_bills" WHERE "budget_bills"."budget_id" = $1`)).
-		WithArgs(id).
-		WillReturnResult(sqlmock.NewResult(1, 1))
-	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budgets" WHERE "budgets"."id" = $1`)).
-		WithArgs(id).
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budgets" SET "deleted_at"=$1 WHERE id = $2 AND "budgets"."deleted_at" IS NULL`)).
+		WithArgs(test_utils.AnyTime{}, id).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	suite.mock.ExpectCommit()
 
@@ -368,22 +488,253 @@ func (suite *TestSuite) TestDeleteSuccess() {
 func (suite *TestSuite) TestDeleteErrorDelete() {
 	require := require.New(suite.T())
 	id := uint(999)
+	for _, table := range []string{"budget_availables", "budget_bills"} {
+		suite.mock.ExpectBegin()
+		suite.mock.
+			ExpectExec(regexp.QuoteMeta(`UPDATE "`+table+`" SET "deleted_at"=$1 WHERE budget_id = $2 AND "`+table+`"."deleted_at" IS NULL`)).
+			WithArgs(test_utils.AnyTime{}, id).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		suite.mock.ExpectCommit()
+	}
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_availables" WHERE "budget_availables"."budget_id" = $1`)).
-		WithArgs(id).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budgets" SET "deleted_at"=$1 WHERE id = $2 AND "budgets"."deleted_at" IS NULL`)).
+		WithArgs(test_utils.AnyTime{}, id).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.Delete(context.Background(), id)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteErrorDetails() {
+	require := require.New(suite.T())
+	id := uint(999)
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_availables" SET "deleted_at"=$1 WHERE budget_id = $2 AND "budget_availables"."deleted_at" IS NULL`)).
+		WithArgs(test_utils.AnyTime{}, id).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.Delete(context.Background(), id)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchAllTrashedByUserIdSuccess() {
+	require := require.New(suite.T())
+	now := time.Now()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_bills" WHERE "budget_bills"."budget_id" = $1`)).
-		WithArgs(id).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE user_id = $1 AND deleted_at IS NOT NULL ORDER BY deleted_at desc`)).
+		WithArgs(suite.userId).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "updated_at", "deleted_at", "name", "year", "month", "user_id"}).
+			AddRow(suite.id, now, now, now, suite.name, suite.year, suite.month, suite.userId),
+		)
+
+	budgets, err := suite.repository.SearchAllTrashedByUserId(context.Background(), suite.userId)
+
+	require.NoError(err)
+	require.Len(budgets, 1)
+	require.Equal(suite.id, *budgets[0].ID)
+	require.Equal(suite.name, *budgets[0].Name)
+	require.WithinDuration(now, *budgets[0].DeletedAt, time.Second)
+}
+
+func (suite *TestSuite) TestSearchAllTrashedByUserIdError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE user_id = $1 AND deleted_at IS NOT NULL ORDER BY deleted_at desc`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	budgets, err := suite.repository.SearchAllTrashedByUserId(context.Background(), suite.userId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Empty(budgets)
+}
+
+func (suite *TestSuite) TestSearchTrashedSuccess() {
+	require := require.New(suite.T())
+	now := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE deleted_at IS NOT NULL AND "budgets"."id" = $1 ORDER BY "budgets"."id" LIMIT 1`)).
+		WithArgs(suite.id).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "deleted_at", "name", "user_id"}).
+			AddRow(suite.id, now, suite.name, suite.userId),
+		)
+
+	budget, err := suite.repository.SearchTrashed(context.Background(), suite.id)
+
+	require.NoError(err)
+	require.Equal(suite.id, *budget.ID)
+	require.Equal(suite.userId, *budget.UserId)
+	require.NotNil(budget.DeletedAt)
+}
+
+func (suite *TestSuite) TestSearchTrashedError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE deleted_at IS NOT NULL AND "budgets"."id" = $1 ORDER BY "budgets"."id" LIMIT 1`)).
+		WithArgs(suite.id).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	budget, err := suite.repository.SearchTrashed(context.Background(), suite.id)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(budget)
+}
+
+func (suite *TestSuite) TestRestoreSuccess() {
+	require := require.New(suite.T())
+	deletedAt := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE deleted_at IS NOT NULL AND "budgets"."id" = $1 ORDER BY "budgets"."id" LIMIT 1`)).
+		WithArgs(suite.id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_at"}).AddRow(suite.id, deletedAt))
+	for _, table := range []string{"budget_availables", "budget_bills"} {
+		suite.mock.ExpectBegin()
+		suite.mock.
+			ExpectExec(regexp.QuoteMeta(`UPDATE "`+table+`" SET "deleted_at"=$1 WHERE budget_id = $2 AND deleted_at = $3`)).
+			WithArgs(nil, suite.id, deletedAt).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		suite.mock.ExpectCommit()
+	}
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budgets" SET "deleted_at"=$1 WHERE id = $2`)).
+		WithArgs(nil, suite.id).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.Restore(context.Background(), suite.id)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestRestoreErrorFind() {
+	require := require.New(suite.T())
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budgets" WHERE "budgets"."id" = $1`)).
-		WithArgs(id).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE deleted_at IS NOT NULL AND "budgets"."id" = $1 ORDER BY "budgets"."id" LIMIT 1`)).
+		WithArgs(suite.id).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	err := suite.repository.Restore(context.Background(), suite.id)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+}
+
+func (suite *TestSuite) TestRestoreError() {
+	require := require.New(suite.T())
+	deletedAt := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budgets" WHERE deleted_at IS NOT NULL AND "budgets"."id" = $1 ORDER BY "budgets"."id" LIMIT 1`)).
+		WithArgs(suite.id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_at"}).AddRow(suite.id, deletedAt))
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_availables" SET "deleted_at"=$1 WHERE budget_id = $2 AND deleted_at = $3`)).
+		WithArgs(nil, suite.id, deletedAt).
 		WillReturnError(gorm.ErrInvalidField)
 	suite.mock.ExpectRollback()
 
-	err := suite.repository.Delete(context.Background(), id)
+	err := suite.repository.Restore(context.Background(), suite.id)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+// purgeStatements are the statements of DeleteTrashedBefore in their order
+var purgeStatements = []struct {
+	table string
+	sql   string
+}{
+	{"budget_availables", `DELETE FROM "budget_availables" WHERE budget_id IN (SELECT "id" FROM "budgets" WHERE deleted_at < $1)`},
+	{"budget_bills", `DELETE FROM "budget_bills" WHERE budget_id IN (SELECT "id" FROM "budgets" WHERE deleted_at < $1)`},
+	{"budget_snapshots", `DELETE FROM "budget_snapshots" WHERE budget_id IN (SELECT "id" FROM "budgets" WHERE deleted_at < $1)`},
+	{"budgets", `DELETE FROM "budgets" WHERE deleted_at < $1`},
+}
+
+// expectPurge expects the statements of DeleteTrashedBefore until the one of the failing table
+func (suite *TestSuite) expectPurge(before time.Time, failing string) {
+	for _, statement := range purgeStatements {
+		suite.mock.ExpectBegin()
+		exec := suite.mock.ExpectExec(regexp.QuoteMeta(statement.sql)).WithArgs(before)
+		if statement.table == failing {
+			exec.WillReturnError(gorm.ErrInvalidField)
+			suite.mock.ExpectRollback()
+			return
+		}
+
+		exec.WillReturnResult(sqlmock.NewResult(1, 1))
+		suite.mock.ExpectCommit()
+	}
+}
+
+func (suite *TestSuite) TestDeleteTrashedBeforeSuccess() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.expectPurge(before, "")
+
+	err := suite.repository.DeleteTrashedBefore(context.Background(), before)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteTrashedBeforeErrorSnapshots() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.expectPurge(before, "budget_snapshots")
+
+	err := suite.repository.DeleteTrashedBefore(context.Background(), before)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteTrashedBeforeError() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.expectPurge(before, "budget_availables")
+
+	err := suite.repository.DeleteTrashedBefore(context.Background(), before)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteAllByUserIdSuccess() {
+	require := require.New(suite.T())
+	for _, table := range []string{"budget_availables", "budget_bills", "budget_snapshots"} {
+		suite.mock.ExpectBegin()
+		suite.mock.
+			ExpectExec(regexp.QuoteMeta(`DELETE FROM "` + table + `" WHERE budget_id IN (SELECT "id" FROM "budgets" WHERE user_id = $1)`)).
+			WithArgs(suite.userId).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		suite.mock.ExpectCommit()
+	}
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budgets" WHERE user_id = $1`)).
+		WithArgs(suite.userId).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteAllByUserId(context.Background(), suite.userId)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteAllByUserIdError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_availables" WHERE budget_id IN (SELECT "id" FROM "budgets" WHERE user_id = $1)`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteAllByUserId(context.Background(), suite.userId)
 
 	require.EqualError(gorm.ErrInvalidField, err.Error())
 }
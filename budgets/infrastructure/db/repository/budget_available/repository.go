@@ -2,6 +2,7 @@ package budget_available
 
 import (
 	"context"
+	"time"
 	"your-accounts-api/budgets/domain"
 	"your-accounts-api/budgets/infrastructure/db/entity"
 	"your-accounts-api/shared/domain/persistent"
@@ -90,6 +91,63 @@ func (r *gormRepository) Delete(ctx context.Context, id uint) error {
 	return nil
 }
 
+func (r *gormRepository) SearchAllTrashedByUserId(ctx context.Context, userId uint) ([]domain.BudgetAvailable, error) {
+	// The ones of a budget in the trash are restored with the budget
+	var models []entity.BudgetAvailable
+	if err := r.db.WithContext(ctx).Unscoped().
+		Joins("JOIN budgets ON budgets.id = budget_availables.budget_id").
+		Where("budgets.user_id = ? AND budgets.deleted_at IS NULL AND budget_availables.deleted_at IS NOT NULL", userId).
+		Order("budget_availables.deleted_at desc").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	var availables []domain.BudgetAvailable
+	for _, model := range models {
+		modelC := model
+		availables = append(availables, toTrashedDomain(&modelC))
+	}
+
+	return availables, nil
+}
+
+func (r *gormRepository) SearchTrashed(ctx context.Context, id uint) (domain.BudgetAvailable, error) {
+	model := new(entity.BudgetAvailable)
+	if err := r.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").First(model, id).Error; err != nil {
+		return domain.BudgetAvailable{}, err
+	}
+
+	return toTrashedDomain(model), nil
+}
+
+func (r *gormRepository) Restore(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Unscoped().Model(&entity.BudgetAvailable{}).Where("id = ?", id).UpdateColumn("deleted_at", nil).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteTrashedBefore removes the availables, their accounts and statements are unlinked by the
+// subscribers of the TrashPurged event
+func (r *gormRepository) DeleteTrashedBefore(ctx context.Context, before time.Time) error {
+	if err := r.db.WithContext(ctx).Unscoped().Where("deleted_at < ?", before).Delete(&entity.BudgetAvailable{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toTrashedDomain(model *entity.BudgetAvailable) domain.BudgetAvailable {
+	return domain.BudgetAvailable{
+		ID:        &model.ID,
+		Name:      &model.Name,
+		Amount:    &model.Amount,
+		BudgetId:  &model.BudgetId,
+		DeletedAt: &model.DeletedAt.Time,
+	}
+}
+
 func NewRepository(db *gorm.DB) domain.BudgetAvailableRepository {
 	return &gormRepository{db}
 }
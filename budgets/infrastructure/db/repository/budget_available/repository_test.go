@@ -91,8 +91,8 @@ func (suite *TestSuite) TestSaveNewSuccess() {
 
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_availables" ("created_at","updated_at","name","amount","budget_id") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.amount, suite.budgetId).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_availables" ("created_at","updated_at","deleted_at","name","amount","budget_id") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.amount, suite.budgetId).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(suite.id)))
 	suite.mock.ExpectCommit()
 	available := domain.BudgetAvailable{
@@ -118,7 +118,7 @@ func (suite *TestSuite) TestSaveExistsSuccess() {
 		BudgetId: &suite.budgetId,
 	}
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."id" = $1 ORDER BY "budget_availables"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."id" = $1 AND "budget_availables"."deleted_at" IS NULL ORDER BY "budget_availables"."id" LIMIT 1`)).
 		WithArgs(suite.id).
 		WillReturnRows(sqlmock.
 			NewRows([]string{"id", "created_at", "updated_at", "name", "amount", "budget_id"}).
@@ -126,8 +126,8 @@ func (suite *TestSuite) TestSaveExistsSuccess() {
 		)
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_availables" SET "created_at"=$1,"updated_at"=$2,"name"=$3,"amount"=$4,"budget_id"=$5 WHERE "id" = $6`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.amount, suite.budgetId, suite.id).
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_availables" SET "created_at"=$1,"updated_at"=$2,"deleted_at"=$3,"name"=$4,"amount"=$5,"budget_id"=$6 WHERE "budget_availables"."deleted_at" IS NULL AND "id" = $7`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.amount, suite.budgetId, suite.id).
 		WillReturnResult(sqlmock.NewResult(int64(999), 1))
 	suite.mock.ExpectCommit()
 	available := domain.BudgetAvailable{
@@ -147,7 +147,7 @@ func (suite *TestSuite) TestSaveExistsSuccess() {
 func (suite *TestSuite) TestSaveExistsErrorFind() {
 	require := require.New(suite.T())
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."id" = $1 ORDER BY "budget_availables"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."id" = $1 AND "budget_availables"."deleted_at" IS NULL ORDER BY "budget_availables"."id" LIMIT 1`)).
 		WithArgs(suite.id).
 		WillReturnError(gorm.ErrInvalidField)
 	available := domain.BudgetAvailable{
@@ -167,8 +167,8 @@ func (suite *TestSuite) TestSaveError() {
 	require := require.New(suite.T())
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_availables" ("created_at","updated_at","name","amount","budget_id") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.amount, suite.budgetId).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_availables" ("created_at","updated_at","deleted_at","name","amount","budget_id") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.amount, suite.budgetId).
 		WillReturnError(gorm.ErrInvalidField)
 	suite.mock.ExpectRollback()
 	available := domain.BudgetAvailable{
@@ -187,8 +187,8 @@ func (suite *TestSuite) TestSaveAllNewSuccess() {
 	require := require.New(suite.T())
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_availables" ("created_at","updated_at","name","amount","budget_id") VALUES ($1,$2,$3,$4,$5),($6,$7,$8,$9,$10) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$11,"name"="excluded"."name","amount"="excluded"."amount","budget_id"="excluded"."budget_id" RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.amount, suite.budgetId, test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.amount, suite.budgetId, test_utils.AnyTime{}).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_availables" ("created_at","updated_at","deleted_at","name","amount","budget_id") VALUES ($1,$2,$3,$4,$5,$6),($7,$8,$9,$10,$11,$12) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$13,"deleted_at"="excluded"."deleted_at","name"="excluded"."name","amount"="excluded"."amount","budget_id"="excluded"."budget_id" RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.amount, suite.budgetId, test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.amount, suite.budgetId, test_utils.AnyTime{}).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(999)))
 	suite.mock.ExpectCommit()
 	availables := []domain.BudgetAvailable{
@@ -213,7 +213,7 @@ func (suite *TestSuite) TestSaveAllNewAndUpdateSuccess() {
 	require := require.New(suite.T())
 	now := time.Now()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."id" = $1 ORDER BY "budget_availables"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."id" = $1 AND "budget_availables"."deleted_at" IS NULL ORDER BY "budget_availables"."id" LIMIT 1`)).
 		WithArgs(suite.id).
 		WillReturnRows(sqlmock.
 			NewRows([]string{"id", "created_at", "updated_at", "name", "amount", "budget_id"}).
@@ -221,8 +221,8 @@ func (suite *TestSuite) TestSaveAllNewAndUpdateSuccess() {
 		)
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_availables" ("created_at","updated_at","name","amount","budget_id","id") VALUES ($1,$2,$3,$4,$5,$6),($7,$8,$9,$10,$11,DEFAULT) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$12,"name"="excluded"."name","amount"="excluded"."amount","budget_id"="excluded"."budget_id" RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.amount, suite.budgetId, suite.id, test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.amount, suite.budgetId, test_utils.AnyTime{}).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_availables" ("created_at","updated_at","deleted_at","name","amount","budget_id","id") VALUES ($1,$2,$3,$4,$5,$6,$7),($8,$9,$10,$11,$12,$13,DEFAULT) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$14,"deleted_at"="excluded"."deleted_at","name"="excluded"."name","amount"="excluded"."amount","budget_id"="excluded"."budget_id" RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.amount, suite.budgetId, suite.id, test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.amount, suite.budgetId, test_utils.AnyTime{}).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(999)))
 	suite.mock.ExpectCommit()
 	availables := []domain.BudgetAvailable{
@@ -249,14 +249,14 @@ func (suite *TestSuite) TestSaveAllUpdateSuccess() {
 	ids := []uint{suite.id, suite.id + 1}
 	now := time.Now()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."id" = $1 ORDER BY "budget_availables"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."id" = $1 AND "budget_availables"."deleted_at" IS NULL ORDER BY "budget_availables"."id" LIMIT 1`)).
 		WithArgs(ids[0]).
 		WillReturnRows(sqlmock.
 			NewRows([]string{"id", "created_at", "updated_at", "name", "amount", "budget_id"}).
 			AddRow(ids[0], now.Add(-1*time.Hour), now, suite.name, suite.amount, suite.budgetId),
 		)
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."id" = $1 ORDER BY "budget_availables"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."id" = $1 AND "budget_availables"."deleted_at" IS NULL ORDER BY "budget_availables"."id" LIMIT 1`)).
 		WithArgs(ids[1]).
 		WillReturnRows(sqlmock.
 			NewRows([]string{"id", "created_at", "updated_at", "name", "amount", "budget_id"}).
@@ -264,8 +264,8 @@ func (suite *TestSuite) TestSaveAllUpdateSuccess() {
 		)
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_availables" ("created_at","updated_at","name","amount","budget_id","id") VALUES ($1,$2,$3,$4,$5,$6),($7,$8,$9,$10,$11,$12) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$13,"name"="excluded"."name","amount"="excluded"."amount","budget_id"="excluded"."budget_id" RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.amount, suite.budgetId, ids[0], test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.amount, suite.budgetId, ids[1], test_utils.AnyTime{}).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_availables" ("created_at","updated_at","deleted_at","name","amount","budget_id","id") VALUES ($1,$2,$3,$4,$5,$6,$7),($8,$9,$10,$11,$12,$13,$14) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$15,"deleted_at"="excluded"."deleted_at","name"="excluded"."name","amount"="excluded"."amount","budget_id"="excluded"."budget_id" RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.amount, suite.budgetId, ids[0], test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.amount, suite.budgetId, ids[1], test_utils.AnyTime{}).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(999)))
 	suite.mock.ExpectCommit()
 	availables := []domain.BudgetAvailable{
@@ -291,7 +291,7 @@ func (suite *TestSuite) TestSaveAllUpdateSuccess() {
 func (suite *TestSuite) TestSaveAllErrorFind() {
 	require := require.New(suite.T())
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."id" = $1 ORDER BY "budget_availables"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE "budget_availables"."id" = $1 AND "budget_availables"."deleted_at" IS NULL ORDER BY "budget_availables"."id" LIMIT 1`)).
 		WithArgs(suite.id).
 		WillReturnError(gorm.ErrInvalidField)
 	availables := []domain.BudgetAvailable{
@@ -317,8 +317,8 @@ func (suite *TestSuite) TestSaveAllError() {
 	require := require.New(suite.T())
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_availables" ("created_at","updated_at","name","amount","budget_id") VALUES ($1,$2,$3,$4,$5),($6,$7,$8,$9,$10) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$11,"name"="excluded"."name","amount"="excluded"."amount","budget_id"="excluded"."budget_id" RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.amount, suite.budgetId, test_utils.AnyTime{}, test_utils.AnyTime{}, suite.name, suite.amount, suite.budgetId, test_utils.AnyTime{}).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_availables" ("created_at","updated_at","deleted_at","name","amount","budget_id") VALUES ($1,$2,$3,$4,$5,$6),($7,$8,$9,$10,$11,$12) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$13,"deleted_at"="excluded"."deleted_at","name"="excluded"."name","amount"="excluded"."amount","budget_id"="excluded"."budget_id" RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.amount, suite.budgetId, test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.name, suite.amount, suite.budgetId, test_utils.AnyTime{}).
 		WillReturnError(gorm.ErrInvalidField)
 	suite.mock.ExpectRollback()
 	availables := []domain.BudgetAvailable{
@@ -344,8 +344,8 @@ func (suite *TestSuite) TestDeleteSuccess() {
 	id := uint(999)
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_availables" WHERE "budget_availables"."id" = $1`)).
-		WithArgs(id).
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_availables" SET "deleted_at"=$1 WHERE "budget_availables"."id" = $2 AND "budget_availables"."deleted_at" IS NULL`)).
+		WithArgs(test_utils.AnyTime{}, id).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	suite.mock.ExpectCommit()
 
@@ -359,8 +359,8 @@ func (suite *TestSuite) TestDeleteErrorDelete() {
 	id := uint(999)
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_availables" WHERE "budget_availables"."id" = $1`)).
-		WithArgs(id).
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_availables" SET "deleted_at"=$1 WHERE "budget_availables"."id" = $2 AND "budget_availables"."deleted_at" IS NULL`)).
+		WithArgs(test_utils.AnyTime{}, id).
 		WillReturnError(gorm.ErrInvalidField)
 	suite.mock.ExpectRollback()
 
@@ -369,6 +369,127 @@ func (suite *TestSuite) TestDeleteErrorDelete() {
 	require.EqualError(gorm.ErrInvalidField, err.Error())
 }
 
+func (suite *TestSuite) TestSearchAllTrashedByUserIdSuccess() {
+	require := require.New(suite.T())
+	now := time.Now()
+	userId := uint(1)
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT "budget_availables"."id","budget_availables"."created_at","budget_availables"."updated_at","budget_availables"."deleted_at"`)).
+		WithArgs(userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_at", "name", "budget_id"}).
+			AddRow(suite.id, now, suite.name, suite.budgetId))
+
+	availables, err := suite.repository.SearchAllTrashedByUserId(context.Background(), userId)
+
+	require.NoError(err)
+	require.Len(availables, 1)
+	require.Equal(suite.id, *availables[0].ID)
+	require.Equal(suite.name, *availables[0].Name)
+	require.Equal(suite.budgetId, *availables[0].BudgetId)
+	require.WithinDuration(now, *availables[0].DeletedAt, time.Second)
+}
+
+func (suite *TestSuite) TestSearchAllTrashedByUserIdError() {
+	require := require.New(suite.T())
+	userId := uint(1)
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`JOIN budgets ON budgets.id = budget_availables.budget_id WHERE budgets.user_id = $1 AND budgets.deleted_at IS NULL AND budget_availables.deleted_at IS NOT NULL ORDER BY budget_availables.deleted_at desc`)).
+		WithArgs(userId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	availables, err := suite.repository.SearchAllTrashedByUserId(context.Background(), userId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Empty(availables)
+}
+
+func (suite *TestSuite) TestSearchTrashedSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE deleted_at IS NOT NULL AND "budget_availables"."id" = $1 ORDER BY "budget_availables"."id" LIMIT 1`)).
+		WithArgs(suite.id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_at", "name", "budget_id"}).
+			AddRow(suite.id, time.Now(), suite.name, suite.budgetId))
+
+	res, err := suite.repository.SearchTrashed(context.Background(), suite.id)
+
+	require.NoError(err)
+	require.Equal(suite.id, *res.ID)
+	require.Equal(suite.budgetId, *res.BudgetId)
+	require.NotNil(res.DeletedAt)
+}
+
+func (suite *TestSuite) TestSearchTrashedError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_availables" WHERE deleted_at IS NOT NULL AND "budget_availables"."id" = $1 ORDER BY "budget_availables"."id" LIMIT 1`)).
+		WithArgs(suite.id).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	res, err := suite.repository.SearchTrashed(context.Background(), suite.id)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestRestoreSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_availables" SET "deleted_at"=$1 WHERE id = $2`)).
+		WithArgs(nil, suite.id).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.Restore(context.Background(), suite.id)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestRestoreError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_availables" SET "deleted_at"=$1 WHERE id = $2`)).
+		WithArgs(nil, suite.id).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.Restore(context.Background(), suite.id)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteTrashedBeforeSuccess() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_availables" WHERE deleted_at < $1`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteTrashedBefore(context.Background(), before)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteTrashedBeforeError() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_availables" WHERE deleted_at < $1`)).
+		WithArgs(before).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteTrashedBefore(context.Background(), before)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
@@ -2,6 +2,7 @@ package budget_bill
 
 import (
 	"context"
+	"time"
 	"your-accounts-api/budgets/domain"
 	"your-accounts-api/budgets/infrastructure/db/entity"
 	"your-accounts-api/shared/domain/persistent"
@@ -140,6 +141,82 @@ func (r *gormRepository) Delete(ctx context.Context, id uint) error {
 	return nil
 }
 
+func (r *gormRepository) SearchAllTrashedByUserId(ctx context.Context, userId uint) ([]domain.BudgetBill, error) {
+	// The ones of a budget in the trash are restored with the budget
+	var models []entity.BudgetBill
+	if err := r.db.WithContext(ctx).Unscoped().
+		Joins("JOIN budgets ON budgets.id = budget_bills.budget_id").
+		Where("budgets.user_id = ? AND budgets.deleted_at IS NULL AND budget_bills.deleted_at IS NOT NULL", userId).
+		Order("budget_bills.deleted_at desc").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	var bills []domain.BudgetBill
+	for _, model := range models {
+		modelC := model
+		bills = append(bills, toTrashedDomain(&modelC))
+	}
+
+	return bills, nil
+}
+
+func (r *gormRepository) SearchTrashed(ctx context.Context, id uint) (domain.BudgetBill, error) {
+	model := new(entity.BudgetBill)
+	if err := r.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").First(model, id).Error; err != nil {
+		return domain.BudgetBill{}, err
+	}
+
+	return toTrashedDomain(model), nil
+}
+
+func (r *gormRepository) Restore(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Unscoped().Model(&entity.BudgetBill{}).Where("id = ?", id).UpdateColumn("deleted_at", nil).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteTrashedBefore removes the bills, their installments, statements, splits and envelopes
+// are cleaned by the subscribers of the TrashPurged event
+func (r *gormRepository) DeleteTrashedBefore(ctx context.Context, before time.Time) error {
+	if err := r.db.WithContext(ctx).Unscoped().Where("deleted_at < ?", before).Delete(&entity.BudgetBill{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SearchAllLinkedIdsByBudgetId returns the bills of the budget created for a loan installment or a
+// credit card statement
+func (r *gormRepository) SearchAllLinkedIdsByBudgetId(ctx context.Context, budgetId uint) ([]uint, error) {
+	installments := r.db.Model(&entity.LoanInstallment{}).Select("budget_bill_id")
+	statements := r.db.Model(&entity.CreditCardStatement{}).Select("budget_bill_id").Where("budget_bill_id IS NOT NULL")
+	var ids []uint
+	if err := r.db.WithContext(ctx).Model(&entity.BudgetBill{}).
+		Where("budget_id = ? AND (id IN (?) OR id IN (?))", budgetId, installments, statements).
+		Order("id").Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func toTrashedDomain(model *entity.BudgetBill) domain.BudgetBill {
+	return domain.BudgetBill{
+		ID:          &model.ID,
+		Description: &model.Description,
+		Amount:      &model.Amount,
+		Payment:     &model.Payment,
+		DueDate:     &model.DueDate,
+		Complete:    &model.Complete,
+		BudgetId:    &model.BudgetId,
+		Category:    &model.Category,
+		DeletedAt:   &model.DeletedAt.Time,
+	}
+}
+
 func NewRepository(db *gorm.DB) domain.BudgetBillRepository {
 	return &gormRepository{db}
 }
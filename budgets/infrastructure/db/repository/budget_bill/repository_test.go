@@ -99,7 +99,7 @@ func (suite *TestSuite) TestSearchSuccess() {
 		Category:    &suite.category,
 	}
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 ORDER BY "budget_bills"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 AND "budget_bills"."deleted_at" IS NULL ORDER BY "budget_bills"."id" LIMIT 1`)).
 		WithArgs(suite.id).
 		WillReturnRows(sqlmock.
 			NewRows([]string{"id", "created_at", "updated_at", "description", "amount", "payment", "due_date", "complete", "budget_id", "category"}).
@@ -120,7 +120,7 @@ func (suite *TestSuite) TestSearchSuccess() {
 func (suite *TestSuite) TestSearchError() {
 	require := require.New(suite.T())
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 ORDER BY "budget_bills"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 AND "budget_bills"."deleted_at" IS NULL ORDER BY "budget_bills"."id" LIMIT 1`)).
 		WithArgs(suite.id).
 		WillReturnError(gorm.ErrInvalidField)
 
@@ -135,8 +135,8 @@ func (suite *TestSuite) TestSaveNewSuccess() {
 
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_bills" ("created_at","updated_at","description","amount","payment","due_date","complete","budget_id","category") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_bills" ("created_at","updated_at","deleted_at","description","amount","payment","due_date","complete","budget_id","category") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(suite.id)))
 	suite.mock.ExpectCommit()
 	bill := domain.BudgetBill{
@@ -166,7 +166,7 @@ func (suite *TestSuite) TestSaveExistsSuccess() {
 		Category:    &suite.category,
 	}
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 ORDER BY "budget_bills"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 AND "budget_bills"."deleted_at" IS NULL ORDER BY "budget_bills"."id" LIMIT 1`)).
 		WithArgs(suite.id).
 		WillReturnRows(sqlmock.
 			NewRows([]string{"id", "created_at", "updated_at", "description", "amount", "payment", "due_date", "complete", "budget_id", "category"}).
@@ -174,8 +174,8 @@ func (suite *TestSuite) TestSaveExistsSuccess() {
 		)
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_bills" SET "created_at"=$1,"updated_at"=$2,"description"=$3,"amount"=$4,"payment"=$5,"due_date"=$6,"complete"=$7,"budget_id"=$8,"category"=$9 WHERE "id" = $10`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.description, suite.amount, suite.amount, dueDate, complete, suite.budgetId, suite.category, suite.id).
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_bills" SET "created_at"=$1,"updated_at"=$2,"deleted_at"=$3,"description"=$4,"amount"=$5,"payment"=$6,"due_date"=$7,"complete"=$8,"budget_id"=$9,"category"=$10 WHERE "budget_bills"."deleted_at" IS NULL AND "id" = $11`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.description, suite.amount, suite.amount, dueDate, complete, suite.budgetId, suite.category, suite.id).
 		WillReturnResult(sqlmock.NewResult(int64(999), 1))
 	suite.mock.ExpectCommit()
 	bill := domain.BudgetBill{
@@ -199,7 +199,7 @@ func (suite *TestSuite) TestSaveExistsSuccess() {
 func (suite *TestSuite) TestSaveExistsErrorFind() {
 	require := require.New(suite.T())
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 ORDER BY "budget_bills"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 AND "budget_bills"."deleted_at" IS NULL ORDER BY "budget_bills"."id" LIMIT 1`)).
 		WithArgs(suite.id).
 		WillReturnError(gorm.ErrInvalidField)
 	bill := domain.BudgetBill{
@@ -220,8 +220,8 @@ func (suite *TestSuite) TestSaveError() {
 	require := require.New(suite.T())
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_bills" ("created_at","updated_at","description","amount","payment","due_date","complete","budget_id","category") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_bills" ("created_at","updated_at","deleted_at","description","amount","payment","due_date","complete","budget_id","category") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category).
 		WillReturnError(gorm.ErrInvalidField)
 	suite.mock.ExpectRollback()
 	bill := domain.BudgetBill{
@@ -241,8 +241,8 @@ func (suite *TestSuite) TestSaveAllNewSuccess() {
 	require := require.New(suite.T())
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_bills" ("created_at","updated_at","description","amount","payment","due_date","complete","budget_id","category") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9),($10,$11,$12,$13,$14,$15,$16,$17,$18) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$19,"description"="excluded"."description","amount"="excluded"."amount","payment"="excluded"."payment","due_date"="excluded"."due_date","complete"="excluded"."complete","budget_id"="excluded"."budget_id","category"="excluded"."category" RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category, test_utils.AnyTime{}, test_utils.AnyTime{}, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category, test_utils.AnyTime{}).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_bills" ("created_at","updated_at","deleted_at","description","amount","payment","due_date","complete","budget_id","category") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10),($11,$12,$13,$14,$15,$16,$17,$18,$19,$20) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$21,"deleted_at"="excluded"."deleted_at","description"="excluded"."description","amount"="excluded"."amount","payment"="excluded"."payment","due_date"="excluded"."due_date","complete"="excluded"."complete","budget_id"="excluded"."budget_id","category"="excluded"."category" RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category, test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category, test_utils.AnyTime{}).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(999)))
 	suite.mock.ExpectCommit()
 	bills := []domain.BudgetBill{
@@ -269,7 +269,7 @@ func (suite *TestSuite) TestSaveAllNewAndUpdateSuccess() {
 	require := require.New(suite.T())
 	now := time.Now()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 ORDER BY "budget_bills"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 AND "budget_bills"."deleted_at" IS NULL ORDER BY "budget_bills"."id" LIMIT 1`)).
 		WithArgs(suite.id).
 		WillReturnRows(sqlmock.
 			NewRows([]string{"id", "created_at", "updated_at", "description", "amount", "payment", "due_date", "complete", "budget_id", "category"}).
@@ -277,8 +277,8 @@ func (suite *TestSuite) TestSaveAllNewAndUpdateSuccess() {
 		)
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_bills" ("created_at","updated_at","description","amount","payment","due_date","complete","budget_id","category","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10),($11,$12,$13,$14,$15,$16,$17,$18,$19,DEFAULT) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$20,"description"="excluded"."description","amount"="excluded"."amount","payment"="excluded"."payment","due_date"="excluded"."due_date","complete"="excluded"."complete","budget_id"="excluded"."budget_id","category"="excluded"."category" RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category, suite.id, test_utils.AnyTime{}, test_utils.AnyTime{}, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category, test_utils.AnyTime{}).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_bills" ("created_at","updated_at","deleted_at","description","amount","payment","due_date","complete","budget_id","category","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11),($12,$13,$14,$15,$16,$17,$18,$19,$20,$21,DEFAULT) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$22,"deleted_at"="excluded"."deleted_at","description"="excluded"."description","amount"="excluded"."amount","payment"="excluded"."payment","due_date"="excluded"."due_date","complete"="excluded"."complete","budget_id"="excluded"."budget_id","category"="excluded"."category" RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category, suite.id, test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category, test_utils.AnyTime{}).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(999)))
 	suite.mock.ExpectCommit()
 	bills := []domain.BudgetBill{
@@ -309,14 +309,14 @@ func (suite *TestSuite) TestSaveAllUpdateSuccess() {
 	dueDate := uint8(1)
 	complete := true
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 ORDER BY "budget_bills"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 AND "budget_bills"."deleted_at" IS NULL ORDER BY "budget_bills"."id" LIMIT 1`)).
 		WithArgs(ids[0]).
 		WillReturnRows(sqlmock.
 			NewRows([]string{"id", "created_at", "updated_at", "description", "amount", "payment", "due_date", "complete", "budget_id", "category"}).
 			AddRow(ids[0], now.Add(-1*time.Hour), now, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category),
 		)
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 ORDER BY "budget_bills"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 AND "budget_bills"."deleted_at" IS NULL ORDER BY "budget_bills"."id" LIMIT 1`)).
 		WithArgs(ids[1]).
 		WillReturnRows(sqlmock.
 			NewRows([]string{"id", "created_at", "updated_at", "description", "amount", "payment", "due_date", "complete", "budget_id", "category"}).
@@ -324,8 +324,8 @@ func (suite *TestSuite) TestSaveAllUpdateSuccess() {
 		)
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_bills" ("created_at","updated_at","description","amount","payment","due_date","complete","budget_id","category","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10),($11,$12,$13,$14,$15,$16,$17,$18,$19,$20) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$21,"description"="excluded"."description","amount"="excluded"."amount","payment"="excluded"."payment","due_date"="excluded"."due_date","complete"="excluded"."complete","budget_id"="excluded"."budget_id","category"="excluded"."category" RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.description, suite.amount, suite.amount, uint8(0), complete, suite.budgetId, suite.category, ids[0], test_utils.AnyTime{}, test_utils.AnyTime{}, suite.description, suite.amount, float64(0), dueDate, false, suite.budgetId, suite.category, ids[1], test_utils.AnyTime{}).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_bills" ("created_at","updated_at","deleted_at","description","amount","payment","due_date","complete","budget_id","category","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11),($12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$23,"deleted_at"="excluded"."deleted_at","description"="excluded"."description","amount"="excluded"."amount","payment"="excluded"."payment","due_date"="excluded"."due_date","complete"="excluded"."complete","budget_id"="excluded"."budget_id","category"="excluded"."category" RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.description, suite.amount, suite.amount, uint8(0), complete, suite.budgetId, suite.category, ids[0], test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.description, suite.amount, float64(0), dueDate, false, suite.budgetId, suite.category, ids[1], test_utils.AnyTime{}).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(999)))
 	suite.mock.ExpectCommit()
 	bills := []domain.BudgetBill{
@@ -356,7 +356,7 @@ func (suite *TestSuite) TestSaveAllUpdateSuccess() {
 func (suite *TestSuite) TestSaveAllErrorFind() {
 	require := require.New(suite.T())
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 ORDER BY "budget_bills"."id" LIMIT 1`)).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE "budget_bills"."id" = $1 AND "budget_bills"."deleted_at" IS NULL ORDER BY "budget_bills"."id" LIMIT 1`)).
 		WithArgs(suite.id).
 		WillReturnError(gorm.ErrInvalidField)
 	bills := []domain.BudgetBill{
@@ -384,8 +384,8 @@ func (suite *TestSuite) TestSaveAllError() {
 	require := require.New(suite.T())
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_bills" ("created_at","updated_at","description","amount","payment","due_date","complete","budget_id","category") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9),($10,$11,$12,$13,$14,$15,$16,$17,$18) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$19,"description"="excluded"."description","amount"="excluded"."amount","payment"="excluded"."payment","due_date"="excluded"."due_date","complete"="excluded"."complete","budget_id"="excluded"."budget_id","category"="excluded"."category" RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category, test_utils.AnyTime{}, test_utils.AnyTime{}, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category, test_utils.AnyTime{}).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_bills" ("created_at","updated_at","deleted_at","description","amount","payment","due_date","complete","budget_id","category") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10),($11,$12,$13,$14,$15,$16,$17,$18,$19,$20) ON CONFLICT ("id") DO UPDATE SET "updated_at"=$21,"deleted_at"="excluded"."deleted_at","description"="excluded"."description","amount"="excluded"."amount","payment"="excluded"."payment","due_date"="excluded"."due_date","complete"="excluded"."complete","budget_id"="excluded"."budget_id","category"="excluded"."category" RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category, test_utils.AnyTime{}, test_utils.AnyTime{}, nil, suite.description, suite.amount, float64(0), uint8(0), false, suite.budgetId, suite.category, test_utils.AnyTime{}).
 		WillReturnError(gorm.ErrInvalidField)
 	suite.mock.ExpectRollback()
 	bills := []domain.BudgetBill{
@@ -413,8 +413,8 @@ func (suite *TestSuite) TestDeleteSuccess() {
 	id := uint(999)
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_bills" WHERE "budget_bills"."id" = $1`)).
-		WithArgs(id).
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_bills" SET "deleted_at"=$1 WHERE "budget_bills"."id" = $2 AND "budget_bills"."deleted_at" IS NULL`)).
+		WithArgs(test_utils.AnyTime{}, id).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	suite.mock.ExpectCommit()
 
@@ -428,8 +428,8 @@ func (suite *TestSuite) TestDeleteErrorDelete() {
 	id := uint(999)
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_bills" WHERE "budget_bills"."id" = $1`)).
-		WithArgs(id).
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_bills" SET "deleted_at"=$1 WHERE "budget_bills"."id" = $2 AND "budget_bills"."deleted_at" IS NULL`)).
+		WithArgs(test_utils.AnyTime{}, id).
 		WillReturnError(gorm.ErrInvalidField)
 	suite.mock.ExpectRollback()
 
@@ -438,6 +438,153 @@ func (suite *TestSuite) TestDeleteErrorDelete() {
 	require.EqualError(gorm.ErrInvalidField, err.Error())
 }
 
+func (suite *TestSuite) TestSearchAllTrashedByUserIdSuccess() {
+	require := require.New(suite.T())
+	now := time.Now()
+	userId := uint(1)
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT "budget_bills"."id","budget_bills"."created_at","budget_bills"."updated_at","budget_bills"."deleted_at"`)).
+		WithArgs(userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_at", "description", "budget_id"}).
+			AddRow(suite.id, now, suite.description, suite.budgetId))
+
+	bills, err := suite.repository.SearchAllTrashedByUserId(context.Background(), userId)
+
+	require.NoError(err)
+	require.Len(bills, 1)
+	require.Equal(suite.id, *bills[0].ID)
+	require.Equal(suite.description, *bills[0].Description)
+	require.Equal(suite.budgetId, *bills[0].BudgetId)
+	require.WithinDuration(now, *bills[0].DeletedAt, time.Second)
+}
+
+func (suite *TestSuite) TestSearchAllTrashedByUserIdError() {
+	require := require.New(suite.T())
+	userId := uint(1)
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`JOIN budgets ON budgets.id = budget_bills.budget_id WHERE budgets.user_id = $1 AND budgets.deleted_at IS NULL AND budget_bills.deleted_at IS NOT NULL ORDER BY budget_bills.deleted_at desc`)).
+		WithArgs(userId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	bills, err := suite.repository.SearchAllTrashedByUserId(context.Background(), userId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Empty(bills)
+}
+
+func (suite *TestSuite) TestSearchTrashedSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE deleted_at IS NOT NULL AND "budget_bills"."id" = $1 ORDER BY "budget_bills"."id" LIMIT 1`)).
+		WithArgs(suite.id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_at", "description", "budget_id"}).
+			AddRow(suite.id, time.Now(), suite.description, suite.budgetId))
+
+	res, err := suite.repository.SearchTrashed(context.Background(), suite.id)
+
+	require.NoError(err)
+	require.Equal(suite.id, *res.ID)
+	require.Equal(suite.budgetId, *res.BudgetId)
+	require.NotNil(res.DeletedAt)
+}
+
+func (suite *TestSuite) TestSearchTrashedError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_bills" WHERE deleted_at IS NOT NULL AND "budget_bills"."id" = $1 ORDER BY "budget_bills"."id" LIMIT 1`)).
+		WithArgs(suite.id).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	res, err := suite.repository.SearchTrashed(context.Background(), suite.id)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestRestoreSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_bills" SET "deleted_at"=$1 WHERE id = $2`)).
+		WithArgs(nil, suite.id).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.Restore(context.Background(), suite.id)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestRestoreError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_bills" SET "deleted_at"=$1 WHERE id = $2`)).
+		WithArgs(nil, suite.id).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.Restore(context.Background(), suite.id)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteTrashedBeforeSuccess() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_bills" WHERE deleted_at < $1`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteTrashedBefore(context.Background(), before)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteTrashedBeforeError() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_bills" WHERE deleted_at < $1`)).
+		WithArgs(before).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteTrashedBefore(context.Background(), before)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchAllLinkedIdsByBudgetIdSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "budget_bills" WHERE (budget_id = $1 AND (id IN (SELECT "budget_bill_id" FROM "loan_installments") OR id IN (SELECT "budget_bill_id" FROM "credit_card_statements" WHERE budget_bill_id IS NOT NULL))) AND "budget_bills"."deleted_at" IS NULL ORDER BY id`)).
+		WithArgs(suite.budgetId).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(3))
+
+	res, err := suite.repository.SearchAllLinkedIdsByBudgetId(context.Background(), suite.budgetId)
+
+	require.NoError(err)
+	require.Equal([]uint{1, 3}, res)
+}
+
+func (suite *TestSuite) TestSearchAllLinkedIdsByBudgetIdError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "budget_bills" WHERE (budget_id = $1 AND (id IN (SELECT "budget_bill_id" FROM "loan_installments") OR id IN (SELECT "budget_bill_id" FROM "credit_card_statements" WHERE budget_bill_id IS NOT NULL))) AND "budget_bills"."deleted_at" IS NULL ORDER BY id`)).
+		WithArgs(suite.budgetId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	res, err := suite.repository.SearchAllLinkedIdsByBudgetId(context.Background(), suite.budgetId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(res)
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
@@ -0,0 +1,109 @@
+package budget_envelope
+
+import (
+	"context"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/db/entity"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	shared_ent "your-accounts-api/shared/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.BudgetEnvelopeRepository {
+	return db.DefaultWithTransaction[domain.BudgetEnvelopeRepository](tx, NewRepository, r)
+}
+
+// Save creates the envelope, an existing envelope only changes its money
+func (r *gormRepository) Save(ctx context.Context, envelope domain.BudgetEnvelope) (uint, error) {
+	model := &entity.BudgetEnvelope{
+		Assigned:  envelope.Assigned,
+		Carryover: envelope.Carryover,
+	}
+	if envelope.ID != 0 {
+		model.ID = envelope.ID
+		if err := r.db.WithContext(ctx).Model(model).Select("Assigned", "Carryover").Updates(model).Error; err != nil {
+			return 0, err
+		}
+
+		return model.ID, nil
+	}
+
+	model.BudgetId = envelope.BudgetId
+	model.Name = envelope.Name
+	model.Category = envelope.Category
+	model.BudgetBillId = envelope.BudgetBillId
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) Search(ctx context.Context, id uint) (domain.BudgetEnvelope, error) {
+	model := new(entity.BudgetEnvelope)
+	if err := r.db.WithContext(ctx).First(model, id).Error; err != nil {
+		return domain.BudgetEnvelope{}, err
+	}
+
+	return toDomain(*model), nil
+}
+
+func (r *gormRepository) SearchAllByBudgetId(ctx context.Context, budgetId uint) ([]domain.BudgetEnvelope, error) {
+	var models []entity.BudgetEnvelope
+	if err := r.db.WithContext(ctx).Where("budget_id = ?", budgetId).Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	envelopes := []domain.BudgetEnvelope{}
+	for _, model := range models {
+		envelopes = append(envelopes, toDomain(model))
+	}
+
+	return envelopes, nil
+}
+
+func (r *gormRepository) Delete(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Delete(&entity.BudgetEnvelope{
+		BaseModel: shared_ent.BaseModel{
+			ID: id,
+		},
+	}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteOrphans removes the envelopes of the purged budgets and bills
+func (r *gormRepository) DeleteOrphans(ctx context.Context) error {
+	// Unscoped to keep the envelopes of the budgets and the bills in the trash
+	budgetIds := r.db.Unscoped().Model(&entity.Budget{}).Select("id")
+	billIds := r.db.Unscoped().Model(&entity.BudgetBill{}).Select("id")
+	if err := r.db.WithContext(ctx).Where("budget_id NOT IN (?)", budgetIds).Or("budget_bill_id NOT IN (?)", billIds).Delete(&entity.BudgetEnvelope{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toDomain(model entity.BudgetEnvelope) domain.BudgetEnvelope {
+	return domain.BudgetEnvelope{
+		ID:           model.ID,
+		BudgetId:     model.BudgetId,
+		Name:         model.Name,
+		Category:     model.Category,
+		BudgetBillId: model.BudgetBillId,
+		Assigned:     model.Assigned,
+		Carryover:    model.Carryover,
+	}
+}
+
+func NewRepository(db *gorm.DB) domain.BudgetEnvelopeRepository {
+	return &gormRepository{db}
+}
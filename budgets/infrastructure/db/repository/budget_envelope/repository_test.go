@@ -0,0 +1,233 @@
+package budget_envelope
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"your-accounts-api/budgets/domain"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain/test_utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	budgetId   uint
+	category   domain.BudgetBillCategory
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.BudgetEnvelopeRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.budgetId = 4
+	suite.category = domain.House
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveNewSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_envelopes" ("created_at","updated_at","budget_id","name","category","budget_bill_id","assigned","carryover") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.budgetId, "house", suite.category, nil, 800.0, -20.5).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repository.Save(context.Background(), domain.BudgetEnvelope{
+		BudgetId:  suite.budgetId,
+		Name:      "house",
+		Category:  &suite.category,
+		Assigned:  800,
+		Carryover: -20.5,
+	})
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSaveExistsSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "budget_envelopes" SET "updated_at"=$1,"assigned"=$2,"carryover"=$3 WHERE "id" = $4`)).
+		WithArgs(test_utils.AnyTime{}, 0.0, 0.0, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repository.Save(context.Background(), domain.BudgetEnvelope{ID: 1, BudgetId: suite.budgetId, Name: "house"})
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_envelopes"`)).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repository.Save(context.Background(), domain.BudgetEnvelope{BudgetId: suite.budgetId, Name: "house"})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchSuccess() {
+	require := require.New(suite.T())
+	billId := uint(7)
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_envelopes" WHERE "budget_envelopes"."id" = $1 ORDER BY "budget_envelopes"."id" LIMIT 1`)).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "budget_id", "name", "category", "budget_bill_id", "assigned", "carryover"}).
+			AddRow(2, suite.budgetId, "Rent", nil, billId, 1000.0, 0.0))
+
+	res, err := suite.repository.Search(context.Background(), 2)
+
+	require.NoError(err)
+	require.Equal(domain.BudgetEnvelope{
+		ID:           2,
+		BudgetId:     suite.budgetId,
+		Name:         "Rent",
+		BudgetBillId: &billId,
+		Assigned:     1000,
+	}, res)
+}
+
+func (suite *TestSuite) TestSearchError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_envelopes" WHERE "budget_envelopes"."id" = $1 ORDER BY "budget_envelopes"."id" LIMIT 1`)).
+		WithArgs(2).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err := suite.repository.Search(context.Background(), 2)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestSuite) TestSearchAllByBudgetIdSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_envelopes" WHERE budget_id = $1 ORDER BY id`)).
+		WithArgs(suite.budgetId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "budget_id", "name", "category", "budget_bill_id", "assigned", "carryover"}).
+			AddRow(1, suite.budgetId, "house", suite.category, nil, 800.0, -20.5))
+
+	res, err := suite.repository.SearchAllByBudgetId(context.Background(), suite.budgetId)
+
+	require.NoError(err)
+	require.Equal([]domain.BudgetEnvelope{
+		{ID: 1, BudgetId: suite.budgetId, Name: "house", Category: &suite.category, Assigned: 800, Carryover: -20.5},
+	}, res)
+}
+
+func (suite *TestSuite) TestSearchAllByBudgetIdError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_envelopes" WHERE budget_id = $1 ORDER BY id`)).
+		WithArgs(suite.budgetId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchAllByBudgetId(context.Background(), suite.budgetId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_envelopes" WHERE "budget_envelopes"."id" = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.Delete(context.Background(), 1)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteOrphansSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_envelopes" WHERE budget_id NOT IN (SELECT "id" FROM "budgets") OR budget_bill_id NOT IN (SELECT "id" FROM "budget_bills")`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteOrphans(context.Background())
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteOrphansError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_envelopes" WHERE budget_id NOT IN (SELECT "id" FROM "budgets") OR budget_bill_id NOT IN (SELECT "id" FROM "budget_bills")`)).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteOrphans(context.Background())
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,216 @@
+package budget_snapshot
+
+import (
+	"context"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/db/entity"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+
+	"gorm.io/gorm"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.BudgetSnapshotRepository {
+	return db.DefaultWithTransaction[domain.BudgetSnapshotRepository](tx, NewRepository, r)
+}
+
+// Save always creates a new snapshot, they are never modified
+func (r *gormRepository) Save(ctx context.Context, snapshot domain.BudgetSnapshot) (uint, error) {
+	model := &entity.BudgetSnapshot{
+		BudgetId:    snapshot.BudgetId,
+		Manual:      snapshot.Manual,
+		Description: snapshot.Description,
+		Content:     toContent(snapshot.Budget, snapshot.Envelopes),
+	}
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchAllByBudgetId(ctx context.Context, budgetId uint) ([]domain.BudgetSnapshot, error) {
+	where := entity.BudgetSnapshot{
+		BudgetId: budgetId,
+	}
+	var models []entity.BudgetSnapshot
+	if err := r.db.WithContext(ctx).Omit("Content").Where(where).Order("id desc").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	snapshots := []domain.BudgetSnapshot{}
+	for _, model := range models {
+		snapshots = append(snapshots, domain.BudgetSnapshot{
+			ID:          model.ID,
+			BudgetId:    model.BudgetId,
+			Manual:      model.Manual,
+			Description: model.Description,
+			CreatedAt:   model.CreatedAt,
+		})
+	}
+
+	return snapshots, nil
+}
+
+func (r *gormRepository) SearchByBudgetIdAndId(ctx context.Context, budgetId, id uint) (domain.BudgetSnapshot, error) {
+	where := entity.BudgetSnapshot{
+		BudgetId: budgetId,
+	}
+	model := new(entity.BudgetSnapshot)
+	if err := r.db.WithContext(ctx).Where(where).First(model, id).Error; err != nil {
+		return domain.BudgetSnapshot{}, err
+	}
+
+	return domain.BudgetSnapshot{
+		ID:          model.ID,
+		BudgetId:    model.BudgetId,
+		Manual:      model.Manual,
+		Description: model.Description,
+		Budget:      toBudget(model.BudgetId, model.Content),
+		Envelopes:   toEnvelopes(model.BudgetId, model.Content),
+		CreatedAt:   model.CreatedAt,
+	}, nil
+}
+
+// DeleteAutomaticByBudgetIdExceedingLimit keeps the newest automatic snapshots of the budget,
+// the manual ones are only removed with the budget.
+func (r *gormRepository) DeleteAutomaticByBudgetIdExceedingLimit(ctx context.Context, budgetId uint, limit int) error {
+	newest := r.db.Model(entity.BudgetSnapshot{}).Select("id").Where("budget_id = ? AND manual = ?", budgetId, false).Order("id desc").Limit(limit)
+	if err := r.db.WithContext(ctx).Where("budget_id = ? AND manual = ? AND id NOT IN (?)", budgetId, false, newest).Delete(entity.BudgetSnapshot{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toContent(budget domain.Budget, envelopes []domain.BudgetEnvelope) entity.SnapshotContent {
+	content := entity.SnapshotContent{
+		Name:             *budget.Name,
+		Year:             *budget.Year,
+		Month:            *budget.Month,
+		FixedIncome:      *budget.FixedIncome,
+		AdditionalIncome: *budget.AdditionalIncome,
+		TotalPending:     *budget.TotalPending,
+		TotalAvailable:   *budget.TotalAvailable,
+		PendingBills:     *budget.PendingBills,
+		Availables:       []entity.SnapshotAvailable{},
+		Bills:            []entity.SnapshotBill{},
+		Envelopes:        []entity.SnapshotEnvelope{},
+	}
+
+	if budget.ZeroBased != nil {
+		content.ZeroBased = *budget.ZeroBased
+	}
+
+	if budget.TotalAssigned != nil {
+		content.TotalAssigned = *budget.TotalAssigned
+	}
+
+	if budget.ToBeAssigned != nil {
+		content.ToBeAssigned = *budget.ToBeAssigned
+	}
+
+	for _, available := range budget.BudgetAvailables {
+		content.Availables = append(content.Availables, entity.SnapshotAvailable{
+			ID:     *available.ID,
+			Name:   *available.Name,
+			Amount: *available.Amount,
+		})
+	}
+
+	for _, bill := range budget.BudgetBills {
+		content.Bills = append(content.Bills, entity.SnapshotBill{
+			ID:          *bill.ID,
+			Description: *bill.Description,
+			Amount:      *bill.Amount,
+			Payment:     *bill.Payment,
+			DueDate:     *bill.DueDate,
+			Complete:    *bill.Complete,
+			Category:    *bill.Category,
+		})
+	}
+
+	for _, envelope := range envelopes {
+		content.Envelopes = append(content.Envelopes, entity.SnapshotEnvelope{
+			ID:           envelope.ID,
+			Name:         envelope.Name,
+			Category:     envelope.Category,
+			BudgetBillId: envelope.BudgetBillId,
+			Assigned:     envelope.Assigned,
+			Carryover:    envelope.Carryover,
+		})
+	}
+
+	return content
+}
+
+func toBudget(budgetId uint, content entity.SnapshotContent) domain.Budget {
+	availables := []domain.BudgetAvailable{}
+	for _, available := range content.Availables {
+		availableC := available
+		availables = append(availables, domain.BudgetAvailable{
+			ID:       &availableC.ID,
+			Name:     &availableC.Name,
+			Amount:   &availableC.Amount,
+			BudgetId: &budgetId,
+		})
+	}
+
+	bills := []domain.BudgetBill{}
+	for _, bill := range content.Bills {
+		billC := bill
+		bills = append(bills, domain.BudgetBill{
+			ID:          &billC.ID,
+			Description: &billC.Description,
+			Amount:      &billC.Amount,
+			Payment:     &billC.Payment,
+			DueDate:     &billC.DueDate,
+			Complete:    &billC.Complete,
+			BudgetId:    &budgetId,
+			Category:    &billC.Category,
+		})
+	}
+
+	return domain.Budget{
+		ID:               &budgetId,
+		Name:             &content.Name,
+		Year:             &content.Year,
+		Month:            &content.Month,
+		FixedIncome:      &content.FixedIncome,
+		AdditionalIncome: &content.AdditionalIncome,
+		TotalPending:     &content.TotalPending,
+		TotalAvailable:   &content.TotalAvailable,
+		PendingBills:     &content.PendingBills,
+		ZeroBased:        &content.ZeroBased,
+		TotalAssigned:    &content.TotalAssigned,
+		ToBeAssigned:     &content.ToBeAssigned,
+		BudgetAvailables: availables,
+		BudgetBills:      bills,
+	}
+}
+
+func toEnvelopes(budgetId uint, content entity.SnapshotContent) []domain.BudgetEnvelope {
+	envelopes := []domain.BudgetEnvelope{}
+	for _, envelope := range content.Envelopes {
+		envelopes = append(envelopes, domain.BudgetEnvelope{
+			ID:           envelope.ID,
+			BudgetId:     budgetId,
+			Name:         envelope.Name,
+			Category:     envelope.Category,
+			BudgetBillId: envelope.BudgetBillId,
+			Assigned:     envelope.Assigned,
+			Carryover:    envelope.Carryover,
+		})
+	}
+
+	return envelopes
+}
+
+func NewRepository(db *gorm.DB) domain.BudgetSnapshotRepository {
+	return &gormRepository{db}
+}
@@ -0,0 +1,261 @@
+package budget_snapshot
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain/test_utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	id         uint
+	budgetId   uint
+	content    string
+	budget     domain.Budget
+	envelopes  []domain.BudgetEnvelope
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.BudgetSnapshotRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.id = 1
+	suite.budgetId = 2
+	suite.content = `{"name":"Test","year":2024,"month":5,"fixedIncome":100,"additionalIncome":0,"totalPending":20,"totalAvailable":80,"pendingBills":1,"zeroBased":true,"totalAssigned":60,"toBeAssigned":40,"availables":[{"id":3,"name":"Cash","amount":80}],"bills":[{"id":4,"description":"Rent","amount":20,"payment":0,"dueDate":5,"complete":false,"category":"house"}],"envelopes":[{"id":5,"name":"house","category":"house","budgetBillId":null,"assigned":60,"carryover":-5}]}`
+
+	name, year, month := "Test", uint16(2024), uint8(5)
+	fixedIncome, additionalIncome, totalPending, totalAvailable, pendingBills := 100.0, 0.0, 20.0, 80.0, uint8(1)
+	zeroBased, totalAssigned, toBeAssigned := true, 60.0, 40.0
+	availableId, availableName, availableAmount := uint(3), "Cash", 80.0
+	billId, description, amount, payment, dueDate, complete, category := uint(4), "Rent", 20.0, 0.0, uint8(5), false, domain.House
+	suite.budget = domain.Budget{
+		ID:               &suite.budgetId,
+		Name:             &name,
+		Year:             &year,
+		Month:            &month,
+		FixedIncome:      &fixedIncome,
+		AdditionalIncome: &additionalIncome,
+		TotalPending:     &totalPending,
+		TotalAvailable:   &totalAvailable,
+		PendingBills:     &pendingBills,
+		ZeroBased:        &zeroBased,
+		TotalAssigned:    &totalAssigned,
+		ToBeAssigned:     &toBeAssigned,
+		BudgetAvailables: []domain.BudgetAvailable{
+			{ID: &availableId, Name: &availableName, Amount: &availableAmount, BudgetId: &suite.budgetId},
+		},
+		BudgetBills: []domain.BudgetBill{
+			{ID: &billId, Description: &description, Amount: &amount, Payment: &payment, DueDate: &dueDate, Complete: &complete, BudgetId: &suite.budgetId, Category: &category},
+		},
+	}
+	suite.envelopes = []domain.BudgetEnvelope{
+		{ID: 5, BudgetId: suite.budgetId, Name: "house", Category: &category, Assigned: 60, Carryover: -5},
+	}
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestSaveSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_snapshots" ("created_at","budget_id","manual","description","content") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.budgetId, true, "Before moving", suite.content).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(suite.id)))
+	suite.mock.ExpectCommit()
+	snapshot := domain.BudgetSnapshot{
+		BudgetId:    suite.budgetId,
+		Manual:      true,
+		Description: "Before moving",
+		Budget:      suite.budget,
+		Envelopes:   suite.envelopes,
+	}
+
+	res, err := suite.repository.Save(context.Background(), snapshot)
+
+	require.NoError(err)
+	require.Equal(suite.id, res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "budget_snapshots" ("created_at","budget_id","manual","description","content") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.budgetId, false, "", suite.content).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+	snapshot := domain.BudgetSnapshot{
+		BudgetId:  suite.budgetId,
+		Budget:    suite.budget,
+		Envelopes: suite.envelopes,
+	}
+
+	res, err := suite.repository.Save(context.Background(), snapshot)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSearchAllByBudgetIdSuccess() {
+	require := require.New(suite.T())
+	now := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT "budget_snapshots"."id","budget_snapshots"."created_at","budget_snapshots"."budget_id","budget_snapshots"."manual","budget_snapshots"."description" FROM "budget_snapshots" WHERE "budget_snapshots"."budget_id" = $1 ORDER BY id desc`)).
+		WithArgs(suite.budgetId).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "budget_id", "manual", "description"}).
+			AddRow(2, now, suite.budgetId, true, "Before moving").
+			AddRow(1, now.Add(-1*time.Hour), suite.budgetId, false, "Cambios en el presupuesto"),
+		)
+
+	res, err := suite.repository.SearchAllByBudgetId(context.Background(), suite.budgetId)
+
+	require.NoError(err)
+	require.Len(res, 2)
+	require.Equal(uint(2), res[0].ID)
+	require.True(res[0].Manual)
+	require.Equal("Before moving", res[0].Description)
+	require.Equal(now, res[0].CreatedAt)
+	require.False(res[1].Manual)
+}
+
+func (suite *TestSuite) TestSearchAllByBudgetIdError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT "budget_snapshots"."id","budget_snapshots"."created_at","budget_snapshots"."budget_id","budget_snapshots"."manual","budget_snapshots"."description" FROM "budget_snapshots" WHERE "budget_snapshots"."budget_id" = $1 ORDER BY id desc`)).
+		WithArgs(suite.budgetId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	res, err := suite.repository.SearchAllByBudgetId(context.Background(), suite.budgetId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(res)
+}
+
+func (suite *TestSuite) TestSearchByBudgetIdAndIdSuccess() {
+	require := require.New(suite.T())
+	now := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_snapshots" WHERE "budget_snapshots"."budget_id" = $1 AND "budget_snapshots"."id" = $2 ORDER BY "budget_snapshots"."id" LIMIT 1`)).
+		WithArgs(suite.budgetId, suite.id).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "budget_id", "manual", "description", "content"}).
+			AddRow(suite.id, now, suite.budgetId, true, "Before moving", suite.content),
+		)
+
+	res, err := suite.repository.SearchByBudgetIdAndId(context.Background(), suite.budgetId, suite.id)
+
+	require.NoError(err)
+	require.Equal(suite.id, res.ID)
+	require.Equal(suite.budgetId, res.BudgetId)
+	require.True(res.Manual)
+	require.Equal(now, res.CreatedAt)
+	require.Equal(suite.budget, res.Budget)
+	require.Equal(suite.envelopes, res.Envelopes)
+}
+
+func (suite *TestSuite) TestSearchByBudgetIdAndIdError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "budget_snapshots" WHERE "budget_snapshots"."budget_id" = $1 AND "budget_snapshots"."id" = $2 ORDER BY "budget_snapshots"."id" LIMIT 1`)).
+		WithArgs(suite.budgetId, suite.id).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	res, err := suite.repository.SearchByBudgetIdAndId(context.Background(), suite.budgetId, suite.id)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Empty(res)
+}
+
+func (suite *TestSuite) TestDeleteAutomaticByBudgetIdExceedingLimitSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_snapshots" WHERE budget_id = $1 AND manual = $2 AND id NOT IN (SELECT "id" FROM "budget_snapshots" WHERE budget_id = $3 AND manual = $4 ORDER BY id desc LIMIT 50)`)).
+		WithArgs(suite.budgetId, false, suite.budgetId, false).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteAutomaticByBudgetIdExceedingLimit(context.Background(), suite.budgetId, 50)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteAutomaticByBudgetIdExceedingLimitError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "budget_snapshots" WHERE budget_id = $1 AND manual = $2 AND id NOT IN (SELECT "id" FROM "budget_snapshots" WHERE budget_id = $3 AND manual = $4 ORDER BY id desc LIMIT 50)`)).
+		WithArgs(suite.budgetId, false, suite.budgetId, false).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteAutomaticByBudgetIdExceedingLimit(context.Background(), suite.budgetId, 50)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,269 @@
+package credit_card
+
+import (
+	"context"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/db/entity"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	shared_ent "your-accounts-api/shared/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.CreditCardRepository {
+	return db.DefaultWithTransaction[domain.CreditCardRepository](tx, NewRepository, r)
+}
+
+func (r *gormRepository) Save(ctx context.Context, card domain.CreditCard) (uint, error) {
+	model := &entity.CreditCard{
+		UserId:            card.UserId,
+		Name:              card.Name,
+		Limit:             card.Limit,
+		ClosingDay:        card.ClosingDay,
+		PaymentDay:        card.PaymentDay,
+		MinimumPercentage: card.MinimumPercentage,
+	}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchByExample(ctx context.Context, example domain.CreditCard) (domain.CreditCard, error) {
+	where := entity.CreditCard{
+		BaseModel: shared_ent.BaseModel{
+			ID: example.ID,
+		},
+		UserId: example.UserId,
+	}
+	model := new(entity.CreditCard)
+	if err := r.db.WithContext(ctx).Where(where).First(model).Error; err != nil {
+		return domain.CreditCard{}, err
+	}
+
+	return toDomain(*model), nil
+}
+
+// SearchForUpdate locks the card until the end of the transaction, so its charges are recorded one
+// after another over the totals of its statements
+func (r *gormRepository) SearchForUpdate(ctx context.Context, id uint) (domain.CreditCard, error) {
+	model := new(entity.CreditCard)
+	if err := r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(model, id).Error; err != nil {
+		return domain.CreditCard{}, err
+	}
+
+	return toDomain(*model), nil
+}
+
+func (r *gormRepository) SearchAllByExample(ctx context.Context, example domain.CreditCard) ([]domain.CreditCard, error) {
+	where := entity.CreditCard{
+		UserId: example.UserId,
+	}
+	var models []entity.CreditCard
+	if err := r.db.WithContext(ctx).Where(where).Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	cards := []domain.CreditCard{}
+	for _, model := range models {
+		cards = append(cards, toDomain(model))
+	}
+
+	return cards, nil
+}
+
+// Delete removes the card with its statements and charges, the bills stay in the budgets
+func (r *gormRepository) Delete(ctx context.Context, id uint) error {
+	statementIds := r.db.Model(&entity.CreditCardStatement{}).Select("id").Where("card_id = ?", id)
+	if err := r.db.WithContext(ctx).Where("statement_id IN (?)", statementIds).Delete(&entity.CreditCardCharge{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("card_id = ?", id).Delete(&entity.CreditCardStatement{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Delete(&entity.CreditCard{
+		BaseModel: shared_ent.BaseModel{
+			ID: id,
+		},
+	}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormRepository) SaveStatement(ctx context.Context, statement domain.CreditCardStatement) (uint, error) {
+	model := &entity.CreditCardStatement{
+		BaseModel: shared_ent.BaseModel{
+			ID: statement.ID,
+		},
+		CardId:         statement.CardId,
+		Year:           statement.Year,
+		Month:          statement.Month,
+		ClosingDate:    statement.ClosingDate,
+		DueDate:        statement.DueDate,
+		Total:          statement.Total,
+		MinimumPayment: statement.MinimumPayment,
+		BudgetBillId:   statement.BudgetBillId,
+		AvailableId:    statement.AvailableId,
+		PaidAt:         statement.PaidAt,
+	}
+	if statement.ID != 0 {
+		if err := r.db.WithContext(ctx).Model(model).Select("Total", "MinimumPayment", "BudgetBillId", "AvailableId", "PaidAt").Updates(model).Error; err != nil {
+			return 0, err
+		}
+
+		return model.ID, nil
+	}
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchStatementByExample(ctx context.Context, example domain.CreditCardStatement) (domain.CreditCardStatement, error) {
+	where := entity.CreditCardStatement{
+		BaseModel: shared_ent.BaseModel{
+			ID: example.ID,
+		},
+		CardId: example.CardId,
+		Year:   example.Year,
+		Month:  example.Month,
+	}
+	model := new(entity.CreditCardStatement)
+	if err := r.db.WithContext(ctx).Where(where).First(model).Error; err != nil {
+		return domain.CreditCardStatement{}, err
+	}
+
+	return toStatementDomain(*model), nil
+}
+
+// SearchAllStatements returns the statements of the card from the newest cycle
+func (r *gormRepository) SearchAllStatements(ctx context.Context, cardId uint) ([]domain.CreditCardStatement, error) {
+	var models []entity.CreditCardStatement
+	if err := r.db.WithContext(ctx).Where("card_id = ?", cardId).Order("year desc, month desc").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	statements := []domain.CreditCardStatement{}
+	for _, model := range models {
+		statements = append(statements, toStatementDomain(model))
+	}
+
+	return statements, nil
+}
+
+func (r *gormRepository) SaveCharge(ctx context.Context, charge domain.CreditCardCharge) (uint, error) {
+	model := &entity.CreditCardCharge{
+		StatementId: charge.StatementId,
+		Description: charge.Description,
+		Amount:      charge.Amount,
+		Date:        charge.Date,
+	}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchAllCharges(ctx context.Context, statementId uint) ([]domain.CreditCardCharge, error) {
+	var models []entity.CreditCardCharge
+	if err := r.db.WithContext(ctx).Where("statement_id = ?", statementId).Order("date, id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	charges := []domain.CreditCardCharge{}
+	for _, model := range models {
+		charges = append(charges, domain.CreditCardCharge{
+			ID:          model.ID,
+			StatementId: model.StatementId,
+			Description: model.Description,
+			Amount:      model.Amount,
+			Date:        model.Date,
+			CreatedAt:   model.CreatedAt,
+		})
+	}
+
+	return charges, nil
+}
+
+// DeleteAllByUserId removes the cards of the user with their statements and charges
+func (r *gormRepository) DeleteAllByUserId(ctx context.Context, userId uint) error {
+	cardIds := r.db.Model(&entity.CreditCard{}).Select("id").Where("user_id = ?", userId)
+	statementIds := r.db.Model(&entity.CreditCardStatement{}).Select("id").Where("card_id IN (?)", cardIds)
+	if err := r.db.WithContext(ctx).Where("statement_id IN (?)", statementIds).Delete(&entity.CreditCardCharge{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("card_id IN (?)", cardIds).Delete(&entity.CreditCardStatement{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userId).Delete(&entity.CreditCard{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteOrphans unlinks the statements from the purged bills and availables, the statements keep
+// their charges and their bill is materialized again like the installments
+func (r *gormRepository) DeleteOrphans(ctx context.Context) error {
+	// Unscoped to keep the links to the bills and the availables in the trash
+	billIds := r.db.Unscoped().Model(&entity.BudgetBill{}).Select("id")
+	if err := r.db.WithContext(ctx).Model(&entity.CreditCardStatement{}).Where("budget_bill_id NOT IN (?)", billIds).UpdateColumn("budget_bill_id", nil).Error; err != nil {
+		return err
+	}
+
+	availableIds := r.db.Unscoped().Model(&entity.BudgetAvailable{}).Select("id")
+	if err := r.db.WithContext(ctx).Model(&entity.CreditCardStatement{}).Where("available_id NOT IN (?)", availableIds).UpdateColumn("available_id", nil).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toDomain(model entity.CreditCard) domain.CreditCard {
+	return domain.CreditCard{
+		ID:                model.ID,
+		UserId:            model.UserId,
+		Name:              model.Name,
+		Limit:             model.Limit,
+		ClosingDay:        model.ClosingDay,
+		PaymentDay:        model.PaymentDay,
+		MinimumPercentage: model.MinimumPercentage,
+		CreatedAt:         model.CreatedAt,
+	}
+}
+
+func toStatementDomain(model entity.CreditCardStatement) domain.CreditCardStatement {
+	return domain.CreditCardStatement{
+		ID:             model.ID,
+		CardId:         model.CardId,
+		Year:           model.Year,
+		Month:          model.Month,
+		ClosingDate:    model.ClosingDate,
+		DueDate:        model.DueDate,
+		Total:          model.Total,
+		MinimumPayment: model.MinimumPayment,
+		BudgetBillId:   model.BudgetBillId,
+		AvailableId:    model.AvailableId,
+		PaidAt:         model.PaidAt,
+	}
+}
+
+func NewRepository(db *gorm.DB) domain.CreditCardRepository {
+	return &gormRepository{db}
+}
@@ -0,0 +1,448 @@
+package credit_card
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain/test_utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId           uint
+	closingDate      time.Time
+	dueDate          time.Time
+	createdAt        time.Time
+	statementColumns []string
+	mock             sqlmock.Sqlmock
+	mockTX           *mocks_persistent.MockTransaction
+	repository       domain.CreditCardRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 999
+	suite.closingDate = time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC)
+	suite.dueDate = time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)
+	suite.createdAt = time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)
+	suite.statementColumns = []string{"id", "card_id", "year", "month", "closing_date", "due_date", "total", "minimum_payment", "budget_bill_id", "available_id", "paid_at"}
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "credit_cards" ("created_at","updated_at","user_id","name","limit","closing_day","payment_day","minimum_percentage") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.userId, "Visa", 2000.0, 25, 10, 5.0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repository.Save(context.Background(), domain.CreditCard{
+		UserId:            suite.userId,
+		Name:              "Visa",
+		Limit:             2000,
+		ClosingDay:        25,
+		PaymentDay:        10,
+		MinimumPercentage: 5,
+	})
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "credit_cards"`)).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repository.Save(context.Background(), domain.CreditCard{UserId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchByExampleSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "credit_cards" WHERE "credit_cards"."id" = $1 AND "credit_cards"."user_id" = $2 ORDER BY "credit_cards"."id" LIMIT 1`)).
+		WithArgs(1, suite.userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "user_id", "name", "limit", "closing_day", "payment_day", "minimum_percentage"}).
+			AddRow(1, suite.createdAt, suite.userId, "Visa", 2000.0, 25, 10, 5.0))
+
+	card, err := suite.repository.SearchByExample(context.Background(), domain.CreditCard{ID: 1, UserId: suite.userId})
+
+	require.NoError(err)
+	require.Equal(domain.CreditCard{
+		ID:                1,
+		UserId:            suite.userId,
+		Name:              "Visa",
+		Limit:             2000,
+		ClosingDay:        25,
+		PaymentDay:        10,
+		MinimumPercentage: 5,
+		CreatedAt:         suite.createdAt,
+	}, card)
+}
+
+func (suite *TestSuite) TestSearchForUpdateSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "credit_cards" WHERE "credit_cards"."id" = $1 ORDER BY "credit_cards"."id" LIMIT 1 FOR UPDATE`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "user_id", "name", "limit", "closing_day", "payment_day", "minimum_percentage"}).
+			AddRow(1, suite.createdAt, suite.userId, "Visa", 2000.0, 25, 10, 5.0))
+
+	card, err := suite.repository.SearchForUpdate(context.Background(), 1)
+
+	require.NoError(err)
+	require.Equal(uint(1), card.ID)
+	require.Equal(2000.0, card.Limit)
+}
+
+func (suite *TestSuite) TestSearchForUpdateError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "credit_cards" WHERE "credit_cards"."id" = $1 ORDER BY "credit_cards"."id" LIMIT 1 FOR UPDATE`)).
+		WithArgs(1).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err := suite.repository.SearchForUpdate(context.Background(), 1)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "credit_cards" WHERE "credit_cards"."user_id" = $1 ORDER BY id`)).
+		WithArgs(suite.userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name"}).
+			AddRow(1, suite.userId, "Visa").
+			AddRow(2, suite.userId, "Amex"))
+
+	cards, err := suite.repository.SearchAllByExample(context.Background(), domain.CreditCard{UserId: suite.userId})
+
+	require.NoError(err)
+	require.Len(cards, 2)
+	require.Equal("Amex", cards[1].Name)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "credit_cards" WHERE "credit_cards"."user_id" = $1 ORDER BY id`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchAllByExample(context.Background(), domain.CreditCard{UserId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "credit_card_charges" WHERE statement_id IN (SELECT "id" FROM "credit_card_statements" WHERE card_id = $1)`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "credit_card_statements" WHERE card_id = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "credit_cards" WHERE "credit_cards"."id" = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.Delete(context.Background(), 1)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestSaveStatementSuccessNew() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "credit_card_statements" ("created_at","updated_at","card_id","year","month","closing_date","due_date","total","minimum_payment","budget_bill_id","available_id","paid_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, 1, 2024, 3, suite.closingDate, suite.dueDate, 50.0, 2.5, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(3)))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repository.SaveStatement(context.Background(), domain.CreditCardStatement{
+		CardId:         1,
+		Year:           2024,
+		Month:          3,
+		ClosingDate:    suite.closingDate,
+		DueDate:        suite.dueDate,
+		Total:          50,
+		MinimumPayment: 2.5,
+	})
+
+	require.NoError(err)
+	require.Equal(uint(3), res)
+}
+
+func (suite *TestSuite) TestSaveStatementSuccessExists() {
+	require := require.New(suite.T())
+	billId := uint(5)
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "credit_card_statements" SET "updated_at"=$1,"total"=$2,"minimum_payment"=$3,"budget_bill_id"=$4,"available_id"=$5,"paid_at"=$6 WHERE "id" = $7`)).
+		WithArgs(test_utils.AnyTime{}, 150.0, 7.5, billId, nil, nil, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repository.SaveStatement(context.Background(), domain.CreditCardStatement{
+		ID:             3,
+		CardId:         1,
+		Total:          150,
+		MinimumPayment: 7.5,
+		BudgetBillId:   &billId,
+	})
+
+	require.NoError(err)
+	require.Equal(uint(3), res)
+}
+
+func (suite *TestSuite) TestSearchStatementByExampleSuccess() {
+	require := require.New(suite.T())
+	billId := uint(5)
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "credit_card_statements" WHERE "credit_card_statements"."card_id" = $1 AND "credit_card_statements"."year" = $2 AND "credit_card_statements"."month" = $3 ORDER BY "credit_card_statements"."id" LIMIT 1`)).
+		WithArgs(1, 2024, 3).
+		WillReturnRows(sqlmock.NewRows(suite.statementColumns).
+			AddRow(3, 1, 2024, 3, suite.closingDate, suite.dueDate, 50.0, 2.5, billId, nil, nil))
+
+	statement, err := suite.repository.SearchStatementByExample(context.Background(), domain.CreditCardStatement{CardId: 1, Year: 2024, Month: 3})
+
+	require.NoError(err)
+	require.Equal(domain.CreditCardStatement{
+		ID:             3,
+		CardId:         1,
+		Year:           2024,
+		Month:          3,
+		ClosingDate:    suite.closingDate,
+		DueDate:        suite.dueDate,
+		Total:          50,
+		MinimumPayment: 2.5,
+		BudgetBillId:   &billId,
+	}, statement)
+}
+
+func (suite *TestSuite) TestSearchStatementByExampleError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "credit_card_statements" WHERE "credit_card_statements"."id" = $1 ORDER BY "credit_card_statements"."id" LIMIT 1`)).
+		WithArgs(3).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err := suite.repository.SearchStatementByExample(context.Background(), domain.CreditCardStatement{ID: 3})
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestSuite) TestSearchAllStatementsSuccess() {
+	require := require.New(suite.T())
+	paidAt := time.Date(2024, 4, 8, 0, 0, 0, 0, time.UTC)
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "credit_card_statements" WHERE card_id = $1 ORDER BY year desc, month desc`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(suite.statementColumns).
+			AddRow(3, 1, 2024, 3, suite.closingDate, suite.dueDate, 50.0, 2.5, 5, 6, paidAt))
+
+	statements, err := suite.repository.SearchAllStatements(context.Background(), 1)
+
+	require.NoError(err)
+	require.Len(statements, 1)
+	require.Equal(paidAt, *statements[0].PaidAt)
+	require.Equal(uint(6), *statements[0].AvailableId)
+}
+
+func (suite *TestSuite) TestSaveChargeSuccess() {
+	require := require.New(suite.T())
+	date := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "credit_card_charges" ("created_at","statement_id","description","amount","date") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, 3, "Groceries", 50.0, date).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repository.SaveCharge(context.Background(), domain.CreditCardCharge{StatementId: 3, Description: "Groceries", Amount: 50, Date: date})
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSearchAllChargesSuccess() {
+	require := require.New(suite.T())
+	date := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "credit_card_charges" WHERE statement_id = $1 ORDER BY date, id`)).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "statement_id", "description", "amount", "date"}).
+			AddRow(1, suite.createdAt, 3, "Groceries", 50.0, date))
+
+	charges, err := suite.repository.SearchAllCharges(context.Background(), 3)
+
+	require.NoError(err)
+	require.Equal([]domain.CreditCardCharge{
+		{ID: 1, StatementId: 3, Description: "Groceries", Amount: 50, Date: date, CreatedAt: suite.createdAt},
+	}, charges)
+}
+
+func (suite *TestSuite) TestSearchAllChargesError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "credit_card_charges" WHERE statement_id = $1 ORDER BY date, id`)).
+		WithArgs(3).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchAllCharges(context.Background(), 3)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteAllByUserIdSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "credit_card_charges" WHERE statement_id IN (SELECT "id" FROM "credit_card_statements" WHERE card_id IN (SELECT "id" FROM "credit_cards" WHERE user_id = $1))`)).
+		WithArgs(suite.userId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "credit_card_statements" WHERE card_id IN (SELECT "id" FROM "credit_cards" WHERE user_id = $1)`)).
+		WithArgs(suite.userId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "credit_cards" WHERE user_id = $1`)).
+		WithArgs(suite.userId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteAllByUserId(context.Background(), suite.userId)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteAllByUserIdError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "credit_card_charges" WHERE statement_id IN (SELECT "id" FROM "credit_card_statements" WHERE card_id IN (SELECT "id" FROM "credit_cards" WHERE user_id = $1))`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteAllByUserId(context.Background(), suite.userId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteOrphansSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "credit_card_statements" SET "budget_bill_id"=$1 WHERE budget_bill_id NOT IN (SELECT "id" FROM "budget_bills")`)).
+		WithArgs(nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "credit_card_statements" SET "available_id"=$1 WHERE available_id NOT IN (SELECT "id" FROM "budget_availables")`)).
+		WithArgs(nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteOrphans(context.Background())
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteOrphansError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "credit_card_statements" SET "budget_bill_id"=$1 WHERE budget_bill_id NOT IN (SELECT "id" FROM "budget_bills")`)).
+		WithArgs(nil).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteOrphans(context.Background())
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
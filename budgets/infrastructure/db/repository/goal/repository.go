@@ -0,0 +1,138 @@
+package goal
+
+import (
+	"context"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/db/entity"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	shared_ent "your-accounts-api/shared/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.GoalRepository {
+	return db.DefaultWithTransaction[domain.GoalRepository](tx, NewRepository, r)
+}
+
+// Save creates the goal, an existing goal keeps its user
+func (r *gormRepository) Save(ctx context.Context, goal domain.Goal) (uint, error) {
+	model := &entity.Goal{
+		Name:         goal.Name,
+		TargetAmount: goal.TargetAmount,
+		TargetDate:   goal.TargetDate,
+		Category:     goal.Category,
+		Available:    goal.Available,
+	}
+	if goal.ID != 0 {
+		model.ID = goal.ID
+		if err := r.db.WithContext(ctx).Model(model).Select("Name", "TargetAmount", "TargetDate", "Category", "Available").Updates(model).Error; err != nil {
+			return 0, err
+		}
+
+		return model.ID, nil
+	}
+
+	model.UserId = goal.UserId
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchByExample(ctx context.Context, example domain.Goal) (domain.Goal, error) {
+	where := entity.Goal{
+		BaseModel: shared_ent.BaseModel{
+			ID: example.ID,
+		},
+		UserId: example.UserId,
+	}
+	model := new(entity.Goal)
+	if err := r.db.WithContext(ctx).Where(where).First(model).Error; err != nil {
+		return domain.Goal{}, err
+	}
+
+	return toDomain(*model), nil
+}
+
+func (r *gormRepository) SearchAllByExample(ctx context.Context, example domain.Goal) ([]domain.Goal, error) {
+	where := entity.Goal{
+		UserId: example.UserId,
+	}
+	var models []entity.Goal
+	if err := r.db.WithContext(ctx).Where(where).Order("target_date, id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	goals := []domain.Goal{}
+	for _, model := range models {
+		goals = append(goals, toDomain(model))
+	}
+
+	return goals, nil
+}
+
+func (r *gormRepository) Delete(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Delete(&entity.Goal{
+		BaseModel: shared_ent.BaseModel{
+			ID: id,
+		},
+	}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SearchContributions sums by month the payments of the bills of the category or the amounts of
+// the availables with the name of the goal, from the month the goal was created.
+func (r *gormRepository) SearchContributions(ctx context.Context, goal domain.Goal) ([]domain.GoalContribution, error) {
+	since := goal.CreatedAt.Year()*12 + int(goal.CreatedAt.Month()) - 1
+	query := r.db.WithContext(ctx).Table("budgets b").
+		Select("b.year, b.month, SUM(d.amount) AS amount").
+		Where("b.user_id = ? AND b.deleted_at IS NULL AND d.deleted_at IS NULL AND b.year * 12 + b.month - 1 >= ?", goal.UserId, since).
+		Group("b.year, b.month").
+		Order("b.year, b.month")
+	if goal.Category != nil {
+		query = query.Joins("JOIN (SELECT budget_id, payment AS amount, deleted_at FROM budget_bills WHERE category = ?) d ON d.budget_id = b.id", *goal.Category)
+	} else {
+		query = query.Joins("JOIN (SELECT budget_id, amount, deleted_at FROM budget_availables WHERE LOWER(name) = LOWER(?)) d ON d.budget_id = b.id", *goal.Available)
+	}
+
+	contributions := []domain.GoalContribution{}
+	if err := query.Scan(&contributions).Error; err != nil {
+		return nil, err
+	}
+
+	return contributions, nil
+}
+
+func (r *gormRepository) DeleteAllByUserId(ctx context.Context, userId uint) error {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userId).Delete(&entity.Goal{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toDomain(model entity.Goal) domain.Goal {
+	return domain.Goal{
+		ID:           model.ID,
+		UserId:       model.UserId,
+		Name:         model.Name,
+		TargetAmount: model.TargetAmount,
+		TargetDate:   model.TargetDate,
+		Category:     model.Category,
+		Available:    model.Available,
+		CreatedAt:    model.CreatedAt,
+	}
+}
+
+func NewRepository(db *gorm.DB) domain.GoalRepository {
+	return &gormRepository{db}
+}
@@ -0,0 +1,292 @@
+package goal
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain/test_utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId     uint
+	targetDate time.Time
+	createdAt  time.Time
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.GoalRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 999
+	suite.targetDate = time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	suite.createdAt = time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveSuccessNew() {
+	require := require.New(suite.T())
+	category := domain.Saving
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "goals" ("created_at","updated_at","user_id","name","target_amount","target_date","category","available") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.userId, "Trip", 1000.0, suite.targetDate, category, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+	suite.mock.ExpectCommit()
+	goal := domain.Goal{
+		UserId:       suite.userId,
+		Name:         "Trip",
+		TargetAmount: 1000,
+		TargetDate:   suite.targetDate,
+		Category:     &category,
+	}
+
+	res, err := suite.repository.Save(context.Background(), goal)
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSaveSuccessExists() {
+	require := require.New(suite.T())
+	available := "Savings account"
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "goals" SET "updated_at"=$1,"name"=$2,"target_amount"=$3,"target_date"=$4,"category"=$5,"available"=$6 WHERE "id" = $7`)).
+		WithArgs(test_utils.AnyTime{}, "Trip", 1500.0, suite.targetDate, nil, available, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	goal := domain.Goal{
+		ID:           1,
+		UserId:       suite.userId,
+		Name:         "Trip",
+		TargetAmount: 1500,
+		TargetDate:   suite.targetDate,
+		Available:    &available,
+	}
+
+	res, err := suite.repository.Save(context.Background(), goal)
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "goals"`)).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repository.Save(context.Background(), domain.Goal{UserId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchByExampleSuccess() {
+	require := require.New(suite.T())
+	category := string(domain.Saving)
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "goals" WHERE "goals"."id" = $1 AND "goals"."user_id" = $2 ORDER BY "goals"."id" LIMIT 1`)).
+		WithArgs(1, suite.userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "user_id", "name", "target_amount", "target_date", "category", "available"}).
+			AddRow(1, suite.createdAt, suite.userId, "Trip", 1000.0, suite.targetDate, category, nil))
+
+	goal, err := suite.repository.SearchByExample(context.Background(), domain.Goal{ID: 1, UserId: suite.userId})
+
+	require.NoError(err)
+	require.Equal(uint(1), goal.ID)
+	require.Equal("Trip", goal.Name)
+	require.Equal(domain.Saving, *goal.Category)
+	require.Nil(goal.Available)
+	require.Equal(suite.createdAt, goal.CreatedAt)
+}
+
+func (suite *TestSuite) TestSearchByExampleError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "goals" WHERE "goals"."id" = $1 AND "goals"."user_id" = $2 ORDER BY "goals"."id" LIMIT 1`)).
+		WithArgs(1, suite.userId).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err := suite.repository.SearchByExample(context.Background(), domain.Goal{ID: 1, UserId: suite.userId})
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "goals" WHERE "goals"."user_id" = $1 ORDER BY target_date, id`)).
+		WithArgs(suite.userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "user_id", "name", "target_amount", "target_date", "category", "available"}).
+			AddRow(1, suite.createdAt, suite.userId, "Trip", 1000.0, suite.targetDate, nil, "Savings account"))
+
+	goals, err := suite.repository.SearchAllByExample(context.Background(), domain.Goal{UserId: suite.userId})
+
+	require.NoError(err)
+	require.Len(goals, 1)
+	require.Equal("Savings account", *goals[0].Available)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "goals" WHERE "goals"."user_id" = $1 ORDER BY target_date, id`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchAllByExample(context.Background(), domain.Goal{UserId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "goals" WHERE "goals"."id" = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.Delete(context.Background(), 1)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestSearchContributionsSuccessCategory() {
+	require := require.New(suite.T())
+	category := domain.Saving
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT b.year, b.month, SUM(d.amount) AS amount FROM budgets b JOIN (SELECT budget_id, payment AS amount, deleted_at FROM budget_bills WHERE category = $1) d ON d.budget_id = b.id WHERE b.user_id = $2 AND b.deleted_at IS NULL AND d.deleted_at IS NULL AND b.year * 12 + b.month - 1 >= $3 GROUP BY b.year, b.month ORDER BY b.year, b.month`)).
+		WithArgs(category, suite.userId, 2024*12+2).
+		WillReturnRows(sqlmock.NewRows([]string{"year", "month", "amount"}).
+			AddRow(2024, 3, 100.0).
+			AddRow(2024, 4, 150.0))
+
+	contributions, err := suite.repository.SearchContributions(context.Background(), domain.Goal{UserId: suite.userId, Category: &category, CreatedAt: suite.createdAt})
+
+	require.NoError(err)
+	require.Equal([]domain.GoalContribution{
+		{Year: 2024, Month: 3, Amount: 100},
+		{Year: 2024, Month: 4, Amount: 150},
+	}, contributions)
+}
+
+func (suite *TestSuite) TestSearchContributionsSuccessAvailable() {
+	require := require.New(suite.T())
+	available := "Savings account"
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT b.year, b.month, SUM(d.amount) AS amount FROM budgets b JOIN (SELECT budget_id, amount, deleted_at FROM budget_availables WHERE LOWER(name) = LOWER($1)) d ON d.budget_id = b.id WHERE b.user_id = $2`)).
+		WithArgs(available, suite.userId, 2024*12+2).
+		WillReturnRows(sqlmock.NewRows([]string{"year", "month", "amount"}))
+
+	contributions, err := suite.repository.SearchContributions(context.Background(), domain.Goal{UserId: suite.userId, Available: &available, CreatedAt: suite.createdAt})
+
+	require.NoError(err)
+	require.Empty(contributions)
+}
+
+func (suite *TestSuite) TestSearchContributionsError() {
+	require := require.New(suite.T())
+	category := domain.Saving
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT b.year, b.month, SUM(d.amount) AS amount FROM budgets b`)).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchContributions(context.Background(), domain.Goal{UserId: suite.userId, Category: &category, CreatedAt: suite.createdAt})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteAllByUserIdSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "goals" WHERE user_id = $1`)).
+		WithArgs(suite.userId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteAllByUserId(context.Background(), suite.userId)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteAllByUserIdError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "goals" WHERE user_id = $1`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteAllByUserId(context.Background(), suite.userId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,214 @@
+package loan
+
+import (
+	"context"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/db/entity"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	shared_ent "your-accounts-api/shared/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.LoanRepository {
+	return db.DefaultWithTransaction[domain.LoanRepository](tx, NewRepository, r)
+}
+
+// Save creates the loan, an existing loan only changes its name and its balance because the
+// installments already added to the budgets come from the other data
+func (r *gormRepository) Save(ctx context.Context, loan domain.Loan) (uint, error) {
+	model := &entity.Loan{
+		Name:    loan.Name,
+		Balance: loan.Balance,
+	}
+	if loan.ID != 0 {
+		model.ID = loan.ID
+		if err := r.db.WithContext(ctx).Model(model).Select("Name", "Balance").Updates(model).Error; err != nil {
+			return 0, err
+		}
+
+		return model.ID, nil
+	}
+
+	model.UserId = loan.UserId
+	model.Principal = loan.Principal
+	model.Rate = loan.Rate
+	model.Term = loan.Term
+	model.StartDate = loan.StartDate
+	model.PaymentDay = loan.PaymentDay
+	model.Amortization = loan.Amortization
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchByExample(ctx context.Context, example domain.Loan) (domain.Loan, error) {
+	where := entity.Loan{
+		BaseModel: shared_ent.BaseModel{
+			ID: example.ID,
+		},
+		UserId: example.UserId,
+	}
+	model := new(entity.Loan)
+	if err := r.db.WithContext(ctx).Where(where).First(model).Error; err != nil {
+		return domain.Loan{}, err
+	}
+
+	return toDomain(*model), nil
+}
+
+func (r *gormRepository) SearchAllByExample(ctx context.Context, example domain.Loan) ([]domain.Loan, error) {
+	where := entity.Loan{
+		UserId: example.UserId,
+	}
+	var models []entity.Loan
+	if err := r.db.WithContext(ctx).Where(where).Order("start_date, id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	loans := []domain.Loan{}
+	for _, model := range models {
+		loans = append(loans, toDomain(model))
+	}
+
+	return loans, nil
+}
+
+// Delete removes the loan with its installments, the bills stay in the budgets
+func (r *gormRepository) Delete(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Where("loan_id = ?", id).Delete(&entity.LoanInstallment{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Delete(&entity.Loan{
+		BaseModel: shared_ent.BaseModel{
+			ID: id,
+		},
+	}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormRepository) SearchByBudgetBillId(ctx context.Context, budgetBillId uint) (domain.Loan, error) {
+	model := new(entity.Loan)
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN loan_installments ON loan_installments.loan_id = loans.id").
+		Where("loan_installments.budget_bill_id = ?", budgetBillId).
+		First(model).Error; err != nil {
+		return domain.Loan{}, err
+	}
+
+	return toDomain(*model), nil
+}
+
+// SearchInstallments reads the installments added to the budgets with the payment of their bills,
+// a bill in the trash counts as not paid
+func (r *gormRepository) SearchInstallments(ctx context.Context, loanId uint) ([]domain.LoanInstallment, error) {
+	type row struct {
+		entity.LoanInstallment
+		Year  uint16
+		Month uint8
+		Paid  float64
+	}
+
+	var rows []row
+	if err := r.db.WithContext(ctx).Model(&entity.LoanInstallment{}).
+		Select("loan_installments.*, budgets.year, budgets.month, COALESCE(budget_bills.payment, 0) AS paid").
+		Joins("LEFT JOIN budget_bills ON budget_bills.id = loan_installments.budget_bill_id AND budget_bills.deleted_at IS NULL").
+		Joins("LEFT JOIN budgets ON budgets.id = budget_bills.budget_id").
+		Where("loan_installments.loan_id = ?", loanId).
+		Order("loan_installments.number").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	installments := []domain.LoanInstallment{}
+	for _, row := range rows {
+		budgetBillId := row.BudgetBillId
+		installments = append(installments, domain.LoanInstallment{
+			Number:       row.Number,
+			Year:         row.Year,
+			Month:        row.Month,
+			Payment:      row.Payment,
+			Interest:     row.Interest,
+			Principal:    row.Principal,
+			Balance:      row.Balance,
+			BudgetBillId: &budgetBillId,
+			Paid:         row.Paid,
+		})
+	}
+
+	return installments, nil
+}
+
+func (r *gormRepository) SaveInstallment(ctx context.Context, loanId uint, installment domain.LoanInstallment) error {
+	model := &entity.LoanInstallment{
+		LoanId:       loanId,
+		Number:       installment.Number,
+		BudgetBillId: *installment.BudgetBillId,
+		Payment:      installment.Payment,
+		Interest:     installment.Interest,
+		Principal:    installment.Principal,
+		Balance:      installment.Balance,
+	}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAllByUserId removes the loans of the user with their installments
+func (r *gormRepository) DeleteAllByUserId(ctx context.Context, userId uint) error {
+	loanIds := r.db.Model(&entity.Loan{}).Select("id").Where("user_id = ?", userId)
+	if err := r.db.WithContext(ctx).Where("loan_id IN (?)", loanIds).Delete(&entity.LoanInstallment{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userId).Delete(&entity.Loan{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteOrphans removes the installments of the purged bills, they are materialized again if a
+// budget of their month is created
+func (r *gormRepository) DeleteOrphans(ctx context.Context) error {
+	// Unscoped to keep the installments of the bills in the trash
+	billIds := r.db.Unscoped().Model(&entity.BudgetBill{}).Select("id")
+	if err := r.db.WithContext(ctx).Where("budget_bill_id NOT IN (?)", billIds).Delete(&entity.LoanInstallment{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toDomain(model entity.Loan) domain.Loan {
+	return domain.Loan{
+		ID:           model.ID,
+		UserId:       model.UserId,
+		Name:         model.Name,
+		Principal:    model.Principal,
+		Rate:         model.Rate,
+		Term:         model.Term,
+		StartDate:    model.StartDate,
+		PaymentDay:   model.PaymentDay,
+		Amortization: model.Amortization,
+		Balance:      model.Balance,
+		CreatedAt:    model.CreatedAt,
+	}
+}
+
+func NewRepository(db *gorm.DB) domain.LoanRepository {
+	return &gormRepository{db}
+}
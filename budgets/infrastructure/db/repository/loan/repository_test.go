@@ -0,0 +1,393 @@
+package loan
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/domain"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain/test_utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId     uint
+	startDate  time.Time
+	createdAt  time.Time
+	columns    []string
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.LoanRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 999
+	suite.startDate = time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	suite.createdAt = time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)
+	suite.columns = []string{"id", "created_at", "user_id", "name", "principal", "rate", "term", "start_date", "payment_day", "amortization", "balance"}
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveSuccessNew() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "loans" ("created_at","updated_at","user_id","name","principal","rate","term","start_date","payment_day","amortization","balance") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, test_utils.AnyTime{}, suite.userId, "Car", 1000.0, 12.0, 3, suite.startDate, 10, domain.French, 1000.0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+	suite.mock.ExpectCommit()
+	loan := domain.Loan{
+		UserId:       suite.userId,
+		Name:         "Car",
+		Principal:    1000,
+		Rate:         12,
+		Term:         3,
+		StartDate:    suite.startDate,
+		PaymentDay:   10,
+		Amortization: domain.French,
+		Balance:      1000,
+	}
+
+	res, err := suite.repository.Save(context.Background(), loan)
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSaveSuccessExists() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "loans" SET "updated_at"=$1,"name"=$2,"balance"=$3 WHERE "id" = $4`)).
+		WithArgs(test_utils.AnyTime{}, "Car", 669.98, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repository.Save(context.Background(), domain.Loan{ID: 1, Name: "Car", Principal: 1000, Balance: 669.98})
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "loans"`)).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repository.Save(context.Background(), domain.Loan{UserId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchByExampleSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "loans" WHERE "loans"."id" = $1 AND "loans"."user_id" = $2 ORDER BY "loans"."id" LIMIT 1`)).
+		WithArgs(1, suite.userId).
+		WillReturnRows(sqlmock.NewRows(suite.columns).
+			AddRow(1, suite.createdAt, suite.userId, "Car", 1000.0, 12.0, 3, suite.startDate, 10, domain.German, 750.0))
+
+	loan, err := suite.repository.SearchByExample(context.Background(), domain.Loan{ID: 1, UserId: suite.userId})
+
+	require.NoError(err)
+	require.Equal(domain.Loan{
+		ID:           1,
+		UserId:       suite.userId,
+		Name:         "Car",
+		Principal:    1000,
+		Rate:         12,
+		Term:         3,
+		StartDate:    suite.startDate,
+		PaymentDay:   10,
+		Amortization: domain.German,
+		Balance:      750,
+		CreatedAt:    suite.createdAt,
+	}, loan)
+}
+
+func (suite *TestSuite) TestSearchByExampleError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "loans" WHERE "loans"."id" = $1 AND "loans"."user_id" = $2 ORDER BY "loans"."id" LIMIT 1`)).
+		WithArgs(1, suite.userId).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err := suite.repository.SearchByExample(context.Background(), domain.Loan{ID: 1, UserId: suite.userId})
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "loans" WHERE "loans"."user_id" = $1 ORDER BY start_date, id`)).
+		WithArgs(suite.userId).
+		WillReturnRows(sqlmock.NewRows(suite.columns).
+			AddRow(1, suite.createdAt, suite.userId, "Car", 1000.0, 12.0, 3, suite.startDate, 10, domain.French, 1000.0))
+
+	loans, err := suite.repository.SearchAllByExample(context.Background(), domain.Loan{UserId: suite.userId})
+
+	require.NoError(err)
+	require.Len(loans, 1)
+	require.Equal(domain.French, loans[0].Amortization)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "loans" WHERE "loans"."user_id" = $1 ORDER BY start_date, id`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchAllByExample(context.Background(), domain.Loan{UserId: suite.userId})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "loan_installments" WHERE loan_id = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "loans" WHERE "loans"."id" = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.Delete(context.Background(), 1)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "loan_installments" WHERE loan_id = $1`)).
+		WithArgs(1).
+		WillReturnError(gorm.ErrInvalidTransaction)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.Delete(context.Background(), 1)
+
+	require.ErrorIs(err, gorm.ErrInvalidTransaction)
+}
+
+func (suite *TestSuite) TestSearchByBudgetBillIdSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT "loans"."id","loans"."created_at","loans"."updated_at","loans"."user_id","loans"."name","loans"."principal","loans"."rate","loans"."term","loans"."start_date","loans"."payment_day","loans"."amortization","loans"."balance" FROM "loans" JOIN loan_installments ON loan_installments.loan_id = loans.id WHERE loan_installments.budget_bill_id = $1 ORDER BY "loans"."id" LIMIT 1`)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows(suite.columns).
+			AddRow(1, suite.createdAt, suite.userId, "Car", 1000.0, 12.0, 3, suite.startDate, 10, domain.French, 1000.0))
+
+	loan, err := suite.repository.SearchByBudgetBillId(context.Background(), 7)
+
+	require.NoError(err)
+	require.Equal(uint(1), loan.ID)
+}
+
+func (suite *TestSuite) TestSearchByBudgetBillIdError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`FROM "loans" JOIN loan_installments ON loan_installments.loan_id = loans.id WHERE loan_installments.budget_bill_id = $1`)).
+		WithArgs(7).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err := suite.repository.SearchByBudgetBillId(context.Background(), 7)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestSuite) TestSearchInstallmentsSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT loan_installments.*, budgets.year, budgets.month, COALESCE(budget_bills.payment, 0) AS paid FROM "loan_installments" LEFT JOIN budget_bills ON budget_bills.id = loan_installments.budget_bill_id AND budget_bills.deleted_at IS NULL LEFT JOIN budgets ON budgets.id = budget_bills.budget_id WHERE loan_installments.loan_id = $1 ORDER BY loan_installments.number`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "loan_id", "number", "budget_bill_id", "payment", "interest", "principal", "balance", "year", "month", "paid"}).
+			AddRow(1, suite.createdAt, 1, 1, 7, 340.02, 10.0, 330.02, 669.98, 2024, 2, 340.02))
+
+	installments, err := suite.repository.SearchInstallments(context.Background(), 1)
+
+	require.NoError(err)
+	billId := uint(7)
+	require.Equal([]domain.LoanInstallment{
+		{Number: 1, Year: 2024, Month: 2, Payment: 340.02, Interest: 10, Principal: 330.02, Balance: 669.98, BudgetBillId: &billId, Paid: 340.02},
+	}, installments)
+}
+
+func (suite *TestSuite) TestSearchInstallmentsError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`FROM "loan_installments"`)).
+		WithArgs(1).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchInstallments(context.Background(), 1)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSaveInstallmentSuccess() {
+	require := require.New(suite.T())
+	billId := uint(7)
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "loan_installments" ("created_at","loan_id","number","budget_bill_id","payment","interest","principal","balance") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, 1, 2, billId, 340.02, 6.7, 333.32, 336.66).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.SaveInstallment(context.Background(), 1, domain.LoanInstallment{
+		Number:       2,
+		Payment:      340.02,
+		Interest:     6.7,
+		Principal:    333.32,
+		Balance:      336.66,
+		BudgetBillId: &billId,
+	})
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestSaveInstallmentError() {
+	require := require.New(suite.T())
+	billId := uint(7)
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "loan_installments"`)).
+		WillReturnError(gorm.ErrDuplicatedKey)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.SaveInstallment(context.Background(), 1, domain.LoanInstallment{Number: 2, BudgetBillId: &billId})
+
+	require.ErrorIs(err, gorm.ErrDuplicatedKey)
+}
+
+func (suite *TestSuite) TestDeleteAllByUserIdSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "loan_installments" WHERE loan_id IN (SELECT "id" FROM "loans" WHERE user_id = $1)`)).
+		WithArgs(suite.userId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "loans" WHERE user_id = $1`)).
+		WithArgs(suite.userId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteAllByUserId(context.Background(), suite.userId)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteAllByUserIdError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "loan_installments" WHERE loan_id IN (SELECT "id" FROM "loans" WHERE user_id = $1)`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteAllByUserId(context.Background(), suite.userId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteOrphansSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "loan_installments" WHERE budget_bill_id NOT IN (SELECT "id" FROM "budget_bills")`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteOrphans(context.Background())
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteOrphansError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "loan_installments" WHERE budget_bill_id NOT IN (SELECT "id" FROM "budget_bills")`)).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteOrphans(context.Background())
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,81 @@
+package report
+
+import (
+	"context"
+	"your-accounts-api/budgets/domain"
+
+	"gorm.io/gorm"
+)
+
+// latestCTE picks the latest budget of each month, as SearchLatestByMonth does, so the copies
+// of a budget in the same month do not count its bills twice
+const latestCTE = `WITH latest AS (
+	SELECT MAX(id) AS id, COUNT(*) AS budgets
+	FROM budgets
+	WHERE user_id = @user AND year = @year AND deleted_at IS NULL
+	GROUP BY month
+)`
+
+const monthsQuery = latestCTE + `
+SELECT b.month, l.budgets, b.fixed_income + b.additional_income AS income, b.total_pending AS pending
+FROM latest l
+JOIN budgets b ON b.id = l.id
+ORDER BY b.month`
+
+const categoriesQuery = latestCTE + `
+SELECT b.month, bb.category, SUM(bb.amount) AS amount, SUM(bb.payment) AS payment,
+	COUNT(*) AS bills
+FROM budget_bills bb
+JOIN latest l ON l.id = bb.budget_id
+JOIN budgets b ON b.id = l.id
+WHERE bb.deleted_at IS NULL
+GROUP BY b.month, bb.category
+ORDER BY b.month, bb.category`
+
+const topBillsQuery = latestCTE + `
+SELECT bb.id, b.id AS budget_id, b.name AS budget_name, b.month, bb.description,
+	bb.category, bb.amount, bb.payment
+FROM budget_bills bb
+JOIN latest l ON l.id = bb.budget_id
+JOIN budgets b ON b.id = l.id
+WHERE bb.deleted_at IS NULL
+ORDER BY bb.amount DESC, bb.id
+LIMIT @limit`
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) SearchMonthsByYear(ctx context.Context, userId uint, year uint16) ([]domain.ReportMonth, error) {
+	var months []domain.ReportMonth
+	params := map[string]any{"user": userId, "year": year}
+	if err := r.db.WithContext(ctx).Raw(monthsQuery, params).Scan(&months).Error; err != nil {
+		return nil, err
+	}
+
+	return months, nil
+}
+
+func (r *gormRepository) SearchCategoriesByYear(ctx context.Context, userId uint, year uint16) ([]domain.ReportCategory, error) {
+	var categories []domain.ReportCategory
+	params := map[string]any{"user": userId, "year": year}
+	if err := r.db.WithContext(ctx).Raw(categoriesQuery, params).Scan(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *gormRepository) SearchTopBillsByYear(ctx context.Context, userId uint, year uint16, limit int) ([]domain.ReportBill, error) {
+	var bills []domain.ReportBill
+	params := map[string]any{"user": userId, "year": year, "limit": limit}
+	if err := r.db.WithContext(ctx).Raw(topBillsQuery, params).Scan(&bills).Error; err != nil {
+		return nil, err
+	}
+
+	return bills, nil
+}
+
+func NewRepository(db *gorm.DB) domain.ReportRepository {
+	return &gormRepository{db}
+}
@@ -0,0 +1,141 @@
+package report
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"your-accounts-api/budgets/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId     uint
+	year       uint16
+	mock       sqlmock.Sqlmock
+	repository domain.ReportRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	suite.year = 2024
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestSearchMonthsByYearSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`WITH latest AS ( SELECT MAX(id) AS id, COUNT(*) AS budgets FROM budgets WHERE user_id = $1 AND year = $2 AND deleted_at IS NULL GROUP BY month )`)+`(.|\n)+`+regexp.QuoteMeta(`SELECT b.month, l.budgets,`)+`(.|\n)+`+regexp.QuoteMeta(`JOIN budgets b ON b.id = l.id ORDER BY b.month`)).
+		WithArgs(suite.userId, suite.year).
+		WillReturnRows(sqlmock.NewRows([]string{"month", "budgets", "income", "pending"}).
+			AddRow(1, 1, 3000.0, 0.0).
+			AddRow(2, 2, 6200.0, 150.0))
+
+	res, err := suite.repository.SearchMonthsByYear(context.Background(), suite.userId, suite.year)
+
+	require.NoError(err)
+	require.Equal([]domain.ReportMonth{
+		{Month: 1, Budgets: 1, Income: 3000, Pending: 0},
+		{Month: 2, Budgets: 2, Income: 6200, Pending: 150},
+	}, res)
+}
+
+func (suite *TestSuite) TestSearchMonthsByYearError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT b.month, l.budgets,`)).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchMonthsByYear(context.Background(), suite.userId, suite.year)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchCategoriesByYearSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT b.month, bb.category,`)+`(.|\n)+`+regexp.QuoteMeta(`JOIN latest l ON l.id = bb.budget_id`)+`(.|\n)+`+regexp.QuoteMeta(`GROUP BY b.month, bb.category ORDER BY b.month, bb.category`)).
+		WithArgs(suite.userId, suite.year).
+		WillReturnRows(sqlmock.NewRows([]string{"month", "category", "amount", "payment", "bills"}).
+			AddRow(1, "house", 1200.0, 1200.0, 2).
+			AddRow(1, "services", 80.0, 60.0, 1))
+
+	res, err := suite.repository.SearchCategoriesByYear(context.Background(), suite.userId, suite.year)
+
+	require.NoError(err)
+	require.Equal([]domain.ReportCategory{
+		{Month: 1, Category: domain.House, Amount: 1200, Payment: 1200, Bills: 2},
+		{Month: 1, Category: domain.Services, Amount: 80, Payment: 60, Bills: 1},
+	}, res)
+}
+
+func (suite *TestSuite) TestSearchCategoriesByYearError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT b.month, bb.category,`)).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchCategoriesByYear(context.Background(), suite.userId, suite.year)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchTopBillsByYearSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT bb.id, b.id AS budget_id,`)+`(.|\n)+`+regexp.QuoteMeta(`JOIN latest l ON l.id = bb.budget_id`)+`(.|\n)+`+regexp.QuoteMeta(`ORDER BY bb.amount DESC, bb.id LIMIT $3`)).
+		WithArgs(suite.userId, suite.year, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "budget_id", "budget_name", "month", "description", "category", "amount", "payment"}).
+			AddRow(7, 4, "January", 1, "Rent", "house", 1000.0, 1000.0))
+
+	res, err := suite.repository.SearchTopBillsByYear(context.Background(), suite.userId, suite.year, 10)
+
+	require.NoError(err)
+	require.Equal([]domain.ReportBill{
+		{ID: 7, BudgetId: 4, BudgetName: "January", Month: 1, Description: "Rent", Category: domain.House, Amount: 1000, Payment: 1000},
+	}, res)
+}
+
+func (suite *TestSuite) TestSearchTopBillsByYearError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT bb.id, b.id AS budget_id,`)).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchTopBillsByYear(context.Background(), suite.userId, suite.year, 10)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,89 @@
+package spending
+
+import (
+	"context"
+	"your-accounts-api/budgets/domain"
+
+	"gorm.io/gorm"
+)
+
+// monthlySpendingCTE sums the bills by month and category, the months are numbered as
+// year * 12 + month - 1 so the previous month and year are plain subtractions. Only the latest
+// budget of each month is read, as SearchLatestByMonth does, so the copies of a budget are not
+// counted twice.
+const monthlySpendingCTE = `WITH latest AS (
+	SELECT MAX(id) AS id, year * 12 + month - 1 AS period
+	FROM budgets
+	WHERE user_id = @user AND deleted_at IS NULL AND year * 12 + month - 1 BETWEEN @since AND @to
+	GROUP BY year, month
+), monthly AS (
+	SELECT l.period, b.year, b.month, bb.category,
+		SUM(bb.amount) AS amount, SUM(bb.payment) AS payment, COUNT(*) AS bills
+	FROM budget_bills bb
+	JOIN latest l ON l.id = bb.budget_id
+	JOIN budgets b ON b.id = l.id
+	WHERE bb.deleted_at IS NULL
+	GROUP BY l.period, b.year, b.month, bb.category
+), periods AS (
+	SELECT period FROM latest
+)`
+
+const monthlySpendingQuery = monthlySpendingCTE + `
+SELECT m.year, m.month, m.category, m.amount, m.payment, m.bills,
+	CASE WHEN pm.period IS NULL THEN NULL ELSE m.amount - COALESCE(lm.amount, 0) END AS month_over_month,
+	CASE WHEN py.period IS NULL THEN NULL ELSE m.amount - COALESCE(ly.amount, 0) END AS year_over_year
+FROM monthly m
+LEFT JOIN periods pm ON pm.period = m.period - 1
+LEFT JOIN monthly lm ON lm.period = m.period - 1 AND lm.category = m.category
+LEFT JOIN periods py ON py.period = m.period - 12
+LEFT JOIN monthly ly ON ly.period = m.period - 12 AND ly.category = m.category
+WHERE m.period >= @from
+ORDER BY m.period, m.category`
+
+const categorySpendingQuery = monthlySpendingCTE + `, categories AS (
+	SELECT DISTINCT category FROM monthly
+)
+SELECT c.category, SUM(COALESCE(m.amount, 0)) AS amount, SUM(COALESCE(m.payment, 0)) AS payment,
+	AVG(COALESCE(m.amount, 0)) AS average,
+	PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY COALESCE(m.amount, 0)) AS median
+FROM periods p
+CROSS JOIN categories c
+LEFT JOIN monthly m ON m.period = p.period AND m.category = c.category
+GROUP BY c.category
+ORDER BY amount DESC, c.category`
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+// SearchMonthlyByFilter also reads the year before the range to compute the deltas of its
+// first months.
+func (r *gormRepository) SearchMonthlyByFilter(ctx context.Context, filter domain.SpendingFilter) ([]domain.MonthlySpending, error) {
+	from, to := periods(filter)
+	var spendings []domain.MonthlySpending
+	params := map[string]any{"user": filter.UserId, "since": from - 12, "from": from, "to": to}
+	if err := r.db.WithContext(ctx).Raw(monthlySpendingQuery, params).Scan(&spendings).Error; err != nil {
+		return nil, err
+	}
+
+	return spendings, nil
+}
+
+func (r *gormRepository) SearchCategoriesByFilter(ctx context.Context, filter domain.SpendingFilter) ([]domain.CategorySpending, error) {
+	from, to := periods(filter)
+	var spendings []domain.CategorySpending
+	params := map[string]any{"user": filter.UserId, "since": from, "to": to}
+	if err := r.db.WithContext(ctx).Raw(categorySpendingQuery, params).Scan(&spendings).Error; err != nil {
+		return nil, err
+	}
+
+	return spendings, nil
+}
+
+func periods(filter domain.SpendingFilter) (int, int) {
+	return int(filter.FromYear)*12 + int(filter.FromMonth) - 1, int(filter.ToYear)*12 + int(filter.ToMonth) - 1
+}
+
+func NewRepository(db *gorm.DB) domain.SpendingRepository {
+	return &gormRepository{db}
+}
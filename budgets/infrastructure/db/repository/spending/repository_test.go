@@ -0,0 +1,126 @@
+package spending
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"your-accounts-api/budgets/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	filter     domain.SpendingFilter
+	mock       sqlmock.Sqlmock
+	repository domain.SpendingRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.filter = domain.SpendingFilter{
+		UserId:    1,
+		FromYear:  2024,
+		FromMonth: 1,
+		ToYear:    2024,
+		ToMonth:   6,
+	}
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestSearchMonthlyByFilterSuccess() {
+	require := require.New(suite.T())
+	from, since, to := 24288, 24276, 24293
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`WITH latest AS ( SELECT MAX(id) AS id`)+`(.|\n)+`+regexp.QuoteMeta(`WHERE m.period >= $4 ORDER BY m.period, m.category`)).
+		WithArgs(suite.filter.UserId, since, to, from).
+		WillReturnRows(sqlmock.NewRows([]string{"year", "month", "category", "amount", "payment", "bills", "month_over_month", "year_over_year"}).
+			AddRow(2024, 1, "house", 120.0, 100.0, 2, nil, 20.0).
+			AddRow(2024, 2, "house", 150.0, 150.0, 2, 30.0, nil))
+
+	res, err := suite.repository.SearchMonthlyByFilter(context.Background(), suite.filter)
+
+	require.NoError(err)
+	require.Len(res, 2)
+	require.Equal(uint16(2024), res[0].Year)
+	require.Equal(uint8(1), res[0].Month)
+	require.Equal(domain.House, res[0].Category)
+	require.Equal(120.0, res[0].Amount)
+	require.Equal(uint(2), res[0].Bills)
+	require.Nil(res[0].MonthOverMonth)
+	require.Equal(20.0, *res[0].YearOverYear)
+	require.Equal(30.0, *res[1].MonthOverMonth)
+	require.Nil(res[1].YearOverYear)
+}
+
+func (suite *TestSuite) TestSearchMonthlyByFilterError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`WITH latest AS ( SELECT MAX(id) AS id`)).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchMonthlyByFilter(context.Background(), suite.filter)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchCategoriesByFilterSuccess() {
+	require := require.New(suite.T())
+	from, to := 24288, 24293
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`WITH latest AS ( SELECT MAX(id) AS id`)+`(.|\n)+`+regexp.QuoteMeta(`PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY COALESCE(m.amount, 0)) AS median`)).
+		WithArgs(suite.filter.UserId, from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"category", "amount", "payment", "average", "median"}).
+			AddRow("house", 270.0, 250.0, 135.0, 135.0).
+			AddRow("services", 40.0, 40.0, 20.0, 20.0))
+
+	res, err := suite.repository.SearchCategoriesByFilter(context.Background(), suite.filter)
+
+	require.NoError(err)
+	require.Equal([]domain.CategorySpending{
+		{Category: domain.House, Amount: 270, Payment: 250, Average: 135, Median: 135},
+		{Category: domain.Services, Amount: 40, Payment: 40, Average: 20, Median: 20},
+	}, res)
+}
+
+func (suite *TestSuite) TestSearchCategoriesByFilterError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`WITH latest AS ( SELECT MAX(id) AS id`)).
+		WillReturnError(gorm.ErrInvalidField)
+
+	_, err := suite.repository.SearchCategoriesByFilter(context.Background(), suite.filter)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,267 @@
+package accounts
+
+import (
+	"errors"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type controller struct {
+	app application.IAccountApp
+}
+
+// AccountCreateHandler godoc
+//
+//	@Summary		Create account
+//	@Description	create a cash, bank or wallet account, its balance is recorded as the opening entry
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Access token"
+//	@Param			request			body		model.AccountRequest	true	"Account data"
+//	@Success		201				{object}	model.AccountResponse
+//	@Failure		400				{string}	string
+//	@Failure		401				{string}	string
+//	@Failure		422				{string}	string
+//	@Failure		500				{string}	string
+//	@Router			/api/v1/accounts/	[post]
+func (ctrl *controller) create(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestBody).(*model.AccountRequest)
+	userData := getUserData(c)
+
+	account, err := ctrl.app.Create(c.UserContext(), request.ToDomain(userData.ID))
+	if err != nil {
+		log.Error("Error creating account:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error creating account")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewAccountResponse(account))
+}
+
+// AccountReadHandler godoc
+//
+//	@Summary		Read accounts
+//	@Description	read the accounts of the authenticated user with their balance
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization		header		string	true	"Access token"
+//	@Success		200					{array}		model.AccountResponse
+//	@Failure		401					{string}	string
+//	@Failure		500					{string}	string
+//	@Router			/api/v1/accounts/	[get]
+func (ctrl *controller) read(c *fiber.Ctx) error {
+	userData := getUserData(c)
+
+	accounts, err := ctrl.app.FindByUserId(c.UserContext(), userData.ID)
+	if err != nil {
+		log.Error("Error reading accounts:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading accounts")
+	}
+
+	response := []model.AccountResponse{}
+	for _, account := range accounts {
+		response = append(response, model.NewAccountResponse(account))
+	}
+
+	return c.JSON(response)
+}
+
+// AccountEntriesHandler godoc
+//
+//	@Summary		Read account entries
+//	@Description	read the movements of the balance of an account from the newest
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization					header		string	true	"Access token"
+//	@Param			id								path		uint	true	"Account ID"
+//	@Success		200								{array}		model.AccountEntryResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/accounts/{id}/entries	[get]
+func (ctrl *controller) entries(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	entries, err := ctrl.app.FindEntries(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error reading account entries:", err)
+		return accountError(err, "Account not found", "Error reading account entries")
+	}
+
+	return c.JSON(model.NewAccountEntriesResponse(entries))
+}
+
+// AccountDeleteHandler godoc
+//
+//	@Summary		Delete account
+//	@Description	delete an account with its entries, its availables stay in the budgets
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization			header		string	true	"Access token"
+//	@Param			id						path		uint	true	"Account ID"
+//	@Success		204						{string}	string
+//	@Failure		400						{string}	string
+//	@Failure		401						{string}	string
+//	@Failure		404						{string}	string
+//	@Failure		500						{string}	string
+//	@Router			/api/v1/accounts/{id}	[delete]
+func (ctrl *controller) delete(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.Delete(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error deleting account:", err)
+		return accountError(err, "Account not found", "Error deleting account")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// AccountTransferHandler godoc
+//
+//	@Summary		Transfer between accounts
+//	@Description	move an amount between two accounts of the user, an entry is recorded in each account and
+//	@Description	the availables of both accounts in the budgets of the month get the new balances
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization				header		string					true	"Access token"
+//	@Param			request						body		model.TransferRequest	true	"Transfer data"
+//	@Success		201							{array}		model.AccountEntryResponse
+//	@Failure		400							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		422							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/api/v1/accounts/transfers	[post]
+func (ctrl *controller) transfer(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestBody).(*model.TransferRequest)
+	userData := getUserData(c)
+
+	entries, err := ctrl.app.Transfer(c.UserContext(), userData.ID, request.FromId, request.ToId, request.Amount, request.Description)
+	if err != nil {
+		log.Error("Error transferring between accounts:", err)
+		return accountError(err, "Account not found", "Error transferring between accounts")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewAccountEntriesResponse(entries))
+}
+
+// AccountReconcileHandler godoc
+//
+//	@Summary		Reconcile account
+//	@Description	set the real balance of an account, the difference is recorded as an adjustment entry
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization					header		string					true	"Access token"
+//	@Param			id								path		uint					true	"Account ID"
+//	@Param			request							body		model.ReconcileRequest	true	"Real balance"
+//	@Success		200								{object}	model.ReconciliationResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		422								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/accounts/{id}/reconcile	[put]
+func (ctrl *controller) reconcile(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestBody).(*model.ReconcileRequest)
+	userData := getUserData(c)
+
+	reconciliation, err := ctrl.app.Reconcile(c.UserContext(), userData.ID, uint(id), *request.Balance)
+	if err != nil {
+		log.Error("Error reconciling account:", err)
+		return accountError(err, "Account not found", "Error reconciling account")
+	}
+
+	return c.JSON(model.NewReconciliationResponse(reconciliation))
+}
+
+// AccountSyncHandler godoc
+//
+//	@Summary		Add accounts to a budget
+//	@Description	set in the budget an available per account with its balance, the new budgets get them when
+//	@Description	they are created and an available with the name of an account is taken by the account
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization							header		string	true	"Access token"
+//	@Param			budgetId								path		uint	true	"Budget ID"
+//	@Success		200										{array}		model.ReadByIDResponseAvailable
+//	@Failure		400										{string}	string
+//	@Failure		401										{string}	string
+//	@Failure		404										{string}	string
+//	@Failure		500										{string}	string
+//	@Router			/api/v1/accounts/budget/{budgetId}		[post]
+func (ctrl *controller) sync(c *fiber.Ctx) error {
+	budgetId, err := c.ParamsInt("budgetId")
+	if err != nil {
+		log.Error("Error getting param 'budgetId':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	availables, err := ctrl.app.Sync(c.UserContext(), userData.ID, uint(budgetId))
+	if err != nil {
+		log.Error("Error adding accounts to budget:", err)
+		return accountError(err, "Budget not found", "Error adding accounts to budget")
+	}
+
+	return c.JSON(model.NewAccountAvailablesResponse(availables))
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.AccountApp}
+
+	group := router.Group("/accounts")
+	group.Post("/", validation.RequestBodyValid(model.AccountRequest{}), controller.create)
+	group.Get("/", controller.read)
+	group.Post("/transfers", validation.RequestBodyValid(model.TransferRequest{}), controller.transfer)
+	group.Post("/budget/:budgetId<min(1)>", controller.sync)
+	group.Get("/:id<min(1)>/entries", controller.entries)
+	group.Put("/:id<min(1)>/reconcile", validation.RequestBodyValid(model.ReconcileRequest{}), controller.reconcile)
+	group.Delete("/:id<min(1)>", controller.delete)
+}
+
+func accountError(err error, notFound, message string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, notFound)
+	} else if errors.Is(err, application.ErrAccountTransferSame) {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	return fiber.NewError(fiber.StatusInternalServerError, message)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
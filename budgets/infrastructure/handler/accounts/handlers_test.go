@@ -0,0 +1,304 @@
+package accounts
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/budgets/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId  uint
+	account domain.Account
+	entry   domain.AccountEntry
+	app     *fiber.App
+	mock    *mocks_application.MockIAccountApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	suite.account = domain.Account{
+		ID:        2,
+		UserId:    suite.userId,
+		Name:      "Bank",
+		Type:      domain.Bank,
+		Balance:   1500,
+		CreatedAt: time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC),
+	}
+	suite.entry = domain.AccountEntry{
+		ID:          5,
+		AccountId:   suite.account.ID,
+		Type:        domain.Adjustment,
+		Amount:      -12.65,
+		Balance:     1487.35,
+		Description: "Conciliación",
+		CreatedAt:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIAccountApp(suite.T())
+	injection.AccountApp = suite.mock
+
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		return c.Next()
+	})
+	NewRoute(suite.app)
+}
+
+func (suite *TestSuite) send(method, target string, body any) (int, []byte) {
+	require := require.New(suite.T())
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(err)
+		reader = bytes.NewReader(data)
+	}
+
+	request := httptest.NewRequest(method, target, reader)
+	if body != nil {
+		request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	response, err := suite.app.Test(request)
+	require.NoError(err)
+	require.NotNil(response)
+
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	return response.StatusCode, resp
+}
+
+func (suite *TestSuite) TestCreate201() {
+	require := require.New(suite.T())
+	expected := suite.account
+	expected.ID = 0
+	expected.CreatedAt = time.Time{}
+	suite.mock.On("Create", mock.Anything, expected).Return(suite.account, nil)
+	expectedBody, err := json.Marshal(model.NewAccountResponse(suite.account))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/accounts/", model.AccountRequest{
+		Name:    suite.account.Name,
+		Type:    suite.account.Type,
+		Balance: suite.account.Balance,
+	})
+
+	require.Equal(fiber.StatusCreated, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreate422() {
+	require := require.New(suite.T())
+	expectedBody, err := json.Marshal([]*validation.ErrorResponse{
+		{
+			Field:      "AccountRequest.type",
+			Constraint: "oneof='cash' 'bank' 'wallet'",
+		},
+	})
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/accounts/", model.AccountRequest{
+		Name: suite.account.Name,
+		Type: "crypto",
+	})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestRead200() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByUserId", mock.Anything, suite.userId).Return([]domain.Account{suite.account}, nil)
+	expectedBody, err := json.Marshal([]model.AccountResponse{model.NewAccountResponse(suite.account)})
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodGet, "/accounts/", nil)
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestEntries200() {
+	require := require.New(suite.T())
+	entries := []domain.AccountEntry{suite.entry}
+	suite.mock.On("FindEntries", mock.Anything, suite.userId, suite.account.ID).Return(entries, nil)
+	expectedBody, err := json.Marshal(model.NewAccountEntriesResponse(entries))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodGet, "/accounts/2/entries", nil)
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestEntries404() {
+	require := require.New(suite.T())
+	suite.mock.On("FindEntries", mock.Anything, suite.userId, suite.account.ID).Return(nil, gorm.ErrRecordNotFound)
+
+	status, _ := suite.send(fiber.MethodGet, "/accounts/2/entries", nil)
+
+	require.Equal(fiber.StatusNotFound, status)
+}
+
+func (suite *TestSuite) TestDelete204() {
+	require := require.New(suite.T())
+	suite.mock.On("Delete", mock.Anything, suite.userId, suite.account.ID).Return(nil)
+
+	status, _ := suite.send(fiber.MethodDelete, "/accounts/2", nil)
+
+	require.Equal(fiber.StatusNoContent, status)
+}
+
+func (suite *TestSuite) TestTransfer201() {
+	require := require.New(suite.T())
+	cashId := uint(3)
+	entries := []domain.AccountEntry{
+		{ID: 6, AccountId: suite.account.ID, Type: domain.Transfer, Amount: -100, Balance: 1400, Description: "Savings", CounterpartId: &cashId},
+		{ID: 7, AccountId: cashId, Type: domain.Transfer, Amount: 100, Balance: 180, Description: "Savings", CounterpartId: &suite.account.ID},
+	}
+	suite.mock.On("Transfer", mock.Anything, suite.userId, suite.account.ID, cashId, 100.0, "Savings").Return(entries, nil)
+	expectedBody, err := json.Marshal(model.NewAccountEntriesResponse(entries))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/accounts/transfers", model.TransferRequest{
+		FromId:      suite.account.ID,
+		ToId:        cashId,
+		Amount:      100,
+		Description: "Savings",
+	})
+
+	require.Equal(fiber.StatusCreated, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestTransfer422() {
+	require := require.New(suite.T())
+	suite.mock.On("Transfer", mock.Anything, suite.userId, suite.account.ID, suite.account.ID, 100.0, "").Return(nil, application.ErrAccountTransferSame)
+
+	status, resp := suite.send(fiber.MethodPost, "/accounts/transfers", model.TransferRequest{
+		FromId: suite.account.ID,
+		ToId:   suite.account.ID,
+		Amount: 100,
+	})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal([]byte(application.ErrAccountTransferSame.Error()), resp)
+}
+
+func (suite *TestSuite) TestTransfer422Amount() {
+	require := require.New(suite.T())
+	expectedBody, err := json.Marshal([]*validation.ErrorResponse{
+		{
+			Field:      "TransferRequest.amount",
+			Constraint: "gt=0",
+		},
+	})
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/accounts/transfers", model.TransferRequest{
+		FromId: suite.account.ID,
+		ToId:   3,
+		Amount: -5,
+	})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestReconcile200() {
+	require := require.New(suite.T())
+	account := suite.account
+	account.Balance = 1487.35
+	reconciliation := application.Reconciliation{Account: account, Adjustment: &suite.entry}
+	suite.mock.On("Reconcile", mock.Anything, suite.userId, suite.account.ID, 1487.35).Return(reconciliation, nil)
+	expectedBody, err := json.Marshal(model.NewReconciliationResponse(reconciliation))
+	require.NoError(err)
+
+	balance := 1487.35
+	status, resp := suite.send(fiber.MethodPut, "/accounts/2/reconcile", model.ReconcileRequest{Balance: &balance})
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestReconcile422() {
+	require := require.New(suite.T())
+	expectedBody, err := json.Marshal([]*validation.ErrorResponse{
+		{
+			Field:      "ReconcileRequest.balance",
+			Constraint: "required",
+		},
+	})
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPut, "/accounts/2/reconcile", model.ReconcileRequest{})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestSync200() {
+	require := require.New(suite.T())
+	id := uint(10)
+	name := "Bank"
+	amount := 1500.0
+	budgetId := uint(4)
+	availables := []domain.BudgetAvailable{{ID: &id, Name: &name, Amount: &amount, BudgetId: &budgetId}}
+	suite.mock.On("Sync", mock.Anything, suite.userId, budgetId).Return(availables, nil)
+	expectedBody, err := json.Marshal(model.NewAccountAvailablesResponse(availables))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/accounts/budget/4", nil)
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestSync404() {
+	require := require.New(suite.T())
+	suite.mock.On("Sync", mock.Anything, suite.userId, uint(4)).Return(nil, gorm.ErrRecordNotFound)
+
+	status, resp := suite.send(fiber.MethodPost, "/accounts/budget/4", nil)
+
+	require.Equal(fiber.StatusNotFound, status)
+	require.Equal([]byte("Budget not found"), resp)
+}
+
+func (suite *TestSuite) TestSync500() {
+	require := require.New(suite.T())
+	suite.mock.On("Sync", mock.Anything, suite.userId, uint(4)).Return(nil, gorm.ErrInvalidField)
+
+	status, resp := suite.send(fiber.MethodPost, "/accounts/budget/4", nil)
+
+	require.Equal(fiber.StatusInternalServerError, status)
+	require.Equal([]byte("Error adding accounts to budget"), resp)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,64 @@
+package analytics
+
+import (
+	"errors"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type controller struct {
+	app application.ISpendingApp
+}
+
+// SpendingHandler godoc
+//
+//	@Summary		Spending by category and month
+//	@Description	aggregate the amounts and payments of the bills of the user by category for each month of the range,
+//	@Description	with the deltas against the month and the year before and the average and median per category
+//	@Tags			analytics
+//	@Produce		json
+//	@Param			Authorization				header		string	true	"Access token"
+//	@Param			from						query		string	true	"First month, in format YYYY-MM"
+//	@Param			to							query		string	true	"Last month, in format YYYY-MM, up to 36 months after from"
+//	@Success		200							{object}	model.SpendingResponse
+//	@Failure		400							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		422							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/api/v1/analytics/spending	[get]
+func (ctrl *controller) spending(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestQuery).(*model.SpendingRequest)
+	userData := getUserData(c)
+
+	spending, err := ctrl.app.FindByFilter(c.UserContext(), request.ToFilter(userData.ID))
+	if err != nil {
+		log.Error("Error reading spending:", err)
+		if errors.Is(err, application.ErrInvalidSpendingRange) {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading spending")
+	}
+
+	return c.JSON(model.NewSpendingResponse(spending))
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.SpendingApp}
+
+	group := router.Group("/analytics")
+	group.Get("/spending", validation.RequestQueryValid(model.SpendingRequest{}), controller.spending)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
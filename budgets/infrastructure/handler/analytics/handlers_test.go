@@ -0,0 +1,133 @@
+package analytics
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/budgets/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId   uint
+	filter   domain.SpendingFilter
+	spending application.Spending
+	app      *fiber.App
+	mock     *mocks_application.MockISpendingApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	suite.filter = domain.SpendingFilter{
+		UserId:    suite.userId,
+		FromYear:  2023,
+		FromMonth: 12,
+		ToYear:    2024,
+		ToMonth:   3,
+	}
+	delta := -20.0
+	suite.spending = application.Spending{
+		Months: []domain.MonthlySpending{
+			{Year: 2024, Month: 1, Category: domain.House, Amount: 100, Payment: 80, Bills: 2},
+			{Year: 2024, Month: 2, Category: domain.House, Amount: 80, Payment: 80, Bills: 2, MonthOverMonth: &delta},
+		},
+		Categories: []domain.CategorySpending{
+			{Category: domain.House, Amount: 180, Payment: 160, Average: 45, Median: 40},
+		},
+	}
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockISpendingApp(suite.T())
+	injection.SpendingApp = suite.mock
+
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		return c.Next()
+	})
+	NewRoute(suite.app)
+}
+
+func (suite *TestSuite) TestSpending200() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByFilter", mock.Anything, suite.filter).Return(suite.spending, nil)
+	expectedBody, err := json.Marshal(model.NewSpendingResponse(suite.spending))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/analytics/spending?from=2023-12&to=2024-03", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestSpending422() {
+	require := require.New(suite.T())
+	urls := []string{
+		"/analytics/spending",
+		"/analytics/spending?from=2024-01",
+		"/analytics/spending?from=2024-13&to=2024-03",
+		"/analytics/spending?from=2024-01-01&to=2024-03",
+	}
+
+	for _, url := range urls {
+		request := httptest.NewRequest(fiber.MethodGet, url, nil)
+		response, err := suite.app.Test(request)
+
+		require.NoError(err)
+		require.NotNil(response)
+		require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+	}
+}
+
+func (suite *TestSuite) TestSpending422Range() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByFilter", mock.Anything, mock.Anything).Return(application.Spending{}, application.ErrInvalidSpendingRange)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/analytics/spending?from=2024-03&to=2023-12", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func (suite *TestSuite) TestSpending500() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByFilter", mock.Anything, suite.filter).Return(application.Spending{}, gorm.ErrInvalidDB)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/analytics/spending?from=2023-12&to=2024-03", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,289 @@
+package cards
+
+import (
+	"errors"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type controller struct {
+	app application.ICreditCardApp
+}
+
+// CreditCardCreateHandler godoc
+//
+//	@Summary		Create credit card
+//	@Description	create a credit card, its statements close on the closing day and are due on the payment day
+//	@Tags			card
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Access token"
+//	@Param			request			body		model.CreditCardRequest	true	"Credit card data"
+//	@Success		201				{object}	model.CreditCardResponse
+//	@Failure		400				{string}	string
+//	@Failure		401				{string}	string
+//	@Failure		422				{string}	string
+//	@Failure		500				{string}	string
+//	@Router			/api/v1/cards/	[post]
+func (ctrl *controller) create(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestBody).(*model.CreditCardRequest)
+	userData := getUserData(c)
+
+	card, err := ctrl.app.Create(c.UserContext(), request.ToDomain(userData.ID))
+	if err != nil {
+		log.Error("Error creating credit card:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error creating credit card")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewCreditCardResponse(card))
+}
+
+// CreditCardReadHandler godoc
+//
+//	@Summary		Read credit cards
+//	@Description	read the credit cards of the authenticated user
+//	@Tags			card
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Access token"
+//	@Success		200				{array}		model.CreditCardResponse
+//	@Failure		401				{string}	string
+//	@Failure		500				{string}	string
+//	@Router			/api/v1/cards/	[get]
+func (ctrl *controller) read(c *fiber.Ctx) error {
+	userData := getUserData(c)
+
+	cards, err := ctrl.app.FindByUserId(c.UserContext(), userData.ID)
+	if err != nil {
+		log.Error("Error reading credit cards:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading credit cards")
+	}
+
+	response := []model.CreditCardResponse{}
+	for _, card := range cards {
+		response = append(response, model.NewCreditCardResponse(card))
+	}
+
+	return c.JSON(response)
+}
+
+// CreditCardDeleteHandler godoc
+//
+//	@Summary		Delete credit card
+//	@Description	delete a credit card with its statements and charges, the bills of the statements stay in the budgets
+//	@Tags			card
+//	@Produce		json
+//	@Param			Authorization			header		string	true	"Access token"
+//	@Param			id						path		uint	true	"Credit card ID"
+//	@Success		204						{string}	string
+//	@Failure		400						{string}	string
+//	@Failure		401						{string}	string
+//	@Failure		404						{string}	string
+//	@Failure		500						{string}	string
+//	@Router			/api/v1/cards/{id}		[delete]
+func (ctrl *controller) delete(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.Delete(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error deleting credit card:", err)
+		return cardError(err, "Credit card not found", "Error deleting credit card")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// CreditCardChargeHandler godoc
+//
+//	@Summary		Record charge
+//	@Description	record a charge in the statement of its date, the statement is added as a bill to the budget of
+//	@Description	its due month when the budget exists and its amount follows the charges
+//	@Tags			card
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization				header		string							true	"Access token"
+//	@Param			id							path		uint							true	"Credit card ID"
+//	@Param			request						body		model.CreditCardChargeRequest	true	"Charge data"
+//	@Success		201							{object}	model.CreditCardStatementResponse
+//	@Failure		400							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		409							{string}	string
+//	@Failure		422							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/api/v1/cards/{id}/charges	[post]
+func (ctrl *controller) charge(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestBody).(*model.CreditCardChargeRequest)
+	userData := getUserData(c)
+
+	statement, err := ctrl.app.RecordCharge(c.UserContext(), userData.ID, uint(id), request.ToDomain())
+	if err != nil {
+		log.Error("Error recording charge:", err)
+		return cardError(err, "Credit card not found", "Error recording charge")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewCreditCardStatementResponse(statement))
+}
+
+// CreditCardStatementsHandler godoc
+//
+//	@Summary		Read statements
+//	@Description	read the statements of a credit card from the newest cycle
+//	@Tags			card
+//	@Produce		json
+//	@Param			Authorization					header		string	true	"Access token"
+//	@Param			id								path		uint	true	"Credit card ID"
+//	@Success		200								{array}		model.CreditCardStatementResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/cards/{id}/statements	[get]
+func (ctrl *controller) statements(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	statements, err := ctrl.app.FindStatements(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error reading statements:", err)
+		return cardError(err, "Credit card not found", "Error reading statements")
+	}
+
+	response := []model.CreditCardStatementResponse{}
+	for _, statement := range statements {
+		response = append(response, model.NewCreditCardStatementResponse(statement))
+	}
+
+	return c.JSON(response)
+}
+
+// CreditCardChargesHandler godoc
+//
+//	@Summary		Read statement charges
+//	@Description	read the charges of a statement by date
+//	@Tags			card
+//	@Produce		json
+//	@Param			Authorization							header		string	true	"Access token"
+//	@Param			id										path		uint	true	"Statement ID"
+//	@Success		200										{array}		model.CreditCardChargeResponse
+//	@Failure		400										{string}	string
+//	@Failure		401										{string}	string
+//	@Failure		404										{string}	string
+//	@Failure		500										{string}	string
+//	@Router			/api/v1/cards/statements/{id}/charges	[get]
+func (ctrl *controller) charges(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	charges, err := ctrl.app.FindCharges(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error reading charges:", err)
+		return cardError(err, "Statement not found", "Error reading charges")
+	}
+
+	response := []model.CreditCardChargeResponse{}
+	for _, charge := range charges {
+		response = append(response, model.NewCreditCardChargeResponse(charge))
+	}
+
+	return c.JSON(response)
+}
+
+// CreditCardPayHandler godoc
+//
+//	@Summary		Pay statement
+//	@Description	pay the bill of a statement with an available of the same budget
+//	@Tags			card
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization						header		string						true	"Access token"
+//	@Param			id									path		uint						true	"Statement ID"
+//	@Param			request								body		model.PayStatementRequest	true	"Available to pay with"
+//	@Success		200									{object}	model.CreditCardStatementResponse
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		409									{string}	string
+//	@Failure		422									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/cards/statements/{id}/pay	[put]
+func (ctrl *controller) pay(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestBody).(*model.PayStatementRequest)
+	userData := getUserData(c)
+
+	statement, err := ctrl.app.PayStatement(c.UserContext(), userData.ID, uint(id), request.AvailableId)
+	if err != nil {
+		log.Error("Error paying statement:", err)
+		return cardError(err, "Statement not found", "Error paying statement")
+	}
+
+	return c.JSON(model.NewCreditCardStatementResponse(statement))
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.CreditCardApp}
+
+	group := router.Group("/cards")
+	group.Post("/", validation.RequestBodyValid(model.CreditCardRequest{}), controller.create)
+	group.Get("/", controller.read)
+	group.Get("/statements/:id<min(1)>/charges", controller.charges)
+	group.Put("/statements/:id<min(1)>/pay", validation.RequestBodyValid(model.PayStatementRequest{}), controller.pay)
+	group.Post("/:id<min(1)>/charges", validation.RequestBodyValid(model.CreditCardChargeRequest{}), controller.charge)
+	group.Get("/:id<min(1)>/statements", controller.statements)
+	group.Delete("/:id<min(1)>", controller.delete)
+}
+
+func cardError(err error, notFound, message string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, notFound)
+	} else if errors.Is(err, application.ErrCreditCardLimit) ||
+		errors.Is(err, application.ErrCreditCardStatementPaid) ||
+		errors.Is(err, application.ErrCreditCardStatementWithoutBill) {
+		return fiber.NewError(fiber.StatusConflict, err.Error())
+	} else if errors.Is(err, application.ErrCreditCardAvailable) {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	return fiber.NewError(fiber.StatusInternalServerError, message)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
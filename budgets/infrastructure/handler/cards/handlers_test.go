@@ -0,0 +1,312 @@
+package cards
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/budgets/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId    uint
+	card      domain.CreditCard
+	statement domain.CreditCardStatement
+	app       *fiber.App
+	mock      *mocks_application.MockICreditCardApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	billId := uint(7)
+	suite.card = domain.CreditCard{
+		ID:                2,
+		UserId:            suite.userId,
+		Name:              "Visa",
+		Limit:             2000,
+		ClosingDay:        25,
+		PaymentDay:        10,
+		MinimumPercentage: 5,
+		CreatedAt:         time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC),
+	}
+	suite.statement = domain.CreditCardStatement{
+		ID:             3,
+		CardId:         suite.card.ID,
+		Year:           2024,
+		Month:          3,
+		ClosingDate:    time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC),
+		DueDate:        time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC),
+		Total:          50,
+		MinimumPayment: 2.5,
+		BudgetBillId:   &billId,
+	}
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockICreditCardApp(suite.T())
+	injection.CreditCardApp = suite.mock
+
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		return c.Next()
+	})
+	NewRoute(suite.app)
+}
+
+func (suite *TestSuite) send(method, target string, body any) (int, []byte) {
+	require := require.New(suite.T())
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(err)
+		reader = bytes.NewReader(data)
+	}
+
+	request := httptest.NewRequest(method, target, reader)
+	if body != nil {
+		request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	response, err := suite.app.Test(request)
+	require.NoError(err)
+	require.NotNil(response)
+
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	return response.StatusCode, resp
+}
+
+func (suite *TestSuite) TestCreate201() {
+	require := require.New(suite.T())
+	expected := suite.card
+	expected.ID = 0
+	expected.CreatedAt = time.Time{}
+	suite.mock.On("Create", mock.Anything, expected).Return(suite.card, nil)
+	expectedBody, err := json.Marshal(model.NewCreditCardResponse(suite.card))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/cards/", model.CreditCardRequest{
+		Name:              suite.card.Name,
+		Limit:             suite.card.Limit,
+		ClosingDay:        suite.card.ClosingDay,
+		PaymentDay:        suite.card.PaymentDay,
+		MinimumPercentage: suite.card.MinimumPercentage,
+	})
+
+	require.Equal(fiber.StatusCreated, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreate422() {
+	require := require.New(suite.T())
+	expectedBody, err := json.Marshal([]*validation.ErrorResponse{
+		{
+			Field:      "CreditCardRequest.closingDay",
+			Constraint: "max=31",
+		},
+	})
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/cards/", model.CreditCardRequest{
+		Name:              suite.card.Name,
+		ClosingDay:        32,
+		PaymentDay:        suite.card.PaymentDay,
+		MinimumPercentage: suite.card.MinimumPercentage,
+	})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestRead200() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByUserId", mock.Anything, suite.userId).Return([]domain.CreditCard{suite.card}, nil)
+	expectedBody, err := json.Marshal([]model.CreditCardResponse{model.NewCreditCardResponse(suite.card)})
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodGet, "/cards/", nil)
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestDelete204() {
+	require := require.New(suite.T())
+	suite.mock.On("Delete", mock.Anything, suite.userId, suite.card.ID).Return(nil)
+
+	status, _ := suite.send(fiber.MethodDelete, "/cards/2", nil)
+
+	require.Equal(fiber.StatusNoContent, status)
+}
+
+func (suite *TestSuite) TestDelete404() {
+	require := require.New(suite.T())
+	suite.mock.On("Delete", mock.Anything, suite.userId, suite.card.ID).Return(gorm.ErrRecordNotFound)
+
+	status, _ := suite.send(fiber.MethodDelete, "/cards/2", nil)
+
+	require.Equal(fiber.StatusNotFound, status)
+}
+
+func (suite *TestSuite) TestCharge201() {
+	require := require.New(suite.T())
+	charge := domain.CreditCardCharge{
+		Description: "Groceries",
+		Amount:      50,
+		Date:        time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
+	}
+	suite.mock.On("RecordCharge", mock.Anything, suite.userId, suite.card.ID, charge).Return(suite.statement, nil)
+	expectedBody, err := json.Marshal(model.NewCreditCardStatementResponse(suite.statement))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/cards/2/charges", model.CreditCardChargeRequest{
+		Description: "Groceries",
+		Amount:      50,
+		Date:        "2024-03-10",
+	})
+
+	require.Equal(fiber.StatusCreated, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCharge409() {
+	require := require.New(suite.T())
+	suite.mock.On("RecordCharge", mock.Anything, suite.userId, suite.card.ID, mock.Anything).Return(domain.CreditCardStatement{}, application.ErrCreditCardLimit)
+
+	status, resp := suite.send(fiber.MethodPost, "/cards/2/charges", model.CreditCardChargeRequest{
+		Description: "TV",
+		Amount:      5000,
+		Date:        "2024-03-10",
+	})
+
+	require.Equal(fiber.StatusConflict, status)
+	require.Equal([]byte(application.ErrCreditCardLimit.Error()), resp)
+}
+
+func (suite *TestSuite) TestCharge422() {
+	require := require.New(suite.T())
+	expectedBody, err := json.Marshal([]*validation.ErrorResponse{
+		{
+			Field:      "CreditCardChargeRequest.date",
+			Constraint: "datetime=2006-01-02",
+		},
+	})
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/cards/2/charges", model.CreditCardChargeRequest{
+		Description: "Groceries",
+		Amount:      50,
+		Date:        "10/03/2024",
+	})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestStatements200() {
+	require := require.New(suite.T())
+	suite.mock.On("FindStatements", mock.Anything, suite.userId, suite.card.ID).Return([]domain.CreditCardStatement{suite.statement}, nil)
+	expectedBody, err := json.Marshal([]model.CreditCardStatementResponse{model.NewCreditCardStatementResponse(suite.statement)})
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodGet, "/cards/2/statements", nil)
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestStatements404() {
+	require := require.New(suite.T())
+	suite.mock.On("FindStatements", mock.Anything, suite.userId, suite.card.ID).Return(nil, gorm.ErrRecordNotFound)
+
+	status, _ := suite.send(fiber.MethodGet, "/cards/2/statements", nil)
+
+	require.Equal(fiber.StatusNotFound, status)
+}
+
+func (suite *TestSuite) TestCharges200() {
+	require := require.New(suite.T())
+	charges := []domain.CreditCardCharge{
+		{ID: 1, StatementId: suite.statement.ID, Description: "Groceries", Amount: 50, Date: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)},
+	}
+	suite.mock.On("FindCharges", mock.Anything, suite.userId, suite.statement.ID).Return(charges, nil)
+	expectedBody, err := json.Marshal([]model.CreditCardChargeResponse{model.NewCreditCardChargeResponse(charges[0])})
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodGet, "/cards/statements/3/charges", nil)
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestPay200() {
+	require := require.New(suite.T())
+	paid := suite.statement
+	paidAt := time.Date(2024, 4, 8, 0, 0, 0, 0, time.UTC)
+	availableId := uint(9)
+	paid.PaidAt = &paidAt
+	paid.AvailableId = &availableId
+	suite.mock.On("PayStatement", mock.Anything, suite.userId, suite.statement.ID, availableId).Return(paid, nil)
+	expectedBody, err := json.Marshal(model.NewCreditCardStatementResponse(paid))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPut, "/cards/statements/3/pay", model.PayStatementRequest{AvailableId: availableId})
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestPay409() {
+	require := require.New(suite.T())
+	suite.mock.On("PayStatement", mock.Anything, suite.userId, suite.statement.ID, uint(9)).Return(domain.CreditCardStatement{}, application.ErrCreditCardStatementPaid)
+
+	status, resp := suite.send(fiber.MethodPut, "/cards/statements/3/pay", model.PayStatementRequest{AvailableId: 9})
+
+	require.Equal(fiber.StatusConflict, status)
+	require.Equal([]byte(application.ErrCreditCardStatementPaid.Error()), resp)
+}
+
+func (suite *TestSuite) TestPay422() {
+	require := require.New(suite.T())
+	suite.mock.On("PayStatement", mock.Anything, suite.userId, suite.statement.ID, uint(9)).Return(domain.CreditCardStatement{}, application.ErrCreditCardAvailable)
+
+	status, _ := suite.send(fiber.MethodPut, "/cards/statements/3/pay", model.PayStatementRequest{AvailableId: 9})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+}
+
+func (suite *TestSuite) TestPay500() {
+	require := require.New(suite.T())
+	suite.mock.On("PayStatement", mock.Anything, suite.userId, suite.statement.ID, uint(9)).Return(domain.CreditCardStatement{}, gorm.ErrInvalidField)
+
+	status, resp := suite.send(fiber.MethodPut, "/cards/statements/3/pay", model.PayStatementRequest{AvailableId: 9})
+
+	require.Equal(fiber.StatusInternalServerError, status)
+	require.Equal([]byte("Error paying statement"), resp)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,314 @@
+package envelopes
+
+import (
+	"errors"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type controller struct {
+	app application.IEnvelopeApp
+}
+
+// EnvelopeModeHandler godoc
+//
+//	@Summary		Set zero-based mode
+//	@Description	turn on or off the zero-based mode of the budget, in this mode every unit of the income
+//	@Description	must be assigned to an envelope
+//	@Tags			budget
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization							header		string						true	"Access token"
+//	@Param			id										path		uint						true	"Budget ID"
+//	@Param			request									body		model.EnvelopeModeRequest	true	"Mode data"
+//	@Success		200										{object}	model.EnvelopeSummaryResponse
+//	@Failure		400										{string}	string
+//	@Failure		401										{string}	string
+//	@Failure		404										{string}	string
+//	@Failure		422										{string}	string
+//	@Failure		500										{string}	string
+//	@Router			/api/v1/budget/{id}/envelopes/mode		[put]
+func (ctrl *controller) setMode(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestBody).(*model.EnvelopeModeRequest)
+	userData := getUserData(c)
+
+	summary, err := ctrl.app.SetMode(c.UserContext(), userData.ID, uint(id), *request.ZeroBased)
+	if err != nil {
+		log.Error("Error setting zero-based mode:", err)
+		return envelopeError(err, "Budget not found", "Error setting zero-based mode")
+	}
+
+	return c.JSON(model.NewEnvelopeSummaryResponse(summary))
+}
+
+// EnvelopesHandler godoc
+//
+//	@Summary		Read envelopes
+//	@Description	read the income to be assigned of the budget with its envelopes, what was spent and what
+//	@Description	is available of each one
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization					header		string	true	"Access token"
+//	@Param			id								path		uint	true	"Budget ID"
+//	@Success		200								{object}	model.EnvelopeSummaryResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/budget/{id}/envelopes	[get]
+func (ctrl *controller) read(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	summary, err := ctrl.app.FindSummary(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error reading envelopes:", err)
+		return envelopeError(err, "Budget not found", "Error reading envelopes")
+	}
+
+	return c.JSON(model.NewEnvelopeSummaryResponse(summary))
+}
+
+// EnvelopeCreateHandler godoc
+//
+//	@Summary		Create envelope
+//	@Description	add an envelope for a category or for a bill of a zero-based budget with the money
+//	@Description	assigned to it
+//	@Tags			budget
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization					header		string					true	"Access token"
+//	@Param			id								path		uint					true	"Budget ID"
+//	@Param			request							body		model.EnvelopeRequest	true	"Envelope data"
+//	@Success		201								{object}	model.EnvelopeResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		409								{string}	string
+//	@Failure		422								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/budget/{id}/envelopes	[post]
+func (ctrl *controller) create(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestBody).(*model.EnvelopeRequest)
+	userData := getUserData(c)
+
+	envelope, err := ctrl.app.Create(c.UserContext(), userData.ID, request.ToDomain(uint(id)))
+	if err != nil {
+		log.Error("Error creating envelope:", err)
+		return envelopeError(err, "Budget not found", "Error creating envelope")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewEnvelopeResponse(envelope))
+}
+
+// EnvelopeAssignHandler godoc
+//
+//	@Summary		Assign envelope
+//	@Description	set the money assigned to the envelope, it can not exceed the income to be assigned
+//	@Tags			budget
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization										header		string						true	"Access token"
+//	@Param			id													path		uint						true	"Budget ID"
+//	@Param			envelopeId											path		uint						true	"Envelope ID"
+//	@Param			request												body		model.AssignEnvelopeRequest	true	"Assign data"
+//	@Success		200													{object}	model.EnvelopeSummaryResponse
+//	@Failure		400													{string}	string
+//	@Failure		401													{string}	string
+//	@Failure		404													{string}	string
+//	@Failure		409													{string}	string
+//	@Failure		422													{string}	string
+//	@Failure		500													{string}	string
+//	@Router			/api/v1/budget/{id}/envelopes/{envelopeId}/assign	[put]
+func (ctrl *controller) assign(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	envelopeId, err := c.ParamsInt("envelopeId")
+	if err != nil {
+		log.Error("Error getting param 'envelopeId':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestBody).(*model.AssignEnvelopeRequest)
+	userData := getUserData(c)
+
+	summary, err := ctrl.app.Assign(c.UserContext(), userData.ID, uint(id), uint(envelopeId), *request.Assigned)
+	if err != nil {
+		log.Error("Error assigning envelope:", err)
+		return envelopeError(err, "Envelope not found", "Error assigning envelope")
+	}
+
+	return c.JSON(model.NewEnvelopeSummaryResponse(summary))
+}
+
+// EnvelopeMoveHandler godoc
+//
+//	@Summary		Move money between envelopes
+//	@Description	move money not spent of an envelope to another envelope of the budget
+//	@Tags			budget
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization						header		string						true	"Access token"
+//	@Param			id									path		uint						true	"Budget ID"
+//	@Param			request								body		model.MoveEnvelopeRequest	true	"Move data"
+//	@Success		200									{object}	model.EnvelopeSummaryResponse
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		409									{string}	string
+//	@Failure		422									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/budget/{id}/envelopes/moves	[post]
+func (ctrl *controller) move(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestBody).(*model.MoveEnvelopeRequest)
+	userData := getUserData(c)
+
+	summary, err := ctrl.app.Move(c.UserContext(), userData.ID, uint(id), request.FromId, request.ToId, request.Amount)
+	if err != nil {
+		log.Error("Error moving money between envelopes:", err)
+		return envelopeError(err, "Envelope not found", "Error moving money between envelopes")
+	}
+
+	return c.JSON(model.NewEnvelopeSummaryResponse(summary))
+}
+
+// EnvelopeDeleteHandler godoc
+//
+//	@Summary		Delete envelope
+//	@Description	delete the envelope, its money goes back to the income to be assigned
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization								header		string	true	"Access token"
+//	@Param			id											path		uint	true	"Budget ID"
+//	@Param			envelopeId									path		uint	true	"Envelope ID"
+//	@Success		204											{string}	string
+//	@Failure		400											{string}	string
+//	@Failure		401											{string}	string
+//	@Failure		404											{string}	string
+//	@Failure		409											{string}	string
+//	@Failure		500											{string}	string
+//	@Router			/api/v1/budget/{id}/envelopes/{envelopeId}	[delete]
+func (ctrl *controller) delete(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	envelopeId, err := c.ParamsInt("envelopeId")
+	if err != nil {
+		log.Error("Error getting param 'envelopeId':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.Delete(c.UserContext(), userData.ID, uint(id), uint(envelopeId))
+	if err != nil {
+		log.Error("Error deleting envelope:", err)
+		return envelopeError(err, "Envelope not found", "Error deleting envelope")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// EnvelopeRolloverHandler godoc
+//
+//	@Summary		Rollover envelopes
+//	@Description	bring the envelopes of the budget into the latest budget of the next month, the
+//	@Description	overspending of each envelope is carried as a negative amount
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization							header		string	true	"Access token"
+//	@Param			id										path		uint	true	"Budget ID"
+//	@Success		200										{object}	model.EnvelopeSummaryResponse
+//	@Failure		400										{string}	string
+//	@Failure		401										{string}	string
+//	@Failure		404										{string}	string
+//	@Failure		409										{string}	string
+//	@Failure		500										{string}	string
+//	@Router			/api/v1/budget/{id}/envelopes/rollover	[post]
+func (ctrl *controller) rollover(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	summary, err := ctrl.app.Rollover(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error rolling over envelopes:", err)
+		return envelopeError(err, "Budget not found", "Error rolling over envelopes")
+	}
+
+	return c.JSON(model.NewEnvelopeSummaryResponse(summary))
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.EnvelopeApp}
+
+	group := router.Group("/:id<min(1)>/envelopes")
+	group.Put("/mode", validation.RequestBodyValid(model.EnvelopeModeRequest{}), controller.setMode)
+	group.Get("/", controller.read)
+	group.Post("/", validation.RequestBodyValid(model.EnvelopeRequest{}), controller.create)
+	group.Put("/:envelopeId<min(1)>/assign", validation.RequestBodyValid(model.AssignEnvelopeRequest{}), controller.assign)
+	group.Post("/moves", validation.RequestBodyValid(model.MoveEnvelopeRequest{}), controller.move)
+	group.Post("/rollover", controller.rollover)
+	group.Delete("/:envelopeId<min(1)>", controller.delete)
+}
+
+func envelopeError(err error, notFound, message string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, notFound)
+	} else if errors.Is(err, application.ErrEnvelopeMode) || errors.Is(err, application.ErrEnvelopeExists) {
+		return fiber.NewError(fiber.StatusConflict, err.Error())
+	} else if errors.Is(err, application.ErrEnvelopeTarget) || errors.Is(err, application.ErrEnvelopeOverAssigned) ||
+		errors.Is(err, application.ErrEnvelopeFunds) || errors.Is(err, application.ErrEnvelopeSame) {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	return fiber.NewError(fiber.StatusInternalServerError, message)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
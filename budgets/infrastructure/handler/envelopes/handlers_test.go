@@ -0,0 +1,280 @@
+package envelopes
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/budgets/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId   uint
+	budgetId uint
+	house    domain.BudgetBillCategory
+	envelope domain.BudgetEnvelope
+	summary  application.EnvelopeSummary
+	app      *fiber.App
+	mock     *mocks_application.MockIEnvelopeApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	suite.budgetId = 4
+	suite.house = domain.House
+	suite.envelope = domain.BudgetEnvelope{
+		ID:        1,
+		BudgetId:  suite.budgetId,
+		Name:      "house",
+		Category:  &suite.house,
+		Assigned:  200,
+		Carryover: -20,
+	}
+	suite.summary = application.EnvelopeSummary{
+		BudgetId:      suite.budgetId,
+		ZeroBased:     true,
+		Income:        3000,
+		TotalAssigned: 200,
+		ToBeAssigned:  2800,
+		Envelopes: []application.EnvelopeStatus{
+			{Envelope: suite.envelope, Spent: 230, Available: -50},
+		},
+	}
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIEnvelopeApp(suite.T())
+	injection.EnvelopeApp = suite.mock
+
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		return c.Next()
+	})
+	NewRoute(suite.app.Group("/budget"))
+}
+
+func (suite *TestSuite) send(method, target string, body any) (int, []byte) {
+	require := require.New(suite.T())
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(err)
+		reader = bytes.NewReader(data)
+	}
+
+	request := httptest.NewRequest(method, target, reader)
+	if body != nil {
+		request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	response, err := suite.app.Test(request)
+	require.NoError(err)
+	require.NotNil(response)
+
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	return response.StatusCode, resp
+}
+
+func (suite *TestSuite) TestSetMode200() {
+	require := require.New(suite.T())
+	zeroBased := true
+	suite.mock.On("SetMode", mock.Anything, suite.userId, suite.budgetId, true).Return(suite.summary, nil)
+	expectedBody, err := json.Marshal(model.NewEnvelopeSummaryResponse(suite.summary))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPut, "/budget/4/envelopes/mode", model.EnvelopeModeRequest{ZeroBased: &zeroBased})
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestSetMode422() {
+	require := require.New(suite.T())
+	expectedBody, err := json.Marshal([]*validation.ErrorResponse{
+		{
+			Field:      "EnvelopeModeRequest.zeroBased",
+			Constraint: "required",
+		},
+	})
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPut, "/budget/4/envelopes/mode", model.EnvelopeModeRequest{})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestRead200() {
+	require := require.New(suite.T())
+	suite.mock.On("FindSummary", mock.Anything, suite.userId, suite.budgetId).Return(suite.summary, nil)
+	expectedBody, err := json.Marshal(model.NewEnvelopeSummaryResponse(suite.summary))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodGet, "/budget/4/envelopes", nil)
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestRead409() {
+	require := require.New(suite.T())
+	suite.mock.On("FindSummary", mock.Anything, suite.userId, suite.budgetId).Return(application.EnvelopeSummary{}, application.ErrEnvelopeMode)
+
+	status, resp := suite.send(fiber.MethodGet, "/budget/4/envelopes", nil)
+
+	require.Equal(fiber.StatusConflict, status)
+	require.Equal([]byte(application.ErrEnvelopeMode.Error()), resp)
+}
+
+func (suite *TestSuite) TestCreate201() {
+	require := require.New(suite.T())
+	envelope := domain.BudgetEnvelope{BudgetId: suite.budgetId, Category: &suite.house, Assigned: 200}
+	suite.mock.On("Create", mock.Anything, suite.userId, envelope).Return(suite.envelope, nil)
+	expectedBody, err := json.Marshal(model.NewEnvelopeResponse(suite.envelope))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/budget/4/envelopes", model.EnvelopeRequest{Category: &suite.house, Assigned: 200})
+
+	require.Equal(fiber.StatusCreated, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreate422Target() {
+	require := require.New(suite.T())
+	billId := uint(7)
+	expectedBody, err := json.Marshal([]*validation.ErrorResponse{
+		{
+			Field:      "EnvelopeRequest.category",
+			Constraint: "excluded_with=BillId",
+		},
+	})
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/budget/4/envelopes", model.EnvelopeRequest{Category: &suite.house, BillId: &billId})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreate409() {
+	require := require.New(suite.T())
+	suite.mock.On("Create", mock.Anything, suite.userId, mock.Anything).Return(domain.BudgetEnvelope{}, application.ErrEnvelopeExists)
+
+	status, resp := suite.send(fiber.MethodPost, "/budget/4/envelopes", model.EnvelopeRequest{Category: &suite.house})
+
+	require.Equal(fiber.StatusConflict, status)
+	require.Equal([]byte(application.ErrEnvelopeExists.Error()), resp)
+}
+
+func (suite *TestSuite) TestAssign200() {
+	require := require.New(suite.T())
+	assigned := 250.0
+	suite.mock.On("Assign", mock.Anything, suite.userId, suite.budgetId, suite.envelope.ID, assigned).Return(suite.summary, nil)
+	expectedBody, err := json.Marshal(model.NewEnvelopeSummaryResponse(suite.summary))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPut, "/budget/4/envelopes/1/assign", model.AssignEnvelopeRequest{Assigned: &assigned})
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestAssign422() {
+	require := require.New(suite.T())
+	assigned := 5000.0
+	suite.mock.On("Assign", mock.Anything, suite.userId, suite.budgetId, suite.envelope.ID, assigned).Return(application.EnvelopeSummary{}, application.ErrEnvelopeOverAssigned)
+
+	status, resp := suite.send(fiber.MethodPut, "/budget/4/envelopes/1/assign", model.AssignEnvelopeRequest{Assigned: &assigned})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal([]byte(application.ErrEnvelopeOverAssigned.Error()), resp)
+}
+
+func (suite *TestSuite) TestMove200() {
+	require := require.New(suite.T())
+	suite.mock.On("Move", mock.Anything, suite.userId, suite.budgetId, uint(2), uint(1), 50.0).Return(suite.summary, nil)
+	expectedBody, err := json.Marshal(model.NewEnvelopeSummaryResponse(suite.summary))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/budget/4/envelopes/moves", model.MoveEnvelopeRequest{FromId: 2, ToId: 1, Amount: 50})
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestMove422() {
+	require := require.New(suite.T())
+	suite.mock.On("Move", mock.Anything, suite.userId, suite.budgetId, uint(1), uint(2), 50.0).Return(application.EnvelopeSummary{}, application.ErrEnvelopeFunds)
+
+	status, resp := suite.send(fiber.MethodPost, "/budget/4/envelopes/moves", model.MoveEnvelopeRequest{FromId: 1, ToId: 2, Amount: 50})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal([]byte(application.ErrEnvelopeFunds.Error()), resp)
+}
+
+func (suite *TestSuite) TestDelete204() {
+	require := require.New(suite.T())
+	suite.mock.On("Delete", mock.Anything, suite.userId, suite.budgetId, suite.envelope.ID).Return(nil)
+
+	status, _ := suite.send(fiber.MethodDelete, "/budget/4/envelopes/1", nil)
+
+	require.Equal(fiber.StatusNoContent, status)
+}
+
+func (suite *TestSuite) TestDelete404() {
+	require := require.New(suite.T())
+	suite.mock.On("Delete", mock.Anything, suite.userId, suite.budgetId, suite.envelope.ID).Return(gorm.ErrRecordNotFound)
+
+	status, resp := suite.send(fiber.MethodDelete, "/budget/4/envelopes/1", nil)
+
+	require.Equal(fiber.StatusNotFound, status)
+	require.Equal([]byte("Envelope not found"), resp)
+}
+
+func (suite *TestSuite) TestRollover200() {
+	require := require.New(suite.T())
+	suite.mock.On("Rollover", mock.Anything, suite.userId, suite.budgetId).Return(suite.summary, nil)
+	expectedBody, err := json.Marshal(model.NewEnvelopeSummaryResponse(suite.summary))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/budget/4/envelopes/rollover", nil)
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestRollover500() {
+	require := require.New(suite.T())
+	suite.mock.On("Rollover", mock.Anything, suite.userId, suite.budgetId).Return(application.EnvelopeSummary{}, gorm.ErrInvalidField)
+
+	status, resp := suite.send(fiber.MethodPost, "/budget/4/envelopes/rollover", nil)
+
+	require.Equal(fiber.StatusInternalServerError, status)
+	require.Equal([]byte("Error rolling over envelopes"), resp)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
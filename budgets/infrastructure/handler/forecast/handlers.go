@@ -0,0 +1,74 @@
+package forecast
+
+import (
+	"errors"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type controller struct {
+	app application.IForecastApp
+}
+
+// ForecastHandler godoc
+//
+//	@Summary		Cash-flow forecast
+//	@Description	project the income, the committed recurring bills and the leftover of the next months from the latest
+//	@Description	budgets, the overrides only change the forecast and are not saved
+//	@Tags			forecast
+//	@Produce		json
+//	@Param			Authorization		header		string		true	"Access token"
+//	@Param			months				query		int			true	"Months to project, up to 24"
+//	@Param			fixedIncome			query		number		false	"Fixed income to use instead of the one of the latest budget"
+//	@Param			additionalIncome	query		number		false	"Additional income to use instead of the average one"
+//	@Param			bill				query		[]string	false	"Bill as description:amount, replaces the recurring one with the description and 0 removes it"	collectionFormat(multi)
+//	@Success		200					{object}	model.ForecastResponse
+//	@Failure		400					{string}	string
+//	@Failure		401					{string}	string
+//	@Failure		409					{string}	string
+//	@Failure		422					{string}	string
+//	@Failure		500					{string}	string
+//	@Router			/api/v1/forecast	[get]
+func (ctrl *controller) forecast(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestQuery).(*model.ForecastRequest)
+	userData := getUserData(c)
+
+	overrides, err := request.ToOverrides()
+	if err != nil {
+		log.Error("Error reading forecast overrides:", err)
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	forecast, err := ctrl.app.Project(c.UserContext(), userData.ID, request.Months, overrides)
+	if err != nil {
+		log.Error("Error projecting forecast:", err)
+		if errors.Is(err, application.ErrForecastWithoutBudgets) {
+			return fiber.NewError(fiber.StatusConflict, err.Error())
+		} else if errors.Is(err, application.ErrInvalidForecastMonths) {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error projecting forecast")
+	}
+
+	return c.JSON(model.NewForecastResponse(forecast))
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.ForecastApp}
+
+	router.Get("/forecast", validation.RequestQueryValid(model.ForecastRequest{}), controller.forecast)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
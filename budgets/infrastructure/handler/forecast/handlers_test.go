@@ -0,0 +1,154 @@
+package forecast
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/budgets/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId   uint
+	forecast application.Forecast
+	app      *fiber.App
+	mock     *mocks_application.MockIForecastApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	suite.forecast = application.Forecast{
+		Bills: []application.ForecastBill{
+			{Description: "Rent", Category: domain.House, Amount: 700},
+		},
+		Months: []application.ForecastMonth{
+			{Year: 2024, Month: 7, FixedIncome: 600, AdditionalIncome: 50, Committed: 700, Leftover: -50, Shortfall: true},
+		},
+	}
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIForecastApp(suite.T())
+	injection.ForecastApp = suite.mock
+
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		return c.Next()
+	})
+	NewRoute(suite.app)
+}
+
+func (suite *TestSuite) TestForecast200() {
+	require := require.New(suite.T())
+	suite.mock.On("Project", mock.Anything, suite.userId, 1, application.ForecastOverrides{Bills: []application.ForecastBill{}}).Return(suite.forecast, nil)
+	expectedBody, err := json.Marshal(model.NewForecastResponse(suite.forecast))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/forecast?months=1", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestForecast200Overrides() {
+	require := require.New(suite.T())
+	fixedIncome := 800.0
+	overrides := application.ForecastOverrides{
+		FixedIncome: &fixedIncome,
+		Bills: []application.ForecastBill{
+			{Description: "Rent", Amount: 750},
+			{Description: "Car: insurance", Amount: 0},
+		},
+	}
+	suite.mock.On("Project", mock.Anything, suite.userId, 6, overrides).Return(suite.forecast, nil)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/forecast?months=6&fixedIncome=800&bill=Rent:750&bill=Car:%20insurance:0", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+}
+
+func (suite *TestSuite) TestForecast400() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/forecast?months=6&bill=Rent:abc", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusBadRequest, response.StatusCode)
+}
+
+func (suite *TestSuite) TestForecast409() {
+	require := require.New(suite.T())
+	suite.mock.On("Project", mock.Anything, suite.userId, 6, mock.Anything).Return(application.Forecast{}, application.ErrForecastWithoutBudgets)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/forecast?months=6", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+}
+
+func (suite *TestSuite) TestForecast422() {
+	require := require.New(suite.T())
+	urls := []string{
+		"/forecast",
+		"/forecast?months=25",
+		"/forecast?months=6&fixedIncome=-1",
+		"/forecast?months=6&bill=Rent",
+	}
+
+	for _, url := range urls {
+		request := httptest.NewRequest(fiber.MethodGet, url, nil)
+		response, err := suite.app.Test(request)
+
+		require.NoError(err)
+		require.NotNil(response)
+		require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+	}
+}
+
+func (suite *TestSuite) TestForecast500() {
+	require := require.New(suite.T())
+	suite.mock.On("Project", mock.Anything, suite.userId, 6, mock.Anything).Return(application.Forecast{}, gorm.ErrInvalidDB)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/forecast?months=6", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,234 @@
+package goals
+
+import (
+	"errors"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type controller struct {
+	app application.IGoalApp
+}
+
+// GoalCreateHandler godoc
+//
+//	@Summary		Create goal
+//	@Description	create a savings goal linked to a category of bills or to an available
+//	@Tags			goal
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string				true	"Access token"
+//	@Param			request			body		model.GoalRequest	true	"Goal data"
+//	@Success		201				{object}	model.GoalResponse
+//	@Failure		400				{string}	string
+//	@Failure		401				{string}	string
+//	@Failure		422				{string}	string
+//	@Failure		500				{string}	string
+//	@Router			/api/v1/goals/	[post]
+func (ctrl *controller) create(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestBody).(*model.GoalRequest)
+	userData := getUserData(c)
+
+	goal, err := ctrl.app.Create(c.UserContext(), request.ToDomain(userData.ID, 0))
+	if err != nil {
+		log.Error("Error creating goal:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error creating goal")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewGoalResponse(goal))
+}
+
+// GoalReadHandler godoc
+//
+//	@Summary		Read goals
+//	@Description	read the savings goals of the authenticated user
+//	@Tags			goal
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Access token"
+//	@Success		200				{array}		model.GoalResponse
+//	@Failure		401				{string}	string
+//	@Failure		500				{string}	string
+//	@Router			/api/v1/goals/	[get]
+func (ctrl *controller) read(c *fiber.Ctx) error {
+	userData := getUserData(c)
+
+	goals, err := ctrl.app.FindByUserId(c.UserContext(), userData.ID)
+	if err != nil {
+		log.Error("Error reading goals:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading goals")
+	}
+
+	response := []model.GoalResponse{}
+	for _, goal := range goals {
+		response = append(response, model.NewGoalResponse(goal))
+	}
+
+	return c.JSON(response)
+}
+
+// GoalReadByIdHandler godoc
+//
+//	@Summary		Read goal
+//	@Description	read a savings goal of the authenticated user
+//	@Tags			goal
+//	@Produce		json
+//	@Param			Authorization			header		string	true	"Access token"
+//	@Param			id						path		uint	true	"Goal ID"
+//	@Success		200						{object}	model.GoalResponse
+//	@Failure		400						{string}	string
+//	@Failure		401						{string}	string
+//	@Failure		404						{string}	string
+//	@Failure		500						{string}	string
+//	@Router			/api/v1/goals/{id}		[get]
+func (ctrl *controller) readById(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	goal, err := ctrl.app.FindById(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error reading goal:", err)
+		return goalError(err, "Error reading goal")
+	}
+
+	return c.JSON(model.NewGoalResponse(goal))
+}
+
+// GoalUpdateHandler godoc
+//
+//	@Summary		Update goal
+//	@Description	replace the data of a savings goal, the progress is computed again from its creation
+//	@Tags			goal
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization			header		string				true	"Access token"
+//	@Param			id						path		uint				true	"Goal ID"
+//	@Param			request					body		model.GoalRequest	true	"Goal data"
+//	@Success		200						{object}	model.GoalResponse
+//	@Failure		400						{string}	string
+//	@Failure		401						{string}	string
+//	@Failure		404						{string}	string
+//	@Failure		422						{string}	string
+//	@Failure		500						{string}	string
+//	@Router			/api/v1/goals/{id}		[put]
+func (ctrl *controller) update(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestBody).(*model.GoalRequest)
+	userData := getUserData(c)
+
+	goal, err := ctrl.app.Update(c.UserContext(), request.ToDomain(userData.ID, uint(id)))
+	if err != nil {
+		log.Error("Error updating goal:", err)
+		return goalError(err, "Error updating goal")
+	}
+
+	return c.JSON(model.NewGoalResponse(goal))
+}
+
+// GoalDeleteHandler godoc
+//
+//	@Summary		Delete goal
+//	@Description	delete a savings goal, the bills and availables linked to it are not changed
+//	@Tags			goal
+//	@Produce		json
+//	@Param			Authorization			header		string	true	"Access token"
+//	@Param			id						path		uint	true	"Goal ID"
+//	@Success		204						{string}	string
+//	@Failure		400						{string}	string
+//	@Failure		401						{string}	string
+//	@Failure		404						{string}	string
+//	@Failure		500						{string}	string
+//	@Router			/api/v1/goals/{id}		[delete]
+func (ctrl *controller) delete(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.Delete(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error deleting goal:", err)
+		return goalError(err, "Error deleting goal")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// GoalProgressHandler godoc
+//
+//	@Summary		Goal progress
+//	@Description	compute the saved amount of a goal from the budgets since its creation, the average monthly rate and
+//	@Description	the month it is reached at that rate
+//	@Tags			goal
+//	@Produce		json
+//	@Param			Authorization					header		string	true	"Access token"
+//	@Param			id								path		uint	true	"Goal ID"
+//	@Success		200								{object}	model.GoalProgressResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/goals/{id}/progress		[get]
+func (ctrl *controller) progress(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	progress, err := ctrl.app.Progress(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error reading goal progress:", err)
+		return goalError(err, "Error reading goal progress")
+	}
+
+	return c.JSON(model.NewGoalProgressResponse(progress))
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.GoalApp}
+
+	group := router.Group("/goals")
+	group.Post("/", validation.RequestBodyValid(model.GoalRequest{}), controller.create)
+	group.Get("/", controller.read)
+	group.Get("/:id<min(1)>", controller.readById)
+	group.Put("/:id<min(1)>", validation.RequestBodyValid(model.GoalRequest{}), controller.update)
+	group.Delete("/:id<min(1)>", controller.delete)
+	group.Get("/:id<min(1)>/progress", controller.progress)
+}
+
+func goalError(err error, message string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, "Goal not found")
+	}
+
+	return fiber.NewError(fiber.StatusInternalServerError, message)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
@@ -0,0 +1,313 @@
+package goals
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/budgets/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId uint
+	goal   domain.Goal
+	app    *fiber.App
+	mock   *mocks_application.MockIGoalApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	category := domain.Saving
+	suite.goal = domain.Goal{
+		ID:           2,
+		UserId:       suite.userId,
+		Name:         "Trip",
+		TargetAmount: 1000,
+		TargetDate:   time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		Category:     &category,
+		CreatedAt:    time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIGoalApp(suite.T())
+	injection.GoalApp = suite.mock
+
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		return c.Next()
+	})
+	NewRoute(suite.app)
+}
+
+func (suite *TestSuite) requestBody() []byte {
+	body, err := json.Marshal(model.GoalRequest{
+		Name:         suite.goal.Name,
+		TargetAmount: suite.goal.TargetAmount,
+		TargetDate:   "2024-12-31",
+		Category:     suite.goal.Category,
+	})
+	require.NoError(suite.T(), err)
+	return body
+}
+
+func (suite *TestSuite) TestCreate201() {
+	require := require.New(suite.T())
+	expected := suite.goal
+	expected.ID = 0
+	expected.CreatedAt = time.Time{}
+	suite.mock.On("Create", mock.Anything, expected).Return(suite.goal, nil)
+	expectedBody, err := json.Marshal(model.NewGoalResponse(suite.goal))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/goals/", bytes.NewReader(suite.requestBody()))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusCreated, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreate400() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodPost, "/goals/", nil)
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusBadRequest, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCreate422() {
+	require := require.New(suite.T())
+	available := "Savings account"
+	body, err := json.Marshal(model.GoalRequest{
+		Name:         suite.goal.Name,
+		TargetAmount: suite.goal.TargetAmount,
+		TargetDate:   "2024-12-31",
+		Category:     suite.goal.Category,
+		Available:    &available,
+	})
+	require.NoError(err)
+	expectedBody, err := json.Marshal([]*validation.ErrorResponse{
+		{
+			Field:      "GoalRequest.category",
+			Constraint: "excluded_with=Available",
+		},
+	})
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/goals/", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreate500() {
+	require := require.New(suite.T())
+	suite.mock.On("Create", mock.Anything, mock.Anything).Return(domain.Goal{}, gorm.ErrInvalidField)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/goals/", bytes.NewReader(suite.requestBody()))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal([]byte("Error creating goal"), resp)
+}
+
+func (suite *TestSuite) TestRead200() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByUserId", mock.Anything, suite.userId).Return([]domain.Goal{suite.goal}, nil)
+	expectedBody, err := json.Marshal([]model.GoalResponse{model.NewGoalResponse(suite.goal)})
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/goals/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestRead500() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByUserId", mock.Anything, suite.userId).Return(nil, gorm.ErrInvalidDB)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/goals/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func (suite *TestSuite) TestReadById200() {
+	require := require.New(suite.T())
+	suite.mock.On("FindById", mock.Anything, suite.userId, suite.goal.ID).Return(suite.goal, nil)
+	expectedBody, err := json.Marshal(model.NewGoalResponse(suite.goal))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/goals/2", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestReadById404() {
+	require := require.New(suite.T())
+	suite.mock.On("FindById", mock.Anything, suite.userId, suite.goal.ID).Return(domain.Goal{}, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/goals/2", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestUpdate200() {
+	require := require.New(suite.T())
+	expected := suite.goal
+	expected.CreatedAt = time.Time{}
+	suite.mock.On("Update", mock.Anything, expected).Return(suite.goal, nil)
+	expectedBody, err := json.Marshal(model.NewGoalResponse(suite.goal))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/goals/2", bytes.NewReader(suite.requestBody()))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestUpdate404() {
+	require := require.New(suite.T())
+	suite.mock.On("Update", mock.Anything, mock.Anything).Return(domain.Goal{}, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/goals/2", bytes.NewReader(suite.requestBody()))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDelete204() {
+	require := require.New(suite.T())
+	suite.mock.On("Delete", mock.Anything, suite.userId, suite.goal.ID).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/goals/2", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNoContent, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDelete500() {
+	require := require.New(suite.T())
+	suite.mock.On("Delete", mock.Anything, suite.userId, suite.goal.ID).Return(gorm.ErrInvalidTransaction)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/goals/2", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func (suite *TestSuite) TestProgress200() {
+	require := require.New(suite.T())
+	projectedDate := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	progress := application.GoalProgress{
+		Goal:          suite.goal,
+		Saved:         300,
+		Percentage:    30,
+		MonthlyRate:   100,
+		ProjectedDate: &projectedDate,
+		OnTrack:       true,
+		Contributions: []domain.GoalContribution{{Year: 2024, Month: 1, Amount: 300}},
+	}
+	suite.mock.On("Progress", mock.Anything, suite.userId, suite.goal.ID).Return(progress, nil)
+	expectedBody, err := json.Marshal(model.NewGoalProgressResponse(progress))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/goals/2/progress", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestProgress404() {
+	require := require.New(suite.T())
+	suite.mock.On("Progress", mock.Anything, suite.userId, suite.goal.ID).Return(application.GoalProgress{}, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/goals/2/progress", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
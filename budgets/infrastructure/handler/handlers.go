@@ -3,8 +3,20 @@ package handler
 import (
 	"errors"
 	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/handler/accounts"
+	"your-accounts-api/budgets/infrastructure/handler/analytics"
 	"your-accounts-api/budgets/infrastructure/handler/availables"
 	"your-accounts-api/budgets/infrastructure/handler/bills"
+	"your-accounts-api/budgets/infrastructure/handler/cards"
+	"your-accounts-api/budgets/infrastructure/handler/envelopes"
+	"your-accounts-api/budgets/infrastructure/handler/forecast"
+	"your-accounts-api/budgets/infrastructure/handler/goals"
+	"your-accounts-api/budgets/infrastructure/handler/loans"
+	"your-accounts-api/budgets/infrastructure/handler/reports"
+	"your-accounts-api/budgets/infrastructure/handler/snapshots"
+	"your-accounts-api/budgets/infrastructure/handler/splits"
+	"your-accounts-api/budgets/infrastructure/handler/stream"
+	"your-accounts-api/budgets/infrastructure/handler/trash"
 	"your-accounts-api/budgets/infrastructure/model"
 
 	"github.com/gofiber/fiber/v2/log"
@@ -218,6 +230,88 @@ func (ctrl *controller) delete(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusOK)
 }
 
+// BudgetUndoHandler godoc
+//
+//	@Summary		Undo budget changes
+//	@Description	Revert the last batch of changes of the budget
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization				header		string	true	"Access token"
+//	@Param			id							path		uint	true	"Budget ID"
+//	@Success		200							{string}	string
+//	@Failure		400							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		409							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/api/v1/budget/{id}/undo	[post]
+func (ctrl *controller) undo(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	err = ctrl.app.Undo(c.UserContext(), uint(id))
+	if err != nil {
+		log.Error("Error undoing budget changes:", err)
+		return historyError(err, "Error undoing budget changes")
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// BudgetRedoHandler godoc
+//
+//	@Summary		Redo budget changes
+//	@Description	Apply again the last batch of changes undone in the budget
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization				header		string	true	"Access token"
+//	@Param			id							path		uint	true	"Budget ID"
+//	@Success		200							{string}	string
+//	@Failure		400							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		409							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/api/v1/budget/{id}/redo	[post]
+func (ctrl *controller) redo(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	err = ctrl.app.Redo(c.UserContext(), uint(id))
+	if err != nil {
+		log.Error("Error redoing budget changes:", err)
+		return historyError(err, "Error redoing budget changes")
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func historyError(err error, message string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, "Budget ID not found")
+	}
+
+	if errors.Is(err, application.ErrNothingToUndo) {
+		return fiber.NewError(fiber.StatusConflict, "There are no changes to undo")
+	}
+
+	if errors.Is(err, application.ErrNothingToRedo) {
+		return fiber.NewError(fiber.StatusConflict, "There are no changes to redo")
+	}
+
+	if errors.Is(err, application.ErrHistoryConflict) {
+		return fiber.NewError(fiber.StatusConflict, "The changes were modified later")
+	}
+
+	return fiber.NewError(fiber.StatusInternalServerError, message)
+}
+
 func NewRoute(router fiber.Router) {
 	controller := &controller{injection.BudgetApp}
 
@@ -227,10 +321,24 @@ func NewRoute(router fiber.Router) {
 	group.Get("/:id<min(1)>", controller.readById)
 	group.Put("/:id<min(1)>/changes", validation.RequestBodyValid(model.ChangesRequest{}), controller.changes)
 	group.Delete("/:id<min(1)>", controller.delete)
+	group.Post("/:id<min(1)>/undo", controller.undo)
+	group.Post("/:id<min(1)>/redo", controller.redo)
 
 	// Additional routes
 	availables.NewRoute(group)
 	bills.NewRoute(group)
+	snapshots.NewRoute(group)
+	stream.NewRoute(group)
+	splits.NewRoute(group)
+	envelopes.NewRoute(group)
+	trash.NewRoute(router)
+	analytics.NewRoute(router)
+	forecast.NewRoute(router)
+	goals.NewRoute(router)
+	loans.NewRoute(router)
+	cards.NewRoute(router)
+	accounts.NewRoute(router)
+	reports.NewRoute(router)
 }
 
 func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
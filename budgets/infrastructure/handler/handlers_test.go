@@ -543,6 +543,158 @@ func (suite *TestSuite) TestDelete500() {
 	require.Equal(expectedErr, resp)
 }
 
+func (suite *TestSuite) TestUndo200() {
+	require := require.New(suite.T())
+	suite.mock.On("Undo", mock.Anything, suite.budgetId).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/budget/%d/undo", suite.budgetId), nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+}
+
+func (suite *TestSuite) TestUndo404() {
+	require := require.New(suite.T())
+	suite.mock.On("Undo", mock.Anything, suite.budgetId).Return(gorm.ErrRecordNotFound)
+	expectedErr := []byte("Budget ID not found")
+
+	request := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/budget/%d/undo", suite.budgetId), nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestUndo409_1() {
+	require := require.New(suite.T())
+	suite.mock.On("Undo", mock.Anything, suite.budgetId).Return(application.ErrNothingToUndo)
+	expectedErr := []byte("There are no changes to undo")
+
+	request := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/budget/%d/undo", suite.budgetId), nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestUndo409_2() {
+	require := require.New(suite.T())
+	suite.mock.On("Undo", mock.Anything, suite.budgetId).Return(application.ErrHistoryConflict)
+	expectedErr := []byte("The changes were modified later")
+
+	request := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/budget/%d/undo", suite.budgetId), nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestUndo500() {
+	require := require.New(suite.T())
+	suite.mock.On("Undo", mock.Anything, suite.budgetId).Return(gorm.ErrInvalidField)
+	expectedErr := []byte("Error undoing budget changes")
+
+	request := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/budget/%d/undo", suite.budgetId), nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestRedo200() {
+	require := require.New(suite.T())
+	suite.mock.On("Redo", mock.Anything, suite.budgetId).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/budget/%d/redo", suite.budgetId), nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRedo404() {
+	require := require.New(suite.T())
+	suite.mock.On("Redo", mock.Anything, suite.budgetId).Return(gorm.ErrRecordNotFound)
+	expectedErr := []byte("Budget ID not found")
+
+	request := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/budget/%d/redo", suite.budgetId), nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestRedo409_1() {
+	require := require.New(suite.T())
+	suite.mock.On("Redo", mock.Anything, suite.budgetId).Return(application.ErrNothingToRedo)
+	expectedErr := []byte("There are no changes to redo")
+
+	request := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/budget/%d/redo", suite.budgetId), nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestRedo409_2() {
+	require := require.New(suite.T())
+	suite.mock.On("Redo", mock.Anything, suite.budgetId).Return(application.ErrHistoryConflict)
+	expectedErr := []byte("The changes were modified later")
+
+	request := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/budget/%d/redo", suite.budgetId), nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestRedo500() {
+	require := require.New(suite.T())
+	suite.mock.On("Redo", mock.Anything, suite.budgetId).Return(gorm.ErrInvalidField)
+	expectedErr := []byte("Error redoing budget changes")
+
+	request := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/budget/%d/redo", suite.budgetId), nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
@@ -0,0 +1,197 @@
+package loans
+
+import (
+	"errors"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type controller struct {
+	app application.ILoanApp
+}
+
+// LoanCreateHandler godoc
+//
+//	@Summary		Create loan
+//	@Description	create a loan paid by monthly installments from the month after its start date
+//	@Tags			loan
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string				true	"Access token"
+//	@Param			request			body		model.LoanRequest	true	"Loan data"
+//	@Success		201				{object}	model.LoanResponse
+//	@Failure		400				{string}	string
+//	@Failure		401				{string}	string
+//	@Failure		422				{string}	string
+//	@Failure		500				{string}	string
+//	@Router			/api/v1/loans/	[post]
+func (ctrl *controller) create(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestBody).(*model.LoanRequest)
+	userData := getUserData(c)
+
+	loan, err := ctrl.app.Create(c.UserContext(), request.ToDomain(userData.ID))
+	if err != nil {
+		log.Error("Error creating loan:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error creating loan")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewLoanResponse(loan))
+}
+
+// LoanReadHandler godoc
+//
+//	@Summary		Read loans
+//	@Description	read the loans of the authenticated user with their outstanding balance
+//	@Tags			loan
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Access token"
+//	@Success		200				{array}		model.LoanResponse
+//	@Failure		401				{string}	string
+//	@Failure		500				{string}	string
+//	@Router			/api/v1/loans/	[get]
+func (ctrl *controller) read(c *fiber.Ctx) error {
+	userData := getUserData(c)
+
+	loans, err := ctrl.app.FindByUserId(c.UserContext(), userData.ID)
+	if err != nil {
+		log.Error("Error reading loans:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading loans")
+	}
+
+	response := []model.LoanResponse{}
+	for _, loan := range loans {
+		response = append(response, model.NewLoanResponse(loan))
+	}
+
+	return c.JSON(response)
+}
+
+// LoanScheduleHandler godoc
+//
+//	@Summary		Loan schedule
+//	@Description	read the installments of a loan, the ones added to a budget carry their bill and its payment
+//	@Tags			loan
+//	@Produce		json
+//	@Param			Authorization					header		string	true	"Access token"
+//	@Param			id								path		uint	true	"Loan ID"
+//	@Success		200								{object}	model.LoanScheduleResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/loans/{id}/schedule		[get]
+func (ctrl *controller) schedule(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	schedule, err := ctrl.app.Schedule(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error reading loan schedule:", err)
+		return loanError(err, "Loan not found", "Error reading loan schedule")
+	}
+
+	return c.JSON(model.NewLoanScheduleResponse(schedule))
+}
+
+// LoanDeleteHandler godoc
+//
+//	@Summary		Delete loan
+//	@Description	delete a loan with its schedule, the bills of its installments stay in the budgets
+//	@Tags			loan
+//	@Produce		json
+//	@Param			Authorization			header		string	true	"Access token"
+//	@Param			id						path		uint	true	"Loan ID"
+//	@Success		204						{string}	string
+//	@Failure		400						{string}	string
+//	@Failure		401						{string}	string
+//	@Failure		404						{string}	string
+//	@Failure		500						{string}	string
+//	@Router			/api/v1/loans/{id}		[delete]
+func (ctrl *controller) delete(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.Delete(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error deleting loan:", err)
+		return loanError(err, "Loan not found", "Error deleting loan")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// LoanMaterializeHandler godoc
+//
+//	@Summary		Add installments to a budget
+//	@Description	add as bills the installments of the loans due in the month of the budget, the new budgets get
+//	@Description	them when they are created and the installments already added are skipped
+//	@Tags			loan
+//	@Produce		json
+//	@Param			Authorization							header		string	true	"Access token"
+//	@Param			budgetId								path		uint	true	"Budget ID"
+//	@Success		200										{array}		model.LoanInstallmentResponse
+//	@Failure		400										{string}	string
+//	@Failure		401										{string}	string
+//	@Failure		404										{string}	string
+//	@Failure		500										{string}	string
+//	@Router			/api/v1/loans/budget/{budgetId}		[post]
+func (ctrl *controller) materialize(c *fiber.Ctx) error {
+	budgetId, err := c.ParamsInt("budgetId")
+	if err != nil {
+		log.Error("Error getting param 'budgetId':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	installments, err := ctrl.app.Materialize(c.UserContext(), userData.ID, uint(budgetId))
+	if err != nil {
+		log.Error("Error adding loan installments:", err)
+		return loanError(err, "Budget not found", "Error adding loan installments")
+	}
+
+	return c.JSON(model.NewLoanInstallmentsResponse(installments))
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.LoanApp}
+
+	group := router.Group("/loans")
+	group.Post("/", validation.RequestBodyValid(model.LoanRequest{}), controller.create)
+	group.Get("/", controller.read)
+	group.Post("/budget/:budgetId<min(1)>", controller.materialize)
+	group.Get("/:id<min(1)>/schedule", controller.schedule)
+	group.Delete("/:id<min(1)>", controller.delete)
+}
+
+func loanError(err error, notFound, message string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, notFound)
+	}
+
+	return fiber.NewError(fiber.StatusInternalServerError, message)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
@@ -0,0 +1,270 @@
+package loans
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/budgets/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId uint
+	loan   domain.Loan
+	app    *fiber.App
+	mock   *mocks_application.MockILoanApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	suite.loan = domain.Loan{
+		ID:           2,
+		UserId:       suite.userId,
+		Name:         "Car",
+		Principal:    1000,
+		Rate:         12,
+		Term:         3,
+		StartDate:    time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		PaymentDay:   10,
+		Amortization: domain.French,
+		Balance:      1000,
+		CreatedAt:    time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockILoanApp(suite.T())
+	injection.LoanApp = suite.mock
+
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		return c.Next()
+	})
+	NewRoute(suite.app)
+}
+
+func (suite *TestSuite) requestBody(amortization domain.LoanAmortization) []byte {
+	body, err := json.Marshal(model.LoanRequest{
+		Name:         suite.loan.Name,
+		Principal:    suite.loan.Principal,
+		Rate:         suite.loan.Rate,
+		Term:         suite.loan.Term,
+		StartDate:    "2024-01-15",
+		PaymentDay:   suite.loan.PaymentDay,
+		Amortization: amortization,
+	})
+	require.NoError(suite.T(), err)
+	return body
+}
+
+func (suite *TestSuite) TestCreate201() {
+	require := require.New(suite.T())
+	expected := suite.loan
+	expected.ID = 0
+	expected.Balance = 0
+	expected.CreatedAt = time.Time{}
+	suite.mock.On("Create", mock.Anything, expected).Return(suite.loan, nil)
+	expectedBody, err := json.Marshal(model.NewLoanResponse(suite.loan))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/loans/", bytes.NewReader(suite.requestBody(domain.French)))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusCreated, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreate422() {
+	require := require.New(suite.T())
+	expectedBody, err := json.Marshal([]*validation.ErrorResponse{
+		{
+			Field:      "LoanRequest.amortization",
+			Constraint: "oneof='fixed' 'french' 'german'",
+		},
+	})
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/loans/", bytes.NewReader(suite.requestBody("american")))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreate500() {
+	require := require.New(suite.T())
+	suite.mock.On("Create", mock.Anything, mock.Anything).Return(domain.Loan{}, gorm.ErrInvalidField)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/loans/", bytes.NewReader(suite.requestBody(domain.German)))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal([]byte("Error creating loan"), resp)
+}
+
+func (suite *TestSuite) TestRead200() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByUserId", mock.Anything, suite.userId).Return([]domain.Loan{suite.loan}, nil)
+	expectedBody, err := json.Marshal([]model.LoanResponse{model.NewLoanResponse(suite.loan)})
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/loans/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestSchedule200() {
+	require := require.New(suite.T())
+	billId := uint(7)
+	schedule := application.LoanSchedule{
+		Loan: suite.loan,
+		Installments: []domain.LoanInstallment{
+			{Number: 1, Year: 2024, Month: 2, Payment: 340.02, Interest: 10, Principal: 330.02, Balance: 669.98, BudgetBillId: &billId, Paid: 340.02},
+		},
+	}
+	suite.mock.On("Schedule", mock.Anything, suite.userId, suite.loan.ID).Return(schedule, nil)
+	expectedBody, err := json.Marshal(model.NewLoanScheduleResponse(schedule))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/loans/2/schedule", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestSchedule404() {
+	require := require.New(suite.T())
+	suite.mock.On("Schedule", mock.Anything, suite.userId, suite.loan.ID).Return(application.LoanSchedule{}, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/loans/2/schedule", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDelete204() {
+	require := require.New(suite.T())
+	suite.mock.On("Delete", mock.Anything, suite.userId, suite.loan.ID).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/loans/2", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNoContent, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDelete404() {
+	require := require.New(suite.T())
+	suite.mock.On("Delete", mock.Anything, suite.userId, suite.loan.ID).Return(gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/loans/2", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestMaterialize200() {
+	require := require.New(suite.T())
+	billId := uint(7)
+	installments := []domain.LoanInstallment{
+		{Number: 2, Year: 2024, Month: 3, Payment: 340.02, Interest: 6.7, Principal: 333.32, Balance: 336.66, BudgetBillId: &billId},
+	}
+	suite.mock.On("Materialize", mock.Anything, suite.userId, uint(3)).Return(installments, nil)
+	expectedBody, err := json.Marshal(model.NewLoanInstallmentsResponse(installments))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/loans/budget/3", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestMaterialize404() {
+	require := require.New(suite.T())
+	suite.mock.On("Materialize", mock.Anything, suite.userId, uint(3)).Return(nil, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/loans/budget/3", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal([]byte("Budget not found"), resp)
+}
+
+func (suite *TestSuite) TestMaterialize500() {
+	require := require.New(suite.T())
+	suite.mock.On("Materialize", mock.Anything, suite.userId, uint(3)).Return(nil, gorm.ErrInvalidTransaction)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/loans/budget/3", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,138 @@
+package reports
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/pdf"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type controller struct {
+	app application.IReportApp
+}
+
+// AnnualReportHandler godoc
+//
+//	@Summary		Annual report
+//	@Description	summarize the budgets of the user in the year, for each month the income, the billed, the paid, the
+//	@Description	current pending of the budget, the savings and the totals by category, with the totals of the
+//	@Description	year and the 10 bills with the highest amounts. The savings are the income not paid in bills plus
+//	@Description	the payments of the saving category.
+//	@Tags			reports
+//	@Produce		json
+//	@Produce		application/pdf
+//	@Param			Authorization					header		string	true	"Access token"
+//	@Param			year							path		uint	true	"Year"
+//	@Param			format							query		string	false	"Format"	Enums(json, pdf)
+//	@Success		200								{object}	model.AnnualReportResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		422								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/reports/annual/{year}	[get]
+func (ctrl *controller) annual(c *fiber.Ctx) error {
+	year, err := c.ParamsInt("year")
+	if err != nil {
+		log.Error("Error getting param 'year':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestQuery).(*model.AnnualReportRequest)
+	userData := getUserData(c)
+
+	report, err := ctrl.app.FindAnnual(c.UserContext(), userData.ID, uint16(year))
+	if err != nil {
+		log.Error("Error reading annual report:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading annual report")
+	}
+
+	if request.Format == "pdf" {
+		c.Set(fiber.HeaderContentType, "application/pdf")
+		c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="annual-report-%d.pdf"`, year))
+		return c.Send(annualReportToPDF(report))
+	}
+
+	return c.JSON(model.NewAnnualReportResponse(report))
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.ReportApp}
+
+	group := router.Group("/reports")
+	group.Get("/annual/:year<range(1,9999)>", validation.RequestQueryValid(model.AnnualReportRequest{}), controller.annual)
+}
+
+// annualReportToPDF writes the months, the categories and the top bills in the first page and
+// the categories of each month in the next ones
+func annualReportToPDF(report application.AnnualReport) []byte {
+	document := pdf.New()
+	document.Title(fmt.Sprintf("Annual report %d", report.Year))
+	document.Space()
+
+	monthRow := "%-10s %12.2f %12.2f %12.2f %12.2f %12.2f"
+	document.Title("Months")
+	document.Title(fmt.Sprintf("%-10s %12s %12s %12s %12s %12s", "Month", "Income", "Billed", "Paid", "Pending", "Savings"))
+	for _, month := range report.Months {
+		document.Textf(monthRow, time.Month(month.Month), month.Income, month.Billed, month.Paid, month.Pending, month.Savings)
+	}
+	totals := report.Totals
+	document.Title(fmt.Sprintf(monthRow, "Total", totals.Income, totals.Billed, totals.Paid, totals.Pending, totals.Savings))
+	document.Space()
+
+	document.Title("Categories")
+	writeCategories(document, totals)
+	document.Space()
+
+	document.Title("Top bills")
+	document.Title(fmt.Sprintf("%-10s %-30s %-22s %12s %12s", "Month", "Description", "Category", "Amount", "Paid"))
+	for _, bill := range report.TopBills {
+		document.Textf(
+			"%-10s %-30s %-22s %12.2f %12.2f",
+			time.Month(bill.Month), truncate(bill.Description, 30), bill.Category, bill.Amount, bill.Payment,
+		)
+	}
+
+	document.PageBreak()
+	document.Title("Categories by month")
+	for _, month := range report.Months {
+		if len(month.Categories) == 0 {
+			continue
+		}
+
+		document.Space()
+		document.Title(time.Month(month.Month).String())
+		writeCategories(document, month.AnnualTotals)
+	}
+
+	return document.Bytes()
+}
+
+func writeCategories(document *pdf.Document, totals application.AnnualTotals) {
+	document.Title(fmt.Sprintf("%-22s %12s %12s", "Category", "Billed", "Paid"))
+	for _, category := range totals.Categories {
+		document.Textf("%-22s %12.2f %12.2f", category.Category, category.Amount, category.Payment)
+	}
+}
+
+func truncate(text string, size int) string {
+	if utf8.RuneCountInString(text) <= size {
+		return text
+	}
+
+	return string([]rune(text)[:size-3]) + "..."
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
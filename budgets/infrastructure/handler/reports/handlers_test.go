@@ -0,0 +1,141 @@
+package reports
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/budgets/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId uint
+	year   uint16
+	report application.AnnualReport
+	app    *fiber.App
+	mock   *mocks_application.MockIReportApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	suite.year = 2024
+	house := []application.AnnualCategory{{Category: domain.House, Amount: 1200, Payment: 1000}}
+	suite.report = application.AnnualReport{
+		Year: suite.year,
+		Months: []application.AnnualMonth{
+			{Month: 1, Budgets: 1, AnnualTotals: application.AnnualTotals{Income: 3000, Billed: 1200, Paid: 1000, Pending: 200, Savings: 2000, Categories: house}},
+			{Month: 2, AnnualTotals: application.AnnualTotals{Categories: []application.AnnualCategory{}}},
+		},
+		Totals: application.AnnualTotals{Income: 3000, Billed: 1200, Paid: 1000, Pending: 200, Savings: 2000, Categories: house},
+		TopBills: []domain.ReportBill{
+			{ID: 7, BudgetId: 4, BudgetName: "January", Month: 1, Description: "Rent (apartment)", Category: domain.House, Amount: 1000, Payment: 1000},
+		},
+	}
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIReportApp(suite.T())
+	injection.ReportApp = suite.mock
+
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		return c.Next()
+	})
+	NewRoute(suite.app)
+}
+
+func (suite *TestSuite) TestAnnual200() {
+	require := require.New(suite.T())
+	suite.mock.On("FindAnnual", mock.Anything, suite.userId, suite.year).Return(suite.report, nil)
+	expectedBody, err := json.Marshal(model.NewAnnualReportResponse(suite.report))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/reports/annual/2024", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestAnnual200PDF() {
+	require := require.New(suite.T())
+	suite.mock.On("FindAnnual", mock.Anything, suite.userId, suite.year).Return(suite.report, nil)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/reports/annual/2024?format=pdf", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	require.Equal("application/pdf", response.Header.Get(fiber.HeaderContentType))
+	require.Equal(`attachment; filename="annual-report-2024.pdf"`, response.Header.Get(fiber.HeaderContentDisposition))
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.True(bytes.HasPrefix(resp, []byte("%PDF-1.4\n")))
+	require.Contains(string(resp), "(Annual report 2024) '")
+	require.Contains(string(resp), "(January         3000.00      1200.00      1000.00       200.00      2000.00) '")
+	require.Contains(string(resp), `(January    Rent \(apartment\)`)
+}
+
+func (suite *TestSuite) TestAnnual422() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/reports/annual/2024?format=csv", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func (suite *TestSuite) TestAnnual404() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/reports/annual/99999", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestAnnual500() {
+	require := require.New(suite.T())
+	suite.mock.On("FindAnnual", mock.Anything, suite.userId, suite.year).Return(application.AnnualReport{}, gorm.ErrInvalidDB)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/reports/annual/2024", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,232 @@
+package snapshots
+
+import (
+	"errors"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/model"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2/log"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type controller struct {
+	app application.IBudgetSnapshotApp
+}
+
+// BudgetSnapshotsHandler godoc
+//
+//	@Summary		Read budget snapshots
+//	@Description	read the snapshots of a budget, newest first
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization					header		string	true	"Access token"
+//	@Param			id								path		uint	true	"Budget ID"
+//	@Success		200								{array}		model.SnapshotResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/budget/{id}/snapshots	[get]
+func (ctrl *controller) read(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	snapshots, err := ctrl.app.FindByBudgetId(c.UserContext(), uint(id))
+	if err != nil {
+		log.Error("Error reading budget snapshots:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "Budget ID not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading budget snapshots")
+	}
+
+	response := []model.SnapshotResponse{}
+	for _, snapshot := range snapshots {
+		response = append(response, model.NewSnapshotResponse(snapshot))
+	}
+
+	return c.JSON(response)
+}
+
+// BudgetSnapshotCreateHandler godoc
+//
+//	@Summary		Create budget snapshot
+//	@Description	keep the current state of a budget to restore it later
+//	@Tags			budget
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization					header		string						true	"Access token"
+//	@Param			id								path		uint						true	"Budget ID"
+//	@Param			request							body		model.CreateSnapshotRequest	true	"Snapshot data"
+//	@Success		201								{object}	model.CreateSnapshotResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		422								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/budget/{id}/snapshots	[post]
+func (ctrl *controller) create(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestBody).(*model.CreateSnapshotRequest)
+	snapshotId, err := ctrl.app.Create(c.UserContext(), uint(id), request.Description)
+	if err != nil {
+		log.Error("Error creating budget snapshot:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "Budget ID not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error creating budget snapshot")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewCreateSnapshotResponse(snapshotId))
+}
+
+// BudgetSnapshotReadByIdHandler godoc
+//
+//	@Summary		Read budget snapshot
+//	@Description	read the state of a budget kept in a snapshot
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization							header		string	true	"Access token"
+//	@Param			id										path		uint	true	"Budget ID"
+//	@Param			sid										path		uint	true	"Snapshot ID"
+//	@Success		200										{object}	model.ReadSnapshotResponse
+//	@Failure		400										{string}	string
+//	@Failure		401										{string}	string
+//	@Failure		404										{string}	string
+//	@Failure		500										{string}	string
+//	@Router			/api/v1/budget/{id}/snapshots/{sid}	[get]
+func (ctrl *controller) readById(c *fiber.Ctx) error {
+	id, sid, err := getParams(c)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := ctrl.app.FindById(c.UserContext(), id, sid)
+	if err != nil {
+		log.Error("Error reading budget snapshot:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "Snapshot ID not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading budget snapshot")
+	}
+
+	return c.JSON(model.NewReadSnapshotResponse(snapshot))
+}
+
+// BudgetSnapshotDiffHandler godoc
+//
+//	@Summary		Compare budget snapshots
+//	@Description	read the differences between two snapshots of a budget
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization						header		string	true	"Access token"
+//	@Param			id									path		uint	true	"Budget ID"
+//	@Param			from								query		uint	true	"Snapshot ID of the initial state"
+//	@Param			to									query		uint	true	"Snapshot ID of the final state"
+//	@Success		200									{object}	model.SnapshotDiffResponse
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		422									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/budget/{id}/snapshots/diff	[get]
+func (ctrl *controller) diff(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestQuery).(*model.SnapshotDiffRequest)
+	diff, err := ctrl.app.Diff(c.UserContext(), uint(id), request.From, request.To)
+	if err != nil {
+		log.Error("Error comparing budget snapshots:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "Snapshot ID not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error comparing budget snapshots")
+	}
+
+	return c.JSON(model.NewSnapshotDiffResponse(diff))
+}
+
+// BudgetSnapshotRestoreHandler godoc
+//
+//	@Summary		Restore budget snapshot
+//	@Description	return the budget, its availables, bills and envelopes to the state of a snapshot, it's refused when a bill created after the snapshot is linked to a loan, a credit card or a split
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization									header		string	true	"Access token"
+//	@Param			id												path		uint	true	"Budget ID"
+//	@Param			sid												path		uint	true	"Snapshot ID"
+//	@Success		200												{string}	string
+//	@Failure		400												{string}	string
+//	@Failure		401												{string}	string
+//	@Failure		404												{string}	string
+//	@Failure		409												{string}	string
+//	@Failure		500												{string}	string
+//	@Router			/api/v1/budget/{id}/snapshots/{sid}/restore	[post]
+func (ctrl *controller) restore(c *fiber.Ctx) error {
+	id, sid, err := getParams(c)
+	if err != nil {
+		return err
+	}
+
+	if err := ctrl.app.Restore(c.UserContext(), id, sid); err != nil {
+		log.Error("Error restoring budget snapshot:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "Snapshot ID not found")
+		}
+
+		if errors.Is(err, application.ErrSnapshotLinkedBills) {
+			return fiber.NewError(fiber.StatusConflict, "The bills created after the snapshot are linked to a loan, a credit card or a split")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error restoring budget snapshot")
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func getParams(c *fiber.Ctx) (uint, uint, error) {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return 0, 0, fiber.ErrBadRequest
+	}
+
+	sid, err := c.ParamsInt("sid")
+	if err != nil {
+		log.Error("Error getting param 'sid':", err)
+		return 0, 0, fiber.ErrBadRequest
+	}
+
+	return uint(id), uint(sid), nil
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.BudgetSnapshotApp}
+
+	group := router.Group("/:id<min(1)>/snapshots")
+	group.Get("/", controller.read)
+	group.Post("/", validation.RequestBodyValid(model.CreateSnapshotRequest{}), controller.create)
+	group.Get("/diff", validation.RequestQueryValid(model.SnapshotDiffRequest{}), controller.diff)
+	group.Get("/:sid<min(1)>", controller.readById)
+	group.Post("/:sid<min(1)>/restore", controller.restore)
+}
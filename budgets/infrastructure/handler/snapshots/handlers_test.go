@@ -0,0 +1,232 @@
+package snapshots
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/budgets/application"
+	"your-accounts-api/shared/infrastructure/injection"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	budgetId   uint
+	snapshotId uint
+	app        *fiber.App
+	mock       *mocks_application.MockIBudgetSnapshotApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.budgetId = 1
+	suite.snapshotId = 2
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIBudgetSnapshotApp(suite.T())
+	injection.BudgetSnapshotApp = suite.mock
+
+	suite.app = fiber.New()
+	NewRoute(suite.app.Group("/budget"))
+}
+
+func (suite *TestSuite) snapshot() domain.BudgetSnapshot {
+	name, year, month := "Test", uint16(2024), uint8(5)
+	zeroFloat, zeroUint := 0.0, uint8(0)
+	return domain.BudgetSnapshot{
+		ID:          suite.snapshotId,
+		BudgetId:    suite.budgetId,
+		Manual:      true,
+		Description: "Before moving",
+		CreatedAt:   time.Now(),
+		Budget: domain.Budget{
+			ID:               &suite.budgetId,
+			Name:             &name,
+			Year:             &year,
+			Month:            &month,
+			FixedIncome:      &zeroFloat,
+			AdditionalIncome: &zeroFloat,
+			TotalPending:     &zeroFloat,
+			TotalAvailable:   &zeroFloat,
+			PendingBills:     &zeroUint,
+		},
+	}
+}
+
+func (suite *TestSuite) TestRead200() {
+	require := require.New(suite.T())
+	snapshots := []domain.BudgetSnapshot{suite.snapshot()}
+	suite.mock.On("FindByBudgetId", mock.Anything, suite.budgetId).Return(snapshots, nil)
+	expectedBody, err := json.Marshal([]model.SnapshotResponse{model.NewSnapshotResponse(snapshots[0])})
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/budget/1/snapshots", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestRead404() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByBudgetId", mock.Anything, suite.budgetId).Return(nil, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/budget/1/snapshots", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCreate201() {
+	require := require.New(suite.T())
+	body, err := json.Marshal(model.CreateSnapshotRequest{Description: "Before moving"})
+	require.NoError(err)
+	suite.mock.On("Create", mock.Anything, suite.budgetId, "Before moving").Return(suite.snapshotId, nil)
+	expectedBody, err := json.Marshal(model.NewCreateSnapshotResponse(suite.snapshotId))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/budget/1/snapshots", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusCreated, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreate500() {
+	require := require.New(suite.T())
+	suite.mock.On("Create", mock.Anything, suite.budgetId, "").Return(uint(0), errors.New("connection lost"))
+
+	request := httptest.NewRequest(fiber.MethodPost, "/budget/1/snapshots", bytes.NewReader([]byte("{}")))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func (suite *TestSuite) TestReadById200() {
+	require := require.New(suite.T())
+	snapshot := suite.snapshot()
+	suite.mock.On("FindById", mock.Anything, suite.budgetId, suite.snapshotId).Return(snapshot, nil)
+	expectedBody, err := json.Marshal(model.NewReadSnapshotResponse(snapshot))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/budget/1/snapshots/2", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestReadById404() {
+	require := require.New(suite.T())
+	suite.mock.On("FindById", mock.Anything, suite.budgetId, suite.snapshotId).Return(domain.BudgetSnapshot{}, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/budget/1/snapshots/2", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDiff200() {
+	require := require.New(suite.T())
+	diff := application.SnapshotDiff{
+		From: suite.snapshot(),
+		To:   suite.snapshot(),
+		Main: []application.FieldChange{{Field: "name", From: "Old", To: "Test"}},
+	}
+	suite.mock.On("Diff", mock.Anything, suite.budgetId, uint(2), uint(3)).Return(diff, nil)
+	expectedBody, err := json.Marshal(model.NewSnapshotDiffResponse(diff))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/budget/1/snapshots/diff?from=2&to=3", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestDiff422() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/budget/1/snapshots/diff?from=2", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRestore200() {
+	require := require.New(suite.T())
+	suite.mock.On("Restore", mock.Anything, suite.budgetId, suite.snapshotId).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/budget/1/snapshots/2/restore", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRestore404() {
+	require := require.New(suite.T())
+	suite.mock.On("Restore", mock.Anything, suite.budgetId, suite.snapshotId).Return(gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/budget/1/snapshots/2/restore", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRestore409() {
+	require := require.New(suite.T())
+	suite.mock.On("Restore", mock.Anything, suite.budgetId, suite.snapshotId).Return(application.ErrSnapshotLinkedBills)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/budget/1/snapshots/2/restore", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRestore500() {
+	require := require.New(suite.T())
+	suite.mock.On("Restore", mock.Anything, suite.budgetId, suite.snapshotId).Return(errors.New("connection lost"))
+
+	request := httptest.NewRequest(fiber.MethodPost, "/budget/1/snapshots/2/restore", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,364 @@
+package splits
+
+import (
+	"errors"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type controller struct {
+	app application.IBillSplitApp
+}
+
+// BudgetMemberCreateHandler godoc
+//
+//	@Summary		Create budget member
+//	@Description	add a person of the household that shares the bills of the budget
+//	@Tags			budget
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization				header		string						true	"Access token"
+//	@Param			id							path		uint						true	"Budget ID"
+//	@Param			request						body		model.BudgetMemberRequest	true	"Member data"
+//	@Success		201							{object}	model.BudgetMemberResponse
+//	@Failure		400							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		422							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/api/v1/budget/{id}/members	[post]
+func (ctrl *controller) createMember(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestBody).(*model.BudgetMemberRequest)
+	userData := getUserData(c)
+
+	member, err := ctrl.app.CreateMember(c.UserContext(), userData.ID, uint(id), request.Name)
+	if err != nil {
+		log.Error("Error creating budget member:", err)
+		return splitError(err, "Budget not found", "Error creating budget member")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewBudgetMemberResponse(member))
+}
+
+// BudgetMembersHandler godoc
+//
+//	@Summary		Read budget members
+//	@Description	read the members of the budget
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization				header		string	true	"Access token"
+//	@Param			id							path		uint	true	"Budget ID"
+//	@Success		200							{array}		model.BudgetMemberResponse
+//	@Failure		400							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/api/v1/budget/{id}/members	[get]
+func (ctrl *controller) readMembers(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	members, err := ctrl.app.FindMembers(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error reading budget members:", err)
+		return splitError(err, "Budget not found", "Error reading budget members")
+	}
+
+	return c.JSON(model.NewBudgetMembersResponse(members))
+}
+
+// BudgetMemberDeleteHandler godoc
+//
+//	@Summary		Delete budget member
+//	@Description	delete a member of the budget without split bills or settlements
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization							header		string	true	"Access token"
+//	@Param			id										path		uint	true	"Budget ID"
+//	@Param			memberId								path		uint	true	"Member ID"
+//	@Success		204										{string}	string
+//	@Failure		400										{string}	string
+//	@Failure		401										{string}	string
+//	@Failure		404										{string}	string
+//	@Failure		409										{string}	string
+//	@Failure		500										{string}	string
+//	@Router			/api/v1/budget/{id}/members/{memberId}	[delete]
+func (ctrl *controller) deleteMember(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	memberId, err := c.ParamsInt("memberId")
+	if err != nil {
+		log.Error("Error getting param 'memberId':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.DeleteMember(c.UserContext(), userData.ID, uint(id), uint(memberId))
+	if err != nil {
+		log.Error("Error deleting budget member:", err)
+		return splitError(err, "Member not found", "Error deleting budget member")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// BillSplitSaveHandler godoc
+//
+//	@Summary		Split bill
+//	@Description	set how a bill is shared between the members of its budget and what each one paid, the
+//	@Description	percentages must add up to 100 and the fixed amounts to the amount of the bill
+//	@Tags			budget
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization						header		string					true	"Access token"
+//	@Param			billId								path		uint					true	"Bill ID"
+//	@Param			request								body		model.BillSplitRequest	true	"Split data"
+//	@Success		200									{object}	model.BillSplitResponse
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		422									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/budget/bill/{billId}/split	[put]
+func (ctrl *controller) saveSplit(c *fiber.Ctx) error {
+	billId, err := c.ParamsInt("billId")
+	if err != nil {
+		log.Error("Error getting param 'billId':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestBody).(*model.BillSplitRequest)
+	userData := getUserData(c)
+
+	split, err := ctrl.app.SaveSplit(c.UserContext(), userData.ID, request.ToDomain(uint(billId)))
+	if err != nil {
+		log.Error("Error splitting bill:", err)
+		return splitError(err, "Bill not found", "Error splitting bill")
+	}
+
+	return c.JSON(model.NewBillSplitResponse(split))
+}
+
+// BillSplitReadHandler godoc
+//
+//	@Summary		Read bill split
+//	@Description	read how a bill is shared between the members of its budget
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization						header		string	true	"Access token"
+//	@Param			billId								path		uint	true	"Bill ID"
+//	@Success		200									{object}	model.BillSplitResponse
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/budget/bill/{billId}/split	[get]
+func (ctrl *controller) readSplit(c *fiber.Ctx) error {
+	billId, err := c.ParamsInt("billId")
+	if err != nil {
+		log.Error("Error getting param 'billId':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	split, err := ctrl.app.FindSplit(c.UserContext(), userData.ID, uint(billId))
+	if err != nil {
+		log.Error("Error reading bill split:", err)
+		return splitError(err, "Bill split not found", "Error reading bill split")
+	}
+
+	return c.JSON(model.NewBillSplitResponse(split))
+}
+
+// BillSplitDeleteHandler godoc
+//
+//	@Summary		Delete bill split
+//	@Description	stop sharing a bill between the members of its budget
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization						header		string	true	"Access token"
+//	@Param			billId								path		uint	true	"Bill ID"
+//	@Success		204									{string}	string
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/budget/bill/{billId}/split	[delete]
+func (ctrl *controller) deleteSplit(c *fiber.Ctx) error {
+	billId, err := c.ParamsInt("billId")
+	if err != nil {
+		log.Error("Error getting param 'billId':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.DeleteSplit(c.UserContext(), userData.ID, uint(billId))
+	if err != nil {
+		log.Error("Error deleting bill split:", err)
+		return splitError(err, "Bill split not found", "Error deleting bill split")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// BudgetBalancesHandler godoc
+//
+//	@Summary		Read member balances
+//	@Description	read what each member paid and owes of the split bills of the budget with the settlements,
+//	@Description	a positive balance is owed to the member, and the payments that settle the balances
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization					header		string	true	"Access token"
+//	@Param			id								path		uint	true	"Budget ID"
+//	@Success		200								{object}	model.SplitBalancesResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/budget/{id}/balances	[get]
+func (ctrl *controller) balances(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	balances, err := ctrl.app.Balances(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error reading member balances:", err)
+		return splitError(err, "Budget not found", "Error reading member balances")
+	}
+
+	return c.JSON(model.NewSplitBalancesResponse(balances))
+}
+
+// BudgetSettlementCreateHandler godoc
+//
+//	@Summary		Create settlement
+//	@Description	record a payment between two members of the budget to settle their balances
+//	@Tags			budget
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization					header		string					true	"Access token"
+//	@Param			id								path		uint					true	"Budget ID"
+//	@Param			request							body		model.SettlementRequest	true	"Settlement data"
+//	@Success		201								{object}	model.SettlementResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		422								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/budget/{id}/settlements	[post]
+func (ctrl *controller) createSettlement(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	request := c.Locals(validation.RequestBody).(*model.SettlementRequest)
+	userData := getUserData(c)
+
+	settlement, err := ctrl.app.CreateSettlement(c.UserContext(), userData.ID, request.ToDomain(uint(id)))
+	if err != nil {
+		log.Error("Error creating settlement:", err)
+		return splitError(err, "Budget not found", "Error creating settlement")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewSettlementResponse(settlement))
+}
+
+// BudgetSettlementsHandler godoc
+//
+//	@Summary		Read settlements
+//	@Description	read the payments between the members of the budget
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization					header		string	true	"Access token"
+//	@Param			id								path		uint	true	"Budget ID"
+//	@Success		200								{array}		model.SettlementResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/budget/{id}/settlements	[get]
+func (ctrl *controller) readSettlements(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	settlements, err := ctrl.app.FindSettlements(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error reading settlements:", err)
+		return splitError(err, "Budget not found", "Error reading settlements")
+	}
+
+	return c.JSON(model.NewSettlementsResponse(settlements))
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.BillSplitApp}
+
+	router.Put("/bill/:billId<min(1)>/split", validation.RequestBodyValid(model.BillSplitRequest{}), controller.saveSplit)
+	router.Get("/bill/:billId<min(1)>/split", controller.readSplit)
+	router.Delete("/bill/:billId<min(1)>/split", controller.deleteSplit)
+
+	group := router.Group("/:id<min(1)>")
+	group.Post("/members", validation.RequestBodyValid(model.BudgetMemberRequest{}), controller.createMember)
+	group.Get("/members", controller.readMembers)
+	group.Delete("/members/:memberId<min(1)>", controller.deleteMember)
+	group.Get("/balances", controller.balances)
+	group.Post("/settlements", validation.RequestBodyValid(model.SettlementRequest{}), controller.createSettlement)
+	group.Get("/settlements", controller.readSettlements)
+}
+
+func splitError(err error, notFound, message string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, notFound)
+	} else if errors.Is(err, application.ErrMemberInUse) {
+		return fiber.NewError(fiber.StatusConflict, err.Error())
+	} else if errors.Is(err, application.ErrSplitMember) || errors.Is(err, application.ErrSplitShares) ||
+		errors.Is(err, application.ErrSplitPaid) || errors.Is(err, application.ErrSettlementSame) {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	return fiber.NewError(fiber.StatusInternalServerError, message)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
@@ -0,0 +1,289 @@
+package splits
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/budgets/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId   uint
+	budgetId uint
+	member   domain.BudgetMember
+	split    domain.BillSplit
+	app      *fiber.App
+	mock     *mocks_application.MockIBillSplitApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	suite.budgetId = 4
+	suite.member = domain.BudgetMember{
+		ID:        1,
+		BudgetId:  suite.budgetId,
+		Name:      "Ana",
+		CreatedAt: time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC),
+	}
+	suite.split = domain.BillSplit{
+		BudgetBillId: 7,
+		Method:       domain.Percentage,
+		Shares: []domain.BillShare{
+			{MemberId: 1, Value: 60, Paid: 1000},
+			{MemberId: 2, Value: 40},
+		},
+	}
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIBillSplitApp(suite.T())
+	injection.BillSplitApp = suite.mock
+
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		return c.Next()
+	})
+	NewRoute(suite.app.Group("/budget"))
+}
+
+func (suite *TestSuite) send(method, target string, body any) (int, []byte) {
+	require := require.New(suite.T())
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(err)
+		reader = bytes.NewReader(data)
+	}
+
+	request := httptest.NewRequest(method, target, reader)
+	if body != nil {
+		request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	response, err := suite.app.Test(request)
+	require.NoError(err)
+	require.NotNil(response)
+
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	return response.StatusCode, resp
+}
+
+func (suite *TestSuite) TestCreateMember201() {
+	require := require.New(suite.T())
+	suite.mock.On("CreateMember", mock.Anything, suite.userId, suite.budgetId, "Ana").Return(suite.member, nil)
+	expectedBody, err := json.Marshal(model.NewBudgetMemberResponse(suite.member))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/budget/4/members", model.BudgetMemberRequest{Name: "Ana"})
+
+	require.Equal(fiber.StatusCreated, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreateMember404() {
+	require := require.New(suite.T())
+	suite.mock.On("CreateMember", mock.Anything, suite.userId, suite.budgetId, "Ana").Return(domain.BudgetMember{}, gorm.ErrRecordNotFound)
+
+	status, resp := suite.send(fiber.MethodPost, "/budget/4/members", model.BudgetMemberRequest{Name: "Ana"})
+
+	require.Equal(fiber.StatusNotFound, status)
+	require.Equal([]byte("Budget not found"), resp)
+}
+
+func (suite *TestSuite) TestReadMembers200() {
+	require := require.New(suite.T())
+	members := []domain.BudgetMember{suite.member}
+	suite.mock.On("FindMembers", mock.Anything, suite.userId, suite.budgetId).Return(members, nil)
+	expectedBody, err := json.Marshal(model.NewBudgetMembersResponse(members))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodGet, "/budget/4/members", nil)
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestDeleteMember204() {
+	require := require.New(suite.T())
+	suite.mock.On("DeleteMember", mock.Anything, suite.userId, suite.budgetId, suite.member.ID).Return(nil)
+
+	status, _ := suite.send(fiber.MethodDelete, "/budget/4/members/1", nil)
+
+	require.Equal(fiber.StatusNoContent, status)
+}
+
+func (suite *TestSuite) TestDeleteMember409() {
+	require := require.New(suite.T())
+	suite.mock.On("DeleteMember", mock.Anything, suite.userId, suite.budgetId, suite.member.ID).Return(application.ErrMemberInUse)
+
+	status, resp := suite.send(fiber.MethodDelete, "/budget/4/members/1", nil)
+
+	require.Equal(fiber.StatusConflict, status)
+	require.Equal([]byte(application.ErrMemberInUse.Error()), resp)
+}
+
+func (suite *TestSuite) TestSaveSplit200() {
+	require := require.New(suite.T())
+	suite.mock.On("SaveSplit", mock.Anything, suite.userId, suite.split).Return(suite.split, nil)
+	expectedBody, err := json.Marshal(model.NewBillSplitResponse(suite.split))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPut, "/budget/bill/7/split", model.BillSplitRequest{
+		Method: domain.Percentage,
+		Shares: []model.BillShareRequest{
+			{MemberId: 1, Value: 60, Paid: 1000},
+			{MemberId: 2, Value: 40},
+		},
+	})
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestSaveSplit422() {
+	require := require.New(suite.T())
+	suite.mock.On("SaveSplit", mock.Anything, suite.userId, mock.Anything).Return(domain.BillSplit{}, application.ErrSplitShares)
+
+	status, resp := suite.send(fiber.MethodPut, "/budget/bill/7/split", model.BillSplitRequest{
+		Method: domain.Percentage,
+		Shares: []model.BillShareRequest{{MemberId: 1, Value: 50}},
+	})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal([]byte(application.ErrSplitShares.Error()), resp)
+}
+
+func (suite *TestSuite) TestSaveSplit422Method() {
+	require := require.New(suite.T())
+	expectedBody, err := json.Marshal([]*validation.ErrorResponse{
+		{
+			Field:      "BillSplitRequest.method",
+			Constraint: "oneof='equal' 'percentage' 'fixed'",
+		},
+	})
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPut, "/budget/bill/7/split", model.BillSplitRequest{
+		Method: "shares",
+		Shares: []model.BillShareRequest{{MemberId: 1}},
+	})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestReadSplit404() {
+	require := require.New(suite.T())
+	suite.mock.On("FindSplit", mock.Anything, suite.userId, uint(7)).Return(domain.BillSplit{}, gorm.ErrRecordNotFound)
+
+	status, resp := suite.send(fiber.MethodGet, "/budget/bill/7/split", nil)
+
+	require.Equal(fiber.StatusNotFound, status)
+	require.Equal([]byte("Bill split not found"), resp)
+}
+
+func (suite *TestSuite) TestDeleteSplit204() {
+	require := require.New(suite.T())
+	suite.mock.On("DeleteSplit", mock.Anything, suite.userId, uint(7)).Return(nil)
+
+	status, _ := suite.send(fiber.MethodDelete, "/budget/bill/7/split", nil)
+
+	require.Equal(fiber.StatusNoContent, status)
+}
+
+func (suite *TestSuite) TestBalances200() {
+	require := require.New(suite.T())
+	balances := application.SplitBalances{
+		Members: []application.MemberBalance{
+			{Member: suite.member, Paid: 1000, Owed: 600, Balance: 400},
+		},
+		Suggestions: []application.SettlementSuggestion{
+			{FromMemberId: 2, ToMemberId: 1, Amount: 400},
+		},
+	}
+	suite.mock.On("Balances", mock.Anything, suite.userId, suite.budgetId).Return(balances, nil)
+	expectedBody, err := json.Marshal(model.NewSplitBalancesResponse(balances))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodGet, "/budget/4/balances", nil)
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestBalances500() {
+	require := require.New(suite.T())
+	suite.mock.On("Balances", mock.Anything, suite.userId, suite.budgetId).Return(application.SplitBalances{}, gorm.ErrInvalidField)
+
+	status, resp := suite.send(fiber.MethodGet, "/budget/4/balances", nil)
+
+	require.Equal(fiber.StatusInternalServerError, status)
+	require.Equal([]byte("Error reading member balances"), resp)
+}
+
+func (suite *TestSuite) TestCreateSettlement201() {
+	require := require.New(suite.T())
+	settlement := domain.BudgetSettlement{BudgetId: suite.budgetId, FromMemberId: 2, ToMemberId: 1, Amount: 400}
+	created := settlement
+	created.ID = 3
+	created.CreatedAt = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	suite.mock.On("CreateSettlement", mock.Anything, suite.userId, settlement).Return(created, nil)
+	expectedBody, err := json.Marshal(model.NewSettlementResponse(created))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodPost, "/budget/4/settlements", model.SettlementRequest{FromMemberId: 2, ToMemberId: 1, Amount: 400})
+
+	require.Equal(fiber.StatusCreated, status)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreateSettlement422() {
+	require := require.New(suite.T())
+	suite.mock.On("CreateSettlement", mock.Anything, suite.userId, mock.Anything).Return(domain.BudgetSettlement{}, application.ErrSettlementSame)
+
+	status, resp := suite.send(fiber.MethodPost, "/budget/4/settlements", model.SettlementRequest{FromMemberId: 1, ToMemberId: 1, Amount: 10})
+
+	require.Equal(fiber.StatusUnprocessableEntity, status)
+	require.Equal([]byte(application.ErrSettlementSame.Error()), resp)
+}
+
+func (suite *TestSuite) TestReadSettlements200() {
+	require := require.New(suite.T())
+	settlements := []domain.BudgetSettlement{{ID: 3, BudgetId: suite.budgetId, FromMemberId: 2, ToMemberId: 1, Amount: 400}}
+	suite.mock.On("FindSettlements", mock.Anything, suite.userId, suite.budgetId).Return(settlements, nil)
+	expectedBody, err := json.Marshal(model.NewSettlementsResponse(settlements))
+	require.NoError(err)
+
+	status, resp := suite.send(fiber.MethodGet, "/budget/4/settlements", nil)
+
+	require.Equal(fiber.StatusOK, status)
+	require.Equal(expectedBody, resp)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,224 @@
+package stream
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/config"
+	"your-accounts-api/shared/infrastructure/injection"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+const (
+	// socketReadLimit limits the messages of the clients, the socket only pushes messages to them
+	socketReadLimit    = 64 * 1024
+	socketWriteTimeout = 10 * time.Second
+)
+
+type controller struct {
+	app application.IBudgetStreamApp
+}
+
+// BudgetStreamHandler godoc
+//
+//	@Summary		Follow budget changes
+//	@Description	server-sent events with the changes applied to a budget and its new totals, an event "update" is sent after each batch of changes.
+//	@Description	The browsers can send the access token in the query param access_token.
+//	@Tags			budget
+//	@Produce		text/event-stream
+//	@Param			Authorization				header		string	true	"Access token"
+//	@Param			id							path		uint	true	"Budget ID"
+//	@Success		200							{object}	model.BudgetUpdateResponse
+//	@Failure		400							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/api/v1/budget/{id}/stream	[get]
+func (ctrl *controller) events(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	// The subscription outlives the handler, it ends when the client leaves
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, err := ctrl.app.Subscribe(ctx, userData.ID, uint(id))
+	if err != nil {
+		cancel()
+		return subscribeError(err)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+		heartbeat := time.NewTicker(config.STREAM_HEARTBEAT)
+		defer heartbeat.Stop()
+
+		fmt.Fprint(w, "retry: 3000\n\n")
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		for {
+			select {
+			case update, ok := <-updates:
+				if !ok {
+					return
+				}
+
+				data, err := json.Marshal(model.NewBudgetUpdateResponse(update))
+				if err != nil {
+					log.Error("Error encoding budget update:", err)
+					continue
+				}
+				fmt.Fprintf(w, "event: update\ndata: %s\n\n", data)
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": heartbeat\n\n")
+			}
+
+			// The write fails when the client is gone
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// BudgetSocketHandler godoc
+//
+//	@Summary		Follow budget changes with WebSocket
+//	@Description	WebSocket variant of the budget stream, each text message is an update after a batch of changes.
+//	@Description	The browsers can send the access token in the query param access_token.
+//	@Tags			budget
+//	@Produce		json
+//	@Param			Authorization			header		string	true	"Access token"
+//	@Param			id						path		uint	true	"Budget ID"
+//	@Success		101						{object}	model.BudgetUpdateResponse
+//	@Failure		400						{string}	string
+//	@Failure		401						{string}	string
+//	@Failure		404						{string}	string
+//	@Failure		426						{string}	string
+//	@Failure		500						{string}	string
+//	@Router			/api/v1/budget/{id}/ws	[get]
+func (ctrl *controller) socket(c *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(c) {
+		return fiber.NewError(fiber.StatusUpgradeRequired, "WebSocket handshake required")
+	}
+
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, err := ctrl.app.Subscribe(ctx, userData.ID, uint(id))
+	if err != nil {
+		cancel()
+		return subscribeError(err)
+	}
+
+	// The locals are copied to the connection by the upgrade
+	c.Locals("updates", updates)
+	c.Locals("cancel", cancel)
+	if err := c.Next(); err != nil {
+		cancel()
+		return err
+	}
+
+	return nil
+}
+
+// push sends the updates of the subscription made by socket until the client leaves
+func (ctrl *controller) push(conn *websocket.Conn) {
+	updates := conn.Locals("updates").(<-chan application.BudgetUpdate)
+	cancel := conn.Locals("cancel").(context.CancelFunc)
+	defer cancel()
+
+	// The reads only answer the control frames and detect when the client leaves
+	conn.SetReadLimit(socketReadLimit)
+	done := make(chan struct{})
+	defer func() {
+		conn.Close()
+		<-done
+	}()
+	go func() {
+		defer close(done)
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(config.STREAM_HEARTBEAT)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+				conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(socketWriteTimeout))
+				return
+			}
+
+			data, err := json.Marshal(model.NewBudgetUpdateResponse(update))
+			if err != nil {
+				log.Error("Error encoding budget update:", err)
+				continue
+			}
+			conn.SetWriteDeadline(time.Now().Add(socketWriteTimeout))
+			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(socketWriteTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.BudgetStreamApp}
+
+	router.Get("/:id<min(1)>/stream", controller.events)
+	router.Get("/:id<min(1)>/ws", controller.socket, websocket.New(controller.push))
+}
+
+func subscribeError(err error) error {
+	log.Error("Error subscribing to budget:", err)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, "Budget ID not found")
+	}
+
+	return fiber.NewError(fiber.StatusInternalServerError, "Error subscribing to budget")
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
@@ -0,0 +1,185 @@
+package stream
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/budgets/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId   uint
+	budgetId uint
+	update   application.BudgetUpdate
+	app      *fiber.App
+	mock     *mocks_application.MockIBudgetStreamApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	suite.budgetId = 999
+	suite.update = application.BudgetUpdate{
+		BudgetId: suite.budgetId,
+		Changes: []application.Change{
+			{ID: 3, Section: domain.Bill, Action: shared.Update, Detail: map[string]any{"payment": 10.0}},
+		},
+		TotalAvailable: 100,
+		TotalPending:   40,
+		PendingBills:   2,
+		CreatedAt:      time.Now(),
+	}
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIBudgetStreamApp(suite.T())
+	injection.BudgetStreamApp = suite.mock
+
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.app = fiber.New(fiber.Config{DisableStartupMessage: true})
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		return c.Next()
+	})
+	NewRoute(suite.app.Group("/budget"))
+}
+
+// updates returns a subscription with the updates that is closed after them
+func (suite *TestSuite) updates(updates ...application.BudgetUpdate) <-chan application.BudgetUpdate {
+	channel := make(chan application.BudgetUpdate, len(updates))
+	for _, update := range updates {
+		channel <- update
+	}
+	close(channel)
+	return channel
+}
+
+func (suite *TestSuite) TestEvents200() {
+	require := require.New(suite.T())
+	suite.mock.On("Subscribe", mock.Anything, suite.userId, suite.budgetId).Return(suite.updates(suite.update), nil)
+	data, err := json.Marshal(model.NewBudgetUpdateResponse(suite.update))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/budget/999/stream", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	require.Equal("text/event-stream", response.Header.Get(fiber.HeaderContentType))
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(fmt.Sprintf("retry: 3000\n\nevent: update\ndata: %s\n\n", data), string(resp))
+}
+
+func (suite *TestSuite) TestEvents404() {
+	require := require.New(suite.T())
+	suite.mock.On("Subscribe", mock.Anything, suite.userId, suite.budgetId).Return(nil, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/budget/999/stream", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestEvents500() {
+	require := require.New(suite.T())
+	suite.mock.On("Subscribe", mock.Anything, suite.userId, suite.budgetId).Return(nil, gorm.ErrInvalidDB)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/budget/999/stream", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func (suite *TestSuite) TestSocket101() {
+	require := require.New(suite.T())
+	suite.mock.On("Subscribe", mock.Anything, suite.userId, suite.budgetId).Return(suite.updates(suite.update), nil)
+	data, err := json.Marshal(model.NewBudgetUpdateResponse(suite.update))
+	require.NoError(err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	go suite.app.Listener(listener)
+	defer suite.app.Shutdown()
+
+	conn, err := net.DialTimeout("tcp", listener.Addr().String(), time.Second)
+	require.NoError(err)
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	fmt.Fprint(conn, "GET /budget/999/ws HTTP/1.1\r\nHost: localhost\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n"+
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\nSec-WebSocket-Version: 13\r\n\r\n")
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, nil)
+
+	require.NoError(err)
+	require.Equal(http.StatusSwitchingProtocols, response.StatusCode)
+	header := make([]byte, 4)
+	_, err = io.ReadFull(reader, header)
+	require.NoError(err)
+	require.Equal(byte(0x81), header[0])
+	require.Equal(byte(126), header[1])
+	payload := make([]byte, int(header[2])<<8|int(header[3]))
+	_, err = io.ReadFull(reader, payload)
+	require.NoError(err)
+	require.Equal(data, payload)
+	closeFrame := make([]byte, 4)
+	_, err = io.ReadFull(reader, closeFrame)
+	require.NoError(err)
+	require.Equal(byte(0x88), closeFrame[0])
+}
+
+func (suite *TestSuite) TestSocket404() {
+	require := require.New(suite.T())
+	suite.mock.On("Subscribe", mock.Anything, suite.userId, suite.budgetId).Return(nil, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/budget/999/ws", nil)
+	request.Header.Set(fiber.HeaderUpgrade, "websocket")
+	request.Header.Set(fiber.HeaderConnection, "Upgrade")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestSocket426() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/budget/999/ws", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUpgradeRequired, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,138 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type controller struct {
+	app application.ITrashApp
+}
+
+// TrashReadHandler godoc
+//
+//	@Summary		Read trash
+//	@Description	read the deleted budgets, availables and bills of the user that can still be restored
+//	@Tags			trash
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Access token"
+//	@Success		200				{object}	model.TrashResponse
+//	@Failure		401				{string}	string
+//	@Failure		500				{string}	string
+//	@Router			/api/v1/trash/	[get]
+func (ctrl *controller) read(c *fiber.Ctx) error {
+	userData := getUserData(c)
+
+	trash, err := ctrl.app.FindByUserId(c.UserContext(), userData.ID)
+	if err != nil {
+		log.Error("Error reading trash:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading trash")
+	}
+
+	return c.JSON(model.NewTrashResponse(trash))
+}
+
+// TrashRestoreBudgetHandler godoc
+//
+//	@Summary		Restore budget
+//	@Description	restore a deleted budget with the availables and bills deleted with it
+//	@Tags			trash
+//	@Produce		json
+//	@Param			Authorization						header		string	true	"Access token"
+//	@Param			id									path		uint	true	"Budget ID"
+//	@Success		200									{string}	string
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/trash/budget/{id}/restore	[put]
+func (ctrl *controller) restoreBudget(c *fiber.Ctx) error {
+	return ctrl.restore(c, "budget", ctrl.app.RestoreBudget)
+}
+
+// TrashRestoreAvailableHandler godoc
+//
+//	@Summary		Restore available
+//	@Description	restore a deleted available in its budget
+//	@Tags			trash
+//	@Produce		json
+//	@Param			Authorization							header		string	true	"Access token"
+//	@Param			id										path		uint	true	"Available ID"
+//	@Success		200										{string}	string
+//	@Failure		400										{string}	string
+//	@Failure		401										{string}	string
+//	@Failure		404										{string}	string
+//	@Failure		409										{string}	string
+//	@Failure		500										{string}	string
+//	@Router			/api/v1/trash/available/{id}/restore	[put]
+func (ctrl *controller) restoreAvailable(c *fiber.Ctx) error {
+	return ctrl.restore(c, "available", ctrl.app.RestoreAvailable)
+}
+
+// TrashRestoreBillHandler godoc
+//
+//	@Summary		Restore bill
+//	@Description	restore a deleted bill in its budget
+//	@Tags			trash
+//	@Produce		json
+//	@Param			Authorization						header		string	true	"Access token"
+//	@Param			id									path		uint	true	"Bill ID"
+//	@Success		200									{string}	string
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		409									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/trash/bill/{id}/restore	[put]
+func (ctrl *controller) restoreBill(c *fiber.Ctx) error {
+	return ctrl.restore(c, "bill", ctrl.app.RestoreBill)
+}
+
+func (ctrl *controller) restore(c *fiber.Ctx, item string, restore func(ctx context.Context, userId, id uint) error) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+	if err := restore(c.UserContext(), userData.ID, uint(id)); err != nil {
+		log.Errorf("Error restoring %s from trash: %s", item, err.Error())
+		if errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, application.ErrTrashNotOwner) {
+			return fiber.NewError(fiber.StatusNotFound, "Item not found in trash")
+		}
+
+		if errors.Is(err, application.ErrBudgetTrashed) {
+			return fiber.NewError(fiber.StatusConflict, "The budget is in the trash, restore it first")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error restoring from trash")
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.TrashApp}
+
+	group := router.Group("/trash")
+	group.Get("/", controller.read)
+	group.Put("/budget/:id<min(1)>/restore", controller.restoreBudget)
+	group.Put("/available/:id<min(1)>/restore", controller.restoreAvailable)
+	group.Put("/bill/:id<min(1)>/restore", controller.restoreBill)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
@@ -0,0 +1,170 @@
+package trash
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/domain"
+	"your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/budgets/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId uint
+	app    *fiber.App
+	mock   *mocks_application.MockITrashApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+}
+
+func (suite *TestSuite) SetupTest() {
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.mock = mocks_application.NewMockITrashApp(suite.T())
+	injection.TrashApp = suite.mock
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		return c.Next()
+	})
+	NewRoute(suite.app)
+}
+
+func (suite *TestSuite) TestRead200() {
+	require := require.New(suite.T())
+	id, budgetId, name, year, month := uint(2), uint(3), "Test", uint16(2024), uint8(5)
+	amount, deletedAt := 10.0, time.Now()
+	trash := application.Trash{
+		Budgets: []domain.Budget{
+			{ID: &budgetId, Name: &name, Year: &year, Month: &month, DeletedAt: &deletedAt},
+		},
+		Availables: []domain.BudgetAvailable{
+			{ID: &id, Name: &name, Amount: &amount, BudgetId: &budgetId, DeletedAt: &deletedAt},
+		},
+		Bills: []domain.BudgetBill{},
+	}
+	suite.mock.On("FindByUserId", mock.Anything, suite.userId).Return(trash, nil)
+	expectedBody, err := json.Marshal(model.NewTrashResponse(trash))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/trash", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestRead500() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByUserId", mock.Anything, suite.userId).Return(application.Trash{}, errors.New("connection lost"))
+
+	request := httptest.NewRequest(fiber.MethodGet, "/trash", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRestoreBudget200() {
+	require := require.New(suite.T())
+	suite.mock.On("RestoreBudget", mock.Anything, suite.userId, uint(2)).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/trash/budget/2/restore", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRestoreBudget404() {
+	require := require.New(suite.T())
+	suite.mock.On("RestoreBudget", mock.Anything, suite.userId, uint(2)).Return(application.ErrTrashNotOwner)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/trash/budget/2/restore", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRestoreAvailable200() {
+	require := require.New(suite.T())
+	suite.mock.On("RestoreAvailable", mock.Anything, suite.userId, uint(2)).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/trash/available/2/restore", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRestoreAvailable409() {
+	require := require.New(suite.T())
+	suite.mock.On("RestoreAvailable", mock.Anything, suite.userId, uint(2)).Return(application.ErrBudgetTrashed)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/trash/available/2/restore", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRestoreBill404() {
+	require := require.New(suite.T())
+	suite.mock.On("RestoreBill", mock.Anything, suite.userId, uint(2)).Return(gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/trash/bill/2/restore", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRestoreBill500() {
+	require := require.New(suite.T())
+	suite.mock.On("RestoreBill", mock.Anything, suite.userId, uint(2)).Return(errors.New("connection lost"))
+
+	request := httptest.NewRequest(fiber.MethodPut, "/trash/bill/2/restore", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRestore404InvalidId() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodPut, "/trash/bill/0/restore", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
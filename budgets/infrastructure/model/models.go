@@ -1,6 +1,10 @@
 package model
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 	"your-accounts-api/budgets/application"
 	"your-accounts-api/budgets/domain"
 	shared "your-accounts-api/shared/domain"
@@ -79,8 +83,18 @@ type ReadByIDResponse struct {
 }
 
 func NewReadByIDResponse(budget domain.Budget) ReadByIDResponse {
+	return ReadByIDResponse{
+		ReadBudgetResponse: NewReadBudgetResponse(*budget.ID, *budget.Name, *budget.Year, *budget.Month),
+		FixedIncome:        *budget.FixedIncome,
+		AdditionalIncome:   *budget.AdditionalIncome,
+		Availables:         newAvailablesResponse(budget.BudgetAvailables),
+		Bills:              newBillsResponse(budget.BudgetBills),
+	}
+}
+
+func newAvailablesResponse(budgetAvailables []domain.BudgetAvailable) []ReadByIDResponseAvailable {
 	availables := []ReadByIDResponseAvailable{}
-	for _, available := range budget.BudgetAvailables {
+	for _, available := range budgetAvailables {
 		availables = append(availables, ReadByIDResponseAvailable{
 			IDResponse:     model.NewIDResponse(*available.ID),
 			NameResponse:   model.NewNameResponse(*available.Name),
@@ -88,8 +102,12 @@ func NewReadByIDResponse(budget domain.Budget) ReadByIDResponse {
 		})
 	}
 
+	return availables
+}
+
+func newBillsResponse(budgetBills []domain.BudgetBill) []ReadByIDResponseBill {
 	bills := []ReadByIDResponseBill{}
-	for _, bill := range budget.BudgetBills {
+	for _, bill := range budgetBills {
 		bills = append(bills, ReadByIDResponseBill{
 			IDResponse:     model.NewIDResponse(*bill.ID),
 			AmountResponse: model.NewAmountResponse(*bill.Amount),
@@ -101,13 +119,7 @@ func NewReadByIDResponse(budget domain.Budget) ReadByIDResponse {
 		})
 	}
 
-	return ReadByIDResponse{
-		ReadBudgetResponse: NewReadBudgetResponse(*budget.ID, *budget.Name, *budget.Year, *budget.Month),
-		FixedIncome:        *budget.FixedIncome,
-		AdditionalIncome:   *budget.AdditionalIncome,
-		Availables:         availables,
-		Bills:              bills,
-	}
+	return bills
 }
 
 type CreateAvailableRequest struct {
@@ -178,3 +190,1091 @@ func NewChangeResponse(change application.Change, err string) ChangeResponse {
 		Error: err,
 	}
 }
+
+type CreateSnapshotRequest struct {
+	Description string `json:"description" validate:"max=200"`
+}
+
+type CreateSnapshotResponse struct {
+	model.IDResponse
+}
+
+func NewCreateSnapshotResponse(id uint) CreateSnapshotResponse {
+	return CreateSnapshotResponse{
+		IDResponse: model.NewIDResponse(id),
+	}
+}
+
+type SnapshotResponse struct {
+	model.IDResponse
+	Manual      bool   `json:"manual"`
+	Description string `json:"description"`
+	CreatedAt   int64  `json:"createdAt"`
+}
+
+func NewSnapshotResponse(snapshot domain.BudgetSnapshot) SnapshotResponse {
+	return SnapshotResponse{
+		IDResponse:  model.NewIDResponse(snapshot.ID),
+		Manual:      snapshot.Manual,
+		Description: snapshot.Description,
+		CreatedAt:   snapshot.CreatedAt.UnixMilli(),
+	}
+}
+
+type ReadSnapshotResponse struct {
+	SnapshotResponse
+	Budget ReadSnapshotBudgetResponse `json:"budget"`
+}
+
+type ReadSnapshotBudgetResponse struct {
+	model.NameResponse
+	Year             uint16                      `json:"year"`
+	Month            uint8                       `json:"month"`
+	FixedIncome      float64                     `json:"fixedIncome"`
+	AdditionalIncome float64                     `json:"additionalIncome"`
+	TotalAvailable   float64                     `json:"totalAvailable"`
+	TotalPending     float64                     `json:"totalPending"`
+	PendingBills     uint8                       `json:"pendingBills"`
+	Availables       []ReadByIDResponseAvailable `json:"availables"`
+	Bills            []ReadByIDResponseBill      `json:"bills"`
+}
+
+func NewReadSnapshotResponse(snapshot domain.BudgetSnapshot) ReadSnapshotResponse {
+	budget := snapshot.Budget
+	return ReadSnapshotResponse{
+		SnapshotResponse: NewSnapshotResponse(snapshot),
+		Budget: ReadSnapshotBudgetResponse{
+			NameResponse:     model.NewNameResponse(*budget.Name),
+			Year:             *budget.Year,
+			Month:            *budget.Month,
+			FixedIncome:      *budget.FixedIncome,
+			AdditionalIncome: *budget.AdditionalIncome,
+			TotalAvailable:   *budget.TotalAvailable,
+			TotalPending:     *budget.TotalPending,
+			PendingBills:     *budget.PendingBills,
+			Availables:       newAvailablesResponse(budget.BudgetAvailables),
+			Bills:            newBillsResponse(budget.BudgetBills),
+		},
+	}
+}
+
+type SnapshotDiffRequest struct {
+	From uint `query:"from" json:"from" validate:"required,min=1"`
+	To   uint `query:"to" json:"to" validate:"required,min=1"`
+}
+
+type FieldChangeResponse struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+type ItemChangeResponse struct {
+	model.IDResponse
+	Fields []FieldChangeResponse `json:"fields"`
+}
+
+type SnapshotDiffAvailablesResponse struct {
+	Added   []ReadByIDResponseAvailable `json:"added"`
+	Removed []ReadByIDResponseAvailable `json:"removed"`
+	Changed []ItemChangeResponse        `json:"changed"`
+}
+
+type SnapshotDiffBillsResponse struct {
+	Added   []ReadByIDResponseBill `json:"added"`
+	Removed []ReadByIDResponseBill `json:"removed"`
+	Changed []ItemChangeResponse   `json:"changed"`
+}
+
+type SnapshotDiffResponse struct {
+	From       SnapshotResponse               `json:"from"`
+	To         SnapshotResponse               `json:"to"`
+	Main       []FieldChangeResponse          `json:"main"`
+	Availables SnapshotDiffAvailablesResponse `json:"availables"`
+	Bills      SnapshotDiffBillsResponse      `json:"bills"`
+}
+
+func NewSnapshotDiffResponse(diff application.SnapshotDiff) SnapshotDiffResponse {
+	return SnapshotDiffResponse{
+		From: NewSnapshotResponse(diff.From),
+		To:   NewSnapshotResponse(diff.To),
+		Main: newFieldChangesResponse(diff.Main),
+		Availables: SnapshotDiffAvailablesResponse{
+			Added:   newAvailablesResponse(diff.AvailablesAdded),
+			Removed: newAvailablesResponse(diff.AvailablesRemoved),
+			Changed: newItemChangesResponse(diff.AvailablesChanged),
+		},
+		Bills: SnapshotDiffBillsResponse{
+			Added:   newBillsResponse(diff.BillsAdded),
+			Removed: newBillsResponse(diff.BillsRemoved),
+			Changed: newItemChangesResponse(diff.BillsChanged),
+		},
+	}
+}
+
+func newFieldChangesResponse(changes []application.FieldChange) []FieldChangeResponse {
+	response := []FieldChangeResponse{}
+	for _, change := range changes {
+		response = append(response, FieldChangeResponse(change))
+	}
+
+	return response
+}
+
+func newItemChangesResponse(changes []application.ItemChange) []ItemChangeResponse {
+	response := []ItemChangeResponse{}
+	for _, change := range changes {
+		response = append(response, ItemChangeResponse{
+			IDResponse: model.NewIDResponse(change.ID),
+			Fields:     newFieldChangesResponse(change.Fields),
+		})
+	}
+
+	return response
+}
+
+type TrashBudgetResponse struct {
+	ReadBudgetResponse
+	DeletedAt int64 `json:"deletedAt"`
+}
+
+type TrashAvailableResponse struct {
+	ReadByIDResponseAvailable
+	BudgetId  uint  `json:"budgetId"`
+	DeletedAt int64 `json:"deletedAt"`
+}
+
+type TrashBillResponse struct {
+	ReadByIDResponseBill
+	BudgetId  uint  `json:"budgetId"`
+	DeletedAt int64 `json:"deletedAt"`
+}
+
+type TrashResponse struct {
+	Budgets    []TrashBudgetResponse    `json:"budgets"`
+	Availables []TrashAvailableResponse `json:"availables"`
+	Bills      []TrashBillResponse      `json:"bills"`
+}
+
+func NewTrashResponse(trash application.Trash) TrashResponse {
+	response := TrashResponse{
+		Budgets:    []TrashBudgetResponse{},
+		Availables: []TrashAvailableResponse{},
+		Bills:      []TrashBillResponse{},
+	}
+
+	for _, budget := range trash.Budgets {
+		response.Budgets = append(response.Budgets, TrashBudgetResponse{
+			ReadBudgetResponse: NewReadBudgetResponse(*budget.ID, *budget.Name, *budget.Year, *budget.Month),
+			DeletedAt:          budget.DeletedAt.UnixMilli(),
+		})
+	}
+
+	availables := newAvailablesResponse(trash.Availables)
+	for i, available := range trash.Availables {
+		response.Availables = append(response.Availables, TrashAvailableResponse{
+			ReadByIDResponseAvailable: availables[i],
+			BudgetId:                  *available.BudgetId,
+			DeletedAt:                 available.DeletedAt.UnixMilli(),
+		})
+	}
+
+	bills := newBillsResponse(trash.Bills)
+	for i, bill := range trash.Bills {
+		response.Bills = append(response.Bills, TrashBillResponse{
+			ReadByIDResponseBill: bills[i],
+			BudgetId:             *bill.BudgetId,
+			DeletedAt:            bill.DeletedAt.UnixMilli(),
+		})
+	}
+
+	return response
+}
+
+type BudgetUpdateResponse struct {
+	BudgetId       uint            `json:"budgetId"`
+	Changes        []ChangeRequest `json:"changes"`
+	TotalAvailable float64         `json:"totalAvailable"`
+	TotalPending   float64         `json:"totalPending"`
+	PendingBills   uint8           `json:"pendingBills"`
+	Reload         bool            `json:"reload"`
+	CreatedAt      int64           `json:"createdAt"`
+}
+
+func NewBudgetUpdateResponse(update application.BudgetUpdate) BudgetUpdateResponse {
+	changes := []ChangeRequest{}
+	for _, change := range update.Changes {
+		changes = append(changes, ChangeRequest(change))
+	}
+
+	return BudgetUpdateResponse{
+		BudgetId:       update.BudgetId,
+		Changes:        changes,
+		TotalAvailable: update.TotalAvailable,
+		TotalPending:   update.TotalPending,
+		PendingBills:   update.PendingBills,
+		Reload:         update.Reload,
+		CreatedAt:      update.CreatedAt.UnixMilli(),
+	}
+}
+
+type SpendingRequest struct {
+	From string `query:"from" json:"from" validate:"required,datetime=2006-01"`
+	To   string `query:"to" json:"to" validate:"required,datetime=2006-01"`
+}
+
+// ToFilter builds the filter of the user, the months were already validated
+func (r SpendingRequest) ToFilter(userId uint) domain.SpendingFilter {
+	from, _ := time.Parse("2006-01", r.From)
+	to, _ := time.Parse("2006-01", r.To)
+	return domain.SpendingFilter{
+		UserId:    userId,
+		FromYear:  uint16(from.Year()),
+		FromMonth: uint8(from.Month()),
+		ToYear:    uint16(to.Year()),
+		ToMonth:   uint8(to.Month()),
+	}
+}
+
+type MonthlySpendingResponse struct {
+	Year           uint16                    `json:"year"`
+	Month          uint8                     `json:"month"`
+	Category       domain.BudgetBillCategory `json:"category"`
+	Amount         float64                   `json:"amount"`
+	Payment        float64                   `json:"payment"`
+	Bills          uint                      `json:"bills"`
+	MonthOverMonth *float64                  `json:"monthOverMonth"`
+	YearOverYear   *float64                  `json:"yearOverYear"`
+}
+
+type CategorySpendingResponse struct {
+	Category domain.BudgetBillCategory `json:"category"`
+	Amount   float64                   `json:"amount"`
+	Payment  float64                   `json:"payment"`
+	Average  float64                   `json:"average"`
+	Median   float64                   `json:"median"`
+}
+
+type SpendingResponse struct {
+	Months     []MonthlySpendingResponse  `json:"months"`
+	Categories []CategorySpendingResponse `json:"categories"`
+}
+
+func NewSpendingResponse(spending application.Spending) SpendingResponse {
+	response := SpendingResponse{
+		Months:     []MonthlySpendingResponse{},
+		Categories: []CategorySpendingResponse{},
+	}
+
+	for _, month := range spending.Months {
+		response.Months = append(response.Months, MonthlySpendingResponse(month))
+	}
+
+	for _, category := range spending.Categories {
+		response.Categories = append(response.Categories, CategorySpendingResponse(category))
+	}
+
+	return response
+}
+
+// ForecastRequest takes the what-if values of the forecast, each bill is a description and an
+// amount separated by a colon, like Rent:750, and the amount 0 removes a recurring bill.
+type ForecastRequest struct {
+	Months           int      `query:"months" json:"months" validate:"required,min=1,max=24"`
+	FixedIncome      *float64 `query:"fixedIncome" json:"fixedIncome" validate:"omitempty,min=0"`
+	AdditionalIncome *float64 `query:"additionalIncome" json:"additionalIncome" validate:"omitempty,min=0"`
+	Bills            []string `query:"bill" json:"bill" validate:"max=20,dive,min=3,max=250,contains=:"`
+}
+
+func (r ForecastRequest) ToOverrides() (application.ForecastOverrides, error) {
+	overrides := application.ForecastOverrides{
+		FixedIncome:      r.FixedIncome,
+		AdditionalIncome: r.AdditionalIncome,
+		Bills:            []application.ForecastBill{},
+	}
+
+	for _, bill := range r.Bills {
+		separator := strings.LastIndex(bill, ":")
+		description := strings.TrimSpace(bill[:separator])
+		amount, err := strconv.ParseFloat(strings.TrimSpace(bill[separator+1:]), 64)
+		if err != nil || description == "" || amount < 0 {
+			return application.ForecastOverrides{}, fmt.Errorf("invalid bill override '%s'", bill)
+		}
+
+		overrides.Bills = append(overrides.Bills, application.ForecastBill{
+			Description: description,
+			Amount:      amount,
+		})
+	}
+
+	return overrides, nil
+}
+
+type ForecastBillResponse struct {
+	Description string                    `json:"description"`
+	Category    domain.BudgetBillCategory `json:"category"`
+	Amount      float64                   `json:"amount"`
+}
+
+type ForecastMonthResponse struct {
+	Year             uint16  `json:"year"`
+	Month            uint8   `json:"month"`
+	FixedIncome      float64 `json:"fixedIncome"`
+	AdditionalIncome float64 `json:"additionalIncome"`
+	Committed        float64 `json:"committed"`
+	Leftover         float64 `json:"leftover"`
+	Shortfall        bool    `json:"shortfall"`
+}
+
+type ForecastResponse struct {
+	Bills  []ForecastBillResponse  `json:"bills"`
+	Months []ForecastMonthResponse `json:"months"`
+}
+
+func NewForecastResponse(forecast application.Forecast) ForecastResponse {
+	response := ForecastResponse{
+		Bills:  []ForecastBillResponse{},
+		Months: []ForecastMonthResponse{},
+	}
+
+	for _, bill := range forecast.Bills {
+		response.Bills = append(response.Bills, ForecastBillResponse(bill))
+	}
+
+	for _, month := range forecast.Months {
+		response.Months = append(response.Months, ForecastMonthResponse(month))
+	}
+
+	return response
+}
+
+// GoalRequest links the goal with a category of bills or with an available, never with both
+type GoalRequest struct {
+	Name         string                     `json:"name" validate:"required,max=40"`
+	TargetAmount float64                    `json:"targetAmount" validate:"required,gt=0"`
+	TargetDate   string                     `json:"targetDate" validate:"required,datetime=2006-01-02"`
+	Category     *domain.BudgetBillCategory `json:"category" validate:"required_without=Available,excluded_with=Available,omitempty,oneof='house' 'entertainment' 'personal' 'vehicle_transportation' 'education' 'services' 'financial' 'saving' 'others'"`
+	Available    *string                    `json:"available" validate:"required_without=Category,omitempty,max=40"`
+}
+
+func (r GoalRequest) ToDomain(userId, id uint) domain.Goal {
+	targetDate, _ := time.Parse(time.DateOnly, r.TargetDate)
+	return domain.Goal{
+		ID:           id,
+		UserId:       userId,
+		Name:         r.Name,
+		TargetAmount: r.TargetAmount,
+		TargetDate:   targetDate,
+		Category:     r.Category,
+		Available:    r.Available,
+	}
+}
+
+type GoalResponse struct {
+	ID           uint                       `json:"id"`
+	Name         string                     `json:"name"`
+	TargetAmount float64                    `json:"targetAmount"`
+	TargetDate   string                     `json:"targetDate"`
+	Category     *domain.BudgetBillCategory `json:"category"`
+	Available    *string                    `json:"available"`
+	CreatedAt    int64                      `json:"createdAt"`
+}
+
+func NewGoalResponse(goal domain.Goal) GoalResponse {
+	return GoalResponse{
+		ID:           goal.ID,
+		Name:         goal.Name,
+		TargetAmount: goal.TargetAmount,
+		TargetDate:   goal.TargetDate.Format(time.DateOnly),
+		Category:     goal.Category,
+		Available:    goal.Available,
+		CreatedAt:    goal.CreatedAt.UnixMilli(),
+	}
+}
+
+type GoalContributionResponse struct {
+	Year   uint16  `json:"year"`
+	Month  uint8   `json:"month"`
+	Amount float64 `json:"amount"`
+}
+
+type GoalProgressResponse struct {
+	Goal          GoalResponse               `json:"goal"`
+	Saved         float64                    `json:"saved"`
+	Percentage    float64                    `json:"percentage"`
+	MonthlyRate   float64                    `json:"monthlyRate"`
+	ProjectedDate *string                    `json:"projectedDate"`
+	Completed     bool                       `json:"completed"`
+	OnTrack       bool                       `json:"onTrack"`
+	Contributions []GoalContributionResponse `json:"contributions"`
+}
+
+func NewGoalProgressResponse(progress application.GoalProgress) GoalProgressResponse {
+	response := GoalProgressResponse{
+		Goal:          NewGoalResponse(progress.Goal),
+		Saved:         progress.Saved,
+		Percentage:    progress.Percentage,
+		MonthlyRate:   progress.MonthlyRate,
+		Completed:     progress.Completed,
+		OnTrack:       progress.OnTrack,
+		Contributions: []GoalContributionResponse{},
+	}
+
+	if progress.ProjectedDate != nil {
+		projectedDate := progress.ProjectedDate.Format("2006-01")
+		response.ProjectedDate = &projectedDate
+	}
+
+	for _, contribution := range progress.Contributions {
+		response.Contributions = append(response.Contributions, GoalContributionResponse(contribution))
+	}
+
+	return response
+}
+
+type LoanRequest struct {
+	Name         string                  `json:"name" validate:"required,max=40"`
+	Principal    float64                 `json:"principal" validate:"required,gt=0"`
+	Rate         float64                 `json:"rate" validate:"gte=0,lte=100"`
+	Term         uint16                  `json:"term" validate:"required,min=1,max=600"`
+	StartDate    string                  `json:"startDate" validate:"required,datetime=2006-01-02"`
+	PaymentDay   uint8                   `json:"paymentDay" validate:"required,min=1,max=31"`
+	Amortization domain.LoanAmortization `json:"amortization" validate:"required,oneof='fixed' 'french' 'german'"`
+}
+
+func (r LoanRequest) ToDomain(userId uint) domain.Loan {
+	startDate, _ := time.Parse(time.DateOnly, r.StartDate)
+	return domain.Loan{
+		UserId:       userId,
+		Name:         r.Name,
+		Principal:    r.Principal,
+		Rate:         r.Rate,
+		Term:         r.Term,
+		StartDate:    startDate,
+		PaymentDay:   r.PaymentDay,
+		Amortization: r.Amortization,
+	}
+}
+
+type LoanResponse struct {
+	ID           uint                    `json:"id"`
+	Name         string                  `json:"name"`
+	Principal    float64                 `json:"principal"`
+	Rate         float64                 `json:"rate"`
+	Term         uint16                  `json:"term"`
+	StartDate    string                  `json:"startDate"`
+	PaymentDay   uint8                   `json:"paymentDay"`
+	Amortization domain.LoanAmortization `json:"amortization"`
+	Balance      float64                 `json:"balance"`
+	CreatedAt    int64                   `json:"createdAt"`
+}
+
+func NewLoanResponse(loan domain.Loan) LoanResponse {
+	return LoanResponse{
+		ID:           loan.ID,
+		Name:         loan.Name,
+		Principal:    loan.Principal,
+		Rate:         loan.Rate,
+		Term:         loan.Term,
+		StartDate:    loan.StartDate.Format(time.DateOnly),
+		PaymentDay:   loan.PaymentDay,
+		Amortization: loan.Amortization,
+		Balance:      loan.Balance,
+		CreatedAt:    loan.CreatedAt.UnixMilli(),
+	}
+}
+
+type LoanInstallmentResponse struct {
+	Number       uint16  `json:"number"`
+	Year         uint16  `json:"year"`
+	Month        uint8   `json:"month"`
+	Payment      float64 `json:"payment"`
+	Interest     float64 `json:"interest"`
+	Principal    float64 `json:"principal"`
+	Balance      float64 `json:"balance"`
+	BudgetBillId *uint   `json:"budgetBillId"`
+	Paid         float64 `json:"paid"`
+}
+
+func NewLoanInstallmentsResponse(installments []domain.LoanInstallment) []LoanInstallmentResponse {
+	response := []LoanInstallmentResponse{}
+	for _, installment := range installments {
+		response = append(response, LoanInstallmentResponse(installment))
+	}
+
+	return response
+}
+
+type LoanScheduleResponse struct {
+	Loan         LoanResponse              `json:"loan"`
+	Installments []LoanInstallmentResponse `json:"installments"`
+}
+
+func NewLoanScheduleResponse(schedule application.LoanSchedule) LoanScheduleResponse {
+	return LoanScheduleResponse{
+		Loan:         NewLoanResponse(schedule.Loan),
+		Installments: NewLoanInstallmentsResponse(schedule.Installments),
+	}
+}
+
+type CreditCardRequest struct {
+	Name              string  `json:"name" validate:"required,max=40"`
+	Limit             float64 `json:"limit" validate:"gte=0"`
+	ClosingDay        uint8   `json:"closingDay" validate:"required,min=1,max=31"`
+	PaymentDay        uint8   `json:"paymentDay" validate:"required,min=1,max=31"`
+	MinimumPercentage float64 `json:"minimumPercentage" validate:"required,gt=0,lte=100"`
+}
+
+func (r CreditCardRequest) ToDomain(userId uint) domain.CreditCard {
+	return domain.CreditCard{
+		UserId:            userId,
+		Name:              r.Name,
+		Limit:             r.Limit,
+		ClosingDay:        r.ClosingDay,
+		PaymentDay:        r.PaymentDay,
+		MinimumPercentage: r.MinimumPercentage,
+	}
+}
+
+type CreditCardResponse struct {
+	ID                uint    `json:"id"`
+	Name              string  `json:"name"`
+	Limit             float64 `json:"limit"`
+	ClosingDay        uint8   `json:"closingDay"`
+	PaymentDay        uint8   `json:"paymentDay"`
+	MinimumPercentage float64 `json:"minimumPercentage"`
+	CreatedAt         int64   `json:"createdAt"`
+}
+
+func NewCreditCardResponse(card domain.CreditCard) CreditCardResponse {
+	return CreditCardResponse{
+		ID:                card.ID,
+		Name:              card.Name,
+		Limit:             card.Limit,
+		ClosingDay:        card.ClosingDay,
+		PaymentDay:        card.PaymentDay,
+		MinimumPercentage: card.MinimumPercentage,
+		CreatedAt:         card.CreatedAt.UnixMilli(),
+	}
+}
+
+type CreditCardChargeRequest struct {
+	Description string  `json:"description" validate:"required,max=200"`
+	Amount      float64 `json:"amount" validate:"required,gt=0"`
+	Date        string  `json:"date" validate:"required,datetime=2006-01-02"`
+}
+
+func (r CreditCardChargeRequest) ToDomain() domain.CreditCardCharge {
+	date, _ := time.Parse(time.DateOnly, r.Date)
+	return domain.CreditCardCharge{
+		Description: r.Description,
+		Amount:      r.Amount,
+		Date:        date,
+	}
+}
+
+type CreditCardChargeResponse struct {
+	ID          uint    `json:"id"`
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+	Date        string  `json:"date"`
+}
+
+func NewCreditCardChargeResponse(charge domain.CreditCardCharge) CreditCardChargeResponse {
+	return CreditCardChargeResponse{
+		ID:          charge.ID,
+		Description: charge.Description,
+		Amount:      charge.Amount,
+		Date:        charge.Date.Format(time.DateOnly),
+	}
+}
+
+type CreditCardStatementResponse struct {
+	ID             uint    `json:"id"`
+	CardId         uint    `json:"cardId"`
+	Year           uint16  `json:"year"`
+	Month          uint8   `json:"month"`
+	ClosingDate    string  `json:"closingDate"`
+	DueDate        string  `json:"dueDate"`
+	Total          float64 `json:"total"`
+	MinimumPayment float64 `json:"minimumPayment"`
+	BudgetBillId   *uint   `json:"budgetBillId"`
+	AvailableId    *uint   `json:"availableId"`
+	PaidAt         *int64  `json:"paidAt"`
+}
+
+func NewCreditCardStatementResponse(statement domain.CreditCardStatement) CreditCardStatementResponse {
+	response := CreditCardStatementResponse{
+		ID:             statement.ID,
+		CardId:         statement.CardId,
+		Year:           statement.Year,
+		Month:          statement.Month,
+		ClosingDate:    statement.ClosingDate.Format(time.DateOnly),
+		DueDate:        statement.DueDate.Format(time.DateOnly),
+		Total:          statement.Total,
+		MinimumPayment: statement.MinimumPayment,
+		BudgetBillId:   statement.BudgetBillId,
+		AvailableId:    statement.AvailableId,
+	}
+
+	if statement.PaidAt != nil {
+		paidAt := statement.PaidAt.UnixMilli()
+		response.PaidAt = &paidAt
+	}
+
+	return response
+}
+
+type PayStatementRequest struct {
+	AvailableId uint `json:"availableId" validate:"required"`
+}
+
+type AccountRequest struct {
+	Name    string             `json:"name" validate:"required,max=40"`
+	Type    domain.AccountType `json:"type" validate:"required,oneof='cash' 'bank' 'wallet'"`
+	Balance float64            `json:"balance"`
+}
+
+func (r AccountRequest) ToDomain(userId uint) domain.Account {
+	return domain.Account{
+		UserId:  userId,
+		Name:    r.Name,
+		Type:    r.Type,
+		Balance: r.Balance,
+	}
+}
+
+type AccountResponse struct {
+	ID        uint               `json:"id"`
+	Name      string             `json:"name"`
+	Type      domain.AccountType `json:"type"`
+	Balance   float64            `json:"balance"`
+	CreatedAt int64              `json:"createdAt"`
+}
+
+func NewAccountResponse(account domain.Account) AccountResponse {
+	return AccountResponse{
+		ID:        account.ID,
+		Name:      account.Name,
+		Type:      account.Type,
+		Balance:   account.Balance,
+		CreatedAt: account.CreatedAt.UnixMilli(),
+	}
+}
+
+type AccountEntryResponse struct {
+	ID            uint                    `json:"id"`
+	AccountId     uint                    `json:"accountId"`
+	Type          domain.AccountEntryType `json:"type"`
+	Amount        float64                 `json:"amount"`
+	Balance       float64                 `json:"balance"`
+	Description   string                  `json:"description"`
+	CounterpartId *uint                   `json:"counterpartId"`
+	CreatedAt     int64                   `json:"createdAt"`
+}
+
+func NewAccountEntriesResponse(entries []domain.AccountEntry) []AccountEntryResponse {
+	response := []AccountEntryResponse{}
+	for _, entry := range entries {
+		response = append(response, newAccountEntryResponse(entry))
+	}
+
+	return response
+}
+
+func newAccountEntryResponse(entry domain.AccountEntry) AccountEntryResponse {
+	return AccountEntryResponse{
+		ID:            entry.ID,
+		AccountId:     entry.AccountId,
+		Type:          entry.Type,
+		Amount:        entry.Amount,
+		Balance:       entry.Balance,
+		Description:   entry.Description,
+		CounterpartId: entry.CounterpartId,
+		CreatedAt:     entry.CreatedAt.UnixMilli(),
+	}
+}
+
+type TransferRequest struct {
+	FromId      uint    `json:"fromId" validate:"required"`
+	ToId        uint    `json:"toId" validate:"required"`
+	Amount      float64 `json:"amount" validate:"required,gt=0"`
+	Description string  `json:"description" validate:"max=200"`
+}
+
+type ReconcileRequest struct {
+	Balance *float64 `json:"balance" validate:"required"`
+}
+
+type ReconciliationResponse struct {
+	Account    AccountResponse       `json:"account"`
+	Adjustment *AccountEntryResponse `json:"adjustment"`
+}
+
+func NewReconciliationResponse(reconciliation application.Reconciliation) ReconciliationResponse {
+	response := ReconciliationResponse{
+		Account: NewAccountResponse(reconciliation.Account),
+	}
+
+	if reconciliation.Adjustment != nil {
+		adjustment := newAccountEntryResponse(*reconciliation.Adjustment)
+		response.Adjustment = &adjustment
+	}
+
+	return response
+}
+
+func NewAccountAvailablesResponse(availables []domain.BudgetAvailable) []ReadByIDResponseAvailable {
+	return newAvailablesResponse(availables)
+}
+
+type BudgetMemberRequest struct {
+	Name string `json:"name" validate:"required,max=40"`
+}
+
+type BudgetMemberResponse struct {
+	ID        uint   `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+func NewBudgetMemberResponse(member domain.BudgetMember) BudgetMemberResponse {
+	return BudgetMemberResponse{
+		ID:        member.ID,
+		Name:      member.Name,
+		CreatedAt: member.CreatedAt.UnixMilli(),
+	}
+}
+
+func NewBudgetMembersResponse(members []domain.BudgetMember) []BudgetMemberResponse {
+	response := []BudgetMemberResponse{}
+	for _, member := range members {
+		response = append(response, NewBudgetMemberResponse(member))
+	}
+
+	return response
+}
+
+type BillShareRequest struct {
+	MemberId uint    `json:"memberId" validate:"required"`
+	Value    float64 `json:"value" validate:"gte=0"`
+	Paid     float64 `json:"paid" validate:"gte=0"`
+}
+
+type BillSplitRequest struct {
+	Method domain.SplitMethod `json:"method" validate:"required,oneof='equal' 'percentage' 'fixed'"`
+	Shares []BillShareRequest `json:"shares" validate:"required,min=1,dive"`
+}
+
+func (r BillSplitRequest) ToDomain(billId uint) domain.BillSplit {
+	shares := []domain.BillShare{}
+	for _, share := range r.Shares {
+		shares = append(shares, domain.BillShare{
+			MemberId: share.MemberId,
+			Value:    share.Value,
+			Paid:     share.Paid,
+		})
+	}
+
+	return domain.BillSplit{
+		BudgetBillId: billId,
+		Method:       r.Method,
+		Shares:       shares,
+	}
+}
+
+type BillShareResponse struct {
+	MemberId uint    `json:"memberId"`
+	Value    float64 `json:"value"`
+	Paid     float64 `json:"paid"`
+}
+
+type BillSplitResponse struct {
+	BillId uint                `json:"billId"`
+	Method domain.SplitMethod  `json:"method"`
+	Shares []BillShareResponse `json:"shares"`
+}
+
+func NewBillSplitResponse(split domain.BillSplit) BillSplitResponse {
+	shares := []BillShareResponse{}
+	for _, share := range split.Shares {
+		shares = append(shares, BillShareResponse{
+			MemberId: share.MemberId,
+			Value:    share.Value,
+			Paid:     share.Paid,
+		})
+	}
+
+	return BillSplitResponse{
+		BillId: split.BudgetBillId,
+		Method: split.Method,
+		Shares: shares,
+	}
+}
+
+type MemberBalanceResponse struct {
+	Member   BudgetMemberResponse `json:"member"`
+	Paid     float64              `json:"paid"`
+	Owed     float64              `json:"owed"`
+	Sent     float64              `json:"sent"`
+	Received float64              `json:"received"`
+	Balance  float64              `json:"balance"`
+}
+
+type SettlementSuggestionResponse struct {
+	FromMemberId uint    `json:"fromMemberId"`
+	ToMemberId   uint    `json:"toMemberId"`
+	Amount       float64 `json:"amount"`
+}
+
+type SplitBalancesResponse struct {
+	Members     []MemberBalanceResponse        `json:"members"`
+	Suggestions []SettlementSuggestionResponse `json:"suggestions"`
+}
+
+func NewSplitBalancesResponse(balances application.SplitBalances) SplitBalancesResponse {
+	response := SplitBalancesResponse{
+		Members:     []MemberBalanceResponse{},
+		Suggestions: []SettlementSuggestionResponse{},
+	}
+	for _, balance := range balances.Members {
+		response.Members = append(response.Members, MemberBalanceResponse{
+			Member:   NewBudgetMemberResponse(balance.Member),
+			Paid:     balance.Paid,
+			Owed:     balance.Owed,
+			Sent:     balance.Sent,
+			Received: balance.Received,
+			Balance:  balance.Balance,
+		})
+	}
+
+	for _, suggestion := range balances.Suggestions {
+		response.Suggestions = append(response.Suggestions, SettlementSuggestionResponse{
+			FromMemberId: suggestion.FromMemberId,
+			ToMemberId:   suggestion.ToMemberId,
+			Amount:       suggestion.Amount,
+		})
+	}
+
+	return response
+}
+
+type SettlementRequest struct {
+	FromMemberId uint    `json:"fromMemberId" validate:"required"`
+	ToMemberId   uint    `json:"toMemberId" validate:"required"`
+	Amount       float64 `json:"amount" validate:"required,gt=0"`
+}
+
+func (r SettlementRequest) ToDomain(budgetId uint) domain.BudgetSettlement {
+	return domain.BudgetSettlement{
+		BudgetId:     budgetId,
+		FromMemberId: r.FromMemberId,
+		ToMemberId:   r.ToMemberId,
+		Amount:       r.Amount,
+	}
+}
+
+type SettlementResponse struct {
+	ID           uint    `json:"id"`
+	FromMemberId uint    `json:"fromMemberId"`
+	ToMemberId   uint    `json:"toMemberId"`
+	Amount       float64 `json:"amount"`
+	CreatedAt    int64   `json:"createdAt"`
+}
+
+func NewSettlementResponse(settlement domain.BudgetSettlement) SettlementResponse {
+	return SettlementResponse{
+		ID:           settlement.ID,
+		FromMemberId: settlement.FromMemberId,
+		ToMemberId:   settlement.ToMemberId,
+		Amount:       settlement.Amount,
+		CreatedAt:    settlement.CreatedAt.UnixMilli(),
+	}
+}
+
+func NewSettlementsResponse(settlements []domain.BudgetSettlement) []SettlementResponse {
+	response := []SettlementResponse{}
+	for _, settlement := range settlements {
+		response = append(response, NewSettlementResponse(settlement))
+	}
+
+	return response
+}
+
+type EnvelopeModeRequest struct {
+	ZeroBased *bool `json:"zeroBased" validate:"required"`
+}
+
+type EnvelopeRequest struct {
+	Category *domain.BudgetBillCategory `json:"category" validate:"required_without=BillId,excluded_with=BillId,omitempty,oneof='house' 'entertainment' 'personal' 'vehicle_transportation' 'education' 'services' 'financial' 'saving' 'others'"`
+	BillId   *uint                      `json:"billId" validate:"omitempty,min=1"`
+	Assigned float64                    `json:"assigned" validate:"gte=0"`
+}
+
+func (r EnvelopeRequest) ToDomain(budgetId uint) domain.BudgetEnvelope {
+	return domain.BudgetEnvelope{
+		BudgetId:     budgetId,
+		Category:     r.Category,
+		BudgetBillId: r.BillId,
+		Assigned:     r.Assigned,
+	}
+}
+
+type AssignEnvelopeRequest struct {
+	Assigned *float64 `json:"assigned" validate:"required,gte=0"`
+}
+
+type MoveEnvelopeRequest struct {
+	FromId uint    `json:"fromId" validate:"required"`
+	ToId   uint    `json:"toId" validate:"required"`
+	Amount float64 `json:"amount" validate:"required,gt=0"`
+}
+
+type EnvelopeResponse struct {
+	ID        uint                       `json:"id"`
+	Name      string                     `json:"name"`
+	Category  *domain.BudgetBillCategory `json:"category"`
+	BillId    *uint                      `json:"billId"`
+	Assigned  float64                    `json:"assigned"`
+	Carryover float64                    `json:"carryover"`
+}
+
+func NewEnvelopeResponse(envelope domain.BudgetEnvelope) EnvelopeResponse {
+	return EnvelopeResponse{
+		ID:        envelope.ID,
+		Name:      envelope.Name,
+		Category:  envelope.Category,
+		BillId:    envelope.BudgetBillId,
+		Assigned:  envelope.Assigned,
+		Carryover: envelope.Carryover,
+	}
+}
+
+type EnvelopeStatusResponse struct {
+	EnvelopeResponse
+	Spent     float64 `json:"spent"`
+	Available float64 `json:"available"`
+}
+
+type EnvelopeSummaryResponse struct {
+	BudgetId      uint                     `json:"budgetId"`
+	ZeroBased     bool                     `json:"zeroBased"`
+	Income        float64                  `json:"income"`
+	TotalAssigned float64                  `json:"totalAssigned"`
+	ToBeAssigned  float64                  `json:"toBeAssigned"`
+	Envelopes     []EnvelopeStatusResponse `json:"envelopes"`
+}
+
+func NewEnvelopeSummaryResponse(summary application.EnvelopeSummary) EnvelopeSummaryResponse {
+	response := EnvelopeSummaryResponse{
+		BudgetId:      summary.BudgetId,
+		ZeroBased:     summary.ZeroBased,
+		Income:        summary.Income,
+		TotalAssigned: summary.TotalAssigned,
+		ToBeAssigned:  summary.ToBeAssigned,
+		Envelopes:     []EnvelopeStatusResponse{},
+	}
+	for _, status := range summary.Envelopes {
+		response.Envelopes = append(response.Envelopes, EnvelopeStatusResponse{
+			EnvelopeResponse: NewEnvelopeResponse(status.Envelope),
+			Spent:            status.Spent,
+			Available:        status.Available,
+		})
+	}
+
+	return response
+}
+
+type AnnualReportRequest struct {
+	Format string `query:"format" json:"format" validate:"omitempty,oneof=json pdf"`
+}
+
+type AnnualCategoryResponse struct {
+	Category domain.BudgetBillCategory `json:"category"`
+	Amount   float64                   `json:"amount"`
+	Payment  float64                   `json:"payment"`
+}
+
+type AnnualTotalsResponse struct {
+	Income     float64                  `json:"income"`
+	Billed     float64                  `json:"billed"`
+	Paid       float64                  `json:"paid"`
+	Pending    float64                  `json:"pending"`
+	Savings    float64                  `json:"savings"`
+	Categories []AnnualCategoryResponse `json:"categories"`
+}
+
+type AnnualMonthResponse struct {
+	Month   uint8 `json:"month"`
+	Budgets uint  `json:"budgets"`
+	AnnualTotalsResponse
+}
+
+type ReportBillResponse struct {
+	ID          uint                      `json:"id"`
+	BudgetId    uint                      `json:"budgetId"`
+	BudgetName  string                    `json:"budgetName"`
+	Month       uint8                     `json:"month"`
+	Description string                    `json:"description"`
+	Category    domain.BudgetBillCategory `json:"category"`
+	Amount      float64                   `json:"amount"`
+	Payment     float64                   `json:"payment"`
+}
+
+type AnnualReportResponse struct {
+	Year     uint16                `json:"year"`
+	Months   []AnnualMonthResponse `json:"months"`
+	Totals   AnnualTotalsResponse  `json:"totals"`
+	TopBills []ReportBillResponse  `json:"topBills"`
+}
+
+func NewAnnualReportResponse(report application.AnnualReport) AnnualReportResponse {
+	response := AnnualReportResponse{
+		Year:     report.Year,
+		Months:   []AnnualMonthResponse{},
+		Totals:   newAnnualTotalsResponse(report.Totals),
+		TopBills: []ReportBillResponse{},
+	}
+	for _, month := range report.Months {
+		response.Months = append(response.Months, AnnualMonthResponse{
+			Month:                month.Month,
+			Budgets:              month.Budgets,
+			AnnualTotalsResponse: newAnnualTotalsResponse(month.AnnualTotals),
+		})
+	}
+
+	for _, bill := range report.TopBills {
+		response.TopBills = append(response.TopBills, ReportBillResponse{
+			ID:          bill.ID,
+			BudgetId:    bill.BudgetId,
+			BudgetName:  bill.BudgetName,
+			Month:       bill.Month,
+			Description: bill.Description,
+			Category:    bill.Category,
+			Amount:      bill.Amount,
+			Payment:     bill.Payment,
+		})
+	}
+
+	return response
+}
+
+func newAnnualTotalsResponse(totals application.AnnualTotals) AnnualTotalsResponse {
+	response := AnnualTotalsResponse{
+		Income:     totals.Income,
+		Billed:     totals.Billed,
+		Paid:       totals.Paid,
+		Pending:    totals.Pending,
+		Savings:    totals.Savings,
+		Categories: []AnnualCategoryResponse{},
+	}
+	for _, category := range totals.Categories {
+		response.Categories = append(response.Categories, AnnualCategoryResponse{
+			Category: category.Category,
+			Amount:   category.Amount,
+			Payment:  category.Payment,
+		})
+	}
+
+	return response
+}
@@ -6,6 +6,8 @@ import (
 	context "context"
 	application "your-accounts-api/budgets/application"
 
+	shareddomain "your-accounts-api/shared/domain"
+
 	domain "your-accounts-api/budgets/domain"
 
 	mock "github.com/stretchr/testify/mock"
@@ -237,6 +239,53 @@ func (_c *MockIBudgetApp_Delete_Call) RunAndReturn(run func(context.Context, uin
 	return _c
 }
 
+// DeleteUserEvent provides a mock function with given fields: ctx, event
+func (_m *MockIBudgetApp) DeleteUserEvent(ctx context.Context, event shareddomain.Event) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, shareddomain.Event) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIBudgetApp_DeleteUserEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserEvent'
+type MockIBudgetApp_DeleteUserEvent_Call struct {
+	*mock.Call
+}
+
+// DeleteUserEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event shareddomain.Event
+func (_e *MockIBudgetApp_Expecter) DeleteUserEvent(ctx interface{}, event interface{}) *MockIBudgetApp_DeleteUserEvent_Call {
+	return &MockIBudgetApp_DeleteUserEvent_Call{Call: _e.mock.On("DeleteUserEvent", ctx, event)}
+}
+
+func (_c *MockIBudgetApp_DeleteUserEvent_Call) Run(run func(ctx context.Context, event shareddomain.Event)) *MockIBudgetApp_DeleteUserEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(shareddomain.Event))
+	})
+	return _c
+}
+
+func (_c *MockIBudgetApp_DeleteUserEvent_Call) Return(_a0 error) *MockIBudgetApp_DeleteUserEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIBudgetApp_DeleteUserEvent_Call) RunAndReturn(run func(context.Context, shareddomain.Event) error) *MockIBudgetApp_DeleteUserEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindById provides a mock function with given fields: ctx, id
 func (_m *MockIBudgetApp) FindById(ctx context.Context, id uint) (domain.Budget, error) {
 	ret := _m.Called(ctx, id)
@@ -353,6 +402,100 @@ func (_c *MockIBudgetApp_FindByUserId_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// Redo provides a mock function with given fields: ctx, id
+func (_m *MockIBudgetApp) Redo(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIBudgetApp_Redo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redo'
+type MockIBudgetApp_Redo_Call struct {
+	*mock.Call
+}
+
+// Redo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIBudgetApp_Expecter) Redo(ctx interface{}, id interface{}) *MockIBudgetApp_Redo_Call {
+	return &MockIBudgetApp_Redo_Call{Call: _e.mock.On("Redo", ctx, id)}
+}
+
+func (_c *MockIBudgetApp_Redo_Call) Run(run func(ctx context.Context, id uint)) *MockIBudgetApp_Redo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIBudgetApp_Redo_Call) Return(_a0 error) *MockIBudgetApp_Redo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIBudgetApp_Redo_Call) RunAndReturn(run func(context.Context, uint) error) *MockIBudgetApp_Redo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Undo provides a mock function with given fields: ctx, id
+func (_m *MockIBudgetApp) Undo(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Undo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIBudgetApp_Undo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Undo'
+type MockIBudgetApp_Undo_Call struct {
+	*mock.Call
+}
+
+// Undo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIBudgetApp_Expecter) Undo(ctx interface{}, id interface{}) *MockIBudgetApp_Undo_Call {
+	return &MockIBudgetApp_Undo_Call{Call: _e.mock.On("Undo", ctx, id)}
+}
+
+func (_c *MockIBudgetApp_Undo_Call) Run(run func(ctx context.Context, id uint)) *MockIBudgetApp_Undo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIBudgetApp_Undo_Call) Return(_a0 error) *MockIBudgetApp_Undo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIBudgetApp_Undo_Call) RunAndReturn(run func(context.Context, uint) error) *MockIBudgetApp_Undo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockIBudgetApp creates a new instance of MockIBudgetApp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIBudgetApp(t interface {
@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	persistent "your-accounts-api/shared/domain/persistent"
+
+	time "time"
 )
 
 // MockBudgetAvailableRepository is an autogenerated mock type for the BudgetAvailableRepository type
@@ -71,6 +73,100 @@ func (_c *MockBudgetAvailableRepository_Delete_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// DeleteTrashedBefore provides a mock function with given fields: ctx, before
+func (_m *MockBudgetAvailableRepository) DeleteTrashedBefore(ctx context.Context, before time.Time) error {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTrashedBefore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBudgetAvailableRepository_DeleteTrashedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTrashedBefore'
+type MockBudgetAvailableRepository_DeleteTrashedBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteTrashedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockBudgetAvailableRepository_Expecter) DeleteTrashedBefore(ctx interface{}, before interface{}) *MockBudgetAvailableRepository_DeleteTrashedBefore_Call {
+	return &MockBudgetAvailableRepository_DeleteTrashedBefore_Call{Call: _e.mock.On("DeleteTrashedBefore", ctx, before)}
+}
+
+func (_c *MockBudgetAvailableRepository_DeleteTrashedBefore_Call) Run(run func(ctx context.Context, before time.Time)) *MockBudgetAvailableRepository_DeleteTrashedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockBudgetAvailableRepository_DeleteTrashedBefore_Call) Return(_a0 error) *MockBudgetAvailableRepository_DeleteTrashedBefore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBudgetAvailableRepository_DeleteTrashedBefore_Call) RunAndReturn(run func(context.Context, time.Time) error) *MockBudgetAvailableRepository_DeleteTrashedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *MockBudgetAvailableRepository) Restore(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBudgetAvailableRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockBudgetAvailableRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockBudgetAvailableRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockBudgetAvailableRepository_Restore_Call {
+	return &MockBudgetAvailableRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockBudgetAvailableRepository_Restore_Call) Run(run func(ctx context.Context, id uint)) *MockBudgetAvailableRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockBudgetAvailableRepository_Restore_Call) Return(_a0 error) *MockBudgetAvailableRepository_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBudgetAvailableRepository_Restore_Call) RunAndReturn(run func(context.Context, uint) error) *MockBudgetAvailableRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: ctx, _a1
 func (_m *MockBudgetAvailableRepository) Save(ctx context.Context, _a1 domain.BudgetAvailable) (uint, error) {
 	ret := _m.Called(ctx, _a1)
@@ -175,6 +271,122 @@ func (_c *MockBudgetAvailableRepository_SaveAll_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// SearchAllTrashedByUserId provides a mock function with given fields: ctx, userId
+func (_m *MockBudgetAvailableRepository) SearchAllTrashedByUserId(ctx context.Context, userId uint) ([]domain.BudgetAvailable, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAllTrashedByUserId")
+	}
+
+	var r0 []domain.BudgetAvailable
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.BudgetAvailable, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.BudgetAvailable); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.BudgetAvailable)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBudgetAvailableRepository_SearchAllTrashedByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAllTrashedByUserId'
+type MockBudgetAvailableRepository_SearchAllTrashedByUserId_Call struct {
+	*mock.Call
+}
+
+// SearchAllTrashedByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uint
+func (_e *MockBudgetAvailableRepository_Expecter) SearchAllTrashedByUserId(ctx interface{}, userId interface{}) *MockBudgetAvailableRepository_SearchAllTrashedByUserId_Call {
+	return &MockBudgetAvailableRepository_SearchAllTrashedByUserId_Call{Call: _e.mock.On("SearchAllTrashedByUserId", ctx, userId)}
+}
+
+func (_c *MockBudgetAvailableRepository_SearchAllTrashedByUserId_Call) Run(run func(ctx context.Context, userId uint)) *MockBudgetAvailableRepository_SearchAllTrashedByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockBudgetAvailableRepository_SearchAllTrashedByUserId_Call) Return(_a0 []domain.BudgetAvailable, _a1 error) *MockBudgetAvailableRepository_SearchAllTrashedByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBudgetAvailableRepository_SearchAllTrashedByUserId_Call) RunAndReturn(run func(context.Context, uint) ([]domain.BudgetAvailable, error)) *MockBudgetAvailableRepository_SearchAllTrashedByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTrashed provides a mock function with given fields: ctx, id
+func (_m *MockBudgetAvailableRepository) SearchTrashed(ctx context.Context, id uint) (domain.BudgetAvailable, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTrashed")
+	}
+
+	var r0 domain.BudgetAvailable
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (domain.BudgetAvailable, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) domain.BudgetAvailable); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.BudgetAvailable)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBudgetAvailableRepository_SearchTrashed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTrashed'
+type MockBudgetAvailableRepository_SearchTrashed_Call struct {
+	*mock.Call
+}
+
+// SearchTrashed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockBudgetAvailableRepository_Expecter) SearchTrashed(ctx interface{}, id interface{}) *MockBudgetAvailableRepository_SearchTrashed_Call {
+	return &MockBudgetAvailableRepository_SearchTrashed_Call{Call: _e.mock.On("SearchTrashed", ctx, id)}
+}
+
+func (_c *MockBudgetAvailableRepository_SearchTrashed_Call) Run(run func(ctx context.Context, id uint)) *MockBudgetAvailableRepository_SearchTrashed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockBudgetAvailableRepository_SearchTrashed_Call) Return(_a0 domain.BudgetAvailable, _a1 error) *MockBudgetAvailableRepository_SearchTrashed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBudgetAvailableRepository_SearchTrashed_Call) RunAndReturn(run func(context.Context, uint) (domain.BudgetAvailable, error)) *MockBudgetAvailableRepository_SearchTrashed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithTransaction provides a mock function with given fields: tx
 func (_m *MockBudgetAvailableRepository) WithTransaction(tx persistent.Transaction) domain.BudgetAvailableRepository {
 	ret := _m.Called(tx)
@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	persistent "your-accounts-api/shared/domain/persistent"
+
+	time "time"
 )
 
 // MockBudgetBillRepository is an autogenerated mock type for the BudgetBillRepository type
@@ -71,6 +73,100 @@ func (_c *MockBudgetBillRepository_Delete_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// DeleteTrashedBefore provides a mock function with given fields: ctx, before
+func (_m *MockBudgetBillRepository) DeleteTrashedBefore(ctx context.Context, before time.Time) error {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTrashedBefore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBudgetBillRepository_DeleteTrashedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTrashedBefore'
+type MockBudgetBillRepository_DeleteTrashedBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteTrashedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockBudgetBillRepository_Expecter) DeleteTrashedBefore(ctx interface{}, before interface{}) *MockBudgetBillRepository_DeleteTrashedBefore_Call {
+	return &MockBudgetBillRepository_DeleteTrashedBefore_Call{Call: _e.mock.On("DeleteTrashedBefore", ctx, before)}
+}
+
+func (_c *MockBudgetBillRepository_DeleteTrashedBefore_Call) Run(run func(ctx context.Context, before time.Time)) *MockBudgetBillRepository_DeleteTrashedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockBudgetBillRepository_DeleteTrashedBefore_Call) Return(_a0 error) *MockBudgetBillRepository_DeleteTrashedBefore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBudgetBillRepository_DeleteTrashedBefore_Call) RunAndReturn(run func(context.Context, time.Time) error) *MockBudgetBillRepository_DeleteTrashedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *MockBudgetBillRepository) Restore(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBudgetBillRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockBudgetBillRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockBudgetBillRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockBudgetBillRepository_Restore_Call {
+	return &MockBudgetBillRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockBudgetBillRepository_Restore_Call) Run(run func(ctx context.Context, id uint)) *MockBudgetBillRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockBudgetBillRepository_Restore_Call) Return(_a0 error) *MockBudgetBillRepository_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBudgetBillRepository_Restore_Call) RunAndReturn(run func(context.Context, uint) error) *MockBudgetBillRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: ctx, _a1
 func (_m *MockBudgetBillRepository) Save(ctx context.Context, _a1 domain.BudgetBill) (uint, error) {
 	ret := _m.Called(ctx, _a1)
@@ -232,6 +328,181 @@ func (_c *MockBudgetBillRepository_Search_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// SearchAllLinkedIdsByBudgetId provides a mock function with given fields: ctx, budgetId
+func (_m *MockBudgetBillRepository) SearchAllLinkedIdsByBudgetId(ctx context.Context, budgetId uint) ([]uint, error) {
+	ret := _m.Called(ctx, budgetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAllLinkedIdsByBudgetId")
+	}
+
+	var r0 []uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]uint, error)); ok {
+		return rf(ctx, budgetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []uint); ok {
+		r0 = rf(ctx, budgetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, budgetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBudgetBillRepository_SearchAllLinkedIdsByBudgetId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAllLinkedIdsByBudgetId'
+type MockBudgetBillRepository_SearchAllLinkedIdsByBudgetId_Call struct {
+	*mock.Call
+}
+
+// SearchAllLinkedIdsByBudgetId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - budgetId uint
+func (_e *MockBudgetBillRepository_Expecter) SearchAllLinkedIdsByBudgetId(ctx interface{}, budgetId interface{}) *MockBudgetBillRepository_SearchAllLinkedIdsByBudgetId_Call {
+	return &MockBudgetBillRepository_SearchAllLinkedIdsByBudgetId_Call{Call: _e.mock.On("SearchAllLinkedIdsByBudgetId", ctx, budgetId)}
+}
+
+func (_c *MockBudgetBillRepository_SearchAllLinkedIdsByBudgetId_Call) Run(run func(ctx context.Context, budgetId uint)) *MockBudgetBillRepository_SearchAllLinkedIdsByBudgetId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockBudgetBillRepository_SearchAllLinkedIdsByBudgetId_Call) Return(_a0 []uint, _a1 error) *MockBudgetBillRepository_SearchAllLinkedIdsByBudgetId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBudgetBillRepository_SearchAllLinkedIdsByBudgetId_Call) RunAndReturn(run func(context.Context, uint) ([]uint, error)) *MockBudgetBillRepository_SearchAllLinkedIdsByBudgetId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchAllTrashedByUserId provides a mock function with given fields: ctx, userId
+func (_m *MockBudgetBillRepository) SearchAllTrashedByUserId(ctx context.Context, userId uint) ([]domain.BudgetBill, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAllTrashedByUserId")
+	}
+
+	var r0 []domain.BudgetBill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.BudgetBill, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.BudgetBill); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.BudgetBill)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBudgetBillRepository_SearchAllTrashedByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAllTrashedByUserId'
+type MockBudgetBillRepository_SearchAllTrashedByUserId_Call struct {
+	*mock.Call
+}
+
+// SearchAllTrashedByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uint
+func (_e *MockBudgetBillRepository_Expecter) SearchAllTrashedByUserId(ctx interface{}, userId interface{}) *MockBudgetBillRepository_SearchAllTrashedByUserId_Call {
+	return &MockBudgetBillRepository_SearchAllTrashedByUserId_Call{Call: _e.mock.On("SearchAllTrashedByUserId", ctx, userId)}
+}
+
+func (_c *MockBudgetBillRepository_SearchAllTrashedByUserId_Call) Run(run func(ctx context.Context, userId uint)) *MockBudgetBillRepository_SearchAllTrashedByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockBudgetBillRepository_SearchAllTrashedByUserId_Call) Return(_a0 []domain.BudgetBill, _a1 error) *MockBudgetBillRepository_SearchAllTrashedByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBudgetBillRepository_SearchAllTrashedByUserId_Call) RunAndReturn(run func(context.Context, uint) ([]domain.BudgetBill, error)) *MockBudgetBillRepository_SearchAllTrashedByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTrashed provides a mock function with given fields: ctx, id
+func (_m *MockBudgetBillRepository) SearchTrashed(ctx context.Context, id uint) (domain.BudgetBill, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTrashed")
+	}
+
+	var r0 domain.BudgetBill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (domain.BudgetBill, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) domain.BudgetBill); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.BudgetBill)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBudgetBillRepository_SearchTrashed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTrashed'
+type MockBudgetBillRepository_SearchTrashed_Call struct {
+	*mock.Call
+}
+
+// SearchTrashed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockBudgetBillRepository_Expecter) SearchTrashed(ctx interface{}, id interface{}) *MockBudgetBillRepository_SearchTrashed_Call {
+	return &MockBudgetBillRepository_SearchTrashed_Call{Call: _e.mock.On("SearchTrashed", ctx, id)}
+}
+
+func (_c *MockBudgetBillRepository_SearchTrashed_Call) Run(run func(ctx context.Context, id uint)) *MockBudgetBillRepository_SearchTrashed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockBudgetBillRepository_SearchTrashed_Call) Return(_a0 domain.BudgetBill, _a1 error) *MockBudgetBillRepository_SearchTrashed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBudgetBillRepository_SearchTrashed_Call) RunAndReturn(run func(context.Context, uint) (domain.BudgetBill, error)) *MockBudgetBillRepository_SearchTrashed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithTransaction provides a mock function with given fields: tx
 func (_m *MockBudgetBillRepository) WithTransaction(tx persistent.Transaction) domain.BudgetBillRepository {
 	ret := _m.Called(tx)
@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	persistent "your-accounts-api/shared/domain/persistent"
+
+	time "time"
 )
 
 // MockBudgetRepository is an autogenerated mock type for the BudgetRepository type
@@ -71,6 +73,147 @@ func (_c *MockBudgetRepository_Delete_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// DeleteAllByUserId provides a mock function with given fields: ctx, userId
+func (_m *MockBudgetRepository) DeleteAllByUserId(ctx context.Context, userId uint) error {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllByUserId")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBudgetRepository_DeleteAllByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllByUserId'
+type MockBudgetRepository_DeleteAllByUserId_Call struct {
+	*mock.Call
+}
+
+// DeleteAllByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uint
+func (_e *MockBudgetRepository_Expecter) DeleteAllByUserId(ctx interface{}, userId interface{}) *MockBudgetRepository_DeleteAllByUserId_Call {
+	return &MockBudgetRepository_DeleteAllByUserId_Call{Call: _e.mock.On("DeleteAllByUserId", ctx, userId)}
+}
+
+func (_c *MockBudgetRepository_DeleteAllByUserId_Call) Run(run func(ctx context.Context, userId uint)) *MockBudgetRepository_DeleteAllByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockBudgetRepository_DeleteAllByUserId_Call) Return(_a0 error) *MockBudgetRepository_DeleteAllByUserId_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBudgetRepository_DeleteAllByUserId_Call) RunAndReturn(run func(context.Context, uint) error) *MockBudgetRepository_DeleteAllByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTrashedBefore provides a mock function with given fields: ctx, before
+func (_m *MockBudgetRepository) DeleteTrashedBefore(ctx context.Context, before time.Time) error {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTrashedBefore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBudgetRepository_DeleteTrashedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTrashedBefore'
+type MockBudgetRepository_DeleteTrashedBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteTrashedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockBudgetRepository_Expecter) DeleteTrashedBefore(ctx interface{}, before interface{}) *MockBudgetRepository_DeleteTrashedBefore_Call {
+	return &MockBudgetRepository_DeleteTrashedBefore_Call{Call: _e.mock.On("DeleteTrashedBefore", ctx, before)}
+}
+
+func (_c *MockBudgetRepository_DeleteTrashedBefore_Call) Run(run func(ctx context.Context, before time.Time)) *MockBudgetRepository_DeleteTrashedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockBudgetRepository_DeleteTrashedBefore_Call) Return(_a0 error) *MockBudgetRepository_DeleteTrashedBefore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBudgetRepository_DeleteTrashedBefore_Call) RunAndReturn(run func(context.Context, time.Time) error) *MockBudgetRepository_DeleteTrashedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *MockBudgetRepository) Restore(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBudgetRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockBudgetRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockBudgetRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockBudgetRepository_Restore_Call {
+	return &MockBudgetRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockBudgetRepository_Restore_Call) Run(run func(ctx context.Context, id uint)) *MockBudgetRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockBudgetRepository_Restore_Call) Return(_a0 error) *MockBudgetRepository_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBudgetRepository_Restore_Call) RunAndReturn(run func(context.Context, uint) error) *MockBudgetRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: ctx, _a1
 func (_m *MockBudgetRepository) Save(ctx context.Context, _a1 domain.Budget) (uint, error) {
 	ret := _m.Called(ctx, _a1)
@@ -244,6 +387,300 @@ func (_c *MockBudgetRepository_SearchAllByExample_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// SearchAllByUserId provides a mock function with given fields: ctx, userId
+func (_m *MockBudgetRepository) SearchAllByUserId(ctx context.Context, userId uint) ([]domain.Budget, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAllByUserId")
+	}
+
+	var r0 []domain.Budget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.Budget, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.Budget); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Budget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBudgetRepository_SearchAllByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAllByUserId'
+type MockBudgetRepository_SearchAllByUserId_Call struct {
+	*mock.Call
+}
+
+// SearchAllByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uint
+func (_e *MockBudgetRepository_Expecter) SearchAllByUserId(ctx interface{}, userId interface{}) *MockBudgetRepository_SearchAllByUserId_Call {
+	return &MockBudgetRepository_SearchAllByUserId_Call{Call: _e.mock.On("SearchAllByUserId", ctx, userId)}
+}
+
+func (_c *MockBudgetRepository_SearchAllByUserId_Call) Run(run func(ctx context.Context, userId uint)) *MockBudgetRepository_SearchAllByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockBudgetRepository_SearchAllByUserId_Call) Return(_a0 []domain.Budget, _a1 error) *MockBudgetRepository_SearchAllByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBudgetRepository_SearchAllByUserId_Call) RunAndReturn(run func(context.Context, uint) ([]domain.Budget, error)) *MockBudgetRepository_SearchAllByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchAllTrashedByUserId provides a mock function with given fields: ctx, userId
+func (_m *MockBudgetRepository) SearchAllTrashedByUserId(ctx context.Context, userId uint) ([]domain.Budget, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAllTrashedByUserId")
+	}
+
+	var r0 []domain.Budget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.Budget, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.Budget); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Budget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBudgetRepository_SearchAllTrashedByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAllTrashedByUserId'
+type MockBudgetRepository_SearchAllTrashedByUserId_Call struct {
+	*mock.Call
+}
+
+// SearchAllTrashedByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uint
+func (_e *MockBudgetRepository_Expecter) SearchAllTrashedByUserId(ctx interface{}, userId interface{}) *MockBudgetRepository_SearchAllTrashedByUserId_Call {
+	return &MockBudgetRepository_SearchAllTrashedByUserId_Call{Call: _e.mock.On("SearchAllTrashedByUserId", ctx, userId)}
+}
+
+func (_c *MockBudgetRepository_SearchAllTrashedByUserId_Call) Run(run func(ctx context.Context, userId uint)) *MockBudgetRepository_SearchAllTrashedByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockBudgetRepository_SearchAllTrashedByUserId_Call) Return(_a0 []domain.Budget, _a1 error) *MockBudgetRepository_SearchAllTrashedByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBudgetRepository_SearchAllTrashedByUserId_Call) RunAndReturn(run func(context.Context, uint) ([]domain.Budget, error)) *MockBudgetRepository_SearchAllTrashedByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchLatestByMonth provides a mock function with given fields: ctx, userId, year, month
+func (_m *MockBudgetRepository) SearchLatestByMonth(ctx context.Context, userId uint, year uint16, month uint8) (domain.Budget, error) {
+	ret := _m.Called(ctx, userId, year, month)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchLatestByMonth")
+	}
+
+	var r0 domain.Budget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint16, uint8) (domain.Budget, error)); ok {
+		return rf(ctx, userId, year, month)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint16, uint8) domain.Budget); ok {
+		r0 = rf(ctx, userId, year, month)
+	} else {
+		r0 = ret.Get(0).(domain.Budget)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint16, uint8) error); ok {
+		r1 = rf(ctx, userId, year, month)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBudgetRepository_SearchLatestByMonth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchLatestByMonth'
+type MockBudgetRepository_SearchLatestByMonth_Call struct {
+	*mock.Call
+}
+
+// SearchLatestByMonth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uint
+//   - year uint16
+//   - month uint8
+func (_e *MockBudgetRepository_Expecter) SearchLatestByMonth(ctx interface{}, userId interface{}, year interface{}, month interface{}) *MockBudgetRepository_SearchLatestByMonth_Call {
+	return &MockBudgetRepository_SearchLatestByMonth_Call{Call: _e.mock.On("SearchLatestByMonth", ctx, userId, year, month)}
+}
+
+func (_c *MockBudgetRepository_SearchLatestByMonth_Call) Run(run func(ctx context.Context, userId uint, year uint16, month uint8)) *MockBudgetRepository_SearchLatestByMonth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint16), args[3].(uint8))
+	})
+	return _c
+}
+
+func (_c *MockBudgetRepository_SearchLatestByMonth_Call) Return(_a0 domain.Budget, _a1 error) *MockBudgetRepository_SearchLatestByMonth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBudgetRepository_SearchLatestByMonth_Call) RunAndReturn(run func(context.Context, uint, uint16, uint8) (domain.Budget, error)) *MockBudgetRepository_SearchLatestByMonth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchLatestByUserId provides a mock function with given fields: ctx, userId, limit
+func (_m *MockBudgetRepository) SearchLatestByUserId(ctx context.Context, userId uint, limit int) ([]domain.Budget, error) {
+	ret := _m.Called(ctx, userId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchLatestByUserId")
+	}
+
+	var r0 []domain.Budget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, int) ([]domain.Budget, error)); ok {
+		return rf(ctx, userId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, int) []domain.Budget); ok {
+		r0 = rf(ctx, userId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Budget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, int) error); ok {
+		r1 = rf(ctx, userId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBudgetRepository_SearchLatestByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchLatestByUserId'
+type MockBudgetRepository_SearchLatestByUserId_Call struct {
+	*mock.Call
+}
+
+// SearchLatestByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uint
+//   - limit int
+func (_e *MockBudgetRepository_Expecter) SearchLatestByUserId(ctx interface{}, userId interface{}, limit interface{}) *MockBudgetRepository_SearchLatestByUserId_Call {
+	return &MockBudgetRepository_SearchLatestByUserId_Call{Call: _e.mock.On("SearchLatestByUserId", ctx, userId, limit)}
+}
+
+func (_c *MockBudgetRepository_SearchLatestByUserId_Call) Run(run func(ctx context.Context, userId uint, limit int)) *MockBudgetRepository_SearchLatestByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockBudgetRepository_SearchLatestByUserId_Call) Return(_a0 []domain.Budget, _a1 error) *MockBudgetRepository_SearchLatestByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBudgetRepository_SearchLatestByUserId_Call) RunAndReturn(run func(context.Context, uint, int) ([]domain.Budget, error)) *MockBudgetRepository_SearchLatestByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTrashed provides a mock function with given fields: ctx, id
+func (_m *MockBudgetRepository) SearchTrashed(ctx context.Context, id uint) (domain.Budget, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTrashed")
+	}
+
+	var r0 domain.Budget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (domain.Budget, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) domain.Budget); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Budget)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBudgetRepository_SearchTrashed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTrashed'
+type MockBudgetRepository_SearchTrashed_Call struct {
+	*mock.Call
+}
+
+// SearchTrashed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockBudgetRepository_Expecter) SearchTrashed(ctx interface{}, id interface{}) *MockBudgetRepository_SearchTrashed_Call {
+	return &MockBudgetRepository_SearchTrashed_Call{Call: _e.mock.On("SearchTrashed", ctx, id)}
+}
+
+func (_c *MockBudgetRepository_SearchTrashed_Call) Run(run func(ctx context.Context, id uint)) *MockBudgetRepository_SearchTrashed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockBudgetRepository_SearchTrashed_Call) Return(_a0 domain.Budget, _a1 error) *MockBudgetRepository_SearchTrashed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBudgetRepository_SearchTrashed_Call) RunAndReturn(run func(context.Context, uint) (domain.Budget, error)) *MockBudgetRepository_SearchTrashed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithTransaction provides a mock function with given fields: tx
 func (_m *MockBudgetRepository) WithTransaction(tx persistent.Transaction) domain.BudgetRepository {
 	ret := _m.Called(tx)
@@ -167,6 +167,238 @@ func (_c *MockILogApp_DeleteOrphan_Call) RunAndReturn(run func(context.Context)
 	return _c
 }
 
+// DeleteOrphanEvent provides a mock function with given fields: ctx, event
+func (_m *MockILogApp) DeleteOrphanEvent(ctx context.Context, event domain.Event) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrphanEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Event) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockILogApp_DeleteOrphanEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrphanEvent'
+type MockILogApp_DeleteOrphanEvent_Call struct {
+	*mock.Call
+}
+
+// DeleteOrphanEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.Event
+func (_e *MockILogApp_Expecter) DeleteOrphanEvent(ctx interface{}, event interface{}) *MockILogApp_DeleteOrphanEvent_Call {
+	return &MockILogApp_DeleteOrphanEvent_Call{Call: _e.mock.On("DeleteOrphanEvent", ctx, event)}
+}
+
+func (_c *MockILogApp_DeleteOrphanEvent_Call) Run(run func(ctx context.Context, event domain.Event)) *MockILogApp_DeleteOrphanEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Event))
+	})
+	return _c
+}
+
+func (_c *MockILogApp_DeleteOrphanEvent_Call) Return(_a0 error) *MockILogApp_DeleteOrphanEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockILogApp_DeleteOrphanEvent_Call) RunAndReturn(run func(context.Context, domain.Event) error) *MockILogApp_DeleteOrphanEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: ctx, filter
+func (_m *MockILogApp) Export(ctx context.Context, filter domain.LogFilter) ([]domain.Log, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 []domain.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LogFilter) ([]domain.Log, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LogFilter) []domain.Log); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.LogFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockILogApp_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type MockILogApp_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.LogFilter
+func (_e *MockILogApp_Expecter) Export(ctx interface{}, filter interface{}) *MockILogApp_Export_Call {
+	return &MockILogApp_Export_Call{Call: _e.mock.On("Export", ctx, filter)}
+}
+
+func (_c *MockILogApp_Export_Call) Run(run func(ctx context.Context, filter domain.LogFilter)) *MockILogApp_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.LogFilter))
+	})
+	return _c
+}
+
+func (_c *MockILogApp_Export_Call) Return(_a0 []domain.Log, _a1 error) *MockILogApp_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockILogApp_Export_Call) RunAndReturn(run func(context.Context, domain.LogFilter) ([]domain.Log, error)) *MockILogApp_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByProject provides a mock function with given fields: ctx, code, resourceId
+func (_m *MockILogApp) FindAllByProject(ctx context.Context, code domain.CodeLog, resourceId uint) ([]domain.Log, error) {
+	ret := _m.Called(ctx, code, resourceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByProject")
+	}
+
+	var r0 []domain.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CodeLog, uint) ([]domain.Log, error)); ok {
+		return rf(ctx, code, resourceId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CodeLog, uint) []domain.Log); ok {
+		r0 = rf(ctx, code, resourceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CodeLog, uint) error); ok {
+		r1 = rf(ctx, code, resourceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockILogApp_FindAllByProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByProject'
+type MockILogApp_FindAllByProject_Call struct {
+	*mock.Call
+}
+
+// FindAllByProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code domain.CodeLog
+//   - resourceId uint
+func (_e *MockILogApp_Expecter) FindAllByProject(ctx interface{}, code interface{}, resourceId interface{}) *MockILogApp_FindAllByProject_Call {
+	return &MockILogApp_FindAllByProject_Call{Call: _e.mock.On("FindAllByProject", ctx, code, resourceId)}
+}
+
+func (_c *MockILogApp_FindAllByProject_Call) Run(run func(ctx context.Context, code domain.CodeLog, resourceId uint)) *MockILogApp_FindAllByProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CodeLog), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MockILogApp_FindAllByProject_Call) Return(_a0 []domain.Log, _a1 error) *MockILogApp_FindAllByProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockILogApp_FindAllByProject_Call) RunAndReturn(run func(context.Context, domain.CodeLog, uint) ([]domain.Log, error)) *MockILogApp_FindAllByProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByFilter provides a mock function with given fields: ctx, filter
+func (_m *MockILogApp) FindByFilter(ctx context.Context, filter domain.LogFilter) ([]domain.Log, uint, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByFilter")
+	}
+
+	var r0 []domain.Log
+	var r1 uint
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LogFilter) ([]domain.Log, uint, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LogFilter) []domain.Log); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.LogFilter) uint); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(uint)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.LogFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockILogApp_FindByFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByFilter'
+type MockILogApp_FindByFilter_Call struct {
+	*mock.Call
+}
+
+// FindByFilter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.LogFilter
+func (_e *MockILogApp_Expecter) FindByFilter(ctx interface{}, filter interface{}) *MockILogApp_FindByFilter_Call {
+	return &MockILogApp_FindByFilter_Call{Call: _e.mock.On("FindByFilter", ctx, filter)}
+}
+
+func (_c *MockILogApp_FindByFilter_Call) Run(run func(ctx context.Context, filter domain.LogFilter)) *MockILogApp_FindByFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.LogFilter))
+	})
+	return _c
+}
+
+func (_c *MockILogApp_FindByFilter_Call) Return(_a0 []domain.Log, _a1 uint, _a2 error) *MockILogApp_FindByFilter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockILogApp_FindByFilter_Call) RunAndReturn(run func(context.Context, domain.LogFilter) ([]domain.Log, uint, error)) *MockILogApp_FindByFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByProject provides a mock function with given fields: ctx, code, resourceId
 func (_m *MockILogApp) FindByProject(ctx context.Context, code domain.CodeLog, resourceId uint) ([]domain.Log, error) {
 	ret := _m.Called(ctx, code, resourceId)
@@ -227,6 +459,67 @@ func (_c *MockILogApp_FindByProject_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// FindByProjectWithDetail provides a mock function with given fields: ctx, code, resourceId, key
+func (_m *MockILogApp) FindByProjectWithDetail(ctx context.Context, code domain.CodeLog, resourceId uint, key string) ([]domain.Log, error) {
+	ret := _m.Called(ctx, code, resourceId, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByProjectWithDetail")
+	}
+
+	var r0 []domain.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CodeLog, uint, string) ([]domain.Log, error)); ok {
+		return rf(ctx, code, resourceId, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CodeLog, uint, string) []domain.Log); ok {
+		r0 = rf(ctx, code, resourceId, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CodeLog, uint, string) error); ok {
+		r1 = rf(ctx, code, resourceId, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockILogApp_FindByProjectWithDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByProjectWithDetail'
+type MockILogApp_FindByProjectWithDetail_Call struct {
+	*mock.Call
+}
+
+// FindByProjectWithDetail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code domain.CodeLog
+//   - resourceId uint
+//   - key string
+func (_e *MockILogApp_Expecter) FindByProjectWithDetail(ctx interface{}, code interface{}, resourceId interface{}, key interface{}) *MockILogApp_FindByProjectWithDetail_Call {
+	return &MockILogApp_FindByProjectWithDetail_Call{Call: _e.mock.On("FindByProjectWithDetail", ctx, code, resourceId, key)}
+}
+
+func (_c *MockILogApp_FindByProjectWithDetail_Call) Run(run func(ctx context.Context, code domain.CodeLog, resourceId uint, key string)) *MockILogApp_FindByProjectWithDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CodeLog), args[2].(uint), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockILogApp_FindByProjectWithDetail_Call) Return(_a0 []domain.Log, _a1 error) *MockILogApp_FindByProjectWithDetail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockILogApp_FindByProjectWithDetail_Call) RunAndReturn(run func(context.Context, domain.CodeLog, uint, string) ([]domain.Log, error)) *MockILogApp_FindByProjectWithDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockILogApp creates a new instance of MockILogApp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockILogApp(t interface {
@@ -24,17 +24,17 @@ func (_m *MockLogRepository) EXPECT() *MockLogRepository_Expecter {
 	return &MockLogRepository_Expecter{mock: &_m.Mock}
 }
 
-// DeleteByResourceIdAndIdLessThanLimit provides a mock function with given fields: ctx, resourceId
-func (_m *MockLogRepository) DeleteByResourceIdAndIdLessThanLimit(ctx context.Context, resourceId uint) error {
-	ret := _m.Called(ctx, resourceId)
+// DeleteByIds provides a mock function with given fields: ctx, ids
+func (_m *MockLogRepository) DeleteByIds(ctx context.Context, ids []uint) error {
+	ret := _m.Called(ctx, ids)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteByResourceIdAndIdLessThanLimit")
+		panic("no return value specified for DeleteByIds")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
-		r0 = rf(ctx, resourceId)
+	if rf, ok := ret.Get(0).(func(context.Context, []uint) error); ok {
+		r0 = rf(ctx, ids)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -42,31 +42,31 @@ func (_m *MockLogRepository) DeleteByResourceIdAndIdLessThanLimit(ctx context.Co
 	return r0
 }
 
-// MockLogRepository_DeleteByResourceIdAndIdLessThanLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByResourceIdAndIdLessThanLimit'
-type MockLogRepository_DeleteByResourceIdAndIdLessThanLimit_Call struct {
+// MockLogRepository_DeleteByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByIds'
+type MockLogRepository_DeleteByIds_Call struct {
 	*mock.Call
 }
 
-// DeleteByResourceIdAndIdLessThanLimit is a helper method to define mock.On call
+// DeleteByIds is a helper method to define mock.On call
 //   - ctx context.Context
-//   - resourceId uint
-func (_e *MockLogRepository_Expecter) DeleteByResourceIdAndIdLessThanLimit(ctx interface{}, resourceId interface{}) *MockLogRepository_DeleteByResourceIdAndIdLessThanLimit_Call {
-	return &MockLogRepository_DeleteByResourceIdAndIdLessThanLimit_Call{Call: _e.mock.On("DeleteByResourceIdAndIdLessThanLimit", ctx, resourceId)}
+//   - ids []uint
+func (_e *MockLogRepository_Expecter) DeleteByIds(ctx interface{}, ids interface{}) *MockLogRepository_DeleteByIds_Call {
+	return &MockLogRepository_DeleteByIds_Call{Call: _e.mock.On("DeleteByIds", ctx, ids)}
 }
 
-func (_c *MockLogRepository_DeleteByResourceIdAndIdLessThanLimit_Call) Run(run func(ctx context.Context, resourceId uint)) *MockLogRepository_DeleteByResourceIdAndIdLessThanLimit_Call {
+func (_c *MockLogRepository_DeleteByIds_Call) Run(run func(ctx context.Context, ids []uint)) *MockLogRepository_DeleteByIds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint))
+		run(args[0].(context.Context), args[1].([]uint))
 	})
 	return _c
 }
 
-func (_c *MockLogRepository_DeleteByResourceIdAndIdLessThanLimit_Call) Return(_a0 error) *MockLogRepository_DeleteByResourceIdAndIdLessThanLimit_Call {
+func (_c *MockLogRepository_DeleteByIds_Call) Return(_a0 error) *MockLogRepository_DeleteByIds_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockLogRepository_DeleteByResourceIdAndIdLessThanLimit_Call) RunAndReturn(run func(context.Context, uint) error) *MockLogRepository_DeleteByResourceIdAndIdLessThanLimit_Call {
+func (_c *MockLogRepository_DeleteByIds_Call) RunAndReturn(run func(context.Context, []uint) error) *MockLogRepository_DeleteByIds_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -233,29 +233,29 @@ func (_c *MockLogRepository_SearchAllByExample_Call) RunAndReturn(run func(conte
 	return _c
 }
 
-// SearchResourceIdsWithLimitExceeded provides a mock function with given fields: ctx
-func (_m *MockLogRepository) SearchResourceIdsWithLimitExceeded(ctx context.Context) ([]uint, error) {
-	ret := _m.Called(ctx)
+// SearchAllByFilter provides a mock function with given fields: ctx, filter
+func (_m *MockLogRepository) SearchAllByFilter(ctx context.Context, filter domain.LogFilter) ([]domain.Log, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SearchResourceIdsWithLimitExceeded")
+		panic("no return value specified for SearchAllByFilter")
 	}
 
-	var r0 []uint
+	var r0 []domain.Log
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]uint, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LogFilter) ([]domain.Log, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []uint); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LogFilter) []domain.Log); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]uint)
+			r0 = ret.Get(0).([]domain.Log)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.LogFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -263,30 +263,151 @@ func (_m *MockLogRepository) SearchResourceIdsWithLimitExceeded(ctx context.Cont
 	return r0, r1
 }
 
-// MockLogRepository_SearchResourceIdsWithLimitExceeded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchResourceIdsWithLimitExceeded'
-type MockLogRepository_SearchResourceIdsWithLimitExceeded_Call struct {
+// MockLogRepository_SearchAllByFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAllByFilter'
+type MockLogRepository_SearchAllByFilter_Call struct {
 	*mock.Call
 }
 
-// SearchResourceIdsWithLimitExceeded is a helper method to define mock.On call
+// SearchAllByFilter is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockLogRepository_Expecter) SearchResourceIdsWithLimitExceeded(ctx interface{}) *MockLogRepository_SearchResourceIdsWithLimitExceeded_Call {
-	return &MockLogRepository_SearchResourceIdsWithLimitExceeded_Call{Call: _e.mock.On("SearchResourceIdsWithLimitExceeded", ctx)}
+//   - filter domain.LogFilter
+func (_e *MockLogRepository_Expecter) SearchAllByFilter(ctx interface{}, filter interface{}) *MockLogRepository_SearchAllByFilter_Call {
+	return &MockLogRepository_SearchAllByFilter_Call{Call: _e.mock.On("SearchAllByFilter", ctx, filter)}
 }
 
-func (_c *MockLogRepository_SearchResourceIdsWithLimitExceeded_Call) Run(run func(ctx context.Context)) *MockLogRepository_SearchResourceIdsWithLimitExceeded_Call {
+func (_c *MockLogRepository_SearchAllByFilter_Call) Run(run func(ctx context.Context, filter domain.LogFilter)) *MockLogRepository_SearchAllByFilter_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(domain.LogFilter))
+	})
+	return _c
+}
+
+func (_c *MockLogRepository_SearchAllByFilter_Call) Return(_a0 []domain.Log, _a1 error) *MockLogRepository_SearchAllByFilter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLogRepository_SearchAllByFilter_Call) RunAndReturn(run func(context.Context, domain.LogFilter) ([]domain.Log, error)) *MockLogRepository_SearchAllByFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchAllExceedingRetention provides a mock function with given fields: ctx, retention
+func (_m *MockLogRepository) SearchAllExceedingRetention(ctx context.Context, retention domain.LogRetention) ([]domain.Log, error) {
+	ret := _m.Called(ctx, retention)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAllExceedingRetention")
+	}
+
+	var r0 []domain.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LogRetention) ([]domain.Log, error)); ok {
+		return rf(ctx, retention)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LogRetention) []domain.Log); ok {
+		r0 = rf(ctx, retention)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.LogRetention) error); ok {
+		r1 = rf(ctx, retention)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLogRepository_SearchAllExceedingRetention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAllExceedingRetention'
+type MockLogRepository_SearchAllExceedingRetention_Call struct {
+	*mock.Call
+}
+
+// SearchAllExceedingRetention is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retention domain.LogRetention
+func (_e *MockLogRepository_Expecter) SearchAllExceedingRetention(ctx interface{}, retention interface{}) *MockLogRepository_SearchAllExceedingRetention_Call {
+	return &MockLogRepository_SearchAllExceedingRetention_Call{Call: _e.mock.On("SearchAllExceedingRetention", ctx, retention)}
+}
+
+func (_c *MockLogRepository_SearchAllExceedingRetention_Call) Run(run func(ctx context.Context, retention domain.LogRetention)) *MockLogRepository_SearchAllExceedingRetention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.LogRetention))
+	})
+	return _c
+}
+
+func (_c *MockLogRepository_SearchAllExceedingRetention_Call) Return(_a0 []domain.Log, _a1 error) *MockLogRepository_SearchAllExceedingRetention_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLogRepository_SearchAllExceedingRetention_Call) RunAndReturn(run func(context.Context, domain.LogRetention) ([]domain.Log, error)) *MockLogRepository_SearchAllExceedingRetention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchAllWithDetailKey provides a mock function with given fields: ctx, code, resourceId, key
+func (_m *MockLogRepository) SearchAllWithDetailKey(ctx context.Context, code domain.CodeLog, resourceId uint, key string) ([]domain.Log, error) {
+	ret := _m.Called(ctx, code, resourceId, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAllWithDetailKey")
+	}
+
+	var r0 []domain.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CodeLog, uint, string) ([]domain.Log, error)); ok {
+		return rf(ctx, code, resourceId, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CodeLog, uint, string) []domain.Log); ok {
+		r0 = rf(ctx, code, resourceId, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CodeLog, uint, string) error); ok {
+		r1 = rf(ctx, code, resourceId, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLogRepository_SearchAllWithDetailKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAllWithDetailKey'
+type MockLogRepository_SearchAllWithDetailKey_Call struct {
+	*mock.Call
+}
+
+// SearchAllWithDetailKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code domain.CodeLog
+//   - resourceId uint
+//   - key string
+func (_e *MockLogRepository_Expecter) SearchAllWithDetailKey(ctx interface{}, code interface{}, resourceId interface{}, key interface{}) *MockLogRepository_SearchAllWithDetailKey_Call {
+	return &MockLogRepository_SearchAllWithDetailKey_Call{Call: _e.mock.On("SearchAllWithDetailKey", ctx, code, resourceId, key)}
+}
+
+func (_c *MockLogRepository_SearchAllWithDetailKey_Call) Run(run func(ctx context.Context, code domain.CodeLog, resourceId uint, key string)) *MockLogRepository_SearchAllWithDetailKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CodeLog), args[2].(uint), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockLogRepository_SearchResourceIdsWithLimitExceeded_Call) Return(_a0 []uint, _a1 error) *MockLogRepository_SearchResourceIdsWithLimitExceeded_Call {
+func (_c *MockLogRepository_SearchAllWithDetailKey_Call) Return(_a0 []domain.Log, _a1 error) *MockLogRepository_SearchAllWithDetailKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockLogRepository_SearchResourceIdsWithLimitExceeded_Call) RunAndReturn(run func(context.Context) ([]uint, error)) *MockLogRepository_SearchResourceIdsWithLimitExceeded_Call {
+func (_c *MockLogRepository_SearchAllWithDetailKey_Call) RunAndReturn(run func(context.Context, domain.CodeLog, uint, string) ([]domain.Log, error)) *MockLogRepository_SearchAllWithDetailKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4,9 +4,11 @@ package mocks_application
 
 import (
 	context "context"
-	time "time"
+	domain "your-accounts-api/users/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockIUserApp is an autogenerated mock type for the IUserApp type
@@ -22,6 +24,101 @@ func (_m *MockIUserApp) EXPECT() *MockIUserApp_Expecter {
 	return &MockIUserApp_Expecter{mock: &_m.Mock}
 }
 
+// CancelDeletion provides a mock function with given fields: ctx, id
+func (_m *MockIUserApp) CancelDeletion(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelDeletion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserApp_CancelDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelDeletion'
+type MockIUserApp_CancelDeletion_Call struct {
+	*mock.Call
+}
+
+// CancelDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIUserApp_Expecter) CancelDeletion(ctx interface{}, id interface{}) *MockIUserApp_CancelDeletion_Call {
+	return &MockIUserApp_CancelDeletion_Call{Call: _e.mock.On("CancelDeletion", ctx, id)}
+}
+
+func (_c *MockIUserApp_CancelDeletion_Call) Run(run func(ctx context.Context, id uint)) *MockIUserApp_CancelDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIUserApp_CancelDeletion_Call) Return(_a0 error) *MockIUserApp_CancelDeletion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserApp_CancelDeletion_Call) RunAndReturn(run func(context.Context, uint) error) *MockIUserApp_CancelDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeEmail provides a mock function with given fields: ctx, id, email
+func (_m *MockIUserApp) ChangeEmail(ctx context.Context, id uint, email string) error {
+	ret := _m.Called(ctx, id, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) error); ok {
+		r0 = rf(ctx, id, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserApp_ChangeEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeEmail'
+type MockIUserApp_ChangeEmail_Call struct {
+	*mock.Call
+}
+
+// ChangeEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - email string
+func (_e *MockIUserApp_Expecter) ChangeEmail(ctx interface{}, id interface{}, email interface{}) *MockIUserApp_ChangeEmail_Call {
+	return &MockIUserApp_ChangeEmail_Call{Call: _e.mock.On("ChangeEmail", ctx, id, email)}
+}
+
+func (_c *MockIUserApp_ChangeEmail_Call) Run(run func(ctx context.Context, id uint, email string)) *MockIUserApp_ChangeEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUserApp_ChangeEmail_Call) Return(_a0 error) *MockIUserApp_ChangeEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserApp_ChangeEmail_Call) RunAndReturn(run func(context.Context, uint, string) error) *MockIUserApp_ChangeEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, email
 func (_m *MockIUserApp) Create(ctx context.Context, email string) (uint, error) {
 	ret := _m.Called(ctx, email)
@@ -125,9 +222,169 @@ func (_c *MockIUserApp_DeleteExpired_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
-// Login provides a mock function with given fields: ctx, email
-func (_m *MockIUserApp) Login(ctx context.Context, email string) (string, time.Time, error) {
-	ret := _m.Called(ctx, email)
+// DeleteScheduled provides a mock function with given fields: ctx
+func (_m *MockIUserApp) DeleteScheduled(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteScheduled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserApp_DeleteScheduled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteScheduled'
+type MockIUserApp_DeleteScheduled_Call struct {
+	*mock.Call
+}
+
+// DeleteScheduled is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUserApp_Expecter) DeleteScheduled(ctx interface{}) *MockIUserApp_DeleteScheduled_Call {
+	return &MockIUserApp_DeleteScheduled_Call{Call: _e.mock.On("DeleteScheduled", ctx)}
+}
+
+func (_c *MockIUserApp_DeleteScheduled_Call) Run(run func(ctx context.Context)) *MockIUserApp_DeleteScheduled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUserApp_DeleteScheduled_Call) Return(_a0 error) *MockIUserApp_DeleteScheduled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserApp_DeleteScheduled_Call) RunAndReturn(run func(context.Context) error) *MockIUserApp_DeleteScheduled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: ctx, id
+func (_m *MockIUserApp) Export(ctx context.Context, id uint) (domain.UserData, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 domain.UserData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (domain.UserData, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) domain.UserData); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.UserData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserApp_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type MockIUserApp_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIUserApp_Expecter) Export(ctx interface{}, id interface{}) *MockIUserApp_Export_Call {
+	return &MockIUserApp_Export_Call{Call: _e.mock.On("Export", ctx, id)}
+}
+
+func (_c *MockIUserApp_Export_Call) Run(run func(ctx context.Context, id uint)) *MockIUserApp_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIUserApp_Export_Call) Return(_a0 domain.UserData, _a1 error) *MockIUserApp_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserApp_Export_Call) RunAndReturn(run func(context.Context, uint) (domain.UserData, error)) *MockIUserApp_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindById provides a mock function with given fields: ctx, id
+func (_m *MockIUserApp) FindById(ctx context.Context, id uint) (domain.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (domain.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) domain.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserApp_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockIUserApp_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIUserApp_Expecter) FindById(ctx interface{}, id interface{}) *MockIUserApp_FindById_Call {
+	return &MockIUserApp_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+}
+
+func (_c *MockIUserApp_FindById_Call) Run(run func(ctx context.Context, id uint)) *MockIUserApp_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIUserApp_FindById_Call) Return(_a0 domain.User, _a1 error) *MockIUserApp_FindById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserApp_FindById_Call) RunAndReturn(run func(context.Context, uint) (domain.User, error)) *MockIUserApp_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: ctx, email, ip
+func (_m *MockIUserApp) Login(ctx context.Context, email string, ip string) (string, time.Time, error) {
+	ret := _m.Called(ctx, email, ip)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
@@ -136,23 +393,23 @@ func (_m *MockIUserApp) Login(ctx context.Context, email string) (string, time.T
 	var r0 string
 	var r1 time.Time
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, time.Time, error)); ok {
-		return rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, time.Time, error)); ok {
+		return rf(ctx, email, ip)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, email, ip)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) time.Time); ok {
-		r1 = rf(ctx, email)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) time.Time); ok {
+		r1 = rf(ctx, email, ip)
 	} else {
 		r1 = ret.Get(1).(time.Time)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
-		r2 = rf(ctx, email)
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, email, ip)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -168,13 +425,14 @@ type MockIUserApp_Login_Call struct {
 // Login is a helper method to define mock.On call
 //   - ctx context.Context
 //   - email string
-func (_e *MockIUserApp_Expecter) Login(ctx interface{}, email interface{}) *MockIUserApp_Login_Call {
-	return &MockIUserApp_Login_Call{Call: _e.mock.On("Login", ctx, email)}
+//   - ip string
+func (_e *MockIUserApp_Expecter) Login(ctx interface{}, email interface{}, ip interface{}) *MockIUserApp_Login_Call {
+	return &MockIUserApp_Login_Call{Call: _e.mock.On("Login", ctx, email, ip)}
 }
 
-func (_c *MockIUserApp_Login_Call) Run(run func(ctx context.Context, email string)) *MockIUserApp_Login_Call {
+func (_c *MockIUserApp_Login_Call) Run(run func(ctx context.Context, email string, ip string)) *MockIUserApp_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -184,7 +442,264 @@ func (_c *MockIUserApp_Login_Call) Return(_a0 string, _a1 time.Time, _a2 error)
 	return _c
 }
 
-func (_c *MockIUserApp_Login_Call) RunAndReturn(run func(context.Context, string) (string, time.Time, error)) *MockIUserApp_Login_Call {
+func (_c *MockIUserApp_Login_Call) RunAndReturn(run func(context.Context, string, string) (string, time.Time, error)) *MockIUserApp_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestDeletion provides a mock function with given fields: ctx, id, confirmation
+func (_m *MockIUserApp) RequestDeletion(ctx context.Context, id uint, confirmation string) (time.Time, error) {
+	ret := _m.Called(ctx, id, confirmation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestDeletion")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) (time.Time, error)); ok {
+		return rf(ctx, id, confirmation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) time.Time); ok {
+		r0 = rf(ctx, id, confirmation)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = rf(ctx, id, confirmation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserApp_RequestDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestDeletion'
+type MockIUserApp_RequestDeletion_Call struct {
+	*mock.Call
+}
+
+// RequestDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - confirmation string
+func (_e *MockIUserApp_Expecter) RequestDeletion(ctx interface{}, id interface{}, confirmation interface{}) *MockIUserApp_RequestDeletion_Call {
+	return &MockIUserApp_RequestDeletion_Call{Call: _e.mock.On("RequestDeletion", ctx, id, confirmation)}
+}
+
+func (_c *MockIUserApp_RequestDeletion_Call) Run(run func(ctx context.Context, id uint, confirmation string)) *MockIUserApp_RequestDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUserApp_RequestDeletion_Call) Return(_a0 time.Time, _a1 error) *MockIUserApp_RequestDeletion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserApp_RequestDeletion_Call) RunAndReturn(run func(context.Context, uint, string) (time.Time, error)) *MockIUserApp_RequestDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestVerification provides a mock function with given fields: ctx, id
+func (_m *MockIUserApp) RequestVerification(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestVerification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserApp_RequestVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestVerification'
+type MockIUserApp_RequestVerification_Call struct {
+	*mock.Call
+}
+
+// RequestVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIUserApp_Expecter) RequestVerification(ctx interface{}, id interface{}) *MockIUserApp_RequestVerification_Call {
+	return &MockIUserApp_RequestVerification_Call{Call: _e.mock.On("RequestVerification", ctx, id)}
+}
+
+func (_c *MockIUserApp_RequestVerification_Call) Run(run func(ctx context.Context, id uint)) *MockIUserApp_RequestVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIUserApp_RequestVerification_Call) Return(_a0 error) *MockIUserApp_RequestVerification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserApp_RequestVerification_Call) RunAndReturn(run func(context.Context, uint) error) *MockIUserApp_RequestVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function with given fields: ctx, id, profile
+func (_m *MockIUserApp) UpdateProfile(ctx context.Context, id uint, profile domain.UserProfile) (domain.User, error) {
+	ret := _m.Called(ctx, id, profile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.UserProfile) (domain.User, error)); ok {
+		return rf(ctx, id, profile)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.UserProfile) domain.User); ok {
+		r0 = rf(ctx, id, profile)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, domain.UserProfile) error); ok {
+		r1 = rf(ctx, id, profile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserApp_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockIUserApp_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - profile domain.UserProfile
+func (_e *MockIUserApp_Expecter) UpdateProfile(ctx interface{}, id interface{}, profile interface{}) *MockIUserApp_UpdateProfile_Call {
+	return &MockIUserApp_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, id, profile)}
+}
+
+func (_c *MockIUserApp_UpdateProfile_Call) Run(run func(ctx context.Context, id uint, profile domain.UserProfile)) *MockIUserApp_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(domain.UserProfile))
+	})
+	return _c
+}
+
+func (_c *MockIUserApp_UpdateProfile_Call) Return(_a0 domain.User, _a1 error) *MockIUserApp_UpdateProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserApp_UpdateProfile_Call) RunAndReturn(run func(context.Context, uint, domain.UserProfile) (domain.User, error)) *MockIUserApp_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateSession provides a mock function with given fields: ctx, token
+func (_m *MockIUserApp) ValidateSession(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserApp_ValidateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateSession'
+type MockIUserApp_ValidateSession_Call struct {
+	*mock.Call
+}
+
+// ValidateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockIUserApp_Expecter) ValidateSession(ctx interface{}, token interface{}) *MockIUserApp_ValidateSession_Call {
+	return &MockIUserApp_ValidateSession_Call{Call: _e.mock.On("ValidateSession", ctx, token)}
+}
+
+func (_c *MockIUserApp_ValidateSession_Call) Run(run func(ctx context.Context, token string)) *MockIUserApp_ValidateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUserApp_ValidateSession_Call) Return(_a0 error) *MockIUserApp_ValidateSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserApp_ValidateSession_Call) RunAndReturn(run func(context.Context, string) error) *MockIUserApp_ValidateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyEmail provides a mock function with given fields: ctx, token
+func (_m *MockIUserApp) VerifyEmail(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserApp_VerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmail'
+type MockIUserApp_VerifyEmail_Call struct {
+	*mock.Call
+}
+
+// VerifyEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockIUserApp_Expecter) VerifyEmail(ctx interface{}, token interface{}) *MockIUserApp_VerifyEmail_Call {
+	return &MockIUserApp_VerifyEmail_Call{Call: _e.mock.On("VerifyEmail", ctx, token)}
+}
+
+func (_c *MockIUserApp_VerifyEmail_Call) Run(run func(ctx context.Context, token string)) *MockIUserApp_VerifyEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUserApp_VerifyEmail_Call) Return(_a0 error) *MockIUserApp_VerifyEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserApp_VerifyEmail_Call) RunAndReturn(run func(context.Context, string) error) *MockIUserApp_VerifyEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
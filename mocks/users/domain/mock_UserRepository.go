@@ -24,6 +24,53 @@ func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
 	return &MockUserRepository_Expecter{mock: &_m.Mock}
 }
 
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockUserRepository) Delete(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockUserRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockUserRepository_Delete_Call {
+	return &MockUserRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockUserRepository_Delete_Call) Run(run func(ctx context.Context, id uint)) *MockUserRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Delete_Call) Return(_a0 error) *MockUserRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_Delete_Call) RunAndReturn(run func(context.Context, uint) error) *MockUserRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExistsByExample provides a mock function with given fields: ctx, example
 func (_m *MockUserRepository) ExistsByExample(ctx context.Context, example domain.User) (bool, error) {
 	ret := _m.Called(ctx, example)
@@ -138,6 +185,180 @@ func (_c *MockUserRepository_Save_Call) RunAndReturn(run func(context.Context, d
 	return _c
 }
 
+// Search provides a mock function with given fields: ctx, id
+func (_m *MockUserRepository) Search(ctx context.Context, id uint) (domain.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (domain.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) domain.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockUserRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockUserRepository_Expecter) Search(ctx interface{}, id interface{}) *MockUserRepository_Search_Call {
+	return &MockUserRepository_Search_Call{Call: _e.mock.On("Search", ctx, id)}
+}
+
+func (_c *MockUserRepository_Search_Call) Run(run func(ctx context.Context, id uint)) *MockUserRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Search_Call) Return(_a0 domain.User, _a1 error) *MockUserRepository_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_Search_Call) RunAndReturn(run func(context.Context, uint) (domain.User, error)) *MockUserRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchAllByDeleteAtLessThanNow provides a mock function with given fields: ctx
+func (_m *MockUserRepository) SearchAllByDeleteAtLessThanNow(ctx context.Context) ([]domain.User, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAllByDeleteAtLessThanNow")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_SearchAllByDeleteAtLessThanNow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAllByDeleteAtLessThanNow'
+type MockUserRepository_SearchAllByDeleteAtLessThanNow_Call struct {
+	*mock.Call
+}
+
+// SearchAllByDeleteAtLessThanNow is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUserRepository_Expecter) SearchAllByDeleteAtLessThanNow(ctx interface{}) *MockUserRepository_SearchAllByDeleteAtLessThanNow_Call {
+	return &MockUserRepository_SearchAllByDeleteAtLessThanNow_Call{Call: _e.mock.On("SearchAllByDeleteAtLessThanNow", ctx)}
+}
+
+func (_c *MockUserRepository_SearchAllByDeleteAtLessThanNow_Call) Run(run func(ctx context.Context)) *MockUserRepository_SearchAllByDeleteAtLessThanNow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SearchAllByDeleteAtLessThanNow_Call) Return(_a0 []domain.User, _a1 error) *MockUserRepository_SearchAllByDeleteAtLessThanNow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_SearchAllByDeleteAtLessThanNow_Call) RunAndReturn(run func(context.Context) ([]domain.User, error)) *MockUserRepository_SearchAllByDeleteAtLessThanNow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchAllByFilter provides a mock function with given fields: ctx, filter
+func (_m *MockUserRepository) SearchAllByFilter(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAllByFilter")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserFilter) ([]domain.User, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserFilter) []domain.User); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UserFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_SearchAllByFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAllByFilter'
+type MockUserRepository_SearchAllByFilter_Call struct {
+	*mock.Call
+}
+
+// SearchAllByFilter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.UserFilter
+func (_e *MockUserRepository_Expecter) SearchAllByFilter(ctx interface{}, filter interface{}) *MockUserRepository_SearchAllByFilter_Call {
+	return &MockUserRepository_SearchAllByFilter_Call{Call: _e.mock.On("SearchAllByFilter", ctx, filter)}
+}
+
+func (_c *MockUserRepository_SearchAllByFilter_Call) Run(run func(ctx context.Context, filter domain.UserFilter)) *MockUserRepository_SearchAllByFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserFilter))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SearchAllByFilter_Call) Return(_a0 []domain.User, _a1 error) *MockUserRepository_SearchAllByFilter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_SearchAllByFilter_Call) RunAndReturn(run func(context.Context, domain.UserFilter) ([]domain.User, error)) *MockUserRepository_SearchAllByFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchByExample provides a mock function with given fields: ctx, example
 func (_m *MockUserRepository) SearchByExample(ctx context.Context, example domain.User) (domain.User, error) {
 	ret := _m.Called(ctx, example)
@@ -70,6 +70,53 @@ func (_c *MockUserTokenRepository_DeleteByExpiresAtGreaterThanNow_Call) RunAndRe
 	return _c
 }
 
+// DeleteByUserId provides a mock function with given fields: ctx, userId
+func (_m *MockUserTokenRepository) DeleteByUserId(ctx context.Context, userId uint) error {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUserId")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserTokenRepository_DeleteByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserId'
+type MockUserTokenRepository_DeleteByUserId_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uint
+func (_e *MockUserTokenRepository_Expecter) DeleteByUserId(ctx interface{}, userId interface{}) *MockUserTokenRepository_DeleteByUserId_Call {
+	return &MockUserTokenRepository_DeleteByUserId_Call{Call: _e.mock.On("DeleteByUserId", ctx, userId)}
+}
+
+func (_c *MockUserTokenRepository_DeleteByUserId_Call) Run(run func(ctx context.Context, userId uint)) *MockUserTokenRepository_DeleteByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockUserTokenRepository_DeleteByUserId_Call) Return(_a0 error) *MockUserTokenRepository_DeleteByUserId_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserTokenRepository_DeleteByUserId_Call) RunAndReturn(run func(context.Context, uint) error) *MockUserTokenRepository_DeleteByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: ctx, _a1
 func (_m *MockUserTokenRepository) Save(ctx context.Context, _a1 domain.UserToken) (uint, error) {
 	ret := _m.Called(ctx, _a1)
@@ -0,0 +1,168 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	"your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/config"
+)
+
+const eventDispatchBatch = 100
+
+// EventSubscriber reacts to a domain event, it must be idempotent because an event is delivered
+// again to all the subscribers when any of them fails.
+type EventSubscriber func(ctx context.Context, event domain.Event) error
+
+type IEventApp interface {
+	Publish(ctx context.Context, name domain.EventName, resourceId uint, payload map[string]any, tx persistent.Transaction) error
+	Subscribe(name domain.EventName, subscriber EventSubscriber)
+	Dispatch(ctx context.Context) error
+	DeleteProcessed(ctx context.Context) error
+	DeleteUserEvent(ctx context.Context, event domain.Event) error
+}
+
+type eventApp struct {
+	tm          persistent.TransactionManager
+	eventRepo   domain.EventRepository
+	subscribers map[domain.EventName][]EventSubscriber
+	mu          sync.RWMutex
+}
+
+// Publish writes the event in the outbox with the transaction of the change that produces it
+func (app *eventApp) Publish(ctx context.Context, name domain.EventName, resourceId uint, payload map[string]any, tx persistent.Transaction) error {
+	eventRepo := app.eventRepo.WithTransaction(tx)
+	newEvent := domain.Event{
+		Name:          name,
+		ResourceId:    resourceId,
+		UserId:        domain.ActorFromContext(ctx).UserId,
+		Payload:       payload,
+		NextAttemptAt: time.Now(),
+	}
+
+	_, err := eventRepo.Save(ctx, newEvent)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (app *eventApp) Subscribe(name domain.EventName, subscriber EventSubscriber) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	app.subscribers[name] = append(app.subscribers[name], subscriber)
+}
+
+// Dispatch delivers the pending events by batches, each batch keeps its events locked until
+// their new state is stored so the events are delivered at least once.
+func (app *eventApp) Dispatch(ctx context.Context) error {
+	for {
+		var pending int
+		err := app.tm.Transaction(func(tx persistent.Transaction) error {
+			eventRepo := app.eventRepo.WithTransaction(tx)
+			events, err := eventRepo.SearchAllPending(ctx, time.Now(), eventDispatchBatch)
+			if err != nil {
+				return err
+			}
+
+			pending = len(events)
+			for _, event := range events {
+				if _, err := eventRepo.Save(ctx, app.deliver(ctx, event)); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if pending < eventDispatchBatch {
+			return nil
+		}
+	}
+}
+
+func (app *eventApp) DeleteProcessed(ctx context.Context) error {
+	err := app.eventRepo.DeleteProcessedBefore(ctx, time.Now().Add(-config.EVENT_RETENTION))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteUserEvent removes the events of a deleted user, they keep the names of the budgets and the
+// bills in their payloads
+func (app *eventApp) DeleteUserEvent(ctx context.Context, event domain.Event) error {
+	err := app.eventRepo.DeleteAllByUserId(ctx, event.ResourceId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// deliver sends the event to its subscribers and returns it with the state of the delivery
+func (app *eventApp) deliver(ctx context.Context, event domain.Event) domain.Event {
+	app.mu.RLock()
+	subscribers := app.subscribers[event.Name]
+	app.mu.RUnlock()
+
+	now := time.Now()
+	event.Attempts += 1
+	for _, subscriber := range subscribers {
+		if err := notify(ctx, subscriber, event); err != nil {
+			event.LastError = err.Error()
+			if event.Attempts >= config.EVENT_MAX_ATTEMPTS {
+				event.FailedAt = &now
+			} else {
+				event.NextAttemptAt = now.Add(eventRetryDelay(event.Attempts))
+			}
+
+			return event
+		}
+	}
+
+	event.LastError = ""
+	event.ProcessedAt = &now
+	return event
+}
+
+// notify turns the panics of a subscriber into errors to retry the event like any other failure
+func notify(ctx context.Context, subscriber EventSubscriber, event domain.Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("subscriber panic: %v", r)
+		}
+	}()
+
+	return subscriber(ctx, event)
+}
+
+// eventRetryDelay doubles the wait on every failed attempt up to the maximum.
+func eventRetryDelay(attempts int) time.Duration {
+	exponent := attempts - 1
+	if exponent > 30 {
+		return config.EVENT_RETRY_MAX
+	}
+
+	delay := config.EVENT_RETRY_BASE << exponent
+	if delay <= 0 || delay > config.EVENT_RETRY_MAX {
+		return config.EVENT_RETRY_MAX
+	}
+
+	return delay
+}
+
+func NewEventApp(tm persistent.TransactionManager, eventRepo domain.EventRepository) IEventApp {
+	return &eventApp{
+		tm:          tm,
+		eventRepo:   eventRepo,
+		subscribers: map[domain.EventName][]EventSubscriber{},
+	}
+}
@@ -0,0 +1,227 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	mocks_domain "your-accounts-api/mocks/shared/domain"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/config"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestEventSuite struct {
+	suite.Suite
+	resourceId             uint
+	userId                 uint
+	mockTransactionManager *mocks_persistent.MockTransactionManager
+	mockEventRepo          *mocks_domain.MockEventRepository
+	app                    IEventApp
+	ctx                    context.Context
+}
+
+func (suite *TestEventSuite) SetupSuite() {
+	suite.resourceId = 1
+	suite.userId = 2
+	suite.ctx = context.Background()
+}
+
+func (suite *TestEventSuite) SetupTest() {
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockEventRepo = mocks_domain.NewMockEventRepository(suite.T())
+	suite.app = NewEventApp(suite.mockTransactionManager, suite.mockEventRepo)
+}
+
+func (suite *TestEventSuite) mockDispatch(events []domain.Event) {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockEventRepo.On("WithTransaction", nil).Return(suite.mockEventRepo)
+	suite.mockEventRepo.On("SearchAllPending", suite.ctx, mock.Anything, eventDispatchBatch).Return(events, nil).Once()
+}
+
+func (suite *TestEventSuite) TestPublishSuccess() {
+	require := require.New(suite.T())
+	ctx := domain.WithActor(suite.ctx, domain.Actor{UserId: &suite.userId})
+	payload := map[string]any{"name": "Test"}
+	suite.mockEventRepo.On("WithTransaction", nil).Return(suite.mockEventRepo)
+	suite.mockEventRepo.On("Save", ctx, mock.MatchedBy(func(event domain.Event) bool {
+		return event.Name == domain.BudgetCreated && event.ResourceId == suite.resourceId && *event.UserId == suite.userId &&
+			event.Payload["name"] == "Test" && !event.NextAttemptAt.IsZero()
+	})).Return(uint(1), nil)
+
+	err := suite.app.Publish(ctx, domain.BudgetCreated, suite.resourceId, payload, nil)
+
+	require.NoError(err)
+}
+
+func (suite *TestEventSuite) TestPublishError() {
+	require := require.New(suite.T())
+	suite.mockEventRepo.On("WithTransaction", nil).Return(suite.mockEventRepo)
+	suite.mockEventRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), gorm.ErrInvalidField)
+
+	err := suite.app.Publish(suite.ctx, domain.BudgetCreated, suite.resourceId, nil, nil)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestEventSuite) TestDispatchSuccess() {
+	require := require.New(suite.T())
+	received := []domain.EventName{}
+	subscriber := func(ctx context.Context, event domain.Event) error {
+		received = append(received, event.Name)
+		return nil
+	}
+	suite.app.Subscribe(domain.BudgetCreated, subscriber)
+	suite.app.Subscribe(domain.BillPaid, subscriber)
+	suite.mockDispatch([]domain.Event{
+		{ID: 1, Name: domain.BudgetCreated, ResourceId: suite.resourceId},
+		{ID: 2, Name: domain.BudgetDeleted, ResourceId: suite.resourceId},
+		{ID: 3, Name: domain.BillPaid, ResourceId: suite.resourceId},
+	})
+	suite.mockEventRepo.On("Save", suite.ctx, mock.MatchedBy(func(event domain.Event) bool {
+		return event.Attempts == 1 && event.ProcessedAt != nil && event.FailedAt == nil
+	})).Return(uint(1), nil).Times(3)
+
+	err := suite.app.Dispatch(suite.ctx)
+
+	require.NoError(err)
+	require.Equal([]domain.EventName{domain.BudgetCreated, domain.BillPaid}, received)
+}
+
+func (suite *TestEventSuite) TestDispatchSuccessBatches() {
+	require := require.New(suite.T())
+	suite.mockDispatch(make([]domain.Event, eventDispatchBatch))
+	suite.mockEventRepo.On("SearchAllPending", suite.ctx, mock.Anything, eventDispatchBatch).Return([]domain.Event{}, nil).Once()
+	suite.mockEventRepo.On("Save", suite.ctx, mock.Anything).Return(uint(1), nil).Times(eventDispatchBatch)
+
+	err := suite.app.Dispatch(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestEventSuite) TestDispatchSuccessRetry() {
+	require := require.New(suite.T())
+	suite.app.Subscribe(domain.BudgetCreated, func(ctx context.Context, event domain.Event) error {
+		return errors.New("Error in subscriber")
+	})
+	suite.mockDispatch([]domain.Event{
+		{ID: 1, Name: domain.BudgetCreated, ResourceId: suite.resourceId, Attempts: 1},
+	})
+	suite.mockEventRepo.On("Save", suite.ctx, mock.MatchedBy(func(event domain.Event) bool {
+		return event.Attempts == 2 && event.LastError == "Error in subscriber" && event.ProcessedAt == nil && event.FailedAt == nil &&
+			time.Until(event.NextAttemptAt) > config.EVENT_RETRY_BASE
+	})).Return(uint(1), nil)
+
+	err := suite.app.Dispatch(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestEventSuite) TestDispatchSuccessPanic() {
+	require := require.New(suite.T())
+	suite.app.Subscribe(domain.BudgetCreated, func(ctx context.Context, event domain.Event) error {
+		panic("subscriber broken")
+	})
+	suite.mockDispatch([]domain.Event{
+		{ID: 1, Name: domain.BudgetCreated, ResourceId: suite.resourceId},
+	})
+	suite.mockEventRepo.On("Save", suite.ctx, mock.MatchedBy(func(event domain.Event) bool {
+		return event.Attempts == 1 && event.LastError == "subscriber panic: subscriber broken" && event.ProcessedAt == nil
+	})).Return(uint(1), nil)
+
+	err := suite.app.Dispatch(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestEventSuite) TestDispatchSuccessFailed() {
+	require := require.New(suite.T())
+	suite.app.Subscribe(domain.BudgetCreated, func(ctx context.Context, event domain.Event) error {
+		return errors.New("Error in subscriber")
+	})
+	suite.mockDispatch([]domain.Event{
+		{ID: 1, Name: domain.BudgetCreated, ResourceId: suite.resourceId, Attempts: config.EVENT_MAX_ATTEMPTS - 1},
+	})
+	suite.mockEventRepo.On("Save", suite.ctx, mock.MatchedBy(func(event domain.Event) bool {
+		return event.Attempts == config.EVENT_MAX_ATTEMPTS && event.FailedAt != nil && event.ProcessedAt == nil
+	})).Return(uint(1), nil)
+
+	err := suite.app.Dispatch(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestEventSuite) TestDispatchErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockEventRepo.On("WithTransaction", nil).Return(suite.mockEventRepo)
+	suite.mockEventRepo.On("SearchAllPending", suite.ctx, mock.Anything, eventDispatchBatch).Return(nil, gorm.ErrInvalidField)
+
+	err := suite.app.Dispatch(suite.ctx)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestEventSuite) TestDispatchErrorSave() {
+	require := require.New(suite.T())
+	suite.mockDispatch([]domain.Event{
+		{ID: 1, Name: domain.BudgetCreated, ResourceId: suite.resourceId},
+	})
+	suite.mockEventRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), gorm.ErrInvalidField)
+
+	err := suite.app.Dispatch(suite.ctx)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestEventSuite) TestDeleteProcessedSuccess() {
+	require := require.New(suite.T())
+	suite.mockEventRepo.On("DeleteProcessedBefore", suite.ctx, mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= config.EVENT_RETENTION
+	})).Return(nil)
+
+	err := suite.app.DeleteProcessed(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestEventSuite) TestDeleteProcessedError() {
+	require := require.New(suite.T())
+	suite.mockEventRepo.On("DeleteProcessedBefore", suite.ctx, mock.Anything).Return(gorm.ErrInvalidField)
+
+	err := suite.app.DeleteProcessed(suite.ctx)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestEventSuite) TestDeleteUserEventSuccess() {
+	require := require.New(suite.T())
+	suite.mockEventRepo.On("DeleteAllByUserId", suite.ctx, uint(999)).Return(nil)
+
+	err := suite.app.DeleteUserEvent(suite.ctx, domain.Event{Name: domain.UserDeleted, ResourceId: 999})
+
+	require.NoError(err)
+}
+
+func (suite *TestEventSuite) TestDeleteUserEventError() {
+	require := require.New(suite.T())
+	suite.mockEventRepo.On("DeleteAllByUserId", suite.ctx, uint(999)).Return(gorm.ErrInvalidField)
+
+	err := suite.app.DeleteUserEvent(suite.ctx, domain.Event{Name: domain.UserDeleted, ResourceId: 999})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestEventSuite(t *testing.T) {
+	suite.Run(t, new(TestEventSuite))
+}
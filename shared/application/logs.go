@@ -3,30 +3,51 @@ package application
 import (
 	"context"
 	"sync"
+	"time"
 	"your-accounts-api/shared/domain"
 	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/config"
+)
+
+const (
+	logPageSize    = 20
+	logMaxPageSize = 100
+	logExportLimit = 10000
+	logExportBatch = 1000
+
+	logRetentionBatch = 1000
 )
 
 type ILogApp interface {
 	Create(ctx context.Context, description string, code domain.CodeLog, resourceId uint, detail map[string]any, tx persistent.Transaction) error
 	FindByProject(ctx context.Context, code domain.CodeLog, resourceId uint) ([]domain.Log, error)
+	FindAllByProject(ctx context.Context, code domain.CodeLog, resourceId uint) ([]domain.Log, error)
+	FindByProjectWithDetail(ctx context.Context, code domain.CodeLog, resourceId uint, key string) ([]domain.Log, error)
+	FindByFilter(ctx context.Context, filter domain.LogFilter) ([]domain.Log, uint, error)
+	Export(ctx context.Context, filter domain.LogFilter) ([]domain.Log, error)
 	DeleteOrphan(ctx context.Context) error
+	DeleteOrphanEvent(ctx context.Context, event domain.Event) error
 	DeleteOld(ctx context.Context) error
 }
 
 type logApp struct {
-	tm      persistent.TransactionManager
-	logRepo domain.LogRepository
-	mu      sync.Mutex
+	tm       persistent.TransactionManager
+	logRepo  domain.LogRepository
+	archiver domain.LogArchiver
+	mu       sync.Mutex
 }
 
 func (app *logApp) Create(ctx context.Context, description string, code domain.CodeLog, resourceId uint, detail map[string]any, tx persistent.Transaction) error {
 	projectLogRepo := app.logRepo.WithTransaction(tx)
+	actor := domain.ActorFromContext(ctx)
 	newLog := domain.Log{
 		Description: description,
 		Detail:      detail,
 		Code:        code,
 		ResourceId:  resourceId,
+		UserId:      actor.UserId,
+		RequestId:   actor.RequestId,
+		IP:          actor.IP,
 	}
 
 	app.mu.Lock()
@@ -52,6 +73,72 @@ func (app *logApp) FindByProject(ctx context.Context, code domain.CodeLog, resou
 	return logs, nil
 }
 
+// FindAllByProject returns every log of the resource, newest first, searched by pages of the cursor
+func (app *logApp) FindAllByProject(ctx context.Context, code domain.CodeLog, resourceId uint) ([]domain.Log, error) {
+	filter := domain.LogFilter{
+		Code:       code,
+		ResourceId: resourceId,
+		Limit:      logExportBatch,
+	}
+	logs := []domain.Log{}
+	for {
+		page, err := app.logRepo.SearchAllByFilter(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, page...)
+		if len(page) < filter.Limit {
+			return logs, nil
+		}
+
+		filter.Cursor = page[len(page)-1].ID
+	}
+}
+
+func (app *logApp) FindByProjectWithDetail(ctx context.Context, code domain.CodeLog, resourceId uint, key string) ([]domain.Log, error) {
+	logs, err := app.logRepo.SearchAllWithDetailKey(ctx, code, resourceId, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// FindByFilter returns a page of logs, newest first, and the cursor of the next page, zero when it is the last one
+func (app *logApp) FindByFilter(ctx context.Context, filter domain.LogFilter) ([]domain.Log, uint, error) {
+	if filter.Limit < 1 {
+		filter.Limit = logPageSize
+	} else if filter.Limit > logMaxPageSize {
+		filter.Limit = logMaxPageSize
+	}
+
+	// One more log is searched to know if there is a next page
+	pageSize := filter.Limit
+	filter.Limit += 1
+	logs, err := app.logRepo.SearchAllByFilter(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(logs) > pageSize {
+		logs = logs[:pageSize]
+		return logs, logs[pageSize-1].ID, nil
+	}
+
+	return logs, 0, nil
+}
+
+func (app *logApp) Export(ctx context.Context, filter domain.LogFilter) ([]domain.Log, error) {
+	filter.Limit = logExportLimit
+	logs, err := app.logRepo.SearchAllByFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
 func (app *logApp) DeleteOrphan(ctx context.Context) error {
 	err := app.logRepo.DeleteByResourceIdNotExists(ctx)
 	if err != nil {
@@ -61,33 +148,74 @@ func (app *logApp) DeleteOrphan(ctx context.Context) error {
 	return nil
 }
 
+// DeleteOrphanEvent removes the logs of the budgets and the bills of a deleted user without waiting
+// for the weekly job
+func (app *logApp) DeleteOrphanEvent(ctx context.Context, _ domain.Event) error {
+	return app.DeleteOrphan(ctx)
+}
+
+// DeleteOld applies the retention policy of each code, the removed logs are archived first
 func (app *logApp) DeleteOld(ctx context.Context) error {
-	resourceIds, err := app.logRepo.SearchResourceIdsWithLimitExceeded(ctx)
-	if err != nil {
-		return err
+	for code, policy := range config.LOG_RETENTIONS {
+		if err := app.deleteOld(ctx, domain.CodeLog(code), policy); err != nil {
+			return err
+		}
 	}
 
-	if len(resourceIds) == 0 {
+	return nil
+}
+
+func (app *logApp) deleteOld(ctx context.Context, code domain.CodeLog, policy config.LogRetentionPolicy) error {
+	if policy.Max == 0 && policy.MaxAge == 0 {
 		return nil
 	}
 
-	return app.tm.Transaction(func(tx persistent.Transaction) error {
-		logRepo := app.logRepo.WithTransaction(tx)
+	retention := domain.LogRetention{
+		Code:  code,
+		Max:   policy.Max,
+		Limit: logRetentionBatch,
+	}
+	if policy.MaxAge > 0 {
+		before := time.Now().Add(-policy.MaxAge)
+		retention.Before = &before
+	}
 
-		for _, resourceId := range resourceIds {
-			err := logRepo.DeleteByResourceIdAndIdLessThanLimit(ctx, resourceId)
-			if err != nil {
-				return err
-			}
+	for {
+		logs, err := app.logRepo.SearchAllExceedingRetention(ctx, retention)
+		if err != nil {
+			return err
 		}
 
-		return nil
-	})
+		if len(logs) == 0 {
+			return nil
+		}
+
+		if err := app.archiver.Archive(ctx, code, logs); err != nil {
+			return err
+		}
+
+		ids := []uint{}
+		for _, log := range logs {
+			ids = append(ids, log.ID)
+		}
+
+		err = app.tm.Transaction(func(tx persistent.Transaction) error {
+			return app.logRepo.WithTransaction(tx).DeleteByIds(ctx, ids)
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(logs) < logRetentionBatch {
+			return nil
+		}
+	}
 }
 
-func NewLogApp(tm persistent.TransactionManager, logRepo domain.LogRepository) ILogApp {
+func NewLogApp(tm persistent.TransactionManager, logRepo domain.LogRepository, archiver domain.LogArchiver) ILogApp {
 	return &logApp{
-		tm:      tm,
-		logRepo: logRepo,
+		tm:       tm,
+		logRepo:  logRepo,
+		archiver: archiver,
 	}
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 	mocks_domain "your-accounts-api/mocks/shared/domain"
 	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
 	"your-accounts-api/shared/domain"
 	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/config"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -24,6 +26,8 @@ type TestSuite struct {
 	mockTransactionManager *mocks_persistent.MockTransactionManager
 	mockTx                 *mocks_persistent.MockTransaction
 	mockLogRepo            *mocks_domain.MockLogRepository
+	mockLogArchiver        *mocks_domain.MockLogArchiver
+	logRetentions          map[string]config.LogRetentionPolicy
 	app                    ILogApp
 	ctx                    context.Context
 }
@@ -34,13 +38,22 @@ func (suite *TestSuite) SetupSuite() {
 	suite.code = domain.Budget
 	suite.cloneId = 1
 	suite.ctx = context.Background()
+	suite.logRetentions = config.LOG_RETENTIONS
 }
 
 func (suite *TestSuite) SetupTest() {
 	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
 	suite.mockTx = mocks_persistent.NewMockTransaction(suite.T())
 	suite.mockLogRepo = mocks_domain.NewMockLogRepository(suite.T())
-	suite.app = NewLogApp(suite.mockTransactionManager, suite.mockLogRepo)
+	suite.mockLogArchiver = mocks_domain.NewMockLogArchiver(suite.T())
+	suite.app = NewLogApp(suite.mockTransactionManager, suite.mockLogRepo, suite.mockLogArchiver)
+	config.LOG_RETENTIONS = map[string]config.LogRetentionPolicy{
+		string(suite.code): {Max: 20, MaxAge: time.Hour},
+	}
+}
+
+func (suite *TestSuite) TearDownTest() {
+	config.LOG_RETENTIONS = suite.logRetentions
 }
 
 func (suite *TestSuite) TestCreateLogSuccess() {
@@ -53,6 +66,24 @@ func (suite *TestSuite) TestCreateLogSuccess() {
 	require.NoError(err)
 }
 
+func (suite *TestSuite) TestCreateLogSuccessWithActor() {
+	require := require.New(suite.T())
+	actor := domain.Actor{
+		UserId:    &suite.userId,
+		RequestId: "request",
+		IP:        "127.0.0.1",
+	}
+	ctx := domain.WithActor(suite.ctx, actor)
+	suite.mockLogRepo.On("WithTransaction", suite.mockTx).Return(suite.mockLogRepo)
+	suite.mockLogRepo.On("Save", ctx, mock.MatchedBy(func(log domain.Log) bool {
+		return *log.UserId == suite.userId && log.RequestId == actor.RequestId && log.IP == actor.IP
+	})).Return(uint(1), nil)
+
+	err := suite.app.Create(ctx, "Save", suite.code, suite.cloneId, nil, suite.mockTx)
+
+	require.NoError(err)
+}
+
 func (suite *TestSuite) TestCreateLogError() {
 	require := require.New(suite.T())
 	errExpected := errors.New("Error in creation project")
@@ -106,6 +137,131 @@ func (suite *TestSuite) TestFindByProjectError() {
 	require.Empty(res)
 }
 
+func (suite *TestSuite) TestFindAllByProjectSuccess() {
+	require := require.New(suite.T())
+	page := []domain.Log{}
+	for i := logExportBatch; i > 0; i-- {
+		page = append(page, domain.Log{ID: uint(i + 1)})
+	}
+	filter := domain.LogFilter{Code: suite.code, ResourceId: suite.cloneId, Limit: logExportBatch}
+	suite.mockLogRepo.On("SearchAllByFilter", suite.ctx, filter).Return(page, nil)
+	filter.Cursor = 2
+	suite.mockLogRepo.On("SearchAllByFilter", suite.ctx, filter).Return([]domain.Log{{ID: 1}}, nil)
+
+	res, err := suite.app.FindAllByProject(suite.ctx, suite.code, suite.cloneId)
+
+	require.NoError(err)
+	require.Len(res, logExportBatch+1)
+	require.Equal(uint(1), res[logExportBatch].ID)
+}
+
+func (suite *TestSuite) TestFindAllByProjectError() {
+	require := require.New(suite.T())
+	suite.mockLogRepo.On("SearchAllByFilter", suite.ctx, mock.Anything).Return(nil, gorm.ErrInvalidField)
+
+	res, err := suite.app.FindAllByProject(suite.ctx, suite.code, suite.cloneId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Empty(res)
+}
+
+func (suite *TestSuite) TestFindByProjectWithDetailSuccess() {
+	require := require.New(suite.T())
+	logsExpected := []domain.Log{
+		{
+			ID:          999,
+			Description: "Test",
+			Detail: map[string]any{
+				"batch": "abc",
+			},
+			ResourceId: suite.cloneId,
+		},
+	}
+	suite.mockLogRepo.On("SearchAllWithDetailKey", suite.ctx, suite.code, suite.cloneId, "batch").Return(logsExpected, nil)
+
+	res, err := suite.app.FindByProjectWithDetail(suite.ctx, suite.code, suite.cloneId, "batch")
+
+	require.NoError(err)
+	require.Equal(logsExpected, res)
+}
+
+func (suite *TestSuite) TestFindByProjectWithDetailError() {
+	require := require.New(suite.T())
+	suite.mockLogRepo.On("SearchAllWithDetailKey", suite.ctx, suite.code, suite.cloneId, "batch").Return(nil, gorm.ErrRecordNotFound)
+
+	res, err := suite.app.FindByProjectWithDetail(suite.ctx, suite.code, suite.cloneId, "batch")
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Empty(res)
+}
+
+func (suite *TestSuite) TestFindByFilterSuccessNextPage() {
+	require := require.New(suite.T())
+	logs := []domain.Log{{ID: 30}, {ID: 29}, {ID: 28}}
+	suite.mockLogRepo.On("SearchAllByFilter", suite.ctx, domain.LogFilter{Code: suite.code, Limit: 3}).Return(logs, nil)
+
+	res, cursor, err := suite.app.FindByFilter(suite.ctx, domain.LogFilter{Code: suite.code, Limit: 2})
+
+	require.NoError(err)
+	require.Equal(logs[:2], res)
+	require.Equal(uint(29), cursor)
+}
+
+func (suite *TestSuite) TestFindByFilterSuccessLastPage() {
+	require := require.New(suite.T())
+	logs := []domain.Log{{ID: 30}}
+	suite.mockLogRepo.On("SearchAllByFilter", suite.ctx, domain.LogFilter{Cursor: 31, Limit: logPageSize + 1}).Return(logs, nil)
+
+	res, cursor, err := suite.app.FindByFilter(suite.ctx, domain.LogFilter{Cursor: 31})
+
+	require.NoError(err)
+	require.Equal(logs, res)
+	require.Zero(cursor)
+}
+
+func (suite *TestSuite) TestFindByFilterSuccessMaxPageSize() {
+	require := require.New(suite.T())
+	suite.mockLogRepo.On("SearchAllByFilter", suite.ctx, domain.LogFilter{Limit: logMaxPageSize + 1}).Return([]domain.Log{}, nil)
+
+	res, cursor, err := suite.app.FindByFilter(suite.ctx, domain.LogFilter{Limit: 1000})
+
+	require.NoError(err)
+	require.Empty(res)
+	require.Zero(cursor)
+}
+
+func (suite *TestSuite) TestFindByFilterError() {
+	require := require.New(suite.T())
+	suite.mockLogRepo.On("SearchAllByFilter", suite.ctx, mock.Anything).Return(nil, gorm.ErrInvalidField)
+
+	res, cursor, err := suite.app.FindByFilter(suite.ctx, domain.LogFilter{})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Empty(res)
+	require.Zero(cursor)
+}
+
+func (suite *TestSuite) TestExportSuccess() {
+	require := require.New(suite.T())
+	logs := []domain.Log{{ID: 30}}
+	suite.mockLogRepo.On("SearchAllByFilter", suite.ctx, domain.LogFilter{Code: suite.code, Limit: logExportLimit}).Return(logs, nil)
+
+	res, err := suite.app.Export(suite.ctx, domain.LogFilter{Code: suite.code, Cursor: 0, Limit: 5})
+
+	require.NoError(err)
+	require.Equal(logs, res)
+}
+
+func (suite *TestSuite) TestExportError() {
+	require := require.New(suite.T())
+	suite.mockLogRepo.On("SearchAllByFilter", suite.ctx, mock.Anything).Return(nil, gorm.ErrInvalidField)
+
+	res, err := suite.app.Export(suite.ctx, domain.LogFilter{})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Empty(res)
+}
+
 func (suite *TestSuite) TestDeleteOrphanSuccess() {
 	require := require.New(suite.T())
 	suite.mockLogRepo.On("DeleteByResourceIdNotExists", suite.ctx).Return(nil)
@@ -124,15 +280,48 @@ func (suite *TestSuite) TestDeleteOrphanError() {
 	require.EqualError(gorm.ErrRecordNotFound, err.Error())
 }
 
+func (suite *TestSuite) TestDeleteOrphanEventSuccess() {
+	require := require.New(suite.T())
+	suite.mockLogRepo.On("DeleteByResourceIdNotExists", suite.ctx).Return(nil)
+
+	err := suite.app.DeleteOrphanEvent(suite.ctx, domain.Event{Name: domain.UserDeleted, ResourceId: 999})
+
+	require.NoError(err)
+}
+
 func (suite *TestSuite) TestDeleteOldSuccess() {
 	require := require.New(suite.T())
-	resourceIdsExpected := []uint{999, 1000}
-	suite.mockLogRepo.On("SearchResourceIdsWithLimitExceeded", suite.ctx).Return(resourceIdsExpected, nil)
+	logs := []domain.Log{{ID: 1}, {ID: 2}}
+	suite.mockLogRepo.On("SearchAllExceedingRetention", suite.ctx, mock.MatchedBy(func(retention domain.LogRetention) bool {
+		return retention.Code == suite.code && retention.Max == 20 && retention.Before != nil &&
+			time.Since(*retention.Before) >= time.Hour && retention.Limit == logRetentionBatch
+	})).Return(logs, nil).Once()
+	suite.mockLogArchiver.On("Archive", suite.ctx, suite.code, logs).Return(nil).Once()
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
 		return fc(nil)
 	})
 	suite.mockLogRepo.On("WithTransaction", nil).Return(suite.mockLogRepo)
-	suite.mockLogRepo.On("DeleteByResourceIdAndIdLessThanLimit", suite.ctx, mock.Anything).Return(nil).Times(2)
+	suite.mockLogRepo.On("DeleteByIds", suite.ctx, []uint{1, 2}).Return(nil).Once()
+
+	err := suite.app.DeleteOld(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteOldSuccessBatches() {
+	require := require.New(suite.T())
+	config.LOG_RETENTIONS = map[string]config.LogRetentionPolicy{
+		string(suite.code): {Max: 20},
+	}
+	logs := make([]domain.Log, logRetentionBatch)
+	suite.mockLogRepo.On("SearchAllExceedingRetention", suite.ctx, domain.LogRetention{Code: suite.code, Max: 20, Limit: logRetentionBatch}).Return(logs, nil).Once()
+	suite.mockLogRepo.On("SearchAllExceedingRetention", suite.ctx, domain.LogRetention{Code: suite.code, Max: 20, Limit: logRetentionBatch}).Return([]domain.Log{}, nil).Once()
+	suite.mockLogArchiver.On("Archive", suite.ctx, suite.code, logs).Return(nil).Once()
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockLogRepo.On("WithTransaction", nil).Return(suite.mockLogRepo)
+	suite.mockLogRepo.On("DeleteByIds", suite.ctx, mock.Anything).Return(nil).Once()
 
 	err := suite.app.DeleteOld(suite.ctx)
 
@@ -141,7 +330,18 @@ func (suite *TestSuite) TestDeleteOldSuccess() {
 
 func (suite *TestSuite) TestDeleteOldSuccessWithoutRecords() {
 	require := require.New(suite.T())
-	suite.mockLogRepo.On("SearchResourceIdsWithLimitExceeded", suite.ctx).Return([]uint{}, nil)
+	suite.mockLogRepo.On("SearchAllExceedingRetention", suite.ctx, mock.Anything).Return([]domain.Log{}, nil)
+
+	err := suite.app.DeleteOld(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteOldSuccessDisabled() {
+	require := require.New(suite.T())
+	config.LOG_RETENTIONS = map[string]config.LogRetentionPolicy{
+		string(suite.code): {},
+	}
 
 	err := suite.app.DeleteOld(suite.ctx)
 
@@ -150,18 +350,31 @@ func (suite *TestSuite) TestDeleteOldSuccessWithoutRecords() {
 
 func (suite *TestSuite) TestDeleteOldErrorSearch() {
 	require := require.New(suite.T())
-	suite.mockLogRepo.On("SearchResourceIdsWithLimitExceeded", suite.ctx).Return(nil, gorm.ErrRecordNotFound)
+	suite.mockLogRepo.On("SearchAllExceedingRetention", suite.ctx, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
 
 	err := suite.app.DeleteOld(suite.ctx)
 
 	require.EqualError(gorm.ErrRecordNotFound, err.Error())
 }
 
+func (suite *TestSuite) TestDeleteOldErrorArchive() {
+	require := require.New(suite.T())
+	errExpected := errors.New("Error archiving logs")
+	logs := []domain.Log{{ID: 1}}
+	suite.mockLogRepo.On("SearchAllExceedingRetention", suite.ctx, mock.Anything).Return(logs, nil)
+	suite.mockLogArchiver.On("Archive", suite.ctx, suite.code, logs).Return(errExpected)
+
+	err := suite.app.DeleteOld(suite.ctx)
+
+	require.EqualError(errExpected, err.Error())
+}
+
 func (suite *TestSuite) TestDeleteOldErrorTransaction() {
 	require := require.New(suite.T())
-	resourceIdsExpected := []uint{999, 1000}
 	errExpected := errors.New("Error in transaction")
-	suite.mockLogRepo.On("SearchResourceIdsWithLimitExceeded", suite.ctx).Return(resourceIdsExpected, nil)
+	logs := []domain.Log{{ID: 1}}
+	suite.mockLogRepo.On("SearchAllExceedingRetention", suite.ctx, mock.Anything).Return(logs, nil)
+	suite.mockLogArchiver.On("Archive", suite.ctx, suite.code, logs).Return(nil)
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(errExpected)
 
 	err := suite.app.DeleteOld(suite.ctx)
@@ -171,14 +384,15 @@ func (suite *TestSuite) TestDeleteOldErrorTransaction() {
 
 func (suite *TestSuite) TestDeleteOldErrorDelete() {
 	require := require.New(suite.T())
-	resourceIdsExpected := []uint{999, 1000}
-	errExpected := errors.New("Error in transaction")
-	suite.mockLogRepo.On("SearchResourceIdsWithLimitExceeded", suite.ctx).Return(resourceIdsExpected, nil)
+	errExpected := errors.New("Error deleting logs")
+	logs := []domain.Log{{ID: 1}}
+	suite.mockLogRepo.On("SearchAllExceedingRetention", suite.ctx, mock.Anything).Return(logs, nil)
+	suite.mockLogArchiver.On("Archive", suite.ctx, suite.code, logs).Return(nil)
 	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
 		return fc(nil)
 	})
 	suite.mockLogRepo.On("WithTransaction", nil).Return(suite.mockLogRepo)
-	suite.mockLogRepo.On("DeleteByResourceIdAndIdLessThanLimit", suite.ctx, mock.Anything).Return(errExpected)
+	suite.mockLogRepo.On("DeleteByIds", suite.ctx, []uint{1}).Return(errExpected)
 
 	err := suite.app.DeleteOld(suite.ctx)
 
@@ -0,0 +1,22 @@
+package domain
+
+import "context"
+
+type actorKey struct{}
+
+// Actor identifies who sends a request, it travels in the context to be stored in the logs
+type Actor struct {
+	UserId    *uint
+	RequestId string
+	IP        string
+}
+
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns an empty actor for the processes without request, e.g. the scheduled tasks
+func ActorFromContext(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorKey{}).(Actor)
+	return actor
+}
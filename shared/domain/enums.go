@@ -5,6 +5,15 @@ type CodeLog string
 const (
 	Budget     CodeLog = "budget"
 	BudgetBill CodeLog = "budget_bill"
+	User       CodeLog = "user"
+)
+
+type Role string
+
+const (
+	UserRole    Role = "user"
+	SupportRole Role = "support"
+	AdminRole   Role = "admin"
 )
 
 type Action string
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+type EventName string
+
+const (
+	BudgetCreated   EventName = "budget.created"
+	BudgetDeleted   EventName = "budget.deleted"
+	BudgetOverspent EventName = "budget.overspent"
+	BillCreated     EventName = "bill.created"
+	BillPaid        EventName = "bill.paid"
+	BillCompleted   EventName = "bill.completed"
+)
+
+var EventNames = []EventName{BudgetCreated, BudgetDeleted, BudgetOverspent, BillCreated, BillPaid, BillCompleted}
+
+// The internal events let each module remove its rows of the purged budgets and the deleted
+// users, they aren't sent to the webhooks.
+const (
+	TrashPurged EventName = "trash.purged"
+	UserDeleted EventName = "user.deleted"
+)
+
+// Event is a fact of the domain stored in the outbox with the changes that produced it,
+// it is pending until ProcessedAt or FailedAt are set.
+type Event struct {
+	ID            uint
+	Name          EventName
+	ResourceId    uint
+	UserId        *uint
+	Payload       map[string]any
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	ProcessedAt   *time.Time
+	FailedAt      *time.Time
+	CreatedAt     time.Time
+}
+
+type EventRepository interface {
+	persistent.TransactionRepository[EventRepository]
+	persistent.SaveRepository[Event]
+	SearchAllPending(ctx context.Context, now time.Time, limit int) ([]Event, error)
+	DeleteProcessedBefore(ctx context.Context, before time.Time) error
+	DeleteAllByUserId(ctx context.Context, userId uint) error
+}
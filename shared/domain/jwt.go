@@ -3,6 +3,7 @@ package domain
 import "github.com/golang-jwt/jwt/v5"
 
 type JwtUserClaims struct {
-	ID uint `json:"id"`
+	ID   uint `json:"id"`
+	Role Role `json:"role"`
 	jwt.RegisteredClaims
 }
@@ -12,14 +12,45 @@ type Log struct {
 	Detail      map[string]any
 	Code        CodeLog
 	ResourceId  uint
+	UserId      *uint
+	RequestId   string
+	IP          string
 	CreatedAt   time.Time
 }
 
+type LogFilter struct {
+	Code       CodeLog
+	ResourceId uint
+	UserId     *uint
+	From       *time.Time
+	To         *time.Time
+	Text       string
+	Cursor     uint
+	Limit      int
+}
+
+// LogRetention selects the logs of a code beyond the Max newest by resource or created before Before,
+// a zero Max or a nil Before disables each limit. The logs of a batch of changes count as one for
+// Max, so the undo and redo of the budgets never find half a batch.
+type LogRetention struct {
+	Code   CodeLog
+	Max    int
+	Before *time.Time
+	Limit  int
+}
+
 type LogRepository interface {
 	persistent.TransactionRepository[LogRepository]
 	persistent.SaveRepository[Log]
 	persistent.SearchAllByExampleRepository[Log]
 	DeleteByResourceIdNotExists(ctx context.Context) error
-	SearchResourceIdsWithLimitExceeded(ctx context.Context) ([]uint, error)
-	DeleteByResourceIdAndIdLessThanLimit(ctx context.Context, resourceId uint) error
+	SearchAllExceedingRetention(ctx context.Context, retention LogRetention) ([]Log, error)
+	DeleteByIds(ctx context.Context, ids []uint) error
+	SearchAllByFilter(ctx context.Context, filter LogFilter) ([]Log, error)
+	SearchAllWithDetailKey(ctx context.Context, code CodeLog, resourceId uint, key string) ([]Log, error)
+}
+
+// LogArchiver keeps the logs removed by the retention policies
+type LogArchiver interface {
+	Archive(ctx context.Context, code CodeLog, logs []Log) error
 }
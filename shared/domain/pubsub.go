@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrMessageTooLarge = errors.New("message too large")
+
+// PubSub fans out the messages of a topic to its subscribers in every instance of the API, the
+// messages are not stored so a subscriber only receives the ones published while it is connected.
+type PubSub interface {
+	Publish(ctx context.Context, topic string, data []byte) error
+	// Subscribe returns the messages of the topic until the context is done, then the channel is closed
+	Subscribe(ctx context.Context, topic string) (<-chan []byte, error)
+}
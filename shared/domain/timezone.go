@@ -0,0 +1,9 @@
+package domain
+
+import "context"
+
+// TimezoneReader gives the other modules the timezone of a user, so they do not depend on the
+// application of the users
+type TimezoneReader interface {
+	FindTimezone(ctx context.Context, userId uint) (string, error)
+}
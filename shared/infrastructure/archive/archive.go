@@ -0,0 +1,10 @@
+package archive
+
+import (
+	"your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/config"
+)
+
+func NewArchiver() domain.LogArchiver {
+	return NewFileArchiver(config.LOG_ARCHIVE_DIR)
+}
@@ -0,0 +1,71 @@
+package archive
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+	"your-accounts-api/shared/domain"
+)
+
+type archivedLog struct {
+	ID          uint           `json:"id"`
+	Description string         `json:"description"`
+	Detail      map[string]any `json:"detail"`
+	Code        domain.CodeLog `json:"code"`
+	ResourceId  uint           `json:"resourceId"`
+	ActorId     *uint          `json:"actorId,omitempty"`
+	RequestId   string         `json:"requestId,omitempty"`
+	IP          string         `json:"ip,omitempty"`
+	CreatedAt   time.Time      `json:"createdAt"`
+}
+
+type fileArchiver struct {
+	dir string
+}
+
+// Archive writes the logs as JSON lines in a new gzip file by call
+func (a *fileArchiver) Archive(ctx context.Context, code domain.CodeLog, logs []domain.Log) error {
+	if err := os.MkdirAll(a.dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%d.jsonl.gz", code, time.Now().UnixNano())
+	file, err := os.OpenFile(filepath.Join(a.dir, name), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := gzip.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, log := range logs {
+		err := encoder.Encode(archivedLog{
+			ID:          log.ID,
+			Description: log.Description,
+			Detail:      log.Detail,
+			Code:        log.Code,
+			ResourceId:  log.ResourceId,
+			ActorId:     log.UserId,
+			RequestId:   log.RequestId,
+			IP:          log.IP,
+			CreatedAt:   log.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+func NewFileArchiver(dir string) domain.LogArchiver {
+	return &fileArchiver{dir}
+}
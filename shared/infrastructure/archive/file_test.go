@@ -0,0 +1,99 @@
+package archive
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"your-accounts-api/shared/domain"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestSuite struct {
+	suite.Suite
+	logs []domain.Log
+}
+
+func (suite *TestSuite) SetupSuite() {
+	userId := uint(3)
+	suite.logs = []domain.Log{
+		{
+			ID:          1,
+			Description: "Test 1",
+			Detail: map[string]any{
+				"name": "Test",
+			},
+			Code:       domain.Budget,
+			ResourceId: 2,
+			UserId:     &userId,
+			RequestId:  "request",
+			IP:         "127.0.0.1",
+			CreatedAt:  time.Now(),
+		},
+		{
+			ID:          2,
+			Description: "Test 2",
+			Code:        domain.Budget,
+			ResourceId:  2,
+			CreatedAt:   time.Now(),
+		},
+	}
+}
+
+func (suite *TestSuite) TestArchiveSuccess() {
+	require := require.New(suite.T())
+	dir := filepath.Join(suite.T().TempDir(), "archive")
+	archiver := NewFileArchiver(dir)
+
+	err := archiver.Archive(context.Background(), domain.Budget, suite.logs)
+
+	require.NoError(err)
+	files, err := os.ReadDir(dir)
+	require.NoError(err)
+	require.Len(files, 1)
+	require.True(strings.HasPrefix(files[0].Name(), "budget-"))
+	require.True(strings.HasSuffix(files[0].Name(), ".jsonl.gz"))
+
+	file, err := os.Open(filepath.Join(dir, files[0].Name()))
+	require.NoError(err)
+	defer file.Close()
+	reader, err := gzip.NewReader(file)
+	require.NoError(err)
+	scanner := bufio.NewScanner(reader)
+	lines := []map[string]any{}
+	for scanner.Scan() {
+		line := map[string]any{}
+		require.NoError(json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.NoError(scanner.Err())
+	require.Len(lines, 2)
+	require.Equal(float64(1), lines[0]["id"])
+	require.Equal("Test 1", lines[0]["description"])
+	require.Equal(float64(3), lines[0]["actorId"])
+	require.Equal("127.0.0.1", lines[0]["ip"])
+	require.Equal(float64(2), lines[1]["id"])
+	require.Nil(lines[1]["actorId"])
+}
+
+func (suite *TestSuite) TestArchiveError() {
+	require := require.New(suite.T())
+	file := filepath.Join(suite.T().TempDir(), "file")
+	require.NoError(os.WriteFile(file, nil, 0o600))
+	archiver := NewFileArchiver(file)
+
+	err := archiver.Archive(context.Background(), domain.Budget, suite.logs)
+
+	require.Error(err)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"your-accounts-api/shared/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// RequireRoles only lets through the requests whose claims have one of the given roles,
+// it must be registered after the authentication middleware.
+func RequireRoles(roles ...domain.Role) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		role := Role(c)
+		for _, allowed := range roles {
+			if role == allowed {
+				return c.Next()
+			}
+		}
+
+		return fiber.NewError(fiber.StatusForbidden, "Insufficient permissions")
+	}
+}
+
+// Role returns the role of the authenticated user, tokens issued before the roles
+// existed are treated as regular users.
+func Role(c *fiber.Ctx) domain.Role {
+	token, ok := c.Locals("user").(*jwt.Token)
+	if !ok {
+		return ""
+	}
+
+	claims, ok := token.Claims.(*domain.JwtUserClaims)
+	if !ok {
+		return ""
+	} else if claims.Role == "" {
+		return domain.UserRole
+	}
+
+	return claims.Role
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+	"your-accounts-api/shared/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestSuite struct {
+	suite.Suite
+	role *domain.Role
+	app  *fiber.App
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.role = nil
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		if suite.role != nil {
+			c.Locals("user", &jwt.Token{
+				Claims: &domain.JwtUserClaims{
+					ID:   999,
+					Role: *suite.role,
+				},
+			})
+		}
+
+		return c.Next()
+	})
+	suite.app.Get("/", RequireRoles(domain.SupportRole, domain.AdminRole), func(c *fiber.Ctx) error {
+		return c.SendString(string(Role(c)))
+	})
+}
+
+func (suite *TestSuite) TestRequireRolesSuccess() {
+	require := require.New(suite.T())
+	role := domain.SupportRole
+	suite.role = &role
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal([]byte(domain.SupportRole), resp)
+}
+
+func (suite *TestSuite) TestRequireRolesForbidden() {
+	require := require.New(suite.T())
+	role := domain.UserRole
+	suite.role = &role
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRequireRolesForbiddenWithoutRole() {
+	require := require.New(suite.T())
+	role := domain.Role("")
+	suite.role = &role
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRequireRolesForbiddenWithoutToken() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
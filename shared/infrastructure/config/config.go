@@ -1,8 +1,12 @@
 package config
 
 import (
+	"fmt"
 	golog "log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2/log"
 
@@ -12,12 +16,123 @@ import (
 const (
 	defaultPort      = "8080"
 	defaultJwtSecret = "aSecret"
+
+	defaultAccountDeletionGrace = 720 * time.Hour
+	defaultEmailVerificationTTL = 24 * time.Hour
+	defaultMailer               = "file"
+	defaultMailerDir            = "mails"
+	defaultOidcStateTTL         = 10 * time.Minute
+
+	defaultLoginAttemptWindow    = 15 * time.Minute
+	defaultLoginAttemptRetention = 720 * time.Hour
+	defaultLoginBackoffThreshold = 3
+	defaultLoginBackoffBase      = 1 * time.Second
+	defaultLoginBackoffMax       = 15 * time.Minute
+	defaultLoginLockoutThreshold = 10
+	defaultLoginLockoutDuration  = 30 * time.Minute
+
+	defaultRateLimitStore = "memory"
+
+	defaultLogArchiveDir = "logs-archive"
+
+	defaultEventDispatchInterval = 10 * time.Second
+	defaultEventMaxAttempts      = 8
+	defaultEventRetryBase        = 30 * time.Second
+	defaultEventRetryMax         = 1 * time.Hour
+	defaultEventRetention        = 168 * time.Hour
+
+	defaultWebhookTimeout           = 10 * time.Second
+	defaultWebhookMaxAttempts       = 6
+	defaultWebhookRetryBase         = 1 * time.Minute
+	defaultWebhookRetryMax          = 6 * time.Hour
+	defaultWebhookDisableAfter      = 15
+	defaultWebhookDeliveryInterval  = 10 * time.Second
+	defaultWebhookDeliveryRetention = 720 * time.Hour
+
+	defaultBudgetSnapshotLimit = 50
+	defaultTrashRetention      = 720 * time.Hour
+
+	defaultPubSub          = "memory"
+	defaultStreamHeartbeat = 15 * time.Second
 )
 
+// RateLimitPolicy allows Max requests per Window, a zero Max disables the limit.
+type RateLimitPolicy struct {
+	Max    int
+	Window time.Duration
+}
+
+// LogRetentionPolicy keeps up to Max logs by resource and none older than MaxAge, a zero value disables each limit.
+// The logs of a batch of changes (the ones with the batch detail used by undo and redo) count as one
+// log for Max and are removed together.
+type LogRetentionPolicy struct {
+	Max    int
+	MaxAge time.Duration
+}
+
+type OidcProvider struct {
+	Name         string
+	Issuer       string
+	ClientId     string
+	ClientSecret string
+	RedirectURL  string
+}
+
 var (
 	PORT         string
 	DATABASE_DSN string
 	JWT_SECRET   = []byte(defaultJwtSecret)
+
+	ACCOUNT_DELETION_GRACE = defaultAccountDeletionGrace
+	EMAIL_VERIFICATION_TTL = defaultEmailVerificationTTL
+	MAILER                 = defaultMailer
+	MAILER_DIR             = defaultMailerDir
+	OIDC_PROVIDERS         = []OidcProvider{}
+	OIDC_STATE_TTL         = defaultOidcStateTTL
+
+	LOGIN_ATTEMPT_WINDOW    = defaultLoginAttemptWindow
+	LOGIN_ATTEMPT_RETENTION = defaultLoginAttemptRetention
+	LOGIN_BACKOFF_THRESHOLD = defaultLoginBackoffThreshold
+	LOGIN_BACKOFF_BASE      = defaultLoginBackoffBase
+	LOGIN_BACKOFF_MAX       = defaultLoginBackoffMax
+	LOGIN_LOCKOUT_THRESHOLD = defaultLoginLockoutThreshold
+	LOGIN_LOCKOUT_DURATION  = defaultLoginLockoutDuration
+
+	LOG_ARCHIVE_DIR = defaultLogArchiveDir
+	LOG_RETENTIONS  = map[string]LogRetentionPolicy{
+		"budget":      {Max: 20},
+		"budget_bill": {Max: 20},
+		"user":        {Max: 20},
+	}
+
+	EVENT_DISPATCH_INTERVAL = defaultEventDispatchInterval
+	EVENT_MAX_ATTEMPTS      = defaultEventMaxAttempts
+	EVENT_RETRY_BASE        = defaultEventRetryBase
+	EVENT_RETRY_MAX         = defaultEventRetryMax
+	EVENT_RETENTION         = defaultEventRetention
+
+	WEBHOOK_TIMEOUT            = defaultWebhookTimeout
+	WEBHOOK_MAX_ATTEMPTS       = defaultWebhookMaxAttempts
+	WEBHOOK_RETRY_BASE         = defaultWebhookRetryBase
+	WEBHOOK_RETRY_MAX          = defaultWebhookRetryMax
+	WEBHOOK_DISABLE_AFTER      = defaultWebhookDisableAfter
+	WEBHOOK_DELIVERY_INTERVAL  = defaultWebhookDeliveryInterval
+	WEBHOOK_DELIVERY_RETENTION = defaultWebhookDeliveryRetention
+
+	BUDGET_SNAPSHOT_LIMIT = defaultBudgetSnapshotLimit
+	TRASH_RETENTION       = defaultTrashRetention
+
+	PUBSUB           = defaultPubSub
+	STREAM_HEARTBEAT = defaultStreamHeartbeat
+
+	RATE_LIMIT_STORE          = defaultRateLimitStore
+	RATE_LIMIT_REDIS_ADDR     string
+	RATE_LIMIT_REDIS_PASSWORD string
+	RATE_LIMITS               = map[string]RateLimitPolicy{
+		"public": {Max: 30, Window: time.Minute},
+		"auth":   {Max: 10, Window: time.Minute},
+		"api":    {Max: 300, Window: time.Minute},
+	}
 )
 
 func LoadVariables() {
@@ -44,4 +159,171 @@ func LoadVariables() {
 	if env := os.Getenv("JWT_SECRET"); env != "" {
 		JWT_SECRET = []byte(env)
 	}
+
+	loadDuration("ACCOUNT_DELETION_GRACE", &ACCOUNT_DELETION_GRACE)
+	loadDuration("EMAIL_VERIFICATION_TTL", &EMAIL_VERIFICATION_TTL)
+
+	if env := os.Getenv("MAILER"); env != "" {
+		if env != "file" && env != "memory" {
+			log.Fatal("Environment variable MAILER is invalid: ", env)
+		}
+
+		MAILER = env
+	}
+
+	if env := os.Getenv("MAILER_DIR"); env != "" {
+		MAILER_DIR = env
+	}
+
+	if env := os.Getenv("OIDC_PROVIDERS"); env != "" {
+		OIDC_PROVIDERS = []OidcProvider{}
+		for _, name := range strings.Split(env, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			prefix := fmt.Sprintf("OIDC_%s_", strings.ToUpper(name))
+			provider := OidcProvider{
+				Name:         name,
+				Issuer:       os.Getenv(prefix + "ISSUER"),
+				ClientId:     os.Getenv(prefix + "CLIENT_ID"),
+				ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+				RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+			}
+			if provider.Issuer == "" || provider.ClientId == "" || provider.RedirectURL == "" {
+				log.Fatalf("Environment variables %sISSUER, %sCLIENT_ID and %sREDIRECT_URL are mandatory", prefix, prefix, prefix)
+			}
+
+			OIDC_PROVIDERS = append(OIDC_PROVIDERS, provider)
+		}
+	}
+
+	loadDuration("OIDC_STATE_TTL", &OIDC_STATE_TTL)
+	loadDuration("LOGIN_ATTEMPT_WINDOW", &LOGIN_ATTEMPT_WINDOW)
+	loadDuration("LOGIN_ATTEMPT_RETENTION", &LOGIN_ATTEMPT_RETENTION)
+	loadInt("LOGIN_BACKOFF_THRESHOLD", &LOGIN_BACKOFF_THRESHOLD)
+	loadDuration("LOGIN_BACKOFF_BASE", &LOGIN_BACKOFF_BASE)
+	loadDuration("LOGIN_BACKOFF_MAX", &LOGIN_BACKOFF_MAX)
+	loadInt("LOGIN_LOCKOUT_THRESHOLD", &LOGIN_LOCKOUT_THRESHOLD)
+	loadDuration("LOGIN_LOCKOUT_DURATION", &LOGIN_LOCKOUT_DURATION)
+	if env := os.Getenv("LOG_ARCHIVE_DIR"); env != "" {
+		LOG_ARCHIVE_DIR = env
+	}
+
+	for code, policy := range LOG_RETENTIONS {
+		loadLogRetention("LOG_RETENTION_"+strings.ToUpper(code), &policy)
+		LOG_RETENTIONS[code] = policy
+	}
+
+	loadDuration("EVENT_DISPATCH_INTERVAL", &EVENT_DISPATCH_INTERVAL)
+	loadInt("EVENT_MAX_ATTEMPTS", &EVENT_MAX_ATTEMPTS)
+	loadDuration("EVENT_RETRY_BASE", &EVENT_RETRY_BASE)
+	loadDuration("EVENT_RETRY_MAX", &EVENT_RETRY_MAX)
+	loadDuration("EVENT_RETENTION", &EVENT_RETENTION)
+	loadDuration("WEBHOOK_TIMEOUT", &WEBHOOK_TIMEOUT)
+	loadInt("WEBHOOK_MAX_ATTEMPTS", &WEBHOOK_MAX_ATTEMPTS)
+	loadDuration("WEBHOOK_RETRY_BASE", &WEBHOOK_RETRY_BASE)
+	loadDuration("WEBHOOK_RETRY_MAX", &WEBHOOK_RETRY_MAX)
+	loadInt("WEBHOOK_DISABLE_AFTER", &WEBHOOK_DISABLE_AFTER)
+	loadDuration("WEBHOOK_DELIVERY_INTERVAL", &WEBHOOK_DELIVERY_INTERVAL)
+	loadDuration("WEBHOOK_DELIVERY_RETENTION", &WEBHOOK_DELIVERY_RETENTION)
+	loadInt("BUDGET_SNAPSHOT_LIMIT", &BUDGET_SNAPSHOT_LIMIT)
+	loadDuration("TRASH_RETENTION", &TRASH_RETENTION)
+
+	if env := os.Getenv("PUBSUB"); env != "" {
+		if env != "memory" && env != "postgres" {
+			log.Fatal("Environment variable PUBSUB is invalid: ", env)
+		}
+
+		PUBSUB = env
+	}
+
+	loadDuration("STREAM_HEARTBEAT", &STREAM_HEARTBEAT)
+
+	if env := os.Getenv("RATE_LIMIT_STORE"); env != "" {
+		if env != "memory" && env != "redis" {
+			log.Fatal("Environment variable RATE_LIMIT_STORE is invalid: ", env)
+		}
+
+		RATE_LIMIT_STORE = env
+	}
+
+	RATE_LIMIT_REDIS_ADDR = os.Getenv("RATE_LIMIT_REDIS_ADDR")
+	RATE_LIMIT_REDIS_PASSWORD = os.Getenv("RATE_LIMIT_REDIS_PASSWORD")
+	if RATE_LIMIT_STORE == "redis" && RATE_LIMIT_REDIS_ADDR == "" {
+		log.Fatal("Environment variable RATE_LIMIT_REDIS_ADDR is mandatory with the redis store")
+	}
+
+	for name, policy := range RATE_LIMITS {
+		loadRateLimit("RATE_LIMIT_"+strings.ToUpper(name), &policy)
+		RATE_LIMITS[name] = policy
+	}
+}
+
+func loadDuration(name string, value *time.Duration) {
+	if env := os.Getenv(name); env != "" {
+		duration, err := time.ParseDuration(env)
+		if err != nil {
+			log.Fatalf("Environment variable %s is invalid: %v", name, err)
+		}
+
+		*value = duration
+	}
+}
+
+func loadInt(name string, value *int) {
+	if env := os.Getenv(name); env != "" {
+		number, err := strconv.Atoi(env)
+		if err != nil || number < 1 {
+			log.Fatalf("Environment variable %s is invalid: %s", name, env)
+		}
+
+		*value = number
+	}
+}
+
+// loadRateLimit reads a policy with the format <max>/<window>, e.g. 10/1m, or off to disable it.
+func loadRateLimit(name string, value *RateLimitPolicy) {
+	env := os.Getenv(name)
+	if env == "" {
+		return
+	} else if env == "off" {
+		*value = RateLimitPolicy{}
+		return
+	}
+
+	max, window, found := strings.Cut(env, "/")
+	number, err := strconv.Atoi(max)
+	if !found || err != nil || number < 1 {
+		log.Fatalf("Environment variable %s is invalid: %s", name, env)
+	}
+
+	duration, err := time.ParseDuration(window)
+	if err != nil || duration <= 0 {
+		log.Fatalf("Environment variable %s is invalid: %s", name, env)
+	}
+
+	*value = RateLimitPolicy{Max: number, Window: duration}
+}
+
+// loadLogRetention reads a policy with the format <max>/<max age>, e.g. 20/720h, where a zero
+// disables that limit, or off to keep all the logs.
+func loadLogRetention(name string, value *LogRetentionPolicy) {
+	env := os.Getenv(name)
+	if env == "" {
+		return
+	} else if env == "off" {
+		*value = LogRetentionPolicy{}
+		return
+	}
+
+	max, maxAge, found := strings.Cut(env, "/")
+	number, err := strconv.Atoi(max)
+	if !found || err != nil || number < 0 {
+		log.Fatalf("Environment variable %s is invalid: %s", name, env)
+	}
+
+	duration, err := time.ParseDuration(maxAge)
+	if err != nil || duration < 0 {
+		log.Fatalf("Environment variable %s is invalid: %s", name, env)
+	}
+
+	*value = LogRetentionPolicy{Max: number, MaxAge: duration}
 }
@@ -45,10 +45,33 @@ func NewDB() {
 		if err = DB.AutoMigrate(
 			new(users.User),
 			new(users.UserToken),
+			new(users.UserVerification),
+			new(users.ApiKey),
+			new(users.UserIdentity),
+			new(users.OidcState),
+			new(users.LoginAttempt),
+			new(users.Webhook),
+			new(users.WebhookDelivery),
 			new(budgets.Budget),
 			new(budgets.BudgetAvailable),
 			new(budgets.BudgetBill),
+			new(budgets.BudgetSnapshot),
+			new(budgets.Goal),
+			new(budgets.Loan),
+			new(budgets.LoanInstallment),
+			new(budgets.CreditCard),
+			new(budgets.CreditCardStatement),
+			new(budgets.CreditCardCharge),
+			new(budgets.Account),
+			new(budgets.AccountEntry),
+			new(budgets.AccountAvailable),
+			new(budgets.BudgetMember),
+			new(budgets.BillSplit),
+			new(budgets.BillShare),
+			new(budgets.BudgetSettlement),
+			new(budgets.BudgetEnvelope),
 			new(shared.Log),
+			new(shared.Event),
 		); err != nil {
 			log.Fatal(err)
 		}
@@ -2,6 +2,8 @@ package entity
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type BaseModel struct {
@@ -12,3 +14,7 @@ type BaseModel struct {
 type BaseUpdateModel struct {
 	UpdatedAt time.Time `gorm:"autoUpdateTime:true"`
 }
+
+type BaseDeleteModel struct {
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+	"your-accounts-api/shared/domain"
+)
+
+type Event struct {
+	BaseModel
+	Name          domain.EventName `gorm:"not null"`
+	ResourceId    uint             `gorm:"not null"`
+	UserId        *uint
+	Payload       map[string]any `gorm:"not null;type:json;serializer:json"`
+	Attempts      int            `gorm:"not null;default:0"`
+	NextAttemptAt time.Time      `gorm:"not null;index"`
+	LastError     string
+	ProcessedAt   *time.Time `gorm:"index"`
+	FailedAt      *time.Time
+}
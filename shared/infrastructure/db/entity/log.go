@@ -8,4 +8,7 @@ type Log struct {
 	Detail      map[string]any `gorm:"not null;type:json;serializer:json"`
 	Code        domain.CodeLog `gorm:"not null"`
 	ResourceId  uint           `gorm:"not null"`
+	UserId      *uint          `gorm:"index"`
+	RequestId   string
+	IP          string
 }
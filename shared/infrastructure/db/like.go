@@ -0,0 +1,11 @@
+package db
+
+import "strings"
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ContainsPattern returns the LIKE pattern that matches the text anywhere, the wildcards of the
+// text are escaped so the queries must use ESCAPE '\'
+func ContainsPattern(text string) string {
+	return "%" + likeEscaper.Replace(text) + "%"
+}
@@ -0,0 +1,107 @@
+package event
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	"your-accounts-api/shared/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.EventRepository {
+	return db.DefaultWithTransaction[domain.EventRepository](tx, NewRepository, r)
+}
+
+// Save creates the event in the outbox, an existing event only updates the state of its delivery
+func (r *gormRepository) Save(ctx context.Context, event domain.Event) (uint, error) {
+	model := &entity.Event{
+		Attempts:      event.Attempts,
+		NextAttemptAt: event.NextAttemptAt,
+		LastError:     event.LastError,
+		ProcessedAt:   event.ProcessedAt,
+		FailedAt:      event.FailedAt,
+	}
+	if event.ID != 0 {
+		model.ID = event.ID
+		if err := r.db.WithContext(ctx).Model(model).Select("Attempts", "NextAttemptAt", "LastError", "ProcessedAt", "FailedAt").Updates(model).Error; err != nil {
+			return 0, err
+		}
+
+		return model.ID, nil
+	}
+
+	if event.Payload == nil {
+		event.Payload = map[string]any{}
+	}
+
+	model.Name = event.Name
+	model.ResourceId = event.ResourceId
+	model.UserId = event.UserId
+	model.Payload = event.Payload
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+// SearchAllPending locks the events until the end of the transaction, the events locked by
+// another dispatcher are skipped.
+func (r *gormRepository) SearchAllPending(ctx context.Context, now time.Time, limit int) ([]domain.Event, error) {
+	var models []entity.Event
+	if err := r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("processed_at IS NULL AND failed_at IS NULL AND next_attempt_at <= ?", now).
+		Order("id").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	events := []domain.Event{}
+	for _, model := range models {
+		events = append(events, domain.Event{
+			ID:            model.ID,
+			Name:          model.Name,
+			ResourceId:    model.ResourceId,
+			UserId:        model.UserId,
+			Payload:       model.Payload,
+			Attempts:      model.Attempts,
+			NextAttemptAt: model.NextAttemptAt,
+			LastError:     model.LastError,
+			ProcessedAt:   model.ProcessedAt,
+			FailedAt:      model.FailedAt,
+			CreatedAt:     model.CreatedAt,
+		})
+	}
+
+	return events, nil
+}
+
+// DeleteProcessedBefore removes the events delivered or failed before the date
+func (r *gormRepository) DeleteProcessedBefore(ctx context.Context, before time.Time) error {
+	if err := r.db.WithContext(ctx).Where("processed_at < ?", before).Or("failed_at < ?", before).Delete(entity.Event{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAllByUserId removes the delivered and failed events of the user, the pending ones are
+// removed by DeleteProcessedBefore once they are delivered or fail
+func (r *gormRepository) DeleteAllByUserId(ctx context.Context, userId uint) error {
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND (processed_at IS NOT NULL OR failed_at IS NOT NULL)", userId).Delete(entity.Event{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewRepository(db *gorm.DB) domain.EventRepository {
+	return &gormRepository{db}
+}
@@ -0,0 +1,271 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/test_utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	resourceId uint
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.EventRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.resourceId = 1
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveSuccessNew() {
+	require := require.New(suite.T())
+	userId := uint(2)
+	nextAttemptAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "events" ("created_at","name","resource_id","user_id","payload","attempts","next_attempt_at","last_error","processed_at","failed_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, domain.BudgetCreated, suite.resourceId, userId, `{"name":"Budget"}`, 0, nextAttemptAt, "", nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(999)))
+	suite.mock.ExpectCommit()
+	event := domain.Event{
+		Name:          domain.BudgetCreated,
+		ResourceId:    suite.resourceId,
+		UserId:        &userId,
+		Payload:       map[string]any{"name": "Budget"},
+		NextAttemptAt: nextAttemptAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), event)
+
+	require.NoError(err)
+	require.Equal(uint(999), res)
+}
+
+func (suite *TestSuite) TestSaveSuccessExists() {
+	require := require.New(suite.T())
+	processedAt := time.Now()
+	nextAttemptAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "attempts"=$1,"next_attempt_at"=$2,"last_error"=$3,"processed_at"=$4,"failed_at"=$5 WHERE "id" = $6`)).
+		WithArgs(1, nextAttemptAt, "", processedAt, nil, 999).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	event := domain.Event{
+		ID:            999,
+		Name:          domain.BudgetCreated,
+		ResourceId:    suite.resourceId,
+		Attempts:      1,
+		NextAttemptAt: nextAttemptAt,
+		ProcessedAt:   &processedAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), event)
+
+	require.NoError(err)
+	require.Equal(uint(999), res)
+}
+
+func (suite *TestSuite) TestSaveErrorNew() {
+	require := require.New(suite.T())
+	nextAttemptAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "events" ("created_at","name","resource_id","user_id","payload","attempts","next_attempt_at","last_error","processed_at","failed_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, domain.BudgetDeleted, suite.resourceId, nil, `{}`, 0, nextAttemptAt, "", nil, nil).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+	event := domain.Event{
+		Name:          domain.BudgetDeleted,
+		ResourceId:    suite.resourceId,
+		NextAttemptAt: nextAttemptAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), event)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSaveErrorExists() {
+	require := require.New(suite.T())
+	failedAt := time.Now()
+	nextAttemptAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "attempts"=$1,"next_attempt_at"=$2,"last_error"=$3,"processed_at"=$4,"failed_at"=$5 WHERE "id" = $6`)).
+		WithArgs(5, nextAttemptAt, "Error", nil, failedAt, 998).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+	event := domain.Event{
+		ID:            998,
+		Attempts:      5,
+		NextAttemptAt: nextAttemptAt,
+		LastError:     "Error",
+		FailedAt:      &failedAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), event)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSearchAllPendingSuccess() {
+	require := require.New(suite.T())
+	now := time.Now()
+	userId := uint(2)
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE processed_at IS NULL AND failed_at IS NULL AND next_attempt_at <= $1 ORDER BY id LIMIT 10 FOR UPDATE SKIP LOCKED`)).
+		WithArgs(now).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "name", "resource_id", "user_id", "payload", "attempts", "next_attempt_at", "last_error", "processed_at", "failed_at"}).
+			AddRow(1, now, domain.BudgetCreated, suite.resourceId, userId, `{"name":"Budget"}`, 0, now, "", nil, nil).
+			AddRow(2, now, domain.BillPaid, 3, nil, `{"amount":10}`, 1, now, "Error", nil, nil),
+		)
+
+	res, err := suite.repository.SearchAllPending(context.Background(), now, 10)
+
+	require.NoError(err)
+	require.Equal([]domain.Event{
+		{ID: 1, Name: domain.BudgetCreated, ResourceId: suite.resourceId, UserId: &userId, Payload: map[string]any{"name": "Budget"}, NextAttemptAt: now, CreatedAt: now},
+		{ID: 2, Name: domain.BillPaid, ResourceId: 3, Payload: map[string]any{"amount": float64(10)}, Attempts: 1, NextAttemptAt: now, LastError: "Error", CreatedAt: now},
+	}, res)
+}
+
+func (suite *TestSuite) TestSearchAllPendingError() {
+	require := require.New(suite.T())
+	now := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE processed_at IS NULL AND failed_at IS NULL AND next_attempt_at <= $1 ORDER BY id LIMIT 20 FOR UPDATE SKIP LOCKED`)).
+		WithArgs(now).
+		WillReturnError(gorm.ErrInvalidField)
+
+	res, err := suite.repository.SearchAllPending(context.Background(), now, 20)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(res)
+}
+
+func (suite *TestSuite) TestDeleteProcessedBeforeSuccess() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "events" WHERE processed_at < $1 OR failed_at < $2`)).
+		WithArgs(before, before).
+		WillReturnResult(sqlmock.NewResult(0, 10))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteProcessedBefore(context.Background(), before)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteProcessedBeforeError() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "events" WHERE processed_at < $1 OR failed_at < $2`)).
+		WithArgs(before, before).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteProcessedBefore(context.Background(), before)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteAllByUserIdSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "events" WHERE user_id = $1 AND (processed_at IS NOT NULL OR failed_at IS NOT NULL)`)).
+		WithArgs(999).
+		WillReturnResult(sqlmock.NewResult(0, 10))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteAllByUserId(context.Background(), 999)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteAllByUserIdError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "events" WHERE user_id = $1 AND (processed_at IS NOT NULL OR failed_at IS NOT NULL)`)).
+		WithArgs(999).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteAllByUserId(context.Background(), 999)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
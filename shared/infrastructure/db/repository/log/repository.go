@@ -2,7 +2,7 @@ package log
 
 import (
 	"context"
-	"database/sql"
+	budgets "your-accounts-api/budgets/infrastructure/db/entity"
 	"your-accounts-api/shared/domain"
 	"your-accounts-api/shared/domain/persistent"
 	"your-accounts-api/shared/infrastructure/db"
@@ -31,6 +31,9 @@ func (r *gormRepository) Save(ctx context.Context, log domain.Log) (uint, error)
 		Detail:      log.Detail,
 		Code:        log.Code,
 		ResourceId:  log.ResourceId,
+		UserId:      log.UserId,
+		RequestId:   log.RequestId,
+		IP:          log.IP,
 	}
 
 	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
@@ -50,46 +53,121 @@ func (r *gormRepository) SearchAllByExample(ctx context.Context, example domain.
 		return nil, err
 	}
 
-	var logs []domain.Log
-	for _, model := range models {
-		logs = append(logs, domain.Log{
-			ID:          model.ID,
-			Description: model.Description,
-			Detail:      model.Detail,
-			Code:        model.Code,
-			ResourceId:  model.ResourceId,
-			CreatedAt:   model.CreatedAt,
-		})
-	}
-
-	return logs, nil
+	return toDomains(models), nil
 }
 
 func (r *gormRepository) DeleteByResourceIdNotExists(ctx context.Context) error {
-	if err := r.db.WithContext(ctx).Where("resource_id NOT IN (SELECT id FROM budgets) AND resource_id NOT IN (SELECT id FROM budget_bills)").Delete(entity.Log{}).Error; err != nil {
+	// Unscoped to keep the logs of the resources in the trash
+	budgetIds := r.db.Unscoped().Model(budgets.Budget{}).Select("id")
+	billIds := r.db.Unscoped().Model(budgets.BudgetBill{}).Select("id")
+	if err := r.db.WithContext(ctx).Where("code = ? AND resource_id NOT IN (?)", domain.Budget, budgetIds).Or("code = ? AND resource_id NOT IN (?)", domain.BudgetBill, billIds).Delete(entity.Log{}).Error; err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (r *gormRepository) SearchResourceIdsWithLimitExceeded(ctx context.Context) ([]uint, error) {
-	var ids []uint
-	if err := r.db.WithContext(ctx).Raw("SELECT resource_id FROM logs GROUP BY resource_id HAVING COUNT(resource_id) > ?", limit).Scan(&ids).Error; err != nil {
+func (r *gormRepository) SearchAllExceedingRetention(ctx context.Context, retention domain.LogRetention) ([]domain.Log, error) {
+	conditions := r.db.Session(&gorm.Session{NewDB: true})
+	if retention.Max > 0 {
+		// The logs of a batch share the unit of its newest log, so the batch is counted once and
+		// is removed whole
+		units := r.db.Model(entity.Log{}).
+			Select("id, resource_id, MAX(id) OVER (PARTITION BY resource_id, COALESCE(detail->>'batch', CAST(id AS text))) AS unit").
+			Where("code = ?", retention.Code)
+		positions := r.db.Table("(?) AS units", units).Select("id, DENSE_RANK() OVER (PARTITION BY resource_id ORDER BY unit DESC) AS position")
+		exceeding := r.db.Table("(?) AS positions", positions).Select("id").Where("position > ?", retention.Max)
+		conditions = conditions.Or("id IN (?)", exceeding)
+	}
+
+	if retention.Before != nil {
+		conditions = conditions.Or("created_at < ?", *retention.Before)
+	}
+
+	var models []entity.Log
+	if err := r.db.WithContext(ctx).Where("code = ?", retention.Code).Where(conditions).Order("id").Limit(retention.Limit).Find(&models).Error; err != nil {
 		return nil, err
 	}
 
-	return ids, nil
+	return toDomains(models), nil
 }
 
-func (r *gormRepository) DeleteByResourceIdAndIdLessThanLimit(ctx context.Context, resourceId uint) error {
-	if err := r.db.WithContext(ctx).Where("resource_id = @resource_id AND id < (SELECT id FROM (SELECT id FROM logs WHERE resource_id = @resource_id ORDER BY id DESC LIMIT @limit) T ORDER BY id ASC LIMIT 1)", sql.Named("resource_id", resourceId), sql.Named("limit", limit)).Delete(entity.Log{}).Error; err != nil {
+func (r *gormRepository) DeleteByIds(ctx context.Context, ids []uint) error {
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Delete(entity.Log{}).Error; err != nil {
 		return err
 	}
 
 	return nil
 }
 
+func (r *gormRepository) SearchAllByFilter(ctx context.Context, filter domain.LogFilter) ([]domain.Log, error) {
+	query := r.db.WithContext(ctx).Model(entity.Log{})
+	if filter.Code != "" {
+		query = query.Where("code = ?", filter.Code)
+	}
+
+	if filter.ResourceId != 0 {
+		query = query.Where("resource_id = ?", filter.ResourceId)
+	}
+
+	if filter.UserId != nil {
+		query = query.Where("user_id = ?", *filter.UserId)
+	}
+
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	if filter.Text != "" {
+		text := db.ContainsPattern(filter.Text)
+		query = query.Where(`description ILIKE ? ESCAPE '\' OR CAST(detail AS TEXT) ILIKE ? ESCAPE '\'`, text, text)
+	}
+
+	// The cursor is the ID of the last log of the previous page
+	if filter.Cursor != 0 {
+		query = query.Where("id < ?", filter.Cursor)
+	}
+
+	var models []entity.Log
+	if err := query.Order("id desc").Limit(filter.Limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomains(models), nil
+}
+
+func (r *gormRepository) SearchAllWithDetailKey(ctx context.Context, code domain.CodeLog, resourceId uint, key string) ([]domain.Log, error) {
+	var models []entity.Log
+	if err := r.db.WithContext(ctx).Where("code = ? AND resource_id = ? AND detail->>? IS NOT NULL", code, resourceId, key).Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomains(models), nil
+}
+
+func toDomains(models []entity.Log) []domain.Log {
+	var logs []domain.Log
+	for _, model := range models {
+		logs = append(logs, domain.Log{
+			ID:          model.ID,
+			Description: model.Description,
+			Detail:      model.Detail,
+			Code:        model.Code,
+			ResourceId:  model.ResourceId,
+			UserId:      model.UserId,
+			RequestId:   model.RequestId,
+			IP:          model.IP,
+			CreatedAt:   model.CreatedAt,
+		})
+	}
+
+	return logs
+}
+
 func NewRepository(db *gorm.DB) domain.LogRepository {
 	return &gormRepository{db}
 }
@@ -26,6 +26,7 @@ type TestSuite struct {
 	detail      map[string]any
 	code        domain.CodeLog
 	resourceId  uint
+	userId      uint
 	mock        sqlmock.Sqlmock
 	mockTX      *mocks_persistent.MockTransaction
 	repository  domain.LogRepository
@@ -40,6 +41,7 @@ func (suite *TestSuite) SetupSuite() {
 	suite.detailStr = string(jsonStr)
 	suite.code = domain.Budget
 	suite.resourceId = 1
+	suite.userId = 2
 
 	require := require.New(suite.T())
 
@@ -94,8 +96,8 @@ func (suite *TestSuite) TestSaveSuccess() {
 
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "logs" ("created_at","description","detail","code","resource_id") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, suite.description, suite.detailStr, suite.code, suite.resourceId).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "logs" ("created_at","description","detail","code","resource_id","user_id","request_id","ip") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.description, suite.detailStr, suite.code, suite.resourceId, suite.userId, "request", "127.0.0.1").
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uint(999)))
 	suite.mock.ExpectCommit()
 	log := domain.Log{
@@ -103,6 +105,9 @@ func (suite *TestSuite) TestSaveSuccess() {
 		Detail:      suite.detail,
 		Code:        suite.code,
 		ResourceId:  suite.resourceId,
+		UserId:      &suite.userId,
+		RequestId:   "request",
+		IP:          "127.0.0.1",
 	}
 
 	res, err := suite.repository.Save(context.Background(), log)
@@ -117,8 +122,8 @@ func (suite *TestSuite) TestSaveError() {
 
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "logs" ("created_at","description","detail","code","resource_id") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, suite.description, "{}", suite.code, suite.resourceId).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "logs" ("created_at","description","detail","code","resource_id","user_id","request_id","ip") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.description, "{}", suite.code, suite.resourceId, nil, "", "").
 		WillReturnError(gorm.ErrInvalidField)
 	suite.mock.ExpectRollback()
 	log := domain.Log{
@@ -186,7 +191,8 @@ func (suite *TestSuite) TestDeleteByResourceIdNotExistsSuccess() {
 	require := require.New(suite.T())
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "logs" WHERE resource_id NOT IN (SELECT id FROM budgets) AND resource_id NOT IN (SELECT id FROM budget_bills)`)).
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "logs" WHERE (code = $1 AND resource_id NOT IN (SELECT "id" FROM "budgets")) OR (code = $2 AND resource_id NOT IN (SELECT "id" FROM "budget_bills"))`)).
+		WithArgs(domain.Budget, domain.BudgetBill).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	suite.mock.ExpectCommit()
 
@@ -199,7 +205,8 @@ func (suite *TestSuite) TestDeleteByResourceIdNotExistsError() {
 	require := require.New(suite.T())
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "logs" WHERE resource_id NOT IN (SELECT id FROM budgets) AND resource_id NOT IN (SELECT id FROM budget_bills)`)).
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "logs" WHERE (code = $1 AND resource_id NOT IN (SELECT "id" FROM "budgets")) OR (code = $2 AND resource_id NOT IN (SELECT "id" FROM "budget_bills"))`)).
+		WithArgs(domain.Budget, domain.BudgetBill).
 		WillReturnError(gorm.ErrRecordNotFound)
 	suite.mock.ExpectRollback()
 
@@ -208,65 +215,206 @@ func (suite *TestSuite) TestDeleteByResourceIdNotExistsError() {
 	require.EqualError(gorm.ErrRecordNotFound, err.Error())
 }
 
-func (suite *TestSuite) TestSearchResourceIdsWithLimitExceededSuccess() {
+func (suite *TestSuite) TestSearchAllExceedingRetentionSuccess() {
 	require := require.New(suite.T())
+	before := time.Now()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT resource_id FROM logs GROUP BY resource_id HAVING COUNT(resource_id) > $1`)).
-		WithArgs(20).
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "logs" WHERE code = $1 AND (id IN (SELECT id FROM (SELECT id, DENSE_RANK() OVER (PARTITION BY resource_id ORDER BY unit DESC) AS position FROM (SELECT id, resource_id, MAX(id) OVER (PARTITION BY resource_id, COALESCE(detail->>'batch', CAST(id AS text))) AS unit FROM "logs" WHERE code = $2) AS units) AS positions WHERE position > $3) OR created_at < $4) ORDER BY id LIMIT 100`)).
+		WithArgs(suite.code, suite.code, 20, before).
 		WillReturnRows(sqlmock.
-			NewRows([]string{"resource_id"}).
-			AddRow(uint(1)).
-			AddRow(uint(2)),
+			NewRows([]string{"id", "created_at", "description", "detail", "code", "resource_id"}).
+			AddRow(1, time.Now(), suite.description, suite.detailStr, suite.code, suite.resourceId).
+			AddRow(2, time.Now(), suite.description, suite.detailStr, suite.code, suite.resourceId),
 		)
 
-	resourceIds, err := suite.repository.SearchResourceIdsWithLimitExceeded(context.Background())
+	logs, err := suite.repository.SearchAllExceedingRetention(context.Background(), domain.LogRetention{
+		Code:   suite.code,
+		Max:    20,
+		Before: &before,
+		Limit:  100,
+	})
 
 	require.NoError(err)
-	require.NotNil(resourceIds)
-	require.Len(resourceIds, 2)
-	require.Equal(uint(1), resourceIds[0])
-	require.Equal(uint(2), resourceIds[1])
+	require.Len(logs, 2)
+	require.Equal(uint(1), logs[0].ID)
+	require.Equal(uint(2), logs[1].ID)
 }
 
-func (suite *TestSuite) TestSearchResourceIdsWithLimitExceededError() {
+func (suite *TestSuite) TestSearchAllExceedingRetentionSuccessOnlyAge() {
 	require := require.New(suite.T())
+	before := time.Now()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`SELECT resource_id FROM logs GROUP BY resource_id HAVING COUNT(resource_id) > $1`)).
-		WithArgs(20).
-		WillReturnError(gorm.ErrRecordNotFound)
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "logs" WHERE code = $1 AND created_at < $2 ORDER BY id LIMIT 100`)).
+		WithArgs(suite.code, before).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	logs, err := suite.repository.SearchAllExceedingRetention(context.Background(), domain.LogRetention{
+		Code:   suite.code,
+		Before: &before,
+		Limit:  100,
+	})
 
-	resourceIds, err := suite.repository.SearchResourceIdsWithLimitExceeded(context.Background())
+	require.NoError(err)
+	require.Empty(logs)
+}
 
-	require.EqualError(gorm.ErrRecordNotFound, err.Error())
-	require.Empty(resourceIds)
+func (suite *TestSuite) TestSearchAllExceedingRetentionError() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "logs" WHERE code = $1 AND (id IN (SELECT id FROM (SELECT id, DENSE_RANK() OVER (PARTITION BY resource_id ORDER BY unit DESC) AS position FROM (SELECT id, resource_id, MAX(id) OVER (PARTITION BY resource_id, COALESCE(detail->>'batch', CAST(id AS text))) AS unit FROM "logs" WHERE code = $2) AS units) AS positions WHERE position > $3) OR created_at < $4) ORDER BY id LIMIT 100`)).
+		WithArgs(suite.code, suite.code, 20, before).
+		WillReturnError(gorm.ErrInvalidField)
+
+	logs, err := suite.repository.SearchAllExceedingRetention(context.Background(), domain.LogRetention{
+		Code:   suite.code,
+		Max:    20,
+		Before: &before,
+		Limit:  100,
+	})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Empty(logs)
 }
 
-func (suite *TestSuite) TestDeleteByResourceIdAndIdLessThanLimitSuccess() {
+func (suite *TestSuite) TestDeleteByIdsSuccess() {
 	require := require.New(suite.T())
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "logs" WHERE resource_id = $1 AND id < (SELECT id FROM (SELECT id FROM logs WHERE resource_id = $2 ORDER BY id DESC LIMIT $3) T ORDER BY id ASC LIMIT 1)`)).
-		WithArgs(uint(1), uint(1), 20).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "logs" WHERE id IN ($1,$2)`)).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
 	suite.mock.ExpectCommit()
 
-	err := suite.repository.DeleteByResourceIdAndIdLessThanLimit(context.Background(), uint(1))
+	err := suite.repository.DeleteByIds(context.Background(), []uint{1, 2})
 
 	require.NoError(err)
 }
 
-func (suite *TestSuite) TestDeleteByResourceIdAndIdLessThanLimitError() {
+func (suite *TestSuite) TestDeleteByIdsError() {
 	require := require.New(suite.T())
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectExec(regexp.QuoteMeta(`DELETE FROM "logs" WHERE resource_id = $1 AND id < (SELECT id FROM (SELECT id FROM logs WHERE resource_id = $2 ORDER BY id DESC LIMIT $3) T ORDER BY id ASC LIMIT 1)`)).
-		WithArgs(uint(1), uint(1), 20).
-		WillReturnError(gorm.ErrRecordNotFound)
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "logs" WHERE id IN ($1,$2)`)).
+		WithArgs(1, 2).
+		WillReturnError(gorm.ErrInvalidField)
 	suite.mock.ExpectRollback()
 
-	err := suite.repository.DeleteByResourceIdAndIdLessThanLimit(context.Background(), uint(1))
+	err := suite.repository.DeleteByIds(context.Background(), []uint{1, 2})
 
-	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchAllByFilterSuccess() {
+	require := require.New(suite.T())
+	from := time.Now().Add(-time.Hour)
+	to := time.Now()
+	filter := domain.LogFilter{
+		Code:       suite.code,
+		ResourceId: suite.resourceId,
+		UserId:     &suite.userId,
+		From:       &from,
+		To:         &to,
+		Text:       "test",
+		Cursor:     1000,
+		Limit:      20,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "logs" WHERE code = $1 AND resource_id = $2 AND user_id = $3 AND created_at >= $4 AND created_at < $5 AND (description ILIKE $6 ESCAPE '\' OR CAST(detail AS TEXT) ILIKE $7 ESCAPE '\') AND id < $8 ORDER BY id desc LIMIT 20`)).
+		WithArgs(suite.code, suite.resourceId, suite.userId, from, to, "%test%", "%test%", 1000).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "description", "detail", "code", "resource_id", "user_id", "request_id", "ip"}).
+			AddRow(999, time.Now(), suite.description, suite.detailStr, suite.code, suite.resourceId, suite.userId, "request", "127.0.0.1"),
+		)
+
+	logs, err := suite.repository.SearchAllByFilter(context.Background(), filter)
+
+	require.NoError(err)
+	require.Len(logs, 1)
+	require.Equal(uint(999), logs[0].ID)
+	require.Equal(suite.userId, *logs[0].UserId)
+	require.Equal("request", logs[0].RequestId)
+	require.Equal("127.0.0.1", logs[0].IP)
+}
+
+func (suite *TestSuite) TestSearchAllByFilterSuccessEscapedText() {
+	require := require.New(suite.T())
+	filter := domain.LogFilter{
+		Text:  `100%_off\`,
+		Limit: 20,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "logs" WHERE description ILIKE $1 ESCAPE '\' OR CAST(detail AS TEXT) ILIKE $2 ESCAPE '\' ORDER BY id desc LIMIT 20`)).
+		WithArgs(`%100\%\_off\\%`, `%100\%\_off\\%`).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "description", "detail", "code", "resource_id"}).
+			AddRow(999, time.Now(), suite.description, suite.detailStr, suite.code, suite.resourceId),
+		)
+
+	logs, err := suite.repository.SearchAllByFilter(context.Background(), filter)
+
+	require.NoError(err)
+	require.Len(logs, 1)
+}
+
+func (suite *TestSuite) TestSearchAllByFilterSuccessWithoutFilters() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "logs" ORDER BY id desc LIMIT 20`)).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "description", "detail", "code", "resource_id"}).
+			AddRow(999, time.Now(), suite.description, suite.detailStr, suite.code, suite.resourceId),
+		)
+
+	logs, err := suite.repository.SearchAllByFilter(context.Background(), domain.LogFilter{Limit: 20})
+
+	require.NoError(err)
+	require.Len(logs, 1)
+	require.Nil(logs[0].UserId)
+}
+
+func (suite *TestSuite) TestSearchAllByFilterError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "logs" WHERE code = $1 ORDER BY id desc LIMIT 20`)).
+		WithArgs(suite.code).
+		WillReturnError(gorm.ErrInvalidField)
+
+	logs, err := suite.repository.SearchAllByFilter(context.Background(), domain.LogFilter{Code: suite.code, Limit: 20})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Empty(logs)
+}
+
+func (suite *TestSuite) TestSearchAllWithDetailKeySuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "logs" WHERE code = $1 AND resource_id = $2 AND detail->>$3 IS NOT NULL ORDER BY id`)).
+		WithArgs(suite.code, suite.resourceId, "batch").
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "description", "detail", "code", "resource_id"}).
+			AddRow(999, time.Now(), suite.description, suite.detailStr, suite.code, suite.resourceId),
+		)
+
+	logs, err := suite.repository.SearchAllWithDetailKey(context.Background(), suite.code, suite.resourceId, "batch")
+
+	require.NoError(err)
+	require.Len(logs, 1)
+	require.Equal(uint(999), logs[0].ID)
+	require.Equal(suite.detail, logs[0].Detail)
+}
+
+func (suite *TestSuite) TestSearchAllWithDetailKeyError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "logs" WHERE code = $1 AND resource_id = $2 AND detail->>$3 IS NOT NULL ORDER BY id`)).
+		WithArgs(suite.code, suite.resourceId, "batch").
+		WillReturnError(gorm.ErrInvalidField)
+
+	logs, err := suite.repository.SearchAllWithDetailKey(context.Background(), suite.code, suite.resourceId, "batch")
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Empty(logs)
 }
 
 func TestTestSuite(t *testing.T) {
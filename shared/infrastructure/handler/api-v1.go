@@ -1,33 +1,72 @@
 package handler
 
 import (
+	"regexp"
 	budgets "your-accounts-api/budgets/infrastructure/handler"
 	"your-accounts-api/shared/domain"
 	"your-accounts-api/shared/infrastructure/config"
 	logs "your-accounts-api/shared/infrastructure/handler/logs"
+	"your-accounts-api/shared/infrastructure/ratelimit"
+	users "your-accounts-api/users/infrastructure/handler"
+	"your-accounts-api/users/infrastructure/handler/apikeys"
+	"your-accounts-api/users/infrastructure/handler/session"
 
 	jwtware "github.com/gofiber/contrib/jwt"
 	"github.com/gofiber/fiber/v2"
 )
 
 var (
-	logsRouter    = logs.NewRoute
-	budgetsRouter = budgets.NewRoute
+	apiKeyMiddleware    = apikeys.NewMiddleware
+	sessionMiddleware   = session.NewMiddleware
+	rateLimitMiddleware = ratelimit.New
+	actorMiddleware     = logs.NewActorMiddleware
+	logsRouter          = logs.NewRoute
+	budgetsRouter       = budgets.NewRoute
+	usersRouter         = users.NewPrivateRoute
 )
 
+// streamPath matches the budget streams, the browsers cannot send headers with EventSource and
+// WebSocket so only these routes take the token from the query param
+var streamPath = regexp.MustCompile(`^/api/v1/budget/\d+/(stream|ws)/?$`)
+
 func NewRoute(app fiber.Router) {
 	api := app.Group("/api/v1")
 	// Middleware
 	{
+		api.Use(apiKeyMiddleware())
+		api.Use(jwtware.New(jwtware.Config{
+			Filter: func(c *fiber.Ctx) bool {
+				return apikeys.IsApiKeyRequest(c) || isStreamRequest(c)
+			},
+			SigningKey:  jwtware.SigningKey{Key: []byte(config.JWT_SECRET)},
+			Claims:      new(domain.JwtUserClaims),
+			TokenLookup: "header:Authorization",
+			AuthScheme:  "Bearer",
+		}))
 		api.Use(jwtware.New(jwtware.Config{
-			SigningKey: jwtware.SigningKey{Key: []byte(config.JWT_SECRET)},
-			Claims:     new(domain.JwtUserClaims),
+			Filter: func(c *fiber.Ctx) bool {
+				return apikeys.IsApiKeyRequest(c) || !isStreamRequest(c)
+			},
+			SigningKey:  jwtware.SigningKey{Key: []byte(config.JWT_SECRET)},
+			Claims:      new(domain.JwtUserClaims),
+			TokenLookup: "header:Authorization,query:access_token",
+			AuthScheme:  "Bearer",
 		}))
+		// The limit runs after the authentication so it counts by user, the clients behind the
+		// same IP do not share their requests
+		api.Use(rateLimitMiddleware("api"))
+		api.Use(sessionMiddleware())
+		api.Use(actorMiddleware())
 	}
 
 	// Routes
 	{
 		logsRouter(api)
 		budgetsRouter(api)
+		usersRouter(api)
 	}
 }
+
+func isStreamRequest(c *fiber.Ctx) bool {
+	return streamPath.MatchString(c.Path())
+}
@@ -1,13 +1,21 @@
 package logs
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 	"your-accounts-api/shared/application"
 	"your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/auth"
 	"your-accounts-api/shared/infrastructure/injection"
 	"your-accounts-api/shared/infrastructure/model"
+	"your-accounts-api/shared/infrastructure/validation"
 
 	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -58,9 +66,114 @@ func (ctrl *controller) readLogs(c *fiber.Ctx) error {
 	return c.JSON(response)
 }
 
+// SearchLogsHandler godoc
+//
+//	@Summary		Search logs
+//	@Description	search the logs by code, resource, actor, dates and text with cursor pagination, the users without
+//	@Description	the support or admin role only get the logs of their own actions
+//	@Tags			log
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			Authorization	header		string			true	"Access token"
+//	@Param			code			query		domain.CodeLog	false	"Code"	Enums(budget, budget_bill, user)
+//	@Param			resourceId		query		uint			false	"Resource ID"
+//	@Param			actorId			query		uint			false	"ID of the user who acted"
+//	@Param			from			query		int				false	"Created from, in Unix milliseconds"
+//	@Param			to				query		int				false	"Created before, in Unix milliseconds"
+//	@Param			text			query		string			false	"Text in the description or the detail"
+//	@Param			cursor			query		uint			false	"Cursor of the page, the nextCursor of the previous one"
+//	@Param			limit			query		int				false	"Logs by page, up to 100"
+//	@Param			format			query		string			false	"Format"	Enums(json, csv)
+//	@Success		200				{object}	model.SearchLogsResponse
+//	@Failure		400				{string}	string
+//	@Failure		401				{string}	string
+//	@Failure		422				{string}	string
+//	@Failure		500				{string}	string
+//	@Router			/api/v1/log/	[get]
+func (ctrl *controller) searchLogs(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestQuery).(*model.SearchLogsRequest)
+	filter := request.ToFilter()
+
+	role := auth.Role(c)
+	if role != domain.AdminRole && role != domain.SupportRole {
+		userData := getUserData(c)
+		filter.UserId = &userData.ID
+	}
+
+	if request.Format == "csv" {
+		logs, err := ctrl.app.Export(c.UserContext(), filter)
+		if err != nil {
+			log.Error("Error exporting logs:", err)
+			return fiber.NewError(fiber.StatusInternalServerError, "Error exporting logs")
+		}
+
+		content, err := logsToCSV(logs)
+		if err != nil {
+			log.Error("Error writing logs CSV:", err)
+			return fiber.NewError(fiber.StatusInternalServerError, "Error exporting logs")
+		}
+
+		c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+		c.Set(fiber.HeaderContentDisposition, `attachment; filename="logs.csv"`)
+		return c.Send(content)
+	}
+
+	logs, nextCursor, err := ctrl.app.FindByFilter(c.UserContext(), filter)
+	if err != nil {
+		log.Error("Error searching logs:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error searching logs")
+	}
+
+	return c.JSON(model.NewSearchLogsResponse(logs, nextCursor))
+}
+
+func logsToCSV(logs []domain.Log) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if err := writer.Write([]string{"id", "createdAt", "code", "resourceId", "actorId", "requestId", "ip", "description", "detail"}); err != nil {
+		return nil, err
+	}
+
+	for _, logItem := range logs {
+		detail, err := json.Marshal(logItem.Detail)
+		if err != nil {
+			return nil, err
+		}
+
+		actorId := ""
+		if logItem.UserId != nil {
+			actorId = strconv.FormatUint(uint64(*logItem.UserId), 10)
+		}
+
+		record := []string{
+			strconv.FormatUint(uint64(logItem.ID), 10),
+			logItem.CreatedAt.Format(time.RFC3339),
+			string(logItem.Code),
+			strconv.FormatUint(uint64(logItem.ResourceId), 10),
+			actorId,
+			logItem.RequestId,
+			logItem.IP,
+			logItem.Description,
+			string(detail),
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buffer.Bytes(), writer.Error()
+}
+
+func getUserData(c *fiber.Ctx) *domain.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*domain.JwtUserClaims)
+}
+
 func NewRoute(router fiber.Router) {
 	controller := &controller{injection.LogApp}
 
 	group := router.Group("/log")
+	group.Get("/", validation.RequestQueryValid(model.SearchLogsRequest{}), controller.searchLogs)
 	group.Get(fmt.Sprintf("/:id<min(1)>/code/:code<regex(^(%s|%s)$)>", domain.Budget, domain.BudgetBill), controller.readLogs)
 }
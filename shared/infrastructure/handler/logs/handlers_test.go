@@ -13,6 +13,7 @@ import (
 	"your-accounts-api/shared/infrastructure/model"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -23,6 +24,8 @@ type TestSuite struct {
 	suite.Suite
 	code       domain.CodeLog
 	resourceId uint
+	userId     uint
+	claims     *domain.JwtUserClaims
 	app        *fiber.App
 	mock       *mocks_application.MockILogApp
 }
@@ -30,13 +33,26 @@ type TestSuite struct {
 func (suite *TestSuite) SetupSuite() {
 	suite.code = domain.Budget
 	suite.resourceId = 1
+	suite.userId = 2
 }
 
 func (suite *TestSuite) SetupTest() {
 	suite.mock = mocks_application.NewMockILogApp(suite.T())
 	injection.LogApp = suite.mock
 
+	suite.claims = &domain.JwtUserClaims{
+		ID:   suite.userId,
+		Role: domain.UserRole,
+	}
+	token := &jwt.Token{
+		Claims: suite.claims,
+	}
+
 	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		return c.Next()
+	})
 	NewRoute(suite.app)
 }
 
@@ -101,6 +117,137 @@ func (suite *TestSuite) TestReadLogs500() {
 	require.Equal(expectedErr, resp)
 }
 
+func (suite *TestSuite) TestSearchLogs200() {
+	require := require.New(suite.T())
+	result := domain.Log{
+		ID:          1,
+		Description: "Test",
+		Code:        suite.code,
+		ResourceId:  suite.resourceId,
+		UserId:      &suite.userId,
+		CreatedAt:   time.Now(),
+	}
+	// The users without role only search their own actions
+	suite.mock.On("FindByFilter", mock.Anything, domain.LogFilter{
+		Code:   suite.code,
+		UserId: &suite.userId,
+		Text:   "test",
+		Cursor: 10,
+		Limit:  5,
+	}).Return([]domain.Log{result}, uint(1), nil)
+	expectedBody, err := json.Marshal(model.NewSearchLogsResponse([]domain.Log{result}, 1))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/log/?code=%s&actorId=9&text=test&cursor=10&limit=5", suite.code), nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestSearchLogs200Admin() {
+	require := require.New(suite.T())
+	suite.claims.Role = domain.AdminRole
+	actorId := uint(9)
+	from := time.UnixMilli(1000)
+	to := time.UnixMilli(2000)
+	suite.mock.On("FindByFilter", mock.Anything, domain.LogFilter{
+		ResourceId: suite.resourceId,
+		UserId:     &actorId,
+		From:       &from,
+		To:         &to,
+	}).Return([]domain.Log{}, uint(0), nil)
+
+	request := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/log/?resourceId=%d&actorId=%d&from=1000&to=2000", suite.resourceId, actorId), nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal([]byte(`{"logs":[]}`), resp)
+}
+
+func (suite *TestSuite) TestSearchLogs200CSV() {
+	require := require.New(suite.T())
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	result := domain.Log{
+		ID:          1,
+		Description: "Test, with comma",
+		Detail: map[string]any{
+			"name": "Test",
+		},
+		Code:       suite.code,
+		ResourceId: suite.resourceId,
+		UserId:     &suite.userId,
+		RequestId:  "request",
+		IP:         "127.0.0.1",
+		CreatedAt:  createdAt,
+	}
+	suite.mock.On("Export", mock.Anything, domain.LogFilter{UserId: &suite.userId}).Return([]domain.Log{result}, nil)
+	expectedBody := "id,createdAt,code,resourceId,actorId,requestId,ip,description,detail\n" +
+		"1,2024-01-02T03:04:05Z,budget,1,2,request,127.0.0.1,\"Test, with comma\",\"{\"\"name\"\":\"\"Test\"\"}\"\n"
+
+	request := httptest.NewRequest(fiber.MethodGet, "/log/?format=csv", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	require.Equal("text/csv; charset=utf-8", response.Header.Get(fiber.HeaderContentType))
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, string(resp))
+}
+
+func (suite *TestSuite) TestSearchLogs422() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/log/?code=invalid&limit=500", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func (suite *TestSuite) TestSearchLogs500() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByFilter", mock.Anything, mock.Anything).Return(nil, uint(0), gorm.ErrInvalidField)
+	expectedErr := []byte("Error searching logs")
+
+	request := httptest.NewRequest(fiber.MethodGet, "/log/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestSearchLogs500CSV() {
+	require := require.New(suite.T())
+	suite.mock.On("Export", mock.Anything, mock.Anything).Return(nil, gorm.ErrInvalidField)
+	expectedErr := []byte("Error exporting logs")
+
+	request := httptest.NewRequest(fiber.MethodGet, "/log/?format=csv", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
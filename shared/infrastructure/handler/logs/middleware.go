@@ -0,0 +1,35 @@
+package logs
+
+import (
+	"fmt"
+	"your-accounts-api/shared/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// NewActorMiddleware keeps in the user context who sends the request to store it in the logs,
+// it must be registered after the request ID middleware and again after the authentication
+// middlewares to add the user.
+func NewActorMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		actor := domain.Actor{
+			IP: c.IP(),
+		}
+
+		if requestId := c.Locals(requestid.ConfigDefault.ContextKey); requestId != nil {
+			actor.RequestId = fmt.Sprint(requestId)
+		}
+
+		if token, ok := c.Locals("user").(*jwt.Token); ok {
+			if claims, ok := token.Claims.(*domain.JwtUserClaims); ok {
+				userId := claims.ID
+				actor.UserId = &userId
+			}
+		}
+
+		c.SetUserContext(domain.WithActor(c.UserContext(), actor))
+		return c.Next()
+	}
+}
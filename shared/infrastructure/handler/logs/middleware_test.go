@@ -0,0 +1,62 @@
+package logs
+
+import (
+	"net/http/httptest"
+	"testing"
+	"your-accounts-api/shared/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActorMiddleware(t *testing.T) {
+	require := require.New(t)
+	var actor domain.Actor
+	app := fiber.New()
+	app.Use(requestid.New(requestid.Config{
+		Generator: func() string {
+			return "request"
+		},
+	}))
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", &jwt.Token{
+			Claims: &domain.JwtUserClaims{
+				ID: 1,
+			},
+		})
+		return c.Next()
+	})
+	app.Use(NewActorMiddleware())
+	app.Get("/", func(c *fiber.Ctx) error {
+		actor = domain.ActorFromContext(c.UserContext())
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	response, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+
+	require.NoError(err)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	require.Equal(uint(1), *actor.UserId)
+	require.Equal("request", actor.RequestId)
+	require.Equal("0.0.0.0", actor.IP)
+}
+
+func TestActorMiddlewareWithoutUser(t *testing.T) {
+	require := require.New(t)
+	var actor domain.Actor
+	app := fiber.New()
+	app.Use(NewActorMiddleware())
+	app.Get("/", func(c *fiber.Ctx) error {
+		actor = domain.ActorFromContext(c.UserContext())
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	response, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+
+	require.NoError(err)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	require.Nil(actor.UserId)
+	require.Empty(actor.RequestId)
+}
@@ -2,38 +2,145 @@ package injection
 
 import (
 	budgets_app "your-accounts-api/budgets/application"
+	"your-accounts-api/budgets/infrastructure/db/repository/account"
+	"your-accounts-api/budgets/infrastructure/db/repository/bill_split"
 	"your-accounts-api/budgets/infrastructure/db/repository/budget"
 	"your-accounts-api/budgets/infrastructure/db/repository/budget_available"
 	"your-accounts-api/budgets/infrastructure/db/repository/budget_bill"
+	"your-accounts-api/budgets/infrastructure/db/repository/budget_envelope"
+	"your-accounts-api/budgets/infrastructure/db/repository/budget_snapshot"
+	"your-accounts-api/budgets/infrastructure/db/repository/credit_card"
+	"your-accounts-api/budgets/infrastructure/db/repository/goal"
+	"your-accounts-api/budgets/infrastructure/db/repository/loan"
+	"your-accounts-api/budgets/infrastructure/db/repository/report"
+	"your-accounts-api/budgets/infrastructure/db/repository/spending"
 	logs_app "your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/archive"
 	"your-accounts-api/shared/infrastructure/db"
+	"your-accounts-api/shared/infrastructure/db/repository/event"
 	"your-accounts-api/shared/infrastructure/db/repository/log"
+	"your-accounts-api/shared/infrastructure/mailer"
+	"your-accounts-api/shared/infrastructure/pubsub"
 	users_app "your-accounts-api/users/application"
+	"your-accounts-api/users/infrastructure/db/repository/api_key"
+	"your-accounts-api/users/infrastructure/db/repository/login_attempt"
+	"your-accounts-api/users/infrastructure/db/repository/oidc_state"
 	"your-accounts-api/users/infrastructure/db/repository/user"
+	"your-accounts-api/users/infrastructure/db/repository/user_identity"
 	"your-accounts-api/users/infrastructure/db/repository/user_token"
+	"your-accounts-api/users/infrastructure/db/repository/user_verification"
+	"your-accounts-api/users/infrastructure/db/repository/webhook"
+	"your-accounts-api/users/infrastructure/db/repository/webhook_delivery"
+	"your-accounts-api/users/infrastructure/oidc"
+	webhook_sender "your-accounts-api/users/infrastructure/webhook"
 )
 
 var (
 	UserApp            users_app.IUserApp
+	ApiKeyApp          users_app.IApiKeyApp
+	OidcApp            users_app.IOidcApp
+	AdminApp           users_app.IAdminApp
+	WebhookApp         users_app.IWebhookApp
 	LogApp             logs_app.ILogApp
+	EventApp           logs_app.IEventApp
 	BudgetApp          budgets_app.IBudgetApp
 	BudgetAvailableApp budgets_app.IBudgetAvailableApp
 	BudgetBillApp      budgets_app.IBudgetBillApp
+	BudgetSnapshotApp  budgets_app.IBudgetSnapshotApp
+	TrashApp           budgets_app.ITrashApp
+	BudgetStreamApp    budgets_app.IBudgetStreamApp
+	SpendingApp        budgets_app.ISpendingApp
+	ForecastApp        budgets_app.IForecastApp
+	GoalApp            budgets_app.IGoalApp
+	LoanApp            budgets_app.ILoanApp
+	CreditCardApp      budgets_app.ICreditCardApp
+	AccountApp         budgets_app.IAccountApp
+	BillSplitApp       budgets_app.IBillSplitApp
+	EnvelopeApp        budgets_app.IEnvelopeApp
+	ReportApp          budgets_app.IReportApp
 )
 
 func LoadInstances() {
 	// Repositories
 	userRepo := user.NewRepository(db.DB)
 	userTokenRepo := user_token.NewRepository(db.DB)
+	userVerificationRepo := user_verification.NewRepository(db.DB)
+	apiKeyRepo := api_key.NewRepository(db.DB)
+	userIdentityRepo := user_identity.NewRepository(db.DB)
+	oidcStateRepo := oidc_state.NewRepository(db.DB)
+	loginAttemptRepo := login_attempt.NewRepository(db.DB)
+	webhookRepo := webhook.NewRepository(db.DB)
+	webhookDeliveryRepo := webhook_delivery.NewRepository(db.DB)
 	logRepo := log.NewRepository(db.DB)
+	eventRepo := event.NewRepository(db.DB)
 	budgetRepo := budget.NewRepository(db.DB)
 	budgetAvailableRepo := budget_available.NewRepository(db.DB)
 	budgetBillRepo := budget_bill.NewRepository(db.DB)
+	budgetSnapshotRepo := budget_snapshot.NewRepository(db.DB)
+	spendingRepo := spending.NewRepository(db.DB)
+	goalRepo := goal.NewRepository(db.DB)
+	loanRepo := loan.NewRepository(db.DB)
+	creditCardRepo := credit_card.NewRepository(db.DB)
+	accountRepo := account.NewRepository(db.DB)
+	billSplitRepo := bill_split.NewRepository(db.DB)
+	budgetEnvelopeRepo := budget_envelope.NewRepository(db.DB)
+	reportRepo := report.NewRepository(db.DB)
+
+	// Adapters
+	mailer := mailer.NewMailer()
+	logArchiver := archive.NewArchiver()
+	identityProviders := oidc.NewProviders()
+	webhookSender := webhook_sender.NewSender()
+	pubSub := pubsub.NewPubSub()
 
 	// Apps
-	UserApp = users_app.NewUserApp(db.Tm, userRepo, userTokenRepo)
-	LogApp = logs_app.NewLogApp(db.Tm, logRepo)
-	BudgetApp = budgets_app.NewBudgetApp(db.Tm, budgetRepo, budgetAvailableRepo, budgetBillRepo, LogApp)
+	LogApp = logs_app.NewLogApp(db.Tm, logRepo, logArchiver)
+	EventApp = logs_app.NewEventApp(db.Tm, eventRepo)
+	UserApp = users_app.NewUserApp(db.Tm, userRepo, userTokenRepo, userVerificationRepo, loginAttemptRepo, budgetRepo, LogApp, EventApp, mailer)
+	ApiKeyApp = users_app.NewApiKeyApp(db.Tm, apiKeyRepo, userRepo, LogApp)
+	OidcApp = users_app.NewOidcApp(db.Tm, identityProviders, oidcStateRepo, userIdentityRepo, userRepo, userTokenRepo, LogApp)
+	AdminApp = users_app.NewAdminApp(db.Tm, userRepo, userTokenRepo, budgetRepo, LogApp)
+	WebhookApp = users_app.NewWebhookApp(db.Tm, webhookRepo, webhookDeliveryRepo, budgetRepo, webhookSender, LogApp)
+	BudgetStreamApp = budgets_app.NewBudgetStreamApp(budgetRepo, pubSub)
+	BudgetApp = budgets_app.NewBudgetApp(db.Tm, budgetRepo, budgetAvailableRepo, budgetBillRepo, LogApp, users_app.NewTimezoneReader(userRepo), budgetSnapshotRepo, budgetEnvelopeRepo, EventApp, BudgetStreamApp)
 	BudgetAvailableApp = budgets_app.NewBudgetAvailableApp(db.Tm, budgetAvailableRepo, LogApp)
-	BudgetBillApp = budgets_app.NewBudgetBillApp(db.Tm, budgetBillRepo, LogApp)
+	BudgetBillApp = budgets_app.NewBudgetBillApp(db.Tm, budgetBillRepo, LogApp, EventApp)
+	BudgetSnapshotApp = budgets_app.NewBudgetSnapshotApp(db.Tm, budgetSnapshotRepo, budgetRepo, budgetAvailableRepo, budgetBillRepo, budgetEnvelopeRepo, billSplitRepo, LogApp)
+	TrashApp = budgets_app.NewTrashApp(db.Tm, budgetRepo, budgetAvailableRepo, budgetBillRepo, LogApp, EventApp)
+	SpendingApp = budgets_app.NewSpendingApp(spendingRepo)
+	ForecastApp = budgets_app.NewForecastApp(budgetRepo)
+	GoalApp = budgets_app.NewGoalApp(db.Tm, goalRepo, LogApp)
+	LoanApp = budgets_app.NewLoanApp(db.Tm, loanRepo, budgetRepo, budgetBillRepo, LogApp, EventApp)
+	CreditCardApp = budgets_app.NewCreditCardApp(db.Tm, creditCardRepo, budgetRepo, budgetAvailableRepo, budgetBillRepo, LogApp, EventApp)
+	AccountApp = budgets_app.NewAccountApp(db.Tm, accountRepo, budgetRepo, budgetAvailableRepo, LogApp)
+	BillSplitApp = budgets_app.NewBillSplitApp(db.Tm, billSplitRepo, budgetRepo, budgetBillRepo, LogApp)
+	EnvelopeApp = budgets_app.NewEnvelopeApp(db.Tm, budgetEnvelopeRepo, budgetRepo, LogApp)
+	ReportApp = budgets_app.NewReportApp(reportRepo)
+
+	// Subscribers
+	for _, name := range shared.EventNames {
+		EventApp.Subscribe(name, WebhookApp.Enqueue)
+	}
+	EventApp.Subscribe(shared.BudgetCreated, LoanApp.MaterializeEvent)
+	EventApp.Subscribe(shared.BillPaid, LoanApp.RefreshBalance)
+	EventApp.Subscribe(shared.BillCompleted, LoanApp.RefreshBalance)
+	EventApp.Subscribe(shared.BudgetCreated, CreditCardApp.MaterializeEvent)
+	EventApp.Subscribe(shared.BudgetCreated, AccountApp.SyncEvent)
+	EventApp.Subscribe(shared.BudgetCreated, EnvelopeApp.CarryoverEvent)
+	EventApp.Subscribe(shared.TrashPurged, LoanApp.PurgeEvent)
+	EventApp.Subscribe(shared.TrashPurged, CreditCardApp.PurgeEvent)
+	EventApp.Subscribe(shared.TrashPurged, AccountApp.PurgeEvent)
+	EventApp.Subscribe(shared.TrashPurged, BillSplitApp.PurgeEvent)
+	EventApp.Subscribe(shared.TrashPurged, EnvelopeApp.PurgeEvent)
+	// The budgets go first so the splits and the envelopes left without them are removed after
+	EventApp.Subscribe(shared.UserDeleted, BudgetApp.DeleteUserEvent)
+	EventApp.Subscribe(shared.UserDeleted, GoalApp.DeleteUserEvent)
+	EventApp.Subscribe(shared.UserDeleted, LoanApp.DeleteUserEvent)
+	EventApp.Subscribe(shared.UserDeleted, CreditCardApp.DeleteUserEvent)
+	EventApp.Subscribe(shared.UserDeleted, AccountApp.DeleteUserEvent)
+	EventApp.Subscribe(shared.UserDeleted, BillSplitApp.PurgeEvent)
+	EventApp.Subscribe(shared.UserDeleted, EnvelopeApp.PurgeEvent)
+	EventApp.Subscribe(shared.UserDeleted, LogApp.DeleteOrphanEvent)
+	EventApp.Subscribe(shared.UserDeleted, EventApp.DeleteUserEvent)
 }
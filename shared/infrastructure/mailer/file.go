@@ -0,0 +1,29 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"your-accounts-api/shared/domain"
+)
+
+type fileMailer struct {
+	dir string
+}
+
+func (m *fileMailer) Send(ctx context.Context, mail domain.Mail) error {
+	if err := os.MkdirAll(m.dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), strings.ReplaceAll(mail.To, "@", "_at_"))
+	content := fmt.Sprintf("To: %s\r\nSubject: %s\r\n\r\n%s\r\n", mail.To, mail.Subject, mail.Body)
+	return os.WriteFile(filepath.Join(m.dir, name), []byte(content), 0o600)
+}
+
+func NewFileMailer(dir string) domain.Mailer {
+	return &fileMailer{dir}
+}
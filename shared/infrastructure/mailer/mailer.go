@@ -0,0 +1,14 @@
+package mailer
+
+import (
+	"your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/config"
+)
+
+func NewMailer() domain.Mailer {
+	if config.MAILER == "memory" {
+		return NewMemoryMailer()
+	}
+
+	return NewFileMailer(config.MAILER_DIR)
+}
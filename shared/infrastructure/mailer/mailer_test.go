@@ -0,0 +1,81 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/config"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestSuite struct {
+	suite.Suite
+	mail domain.Mail
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.mail = domain.Mail{
+		To:      "example@exaple.com",
+		Subject: "Subject",
+		Body:    "Body",
+	}
+}
+
+func (suite *TestSuite) TestFileMailerSendSuccess() {
+	require := require.New(suite.T())
+	dir := filepath.Join(suite.T().TempDir(), "mails")
+	mailer := NewFileMailer(dir)
+
+	err := mailer.Send(context.Background(), suite.mail)
+
+	require.NoError(err)
+	files, err := os.ReadDir(dir)
+	require.NoError(err)
+	require.Len(files, 1)
+	content, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(err)
+	require.Contains(string(content), "To: example@exaple.com")
+	require.Contains(string(content), "Subject: Subject")
+	require.Contains(string(content), "Body")
+}
+
+func (suite *TestSuite) TestFileMailerSendError() {
+	require := require.New(suite.T())
+	file := filepath.Join(suite.T().TempDir(), "file")
+	require.NoError(os.WriteFile(file, nil, 0o600))
+	mailer := NewFileMailer(file)
+
+	err := mailer.Send(context.Background(), suite.mail)
+
+	require.Error(err)
+}
+
+func (suite *TestSuite) TestMemoryMailerSendSuccess() {
+	require := require.New(suite.T())
+	mailer := NewMemoryMailer()
+
+	err := mailer.Send(context.Background(), suite.mail)
+
+	require.NoError(err)
+	require.Equal([]domain.Mail{suite.mail}, mailer.Mails())
+}
+
+func (suite *TestSuite) TestNewMailerSuccess() {
+	require := require.New(suite.T())
+	original := config.MAILER
+	defer func() { config.MAILER = original }()
+
+	config.MAILER = "memory"
+	require.IsType(&MemoryMailer{}, NewMailer())
+
+	config.MAILER = "file"
+	require.IsType(&fileMailer{}, NewMailer())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,31 @@
+package mailer
+
+import (
+	"context"
+	"sync"
+	"your-accounts-api/shared/domain"
+)
+
+type MemoryMailer struct {
+	mu    sync.Mutex
+	mails []domain.Mail
+}
+
+func (m *MemoryMailer) Send(ctx context.Context, mail domain.Mail) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.mails = append(m.mails, mail)
+	return nil
+}
+
+func (m *MemoryMailer) Mails() []domain.Mail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]domain.Mail{}, m.mails...)
+}
+
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
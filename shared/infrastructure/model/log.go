@@ -9,6 +9,11 @@ type ReadLogsResponse struct {
 	IDResponse
 	Description string         `json:"description"`
 	Detail      map[string]any `json:"detail"`
+	Code        domain.CodeLog `json:"code"`
+	ResourceId  uint           `json:"resourceId"`
+	ActorId     *uint          `json:"actorId,omitempty"`
+	RequestId   string         `json:"requestId,omitempty"`
+	IP          string         `json:"ip,omitempty"`
 	CreatedAt   time.Time      `json:"createdAt"`
 }
 
@@ -17,6 +22,67 @@ func NewReadLogsResponse(log domain.Log) ReadLogsResponse {
 		IDResponse:  NewIDResponse(log.ID),
 		Description: log.Description,
 		Detail:      log.Detail,
+		Code:        log.Code,
+		ResourceId:  log.ResourceId,
+		ActorId:     log.UserId,
+		RequestId:   log.RequestId,
+		IP:          log.IP,
 		CreatedAt:   log.CreatedAt,
 	}
 }
+
+type SearchLogsRequest struct {
+	Code       domain.CodeLog `query:"code" json:"code" validate:"omitempty,oneof=budget budget_bill user"`
+	ResourceId uint           `query:"resourceId" json:"resourceId"`
+	ActorId    uint           `query:"actorId" json:"actorId"`
+	From       int64          `query:"from" json:"from" validate:"omitempty,min=0"`
+	To         int64          `query:"to" json:"to" validate:"omitempty,min=0"`
+	Text       string         `query:"text" json:"text" validate:"omitempty,max=255"`
+	Cursor     uint           `query:"cursor" json:"cursor"`
+	Limit      int            `query:"limit" json:"limit" validate:"omitempty,min=1,max=100"`
+	Format     string         `query:"format" json:"format" validate:"omitempty,oneof=json csv"`
+}
+
+func (r SearchLogsRequest) ToFilter() domain.LogFilter {
+	filter := domain.LogFilter{
+		Code:       r.Code,
+		ResourceId: r.ResourceId,
+		Text:       r.Text,
+		Cursor:     r.Cursor,
+		Limit:      r.Limit,
+	}
+
+	if r.ActorId != 0 {
+		actorId := r.ActorId
+		filter.UserId = &actorId
+	}
+
+	if r.From != 0 {
+		from := time.UnixMilli(r.From)
+		filter.From = &from
+	}
+
+	if r.To != 0 {
+		to := time.UnixMilli(r.To)
+		filter.To = &to
+	}
+
+	return filter
+}
+
+type SearchLogsResponse struct {
+	Logs       []ReadLogsResponse `json:"logs"`
+	NextCursor uint               `json:"nextCursor,omitempty"`
+}
+
+func NewSearchLogsResponse(logs []domain.Log, nextCursor uint) SearchLogsResponse {
+	response := SearchLogsResponse{
+		Logs:       []ReadLogsResponse{},
+		NextCursor: nextCursor,
+	}
+	for _, log := range logs {
+		response.Logs = append(response.Logs, NewReadLogsResponse(log))
+	}
+
+	return response
+}
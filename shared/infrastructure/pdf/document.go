@@ -0,0 +1,149 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pageWidth  = 595.0
+	pageHeight = 842.0
+	margin     = 40.0
+	fontSize   = 9.0
+	leading    = 12.0
+	// linesPerPage fits the lines between the top and the bottom margins
+	linesPerPage = 63
+)
+
+type line struct {
+	text string
+	bold bool
+}
+
+// Document writes text documents in A4 pages with a monospaced font, so the tables can be
+// aligned with padding. It only uses the standard fonts of the readers, the characters out of
+// Latin-1 are written as '?'.
+type Document struct {
+	pages [][]line
+}
+
+// Title adds a line in bold
+func (d *Document) Title(text string) {
+	d.add(line{text, true})
+}
+
+// Text adds a line
+func (d *Document) Text(text string) {
+	d.add(line{text, false})
+}
+
+// Textf adds a line formatted as fmt.Sprintf
+func (d *Document) Textf(format string, a ...any) {
+	d.Text(fmt.Sprintf(format, a...))
+}
+
+// Space adds an empty line
+func (d *Document) Space() {
+	d.add(line{})
+}
+
+// PageBreak starts a new page, the empty pages are left out
+func (d *Document) PageBreak() {
+	if len(d.pages) > 0 && len(d.pages[len(d.pages)-1]) > 0 {
+		d.pages = append(d.pages, []line{})
+	}
+}
+
+func (d *Document) add(l line) {
+	if len(d.pages) == 0 || len(d.pages[len(d.pages)-1]) >= linesPerPage {
+		d.pages = append(d.pages, []line{})
+	}
+
+	last := len(d.pages) - 1
+	d.pages[last] = append(d.pages[last], l)
+}
+
+// Bytes renders the document, the objects 1 to 4 are the catalog, the pages and the fonts and
+// each page adds its page and content objects.
+func (d *Document) Bytes() []byte {
+	pages := d.pages
+	if len(pages) == 0 {
+		pages = [][]line{{}}
+	}
+
+	var buffer bytes.Buffer
+	offsets := []int{}
+	object := func(body string) {
+		offsets = append(offsets, buffer.Len())
+		fmt.Fprintf(&buffer, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buffer.WriteString("%PDF-1.4\n")
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+i*2)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>")
+	for i, page := range pages {
+		content := pageContent(page)
+		object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+i*2,
+		))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := buffer.Len()
+	fmt.Fprintf(&buffer, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buffer, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buffer, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buffer.Bytes()
+}
+
+func pageContent(lines []line) string {
+	var content strings.Builder
+	fmt.Fprintf(&content, "BT\n%.0f TL\n%.0f %.0f Td\n", leading, margin, pageHeight-margin)
+	for _, l := range lines {
+		font := "F1"
+		if l.bold {
+			font = "F2"
+		}
+
+		fmt.Fprintf(&content, "/%s %.0f Tf\n(%s) '\n", font, fontSize, escape(l.text))
+	}
+	content.WriteString("ET")
+
+	return content.String()
+}
+
+// escape encodes the text as a string of the PDF in Latin-1
+func escape(text string) string {
+	var escaped strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			escaped.WriteByte('\\')
+			escaped.WriteRune(r)
+		case r < 32 || r > 255 || (r > 126 && r < 160):
+			escaped.WriteByte('?')
+		case r > 126:
+			fmt.Fprintf(&escaped, "\\%03o", r)
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+
+	return escaped.String()
+}
+
+func New() *Document {
+	return &Document{}
+}
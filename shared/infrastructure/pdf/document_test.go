@@ -0,0 +1,78 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestSuite struct {
+	suite.Suite
+}
+
+func (suite *TestSuite) TestBytes() {
+	require := require.New(suite.T())
+	document := New()
+	document.Title("Annual report")
+	document.Textf("%-10s %8.2f", "Income", 3000.5)
+
+	content := document.Bytes()
+
+	require.True(bytes.HasPrefix(content, []byte("%PDF-1.4\n")))
+	require.True(bytes.HasSuffix(content, []byte("%%EOF\n")))
+	require.Contains(string(content), "/Count 1 >>")
+	require.Contains(string(content), "/F2 9 Tf\n(Annual report) '")
+	require.Contains(string(content), "/F1 9 Tf\n(Income      3000.50) '")
+}
+
+func (suite *TestSuite) TestBytesXref() {
+	require := require.New(suite.T())
+	document := New()
+	document.Text("Rent")
+
+	content := document.Bytes()
+
+	match := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(content)
+	require.NotNil(match)
+	xref, err := strconv.Atoi(string(match[1]))
+	require.NoError(err)
+	require.True(bytes.HasPrefix(content[xref:], []byte("xref\n0 7\n")))
+
+	offsets := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(content, -1)
+	require.Len(offsets, 6)
+	for i, offset := range offsets {
+		position, err := strconv.Atoi(string(offset[1]))
+		require.NoError(err)
+		require.True(bytes.HasPrefix(content[position:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))))
+	}
+}
+
+func (suite *TestSuite) TestPages() {
+	require := require.New(suite.T())
+	document := New()
+	for i := 0; i < 70; i++ {
+		document.Textf("Line %d", i)
+	}
+	document.PageBreak()
+	document.PageBreak()
+	document.Text("Last")
+
+	content := document.Bytes()
+
+	require.Contains(string(content), "/Kids [5 0 R 7 0 R 9 0 R] /Count 3 >>")
+}
+
+func (suite *TestSuite) TestEscape() {
+	require := require.New(suite.T())
+
+	require.Equal(`Pago \(a\) \\ Educaci\363n ?`, escape("Pago (a) \\ Educación €"))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
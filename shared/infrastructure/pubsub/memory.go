@@ -0,0 +1,69 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"your-accounts-api/shared/domain"
+)
+
+// subscriberBuffer is the number of messages kept for a slow subscriber, the next ones are dropped
+const subscriberBuffer = 16
+
+type memoryPubSub struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan []byte]struct{}
+}
+
+func (p *memoryPubSub) Publish(_ context.Context, topic string, data []byte) error {
+	p.deliver(topic, data)
+	return nil
+}
+
+func (p *memoryPubSub) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	messages := make(chan []byte, subscriberBuffer)
+
+	p.mu.Lock()
+	if p.subscribers[topic] == nil {
+		p.subscribers[topic] = map[chan []byte]struct{}{}
+	}
+	p.subscribers[topic][messages] = struct{}{}
+	p.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		delete(p.subscribers[topic], messages)
+		if len(p.subscribers[topic]) == 0 {
+			delete(p.subscribers, topic)
+		}
+		close(messages)
+	}()
+
+	return messages, nil
+}
+
+// deliver never blocks the publisher, a subscriber with its buffer full loses the message
+func (p *memoryPubSub) deliver(topic string, data []byte) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for messages := range p.subscribers[topic] {
+		select {
+		case messages <- data:
+		default:
+		}
+	}
+}
+
+func newMemoryPubSub() *memoryPubSub {
+	return &memoryPubSub{
+		subscribers: map[string]map[chan []byte]struct{}{},
+	}
+}
+
+// NewMemoryPubSub keeps the subscribers in the process, they are not shared between instances.
+func NewMemoryPubSub() domain.PubSub {
+	return newMemoryPubSub()
+}
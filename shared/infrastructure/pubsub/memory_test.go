@@ -0,0 +1,83 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestMemorySuite struct {
+	suite.Suite
+	pubSub *memoryPubSub
+}
+
+func (suite *TestMemorySuite) SetupTest() {
+	suite.pubSub = newMemoryPubSub()
+}
+
+func (suite *TestMemorySuite) TestPublishSuccess() {
+	require := require.New(suite.T())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	first, err := suite.pubSub.Subscribe(ctx, "budget:1")
+	require.NoError(err)
+	second, err := suite.pubSub.Subscribe(ctx, "budget:1")
+	require.NoError(err)
+	other, err := suite.pubSub.Subscribe(ctx, "budget:2")
+	require.NoError(err)
+
+	err = suite.pubSub.Publish(ctx, "budget:1", []byte("message"))
+
+	require.NoError(err)
+	require.Equal([]byte("message"), <-first)
+	require.Equal([]byte("message"), <-second)
+	require.Empty(other)
+}
+
+func (suite *TestMemorySuite) TestPublishSuccessWithoutSubscribers() {
+	require := require.New(suite.T())
+
+	err := suite.pubSub.Publish(context.Background(), "budget:1", []byte("message"))
+
+	require.NoError(err)
+}
+
+func (suite *TestMemorySuite) TestPublishSuccessSlowSubscriber() {
+	require := require.New(suite.T())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	messages, err := suite.pubSub.Subscribe(ctx, "budget:1")
+	require.NoError(err)
+
+	for i := 0; i < subscriberBuffer+5; i++ {
+		require.NoError(suite.pubSub.Publish(ctx, "budget:1", []byte("message")))
+	}
+
+	require.Len(messages, subscriberBuffer)
+}
+
+func (suite *TestMemorySuite) TestSubscribeCancel() {
+	require := require.New(suite.T())
+	ctx, cancel := context.WithCancel(context.Background())
+	messages, err := suite.pubSub.Subscribe(ctx, "budget:1")
+	require.NoError(err)
+
+	cancel()
+
+	select {
+	case _, ok := <-messages:
+		require.False(ok)
+	case <-time.After(time.Second):
+		require.Fail("the subscription was not closed")
+	}
+	suite.pubSub.mu.RLock()
+	defer suite.pubSub.mu.RUnlock()
+	require.Empty(suite.pubSub.subscribers)
+}
+
+func TestTestMemorySuite(t *testing.T) {
+	suite.Run(t, new(TestMemorySuite))
+}
@@ -0,0 +1,109 @@
+package pubsub
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+	"your-accounts-api/shared/domain"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/jackc/pgx/v5"
+	"gorm.io/gorm"
+)
+
+const (
+	postgresChannel = "your_accounts_pubsub"
+	// postgresMaxPayload is the limit of the payload of NOTIFY with the default server configuration
+	postgresMaxPayload = 7999
+	postgresRetryMax   = 30 * time.Second
+)
+
+// postgresPubSub sends the messages with NOTIFY, every instance keeps a dedicated connection
+// listening the channel and delivers the messages to its own subscribers.
+type postgresPubSub struct {
+	db     *gorm.DB
+	dsn    string
+	local  *memoryPubSub
+	listen sync.Once
+}
+
+func (p *postgresPubSub) Publish(ctx context.Context, topic string, data []byte) error {
+	payload := topic + "\n" + string(data)
+	if len(payload) > postgresMaxPayload {
+		return domain.ErrMessageTooLarge
+	}
+
+	if err := p.db.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", postgresChannel, payload).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresPubSub) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	p.listen.Do(func() {
+		go p.listenLoop(context.Background())
+	})
+
+	return p.local.Subscribe(ctx, topic)
+}
+
+// listenLoop connects again after a failure, the messages published while it is disconnected are lost
+func (p *postgresPubSub) listenLoop(ctx context.Context) {
+	delay := time.Second
+	for {
+		connected, err := p.receive(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if connected {
+			delay = time.Second
+		}
+
+		log.Error("Error listening notifications:", err)
+		time.Sleep(delay)
+		if delay *= 2; delay > postgresRetryMax {
+			delay = postgresRetryMax
+		}
+	}
+}
+
+func (p *postgresPubSub) receive(ctx context.Context) (bool, error) {
+	conn, err := pgx.Connect(ctx, p.dsn)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+postgresChannel); err != nil {
+		return false, err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return true, err
+		}
+
+		p.dispatch(notification.Payload)
+	}
+}
+
+func (p *postgresPubSub) dispatch(payload string) {
+	topic, data, ok := strings.Cut(payload, "\n")
+	if !ok {
+		return
+	}
+
+	p.local.deliver(topic, []byte(data))
+}
+
+func NewPostgresPubSub(db *gorm.DB, dsn string) domain.PubSub {
+	return &postgresPubSub{
+		db:    db,
+		dsn:   dsn,
+		local: newMemoryPubSub(),
+	}
+}
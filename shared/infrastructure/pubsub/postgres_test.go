@@ -0,0 +1,94 @@
+package pubsub
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+	"testing"
+	"your-accounts-api/shared/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestPostgresSuite struct {
+	suite.Suite
+	mock   sqlmock.Sqlmock
+	pubSub *postgresPubSub
+}
+
+func (suite *TestPostgresSuite) SetupTest() {
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.pubSub = NewPostgresPubSub(DB, "").(*postgresPubSub)
+}
+
+func (suite *TestPostgresSuite) TestPublishSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_notify($1, $2)`)).
+		WithArgs(postgresChannel, "budget:1\nmessage").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := suite.pubSub.Publish(context.Background(), "budget:1", []byte("message"))
+
+	require.NoError(err)
+	require.NoError(suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestPostgresSuite) TestPublishErrorTooLarge() {
+	require := require.New(suite.T())
+
+	err := suite.pubSub.Publish(context.Background(), "budget:1", []byte(strings.Repeat("a", postgresMaxPayload)))
+
+	require.ErrorIs(err, domain.ErrMessageTooLarge)
+	require.NoError(suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestPostgresSuite) TestPublishError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_notify($1, $2)`)).
+		WillReturnError(gorm.ErrInvalidDB)
+
+	err := suite.pubSub.Publish(context.Background(), "budget:1", []byte("message"))
+
+	require.ErrorIs(err, gorm.ErrInvalidDB)
+}
+
+func (suite *TestPostgresSuite) TestDispatch() {
+	require := require.New(suite.T())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	messages, err := suite.pubSub.local.Subscribe(ctx, "budget:1")
+	require.NoError(err)
+
+	suite.pubSub.dispatch("budget:1\n{\"id\":1}")
+	suite.pubSub.dispatch("budget:2\nother")
+	suite.pubSub.dispatch("invalid")
+
+	require.Len(messages, 1)
+	require.Equal([]byte("{\"id\":1}"), <-messages)
+}
+
+func TestTestPostgresSuite(t *testing.T) {
+	suite.Run(t, new(TestPostgresSuite))
+}
@@ -0,0 +1,26 @@
+package pubsub
+
+import (
+	"sync"
+	"your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/config"
+	"your-accounts-api/shared/infrastructure/db"
+)
+
+var (
+	postgresOnce   sync.Once
+	sharedPostgres domain.PubSub
+)
+
+// NewPubSub returns the configured backend, the memory backend only reaches the subscribers of
+// this instance while the Postgres one reaches the subscribers of every instance.
+func NewPubSub() domain.PubSub {
+	if config.PUBSUB == "postgres" {
+		postgresOnce.Do(func() {
+			sharedPostgres = NewPostgresPubSub(db.DB, config.DATABASE_DSN)
+		})
+		return sharedPostgres
+	}
+
+	return NewMemoryPubSub()
+}
@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type counter struct {
+	count     int
+	expiresAt time.Time
+}
+
+type memoryStore struct {
+	mu        sync.Mutex
+	counters  map[string]*counter
+	lastSweep time.Time
+}
+
+func (s *memoryStore) Increment(_ context.Context, key string, window time.Duration) (int, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now, window)
+
+	c, ok := s.counters[key]
+	if !ok || !now.Before(c.expiresAt) {
+		c = &counter{expiresAt: now.Add(window)}
+		s.counters[key] = c
+	}
+
+	c.count++
+	return c.count, c.expiresAt.Sub(now), nil
+}
+
+// sweep removes the expired counters at most once per window to keep the map bounded.
+func (s *memoryStore) sweep(now time.Time, window time.Duration) {
+	if now.Sub(s.lastSweep) < window {
+		return
+	}
+
+	for key, c := range s.counters {
+		if !now.Before(c.expiresAt) {
+			delete(s.counters, key)
+		}
+	}
+	s.lastSweep = now
+}
+
+// NewMemoryStore keeps the counters in the process, they are not shared between instances.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		counters:  make(map[string]*counter),
+		lastSweep: time.Now(),
+	}
+}
@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// New limits the requests with the policy of the configuration of the same name, the
+// counters are kept by user for the authenticated routes and by IP otherwise. A missing
+// or disabled policy lets every request through.
+func New(name string) fiber.Handler {
+	policy := config.RATE_LIMITS[name]
+	if policy.Max < 1 {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	return newMiddleware(name, policy, NewStore())
+}
+
+func newMiddleware(name string, policy config.RateLimitPolicy, store Store) fiber.Handler {
+	limit := strconv.Itoa(policy.Max)
+	policyHeader := fmt.Sprintf("%d;w=%d", policy.Max, int(policy.Window.Seconds()))
+	return func(c *fiber.Ctx) error {
+		count, reset, err := store.Increment(c.UserContext(), name+":"+key(c), policy.Window)
+		if err != nil {
+			// An unavailable store must not take down the API
+			log.Error("Error counting request for rate limit:", err)
+			return c.Next()
+		}
+
+		resetSeconds := strconv.Itoa(int(math.Ceil(reset.Seconds())))
+		remaining := policy.Max - count
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		c.Set("RateLimit-Policy", policyHeader)
+		c.Set("RateLimit-Limit", limit)
+		c.Set("RateLimit-Remaining", strconv.Itoa(remaining))
+		c.Set("RateLimit-Reset", resetSeconds)
+		if count > policy.Max {
+			c.Set(fiber.HeaderRetryAfter, resetSeconds)
+			return fiber.NewError(fiber.StatusTooManyRequests, "Too many requests")
+		}
+
+		return c.Next()
+	}
+}
+
+func key(c *fiber.Ctx) string {
+	if token, ok := c.Locals("user").(*jwt.Token); ok {
+		if claims, ok := token.Claims.(*domain.JwtUserClaims); ok && claims.ID != 0 {
+			return "user:" + strconv.FormatUint(uint64(claims.ID), 10)
+		}
+	}
+
+	return "ip:" + c.IP()
+}
@@ -0,0 +1,175 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+	"your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type failingStore struct{}
+
+func (failingStore) Increment(_ context.Context, _ string, _ time.Duration) (int, time.Duration, error) {
+	return 0, 0, errors.New("store unavailable")
+}
+
+func status(response *http.Response, _ string) int {
+	return response.StatusCode
+}
+
+type TestSuite struct {
+	suite.Suite
+	limits map[string]config.RateLimitPolicy
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.limits = config.RATE_LIMITS
+}
+
+func (suite *TestSuite) SetupTest() {
+	config.RATE_LIMIT_STORE = "memory"
+	config.RATE_LIMITS = map[string]config.RateLimitPolicy{
+		"test": {Max: 2, Window: time.Minute},
+		"off":  {},
+	}
+}
+
+func (suite *TestSuite) TearDownSuite() {
+	config.RATE_LIMITS = suite.limits
+}
+
+func (suite *TestSuite) newApp(limiter fiber.Handler) *fiber.App {
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		if id := c.Get("X-User"); id != "" {
+			userId, _ := strconv.Atoi(id)
+			c.Locals("user", &jwt.Token{Claims: &domain.JwtUserClaims{ID: uint(userId)}, Valid: true})
+		}
+
+		return c.Next()
+	})
+	app.Use(limiter)
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendString("Next")
+	})
+	return app
+}
+
+func (suite *TestSuite) request(app *fiber.App, user string) (*http.Response, string) {
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	if user != "" {
+		request.Header.Set("X-User", user)
+	}
+
+	response, err := app.Test(request)
+	suite.Require().NoError(err)
+
+	body, err := io.ReadAll(response.Body)
+	suite.Require().NoError(err)
+	return response, string(body)
+}
+
+func (suite *TestSuite) TestLimitHeaders() {
+	require := require.New(suite.T())
+	app := suite.newApp(New("test"))
+
+	response, _ := suite.request(app, "")
+
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	require.Equal("2;w=60", response.Header.Get("RateLimit-Policy"))
+	require.Equal("2", response.Header.Get("RateLimit-Limit"))
+	require.Equal("1", response.Header.Get("RateLimit-Remaining"))
+	require.Equal("60", response.Header.Get("RateLimit-Reset"))
+	require.Empty(response.Header.Get(fiber.HeaderRetryAfter))
+}
+
+func (suite *TestSuite) TestLimitExceeded() {
+	require := require.New(suite.T())
+	app := suite.newApp(New("test"))
+
+	suite.request(app, "")
+	suite.request(app, "")
+	response, body := suite.request(app, "")
+
+	require.Equal(fiber.StatusTooManyRequests, response.StatusCode)
+	require.Equal("Too many requests", body)
+	require.Equal("0", response.Header.Get("RateLimit-Remaining"))
+	require.Equal("60", response.Header.Get(fiber.HeaderRetryAfter))
+}
+
+func (suite *TestSuite) TestLimitByUser() {
+	require := require.New(suite.T())
+	app := suite.newApp(New("test"))
+
+	suite.request(app, "1")
+	suite.request(app, "1")
+
+	require.Equal(fiber.StatusTooManyRequests, status(suite.request(app, "1")))
+	require.Equal(fiber.StatusOK, status(suite.request(app, "2")))
+	require.Equal(fiber.StatusOK, status(suite.request(app, "")))
+}
+
+func (suite *TestSuite) TestLimitDisabled() {
+	require := require.New(suite.T())
+	app := suite.newApp(New("off"))
+
+	for i := 0; i < 5; i++ {
+		response, _ := suite.request(app, "")
+		require.Equal(fiber.StatusOK, response.StatusCode)
+		require.Empty(response.Header.Get("RateLimit-Limit"))
+	}
+}
+
+func (suite *TestSuite) TestLimitUnknownPolicy() {
+	require := require.New(suite.T())
+	app := suite.newApp(New("unknown"))
+
+	response, _ := suite.request(app, "")
+
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	require.Empty(response.Header.Get("RateLimit-Limit"))
+}
+
+func (suite *TestSuite) TestLimitStoreError() {
+	require := require.New(suite.T())
+	app := suite.newApp(newMiddleware("test", config.RATE_LIMITS["test"], failingStore{}))
+
+	response, body := suite.request(app, "")
+
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	require.Equal("Next", body)
+}
+
+func (suite *TestSuite) TestMemoryStoreWindow() {
+	require := require.New(suite.T())
+	store := NewMemoryStore()
+
+	count, reset, err := store.Increment(context.Background(), "key", 20*time.Millisecond)
+	require.NoError(err)
+	require.Equal(1, count)
+	require.LessOrEqual(reset, 20*time.Millisecond)
+
+	count, _, err = store.Increment(context.Background(), "key", 20*time.Millisecond)
+	require.NoError(err)
+	require.Equal(2, count)
+
+	time.Sleep(30 * time.Millisecond)
+	count, _, err = store.Increment(context.Background(), "key", 20*time.Millisecond)
+	require.NoError(err)
+	require.Equal(1, count)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
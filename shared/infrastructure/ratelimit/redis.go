@@ -0,0 +1,197 @@
+package ratelimit
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+const redisTimeout = 2 * time.Second
+
+// incrementScript increments the counter and starts its window atomically, so every
+// instance sharing the server sees the same counter.
+const incrementScript = `local count = redis.call('INCR', KEYS[1])
+if count == 1 then redis.call('PEXPIRE', KEYS[1], ARGV[1]) end
+return {count, redis.call('PTTL', KEYS[1])}`
+
+// redisPoolSize is the number of idle connections kept open, the requests over it open
+// their own connection and close it when done.
+const redisPoolSize = 16
+
+var errRedisReply = errors.New("unexpected reply from redis")
+
+// redisConn is a connection of the pool with the reader of its replies
+type redisConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// redisStore speaks the RESP protocol, enough for the commands of the limiter and compatible
+// with Redis, Valkey or KeyDB servers. The connections are taken from a pool so the requests
+// do not wait for each other.
+type redisStore struct {
+	addr     string
+	password string
+	idle     chan *redisConn
+}
+
+func (s *redisStore) Increment(ctx context.Context, key string, window time.Duration) (int, time.Duration, error) {
+	reply, err := s.do(ctx, "EVAL", incrementScript, "1", "ratelimit:"+key, strconv.FormatInt(window.Milliseconds(), 10))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	values, ok := reply.([]any)
+	if !ok || len(values) != 2 {
+		return 0, 0, errRedisReply
+	}
+
+	count, ok := values[0].(int64)
+	if !ok {
+		return 0, 0, errRedisReply
+	}
+
+	ttl, ok := values[1].(int64)
+	if !ok {
+		return 0, 0, errRedisReply
+	}
+
+	if ttl < 0 {
+		ttl = window.Milliseconds()
+	}
+
+	return int(count), time.Duration(ttl) * time.Millisecond, nil
+}
+
+// do sends a command through a connection of the pool and gives it back when the reply was
+// read, the connection is discarded on any error.
+func (s *redisStore) do(ctx context.Context, args ...string) (any, error) {
+	conn, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := conn.roundTrip(ctx, args...)
+	if err != nil {
+		conn.conn.Close()
+		return nil, err
+	}
+
+	s.put(conn)
+	return reply, nil
+}
+
+func (s *redisStore) get(ctx context.Context) (*redisConn, error) {
+	select {
+	case conn := <-s.idle:
+		return conn, nil
+	default:
+		return s.connect(ctx)
+	}
+}
+
+func (s *redisStore) put(conn *redisConn) {
+	select {
+	case s.idle <- conn:
+	default:
+		conn.conn.Close()
+	}
+}
+
+func (s *redisStore) connect(ctx context.Context) (*redisConn, error) {
+	dialer := net.Dialer{Timeout: redisTimeout}
+	netConn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &redisConn{conn: netConn, reader: bufio.NewReader(netConn)}
+	if s.password == "" {
+		return conn, nil
+	}
+
+	if _, err := conn.roundTrip(ctx, "AUTH", s.password); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func (c *redisConn) roundTrip(ctx context.Context, args ...string) (any, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(redisTimeout)
+	}
+	if err := c.conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	command := fmt.Sprintf("*%d\r\n", len(args))
+	for _, arg := range args {
+		command += fmt.Sprintf("$%d\r\n%s\r\n", len(arg), arg)
+	}
+
+	if _, err := c.conn.Write([]byte(command)); err != nil {
+		return nil, err
+	}
+
+	return c.readReply()
+}
+
+func (c *redisConn) readReply() (any, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	} else if len(line) < 3 {
+		return nil, errRedisReply
+	}
+
+	value := line[1 : len(line)-2]
+	switch line[0] {
+	case '+':
+		return value, nil
+	case '-':
+		return nil, errors.New(value)
+	case ':':
+		return strconv.ParseInt(value, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(c.reader, data); err != nil {
+			return nil, err
+		}
+
+		return string(data[:size]), nil
+	case '*':
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+
+		values := make([]any, size)
+		for i := range values {
+			if values[i], err = c.readReply(); err != nil {
+				return nil, err
+			}
+		}
+
+		return values, nil
+	}
+
+	return nil, errRedisReply
+}
+
+// NewRedisStore shares the counters between instances through a Redis compatible server.
+func NewRedisStore(addr, password string) Store {
+	return &redisStore{addr: addr, password: password, idle: make(chan *redisConn, redisPoolSize)}
+}
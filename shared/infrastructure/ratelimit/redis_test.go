@@ -0,0 +1,179 @@
+package ratelimit
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis answers the commands of the limiter keeping the counters in memory, also
+// returns the number of connections accepted.
+func fakeRedis(t *testing.T, password string) (string, *atomic.Int32) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	var (
+		mu          sync.Mutex
+		connections atomic.Int32
+	)
+	counters := map[string]int{}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			connections.Add(1)
+			go func(conn net.Conn) {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				for {
+					args, err := readCommand(reader)
+					if err != nil {
+						return
+					}
+
+					switch args[0] {
+					case "AUTH":
+						if args[1] != password {
+							fmt.Fprint(conn, "-WRONGPASS invalid password\r\n")
+							continue
+						}
+						fmt.Fprint(conn, "+OK\r\n")
+					case "EVAL":
+						mu.Lock()
+						counters[args[3]]++
+						count := counters[args[3]]
+						mu.Unlock()
+						fmt.Fprintf(conn, "*2\r\n:%d\r\n:%s\r\n", count, args[4])
+					default:
+						fmt.Fprintf(conn, "-ERR unknown command '%s'\r\n", args[0])
+					}
+				}
+			}(conn)
+		}
+	}()
+
+	return listener.Addr().String(), &connections
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	size, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+	args := make([]string, size)
+	for i := range args {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		length, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+		arg := make([]byte, length+2)
+		if _, err := io.ReadFull(reader, arg); err != nil {
+			return nil, err
+		}
+		args[i] = string(arg[:length])
+	}
+
+	return args, nil
+}
+
+func TestRedisStoreIncrement(t *testing.T) {
+	addr, _ := fakeRedis(t, "secret")
+	store := NewRedisStore(addr, "secret")
+
+	count, reset, err := store.Increment(context.Background(), "api:user:1", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Equal(t, time.Minute, reset)
+
+	count, _, err = store.Increment(context.Background(), "api:user:1", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func TestRedisStoreSharedCounters(t *testing.T) {
+	addr, _ := fakeRedis(t, "")
+	first := NewRedisStore(addr, "")
+	second := NewRedisStore(addr, "")
+
+	_, _, err := first.Increment(context.Background(), "auth:ip:0.0.0.0", time.Minute)
+	require.NoError(t, err)
+
+	count, _, err := second.Increment(context.Background(), "auth:ip:0.0.0.0", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func TestRedisStoreReusesConnections(t *testing.T) {
+	addr, connections := fakeRedis(t, "")
+	store := NewRedisStore(addr, "")
+
+	for i := 0; i < 5; i++ {
+		_, _, err := store.Increment(context.Background(), "api:user:1", time.Minute)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, int32(1), connections.Load())
+}
+
+func TestRedisStoreConcurrentIncrements(t *testing.T) {
+	addr, connections := fakeRedis(t, "")
+	store := NewRedisStore(addr, "")
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		counts = map[int]bool{}
+	)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			count, _, err := store.Increment(context.Background(), "api:ip:0.0.0.0", time.Minute)
+			require.NoError(t, err)
+
+			mu.Lock()
+			counts[count] = true
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, counts, 50)
+	require.LessOrEqual(t, connections.Load(), int32(50))
+}
+
+func TestRedisStoreWrongPassword(t *testing.T) {
+	addr, _ := fakeRedis(t, "secret")
+	store := NewRedisStore(addr, "other")
+
+	_, _, err := store.Increment(context.Background(), "api:user:1", time.Minute)
+	require.EqualError(t, err, "WRONGPASS invalid password")
+}
+
+func TestRedisStoreUnavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+
+	_, _, err = NewRedisStore(addr, "").Increment(context.Background(), "api:user:1", time.Minute)
+	require.Error(t, err)
+}
@@ -0,0 +1,34 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+	"your-accounts-api/shared/infrastructure/config"
+)
+
+// Store counts the requests of a key in fixed windows, the counter starts with the first
+// request and is reset when the window expires.
+type Store interface {
+	// Increment adds a request to the key and returns the requests counted in the current
+	// window and the time left until it is reset.
+	Increment(ctx context.Context, key string, window time.Duration) (int, time.Duration, error)
+}
+
+var (
+	redisOnce   sync.Once
+	sharedRedis Store
+)
+
+// NewStore returns the configured store, the pool of Redis connections is shared between all
+// the policies while the memory counters belong to each middleware.
+func NewStore() Store {
+	if config.RATE_LIMIT_STORE == "redis" {
+		redisOnce.Do(func() {
+			sharedRedis = NewRedisStore(config.RATE_LIMIT_REDIS_ADDR, config.RATE_LIMIT_REDIS_PASSWORD)
+		})
+		return sharedRedis
+	}
+
+	return NewMemoryStore()
+}
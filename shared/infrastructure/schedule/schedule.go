@@ -3,6 +3,7 @@ package schedule
 import (
 	"context"
 	"time"
+	"your-accounts-api/shared/infrastructure/config"
 	"your-accounts-api/shared/infrastructure/injection"
 
 	"codnect.io/chrono"
@@ -37,12 +38,71 @@ func Start() {
 		if err := injection.UserApp.DeleteExpired(context.Background()); err != nil {
 			log.Error(err)
 		}
+
+		if err := injection.OidcApp.DeleteExpired(context.Background()); err != nil {
+			log.Error(err)
+		}
 	}, 168*time.Hour)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	tasks = append(tasks, taskCleanLogsOrphan, taskCleanLogsOld, taskCleanTokens)
+	taskDeleteUsers, err := taskScheduler.ScheduleAtFixedRate(func(ctx context.Context) {
+		if err := injection.UserApp.DeleteScheduled(context.Background()); err != nil {
+			log.Error(err)
+		}
+	}, 24*time.Hour)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	taskPurgeTrash, err := taskScheduler.ScheduleAtFixedRate(func(ctx context.Context) {
+		if err := injection.TrashApp.Purge(context.Background()); err != nil {
+			log.Error(err)
+		}
+	}, 24*time.Hour)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	taskDispatchEvents, err := taskScheduler.ScheduleWithFixedDelay(func(ctx context.Context) {
+		if err := injection.EventApp.Dispatch(context.Background()); err != nil {
+			log.Error(err)
+		}
+	}, config.EVENT_DISPATCH_INTERVAL)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	taskCleanEvents, err := taskScheduler.ScheduleAtFixedRate(func(ctx context.Context) {
+		if err := injection.EventApp.DeleteProcessed(context.Background()); err != nil {
+			log.Error(err)
+		}
+	}, 24*time.Hour)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	taskDeliverWebhooks, err := taskScheduler.ScheduleWithFixedDelay(func(ctx context.Context) {
+		if err := injection.WebhookApp.Deliver(context.Background()); err != nil {
+			log.Error(err)
+		}
+	}, config.WEBHOOK_DELIVERY_INTERVAL)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	taskCleanWebhookDeliveries, err := taskScheduler.ScheduleAtFixedRate(func(ctx context.Context) {
+		if err := injection.WebhookApp.DeleteOldDeliveries(context.Background()); err != nil {
+			log.Error(err)
+		}
+	}, 24*time.Hour)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tasks = append(tasks, taskCleanLogsOrphan, taskCleanLogsOld, taskCleanTokens, taskDeleteUsers, taskPurgeTrash, taskDispatchEvents, taskCleanEvents,
+		taskDeliverWebhooks, taskCleanWebhookDeliveries)
 }
 
 func Stop() {
@@ -5,15 +5,13 @@ import (
 	"fmt"
 	"reflect"
 	"runtime"
-	"strings"
-	"time"
 	"your-accounts-api/shared/infrastructure/config"
+	"your-accounts-api/shared/infrastructure/handler/logs"
 
 	"github.com/gofiber/fiber/v2/log"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/healthcheck"
-	"github.com/gofiber/fiber/v2/middleware/limiter"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gofiber/fiber/v2/middleware/requestid"
@@ -78,17 +76,11 @@ Response: ${%s}
 				logger.TagTime, logger.TagMagenta, logger.TagLocals, logger.TagReset, logger.TagIP, logger.TagStatus, logger.TagMethod, logger.TagLatency, logger.TagPath, logger.TagReqHeaders, logger.TagQueryStringParams, logger.TagBody, logger.TagResBody),
 			TimeFormat: "2006/01/02 15:04:05",
 		}))
-		app.Use(limiter.New(limiter.Config{
-			Next: func(c *fiber.Ctx) bool {
-				return c.IP() == "127.0.0.1" || strings.HasPrefix(c.IP(), "172")
-			},
-			Max:        10,
-			Expiration: 1 * time.Minute,
-		}))
 		app.Use(recover.New(recover.Config{
 			EnableStackTrace: true,
 		}))
 		app.Use(requestid.New())
+		app.Use(logs.NewActorMiddleware())
 		app.Use(healthcheck.New(healthcheck.Config{
 			LivenessEndpoint:  "/",
 			ReadinessEndpoint: "/ready",
@@ -0,0 +1,31 @@
+package validation
+
+import (
+	"reflect"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+const RequestQuery = "query"
+
+func RequestQueryValid(t any) fiber.Handler {
+	typ := reflect.TypeOf(t)
+
+	return func(c *fiber.Ctx) error {
+		instance := reflect.New(typ).Interface()
+
+		if err := c.QueryParser(instance); err != nil {
+			log.Error("Error request query parser:", err)
+			return fiber.ErrBadRequest
+		}
+
+		if err := validate.Struct(instance); err != nil {
+			log.Error("Error request query validation:", err)
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(getErrors(err))
+		}
+
+		c.Locals(RequestQuery, instance)
+		return c.Next()
+	}
+}
@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestQueryStruct struct {
+	Page uint   `query:"page" json:"page" validate:"required"`
+	Sort string `query:"sort" json:"sort" validate:"omitempty,oneof=asc desc"`
+}
+
+type TestQuerySuite struct {
+	suite.Suite
+	app *fiber.App
+}
+
+func (suite *TestQuerySuite) SetupSuite() {
+	suite.app = fiber.New()
+	suite.app.Get("/", RequestQueryValid(TestQueryStruct{}), func(c *fiber.Ctx) error {
+		request := c.Locals(RequestQuery).(*TestQueryStruct)
+		if request == nil || request.Page == 0 {
+			return c.SendStatus(fiber.StatusInternalServerError)
+		}
+
+		return c.SendStatus(fiber.StatusOK)
+	})
+}
+
+func (suite *TestQuerySuite) TestRequestQueryValidSuccess() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/?page=1&sort=asc", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+}
+
+func (suite *TestQuerySuite) TestRequestQueryValidErrorQueryParser() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/?page=abc", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusBadRequest, response.StatusCode)
+}
+
+func (suite *TestQuerySuite) TestRequestQueryValidErrorValidate() {
+	require := require.New(suite.T())
+	validationErrors := []*ErrorResponse{
+		{
+			Field:      "TestQueryStruct.page",
+			Constraint: "required",
+		},
+		{
+			Field:      "TestQueryStruct.sort",
+			Constraint: "oneof=asc desc",
+		},
+	}
+	expectedBody, err := json.Marshal(validationErrors)
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/?sort=up", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func TestQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(TestQuerySuite))
+}
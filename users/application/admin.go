@@ -0,0 +1,211 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+	budgets "your-accounts-api/budgets/domain"
+	"your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/users/domain"
+)
+
+const adminPageSize = 20
+
+var (
+	ErrSelfAction          = errors.New("action not allowed on the own account")
+	ErrUserAlreadyDisabled = errors.New("user already disabled")
+	ErrUserNotDisabled     = errors.New("user not disabled")
+	ErrInvalidRole         = errors.New("invalid role")
+)
+
+type IAdminApp interface {
+	FindUsers(ctx context.Context, adminId uint, email string, role shared.Role, page int) ([]domain.User, error)
+	Disable(ctx context.Context, adminId, userId uint) error
+	Enable(ctx context.Context, adminId, userId uint) error
+	Logout(ctx context.Context, adminId, userId uint) error
+	ChangeRole(ctx context.Context, adminId, userId uint, role shared.Role) error
+	FindBudgets(ctx context.Context, adminId, userId uint) ([]budgets.Budget, error)
+}
+
+type adminApp struct {
+	tm            persistent.TransactionManager
+	userRepo      domain.UserRepository
+	userTokenRepo domain.UserTokenRepository
+	budgetRepo    budgets.BudgetRepository
+	logApp        application.ILogApp
+}
+
+func (app *adminApp) FindUsers(ctx context.Context, adminId uint, email string, role shared.Role, page int) ([]domain.User, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	users, err := app.userRepo.SearchAllByFilter(ctx, domain.UserFilter{
+		Email:  email,
+		Role:   role,
+		Offset: (page - 1) * adminPageSize,
+		Limit:  adminPageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		detail := map[string]any{
+			"email": email,
+			"role":  role,
+			"page":  page,
+		}
+		return app.logApp.Create(ctx, "Consulta de usuarios desde la administración", shared.User, adminId, detail, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (app *adminApp) Disable(ctx context.Context, adminId, userId uint) error {
+	user, err := app.findTarget(ctx, adminId, userId)
+	if err != nil {
+		return err
+	}
+
+	if user.DisabledAt != nil {
+		return ErrUserAlreadyDisabled
+	}
+
+	disabledAt := time.Now()
+	user.DisabledAt = &disabledAt
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		userRepo := app.userRepo.WithTransaction(tx)
+		if _, err := userRepo.Save(ctx, user); err != nil {
+			return err
+		}
+
+		userTokenRepo := app.userTokenRepo.WithTransaction(tx)
+		if err := userTokenRepo.DeleteByUserId(ctx, userId); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"adminId": adminId,
+		}
+		return app.logApp.Create(ctx, "Se deshabilita la cuenta desde la administración", shared.User, userId, detail, tx)
+	})
+}
+
+func (app *adminApp) Enable(ctx context.Context, adminId, userId uint) error {
+	user, err := app.findTarget(ctx, adminId, userId)
+	if err != nil {
+		return err
+	}
+
+	if user.DisabledAt == nil {
+		return ErrUserNotDisabled
+	}
+
+	detail := map[string]any{
+		"adminId":    adminId,
+		"disabledAt": user.DisabledAt,
+	}
+	user.DisabledAt = nil
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		userRepo := app.userRepo.WithTransaction(tx)
+		if _, err := userRepo.Save(ctx, user); err != nil {
+			return err
+		}
+
+		return app.logApp.Create(ctx, "Se habilita la cuenta desde la administración", shared.User, userId, detail, tx)
+	})
+}
+
+func (app *adminApp) Logout(ctx context.Context, adminId, userId uint) error {
+	if _, err := app.userRepo.Search(ctx, userId); err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		userTokenRepo := app.userTokenRepo.WithTransaction(tx)
+		if err := userTokenRepo.DeleteByUserId(ctx, userId); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"adminId": adminId,
+		}
+		return app.logApp.Create(ctx, "Se cierran las sesiones desde la administración", shared.User, userId, detail, tx)
+	})
+}
+
+func (app *adminApp) ChangeRole(ctx context.Context, adminId, userId uint, role shared.Role) error {
+	if role != shared.UserRole && role != shared.SupportRole && role != shared.AdminRole {
+		return ErrInvalidRole
+	}
+
+	user, err := app.findTarget(ctx, adminId, userId)
+	if err != nil {
+		return err
+	}
+
+	detail := map[string]any{
+		"adminId":      adminId,
+		"role":         role,
+		"previousRole": user.Role,
+	}
+	user.Role = role
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		userRepo := app.userRepo.WithTransaction(tx)
+		if _, err := userRepo.Save(ctx, user); err != nil {
+			return err
+		}
+
+		// The role travels in the claims, the sessions are closed to issue it again
+		userTokenRepo := app.userTokenRepo.WithTransaction(tx)
+		if err := userTokenRepo.DeleteByUserId(ctx, userId); err != nil {
+			return err
+		}
+
+		return app.logApp.Create(ctx, "Se cambia el rol desde la administración", shared.User, userId, detail, tx)
+	})
+}
+
+func (app *adminApp) FindBudgets(ctx context.Context, adminId, userId uint) ([]budgets.Budget, error) {
+	if _, err := app.userRepo.Search(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	budgetList, err := app.budgetRepo.SearchAllByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		detail := map[string]any{
+			"adminId": adminId,
+		}
+		return app.logApp.Create(ctx, "Consulta de los presupuestos desde la administración", shared.User, userId, detail, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return budgetList, nil
+}
+
+func (app *adminApp) findTarget(ctx context.Context, adminId, userId uint) (domain.User, error) {
+	if adminId == userId {
+		return domain.User{}, ErrSelfAction
+	}
+
+	return app.userRepo.Search(ctx, userId)
+}
+
+func NewAdminApp(
+	tm persistent.TransactionManager, userRepo domain.UserRepository, userTokenRepo domain.UserTokenRepository,
+	budgetRepo budgets.BudgetRepository, logApp application.ILogApp,
+) IAdminApp {
+	return &adminApp{tm, userRepo, userTokenRepo, budgetRepo, logApp}
+}
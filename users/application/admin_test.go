@@ -0,0 +1,294 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	budgets "your-accounts-api/budgets/domain"
+	mocks_budgets "your-accounts-api/mocks/budgets/domain"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	mocks_domain "your-accounts-api/mocks/users/domain"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/users/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestAdminSuite struct {
+	suite.Suite
+	adminId                uint
+	userId                 uint
+	mockTransactionManager *mocks_persistent.MockTransactionManager
+	mockUserRepo           *mocks_domain.MockUserRepository
+	mockUserTokenRepo      *mocks_domain.MockUserTokenRepository
+	mockBudgetRepo         *mocks_budgets.MockBudgetRepository
+	mockLogApp             *mocks_application.MockILogApp
+	app                    IAdminApp
+	ctx                    context.Context
+}
+
+func (suite *TestAdminSuite) SetupSuite() {
+	suite.adminId = 1
+	suite.userId = 999
+	suite.ctx = context.Background()
+}
+
+func (suite *TestAdminSuite) SetupTest() {
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockUserRepo = mocks_domain.NewMockUserRepository(suite.T())
+	suite.mockUserTokenRepo = mocks_domain.NewMockUserTokenRepository(suite.T())
+	suite.mockBudgetRepo = mocks_budgets.NewMockBudgetRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.app = NewAdminApp(suite.mockTransactionManager, suite.mockUserRepo, suite.mockUserTokenRepo, suite.mockBudgetRepo, suite.mockLogApp)
+}
+
+func (suite *TestAdminSuite) mockTransaction() {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+}
+
+func (suite *TestAdminSuite) TestFindUsersSuccess() {
+	require := require.New(suite.T())
+	usersExpected := []domain.User{{ID: suite.userId, Role: shared.SupportRole}}
+	suite.mockUserRepo.On("SearchAllByFilter", suite.ctx, domain.UserFilter{
+		Email:  "test",
+		Role:   shared.SupportRole,
+		Offset: adminPageSize,
+		Limit:  adminPageSize,
+	}).Return(usersExpected, nil)
+	suite.mockTransaction()
+	suite.mockLogApp.On("Create", suite.ctx, "Consulta de usuarios desde la administración", shared.User, suite.adminId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.FindUsers(suite.ctx, suite.adminId, "test", shared.SupportRole, 2)
+
+	require.NoError(err)
+	require.Equal(usersExpected, res)
+}
+
+func (suite *TestAdminSuite) TestFindUsersSuccessFirstPage() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("SearchAllByFilter", suite.ctx, domain.UserFilter{
+		Limit: adminPageSize,
+	}).Return([]domain.User{}, nil)
+	suite.mockTransaction()
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, suite.adminId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.FindUsers(suite.ctx, suite.adminId, "", "", 0)
+
+	require.NoError(err)
+	require.Empty(res)
+}
+
+func (suite *TestAdminSuite) TestFindUsersErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("SearchAllByFilter", suite.ctx, mock.Anything).Return(nil, gorm.ErrInvalidField)
+
+	res, err := suite.app.FindUsers(suite.ctx, suite.adminId, "", "", 1)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(res)
+}
+
+func (suite *TestAdminSuite) TestFindUsersErrorLog() {
+	require := require.New(suite.T())
+	errExpected := errors.New("not logged")
+	suite.mockUserRepo.On("SearchAllByFilter", suite.ctx, mock.Anything).Return([]domain.User{}, nil)
+	suite.mockTransaction()
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, suite.adminId, mock.Anything, nil).Return(errExpected)
+
+	res, err := suite.app.FindUsers(suite.ctx, suite.adminId, "", "", 1)
+
+	require.EqualError(errExpected, err.Error())
+	require.Nil(res)
+}
+
+func (suite *TestAdminSuite) TestDisableSuccess() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{ID: suite.userId}, nil)
+	suite.mockTransaction()
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, mock.MatchedBy(func(u domain.User) bool {
+		return u.ID == suite.userId && u.DisabledAt != nil
+	})).Return(suite.userId, nil)
+	suite.mockUserTokenRepo.On("WithTransaction", nil).Return(suite.mockUserTokenRepo)
+	suite.mockUserTokenRepo.On("DeleteByUserId", suite.ctx, suite.userId).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se deshabilita la cuenta desde la administración", shared.User, suite.userId, map[string]any{
+		"adminId": suite.adminId,
+	}, nil).Return(nil)
+
+	err := suite.app.Disable(suite.ctx, suite.adminId, suite.userId)
+
+	require.NoError(err)
+}
+
+func (suite *TestAdminSuite) TestDisableErrorSelfAction() {
+	require := require.New(suite.T())
+
+	err := suite.app.Disable(suite.ctx, suite.adminId, suite.adminId)
+
+	require.ErrorIs(err, ErrSelfAction)
+}
+
+func (suite *TestAdminSuite) TestDisableErrorAlreadyDisabled() {
+	require := require.New(suite.T())
+	disabledAt := time.Now()
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{ID: suite.userId, DisabledAt: &disabledAt}, nil)
+
+	err := suite.app.Disable(suite.ctx, suite.adminId, suite.userId)
+
+	require.ErrorIs(err, ErrUserAlreadyDisabled)
+}
+
+func (suite *TestAdminSuite) TestDisableErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.Disable(suite.ctx, suite.adminId, suite.userId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestAdminSuite) TestDisableErrorDeleteTokens() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{ID: suite.userId}, nil)
+	suite.mockTransaction()
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, mock.Anything).Return(suite.userId, nil)
+	suite.mockUserTokenRepo.On("WithTransaction", nil).Return(suite.mockUserTokenRepo)
+	suite.mockUserTokenRepo.On("DeleteByUserId", suite.ctx, suite.userId).Return(gorm.ErrInvalidField)
+
+	err := suite.app.Disable(suite.ctx, suite.adminId, suite.userId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestAdminSuite) TestEnableSuccess() {
+	require := require.New(suite.T())
+	disabledAt := time.Now()
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{ID: suite.userId, DisabledAt: &disabledAt}, nil)
+	suite.mockTransaction()
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, domain.User{ID: suite.userId}).Return(suite.userId, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se habilita la cuenta desde la administración", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.Enable(suite.ctx, suite.adminId, suite.userId)
+
+	require.NoError(err)
+}
+
+func (suite *TestAdminSuite) TestEnableErrorNotDisabled() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{ID: suite.userId}, nil)
+
+	err := suite.app.Enable(suite.ctx, suite.adminId, suite.userId)
+
+	require.ErrorIs(err, ErrUserNotDisabled)
+}
+
+func (suite *TestAdminSuite) TestLogoutSuccess() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{ID: suite.userId}, nil)
+	suite.mockTransaction()
+	suite.mockUserTokenRepo.On("WithTransaction", nil).Return(suite.mockUserTokenRepo)
+	suite.mockUserTokenRepo.On("DeleteByUserId", suite.ctx, suite.userId).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se cierran las sesiones desde la administración", shared.User, suite.userId, map[string]any{
+		"adminId": suite.adminId,
+	}, nil).Return(nil)
+
+	err := suite.app.Logout(suite.ctx, suite.adminId, suite.userId)
+
+	require.NoError(err)
+}
+
+func (suite *TestAdminSuite) TestLogoutErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.Logout(suite.ctx, suite.adminId, suite.userId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestAdminSuite) TestChangeRoleSuccess() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{ID: suite.userId, Role: shared.UserRole}, nil)
+	suite.mockTransaction()
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, domain.User{ID: suite.userId, Role: shared.SupportRole}).Return(suite.userId, nil)
+	suite.mockUserTokenRepo.On("WithTransaction", nil).Return(suite.mockUserTokenRepo)
+	suite.mockUserTokenRepo.On("DeleteByUserId", suite.ctx, suite.userId).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se cambia el rol desde la administración", shared.User, suite.userId, map[string]any{
+		"adminId":      suite.adminId,
+		"role":         shared.SupportRole,
+		"previousRole": shared.UserRole,
+	}, nil).Return(nil)
+
+	err := suite.app.ChangeRole(suite.ctx, suite.adminId, suite.userId, shared.SupportRole)
+
+	require.NoError(err)
+}
+
+func (suite *TestAdminSuite) TestChangeRoleErrorInvalidRole() {
+	require := require.New(suite.T())
+
+	err := suite.app.ChangeRole(suite.ctx, suite.adminId, suite.userId, shared.Role("root"))
+
+	require.ErrorIs(err, ErrInvalidRole)
+}
+
+func (suite *TestAdminSuite) TestChangeRoleErrorSelfAction() {
+	require := require.New(suite.T())
+
+	err := suite.app.ChangeRole(suite.ctx, suite.adminId, suite.adminId, shared.UserRole)
+
+	require.ErrorIs(err, ErrSelfAction)
+}
+
+func (suite *TestAdminSuite) TestFindBudgetsSuccess() {
+	require := require.New(suite.T())
+	id := uint(5)
+	budgetsExpected := []budgets.Budget{{ID: &id, UserId: &suite.userId}}
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{ID: suite.userId}, nil)
+	suite.mockBudgetRepo.On("SearchAllByUserId", suite.ctx, suite.userId).Return(budgetsExpected, nil)
+	suite.mockTransaction()
+	suite.mockLogApp.On("Create", suite.ctx, "Consulta de los presupuestos desde la administración", shared.User, suite.userId, map[string]any{
+		"adminId": suite.adminId,
+	}, nil).Return(nil)
+
+	res, err := suite.app.FindBudgets(suite.ctx, suite.adminId, suite.userId)
+
+	require.NoError(err)
+	require.Equal(budgetsExpected, res)
+}
+
+func (suite *TestAdminSuite) TestFindBudgetsErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	res, err := suite.app.FindBudgets(suite.ctx, suite.adminId, suite.userId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+	require.Nil(res)
+}
+
+func (suite *TestAdminSuite) TestFindBudgetsErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{ID: suite.userId}, nil)
+	suite.mockBudgetRepo.On("SearchAllByUserId", suite.ctx, suite.userId).Return(nil, gorm.ErrInvalidField)
+
+	res, err := suite.app.FindBudgets(suite.ctx, suite.adminId, suite.userId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(res)
+}
+
+func TestTestAdminSuite(t *testing.T) {
+	suite.Run(t, new(TestAdminSuite))
+}
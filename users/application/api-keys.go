@@ -0,0 +1,163 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+	"your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/users/domain"
+)
+
+const apiKeyPrefix = "ya_"
+
+var (
+	ErrInvalidApiKey = errors.New("invalid or expired api key")
+	ErrInvalidExpiry = errors.New("expiry must be in the future")
+)
+
+type IApiKeyApp interface {
+	Create(ctx context.Context, userId uint, name string, scope domain.ApiKeyScope, expiresAt *time.Time) (domain.ApiKey, string, error)
+	FindByUserId(ctx context.Context, userId uint) ([]domain.ApiKey, error)
+	Revoke(ctx context.Context, userId, id uint) error
+	Authenticate(ctx context.Context, key string) (domain.ApiKey, error)
+}
+
+type apiKeyApp struct {
+	tm         persistent.TransactionManager
+	apiKeyRepo domain.ApiKeyRepository
+	userRepo   domain.UserRepository
+	logApp     application.ILogApp
+}
+
+func (app *apiKeyApp) Create(ctx context.Context, userId uint, name string, scope domain.ApiKeyScope, expiresAt *time.Time) (domain.ApiKey, string, error) {
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return domain.ApiKey{}, "", ErrInvalidExpiry
+	}
+
+	user, err := app.userRepo.Search(ctx, userId)
+	if err != nil {
+		return domain.ApiKey{}, "", err
+	}
+
+	if user.EmailVerifiedAt == nil {
+		return domain.ApiKey{}, "", ErrEmailNotVerified
+	}
+
+	key, err := apiKeyGenerate()
+	if err != nil {
+		return domain.ApiKey{}, "", err
+	}
+
+	apiKey := domain.ApiKey{
+		Name:      name,
+		Prefix:    key[:len(apiKeyPrefix)+8],
+		Hash:      apiKeyHash(key),
+		Scope:     scope,
+		UserId:    userId,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		apiKeyRepo := app.apiKeyRepo.WithTransaction(tx)
+		id, err := apiKeyRepo.Save(ctx, apiKey)
+		if err != nil {
+			return err
+		}
+
+		apiKey.ID = id
+		detail := map[string]any{
+			"id":        id,
+			"name":      name,
+			"prefix":    apiKey.Prefix,
+			"scope":     scope,
+			"expiresAt": expiresAt,
+		}
+		return app.logApp.Create(ctx, "Se crea una llave de API", shared.User, userId, detail, tx)
+	})
+	if err != nil {
+		return domain.ApiKey{}, "", err
+	}
+
+	return apiKey, key, nil
+}
+
+func (app *apiKeyApp) FindByUserId(ctx context.Context, userId uint) ([]domain.ApiKey, error) {
+	return app.apiKeyRepo.SearchAllByExample(ctx, domain.ApiKey{
+		UserId: userId,
+	})
+}
+
+func (app *apiKeyApp) Revoke(ctx context.Context, userId, id uint) error {
+	apiKey, err := app.apiKeyRepo.SearchByExample(ctx, domain.ApiKey{
+		ID:     id,
+		UserId: userId,
+	})
+	if err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		apiKeyRepo := app.apiKeyRepo.WithTransaction(tx)
+		if err := apiKeyRepo.Delete(ctx, apiKey.ID); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"id":     apiKey.ID,
+			"name":   apiKey.Name,
+			"prefix": apiKey.Prefix,
+		}
+		return app.logApp.Create(ctx, "Se revoca una llave de API", shared.User, userId, detail, tx)
+	})
+}
+
+func (app *apiKeyApp) Authenticate(ctx context.Context, key string) (domain.ApiKey, error) {
+	apiKey, err := app.apiKeyRepo.SearchByExample(ctx, domain.ApiKey{
+		Hash: apiKeyHash(key),
+	})
+	if err != nil {
+		return domain.ApiKey{}, err
+	}
+
+	now := time.Now()
+	if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(now) {
+		return domain.ApiKey{}, ErrInvalidApiKey
+	}
+
+	user, err := app.userRepo.Search(ctx, apiKey.UserId)
+	if err != nil {
+		return domain.ApiKey{}, err
+	} else if user.DisabledAt != nil {
+		return domain.ApiKey{}, ErrUserDisabled
+	}
+
+	if err := app.apiKeyRepo.UpdateLastUsedAt(ctx, apiKey.ID, now); err != nil {
+		return domain.ApiKey{}, err
+	}
+
+	apiKey.LastUsedAt = &now
+	return apiKey, nil
+}
+
+func NewApiKeyApp(tm persistent.TransactionManager, apiKeyRepo domain.ApiKeyRepository, userRepo domain.UserRepository, logApp application.ILogApp) IApiKeyApp {
+	return &apiKeyApp{tm, apiKeyRepo, userRepo, logApp}
+}
+
+func apiKeyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+var apiKeyGenerate = func() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return apiKeyPrefix + hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,262 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	mocks_domain "your-accounts-api/mocks/users/domain"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/users/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestApiKeySuite struct {
+	suite.Suite
+	userId                 uint
+	key                    string
+	verifiedAt             time.Time
+	mockTransactionManager *mocks_persistent.MockTransactionManager
+	mockApiKeyRepo         *mocks_domain.MockApiKeyRepository
+	mockUserRepo           *mocks_domain.MockUserRepository
+	mockLogApp             *mocks_application.MockILogApp
+	app                    IApiKeyApp
+	ctx                    context.Context
+	originalKeyGenerate    func() (string, error)
+}
+
+func (suite *TestApiKeySuite) SetupSuite() {
+	suite.userId = 1
+	suite.key = apiKeyPrefix + strings.Repeat("a", 64)
+	suite.verifiedAt = time.Now()
+	suite.ctx = context.Background()
+	suite.originalKeyGenerate = apiKeyGenerate
+}
+
+func (suite *TestApiKeySuite) SetupTest() {
+	apiKeyGenerate = suite.originalKeyGenerate
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockApiKeyRepo = mocks_domain.NewMockApiKeyRepository(suite.T())
+	suite.mockUserRepo = mocks_domain.NewMockUserRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.app = NewApiKeyApp(suite.mockTransactionManager, suite.mockApiKeyRepo, suite.mockUserRepo, suite.mockLogApp)
+}
+
+func (suite *TestApiKeySuite) TestCreateSuccess() {
+	require := require.New(suite.T())
+	expiresAt := time.Now().Add(time.Hour)
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{
+		ID:              suite.userId,
+		EmailVerifiedAt: &suite.verifiedAt,
+	}, nil)
+	apiKeyGenerate = func() (string, error) {
+		return suite.key, nil
+	}
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockApiKeyRepo.On("WithTransaction", nil).Return(suite.mockApiKeyRepo)
+	suite.mockApiKeyRepo.On("Save", suite.ctx, mock.MatchedBy(func(k domain.ApiKey) bool {
+		return k.Name == "Test" && k.Prefix == suite.key[:11] && k.Hash == apiKeyHash(suite.key) &&
+			k.Scope == domain.ReadScope && k.UserId == suite.userId && k.ExpiresAt == &expiresAt
+	})).Return(uint(999), nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se crea una llave de API", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	res, key, err := suite.app.Create(suite.ctx, suite.userId, "Test", domain.ReadScope, &expiresAt)
+
+	require.NoError(err)
+	require.Equal(suite.key, key)
+	require.Equal(uint(999), res.ID)
+	require.NotEqual(key, res.Hash)
+	require.Len(res.Hash, 64)
+}
+
+func (suite *TestApiKeySuite) TestCreateErrorInvalidExpiry() {
+	require := require.New(suite.T())
+	expiresAt := time.Now().Add(-time.Hour)
+
+	res, key, err := suite.app.Create(suite.ctx, suite.userId, "Test", domain.ReadScope, &expiresAt)
+
+	require.ErrorIs(err, ErrInvalidExpiry)
+	require.Empty(key)
+	require.Zero(res)
+}
+
+func (suite *TestApiKeySuite) TestCreateErrorEmailNotVerified() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{
+		ID: suite.userId,
+	}, nil)
+
+	res, key, err := suite.app.Create(suite.ctx, suite.userId, "Test", domain.WriteScope, nil)
+
+	require.ErrorIs(err, ErrEmailNotVerified)
+	require.Empty(key)
+	require.Zero(res)
+}
+
+func (suite *TestApiKeySuite) TestCreateErrorSearchUser() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	res, key, err := suite.app.Create(suite.ctx, suite.userId, "Test", domain.WriteScope, nil)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Empty(key)
+	require.Zero(res)
+}
+
+func (suite *TestApiKeySuite) TestCreateErrorSave() {
+	require := require.New(suite.T())
+	errExpected := errors.New("not saved")
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{
+		ID:              suite.userId,
+		EmailVerifiedAt: &suite.verifiedAt,
+	}, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockApiKeyRepo.On("WithTransaction", nil).Return(suite.mockApiKeyRepo)
+	suite.mockApiKeyRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), errExpected)
+
+	res, key, err := suite.app.Create(suite.ctx, suite.userId, "Test", domain.WriteScope, nil)
+
+	require.EqualError(errExpected, err.Error())
+	require.Empty(key)
+	require.Zero(res)
+}
+
+func (suite *TestApiKeySuite) TestFindByUserIdSuccess() {
+	require := require.New(suite.T())
+	apiKeys := []domain.ApiKey{
+		{
+			ID:     999,
+			Name:   "Test",
+			UserId: suite.userId,
+		},
+	}
+	suite.mockApiKeyRepo.On("SearchAllByExample", suite.ctx, domain.ApiKey{
+		UserId: suite.userId,
+	}).Return(apiKeys, nil)
+
+	res, err := suite.app.FindByUserId(suite.ctx, suite.userId)
+
+	require.NoError(err)
+	require.Equal(apiKeys, res)
+}
+
+func (suite *TestApiKeySuite) TestRevokeSuccess() {
+	require := require.New(suite.T())
+	suite.mockApiKeyRepo.On("SearchByExample", suite.ctx, domain.ApiKey{
+		ID:     999,
+		UserId: suite.userId,
+	}).Return(domain.ApiKey{
+		ID:     999,
+		UserId: suite.userId,
+	}, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockApiKeyRepo.On("WithTransaction", nil).Return(suite.mockApiKeyRepo)
+	suite.mockApiKeyRepo.On("Delete", suite.ctx, uint(999)).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se revoca una llave de API", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.Revoke(suite.ctx, suite.userId, 999)
+
+	require.NoError(err)
+}
+
+func (suite *TestApiKeySuite) TestRevokeErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockApiKeyRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.ApiKey{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.Revoke(suite.ctx, suite.userId, 999)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+}
+
+func (suite *TestApiKeySuite) TestAuthenticateSuccess() {
+	require := require.New(suite.T())
+	suite.mockApiKeyRepo.On("SearchByExample", suite.ctx, domain.ApiKey{
+		Hash: apiKeyHash(suite.key),
+	}).Return(domain.ApiKey{
+		ID:     999,
+		Scope:  domain.WriteScope,
+		UserId: suite.userId,
+	}, nil)
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{ID: suite.userId}, nil)
+	suite.mockApiKeyRepo.On("UpdateLastUsedAt", suite.ctx, uint(999), mock.AnythingOfType("time.Time")).Return(nil)
+
+	res, err := suite.app.Authenticate(suite.ctx, suite.key)
+
+	require.NoError(err)
+	require.Equal(suite.userId, res.UserId)
+	require.NotNil(res.LastUsedAt)
+}
+
+func (suite *TestApiKeySuite) TestAuthenticateErrorExpired() {
+	require := require.New(suite.T())
+	expiresAt := time.Now().Add(-time.Minute)
+	suite.mockApiKeyRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.ApiKey{
+		ID:        999,
+		UserId:    suite.userId,
+		ExpiresAt: &expiresAt,
+	}, nil)
+
+	res, err := suite.app.Authenticate(suite.ctx, suite.key)
+
+	require.ErrorIs(err, ErrInvalidApiKey)
+	require.Zero(res)
+}
+
+func (suite *TestApiKeySuite) TestAuthenticateErrorUserDisabled() {
+	require := require.New(suite.T())
+	disabledAt := time.Now()
+	suite.mockApiKeyRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.ApiKey{
+		ID:     999,
+		UserId: suite.userId,
+	}, nil)
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(domain.User{ID: suite.userId, DisabledAt: &disabledAt}, nil)
+
+	res, err := suite.app.Authenticate(suite.ctx, suite.key)
+
+	require.ErrorIs(err, ErrUserDisabled)
+	require.Zero(res)
+}
+
+func (suite *TestApiKeySuite) TestAuthenticateErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockApiKeyRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.ApiKey{}, gorm.ErrRecordNotFound)
+
+	res, err := suite.app.Authenticate(suite.ctx, suite.key)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestApiKeySuite) TestAuthenticateErrorUpdateLastUsedAt() {
+	require := require.New(suite.T())
+	errExpected := errors.New("not updated")
+	suite.mockApiKeyRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.ApiKey{
+		ID: 999,
+	}, nil)
+	suite.mockUserRepo.On("Search", suite.ctx, uint(0)).Return(domain.User{}, nil)
+	suite.mockApiKeyRepo.On("UpdateLastUsedAt", suite.ctx, uint(999), mock.Anything).Return(errExpected)
+
+	res, err := suite.app.Authenticate(suite.ctx, suite.key)
+
+	require.EqualError(errExpected, err.Error())
+	require.Zero(res)
+}
+
+func TestTestApiKeySuite(t *testing.T) {
+	suite.Run(t, new(TestApiKeySuite))
+}
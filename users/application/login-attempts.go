@@ -0,0 +1,140 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/config"
+	"your-accounts-api/users/domain"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+// LoginThrottledError is returned while the backoff of the failed logins is running,
+// it matches ErrTooManyAttempts with errors.Is.
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrTooManyAttempts, e.RetryAfter.Round(time.Second))
+}
+
+func (e *LoginThrottledError) Unwrap() error {
+	return ErrTooManyAttempts
+}
+
+// checkLoginAttempts rejects the login while the backoff of the email or the IP is running
+// and returns the consecutive failures of the email, the failures before the end of the last
+// lock of the account were already punished and are not counted again.
+func (app *userApp) checkLoginAttempts(ctx context.Context, email, ip string, lockedUntil *time.Time) (int, error) {
+	now := time.Now()
+	attempts, err := app.loginAttemptRepo.SearchAllByEmailOrIpSince(ctx, email, ip, now.Add(-config.LOGIN_ATTEMPT_WINDOW))
+	if err != nil {
+		return 0, err
+	}
+
+	emailFailures, emailLast := consecutiveFailures(attempts, func(attempt domain.LoginAttempt) bool {
+		return attempt.Email == email && (lockedUntil == nil || attempt.CreatedAt.After(*lockedUntil))
+	})
+	ipFailures, ipLast := consecutiveFailures(attempts, func(attempt domain.LoginAttempt) bool {
+		return attempt.IP == ip
+	})
+
+	failures, last := emailFailures, emailLast
+	if ipFailures > failures {
+		failures, last = ipFailures, ipLast
+	}
+
+	if retryAt := last.Add(loginBackoff(failures)); retryAt.After(now) {
+		return 0, &LoginThrottledError{RetryAfter: retryAt.Sub(now)}
+	}
+
+	return emailFailures, nil
+}
+
+func (app *userApp) saveLoginAttempt(ctx context.Context, email, ip string, success bool) error {
+	_, err := app.loginAttemptRepo.Save(ctx, domain.LoginAttempt{
+		Email:   email,
+		IP:      ip,
+		Success: success,
+	})
+	return err
+}
+
+func (app *userApp) lockAccount(ctx context.Context, user domain.User, ip string, failures int) error {
+	lockedUntil := time.Now().Add(config.LOGIN_LOCKOUT_DURATION)
+	user.LockedUntil = &lockedUntil
+	err := app.tm.Transaction(func(tx persistent.Transaction) error {
+		userRepo := app.userRepo.WithTransaction(tx)
+		if _, err := userRepo.Save(ctx, user); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"ip":          ip,
+			"failures":    failures,
+			"lockedUntil": lockedUntil,
+		}
+		return app.logApp.Create(ctx, "Bloqueo temporal de la cuenta por intentos fallidos", shared.User, user.ID, detail, tx)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The account stays locked when the notice cannot be sent
+	if err := app.mailer.Send(ctx, shared.Mail{
+		To:      user.Email,
+		Subject: "Bloqueo temporal de la cuenta",
+		Body: fmt.Sprintf("Detectamos %d intentos fallidos de inicio de sesión en tu cuenta, el último desde la IP %s. Por seguridad la cuenta queda bloqueada hasta el %s.",
+			failures, ip, lockedUntil.Format(time.RFC1123)),
+	}); err != nil {
+		log.Error("Error sending lockout mail:", err)
+	}
+
+	return nil
+}
+
+// consecutiveFailures counts the failed attempts after the last success, the attempts
+// are sorted from the newest, also returns the time of the newest failure.
+func consecutiveFailures(attempts []domain.LoginAttempt, match func(domain.LoginAttempt) bool) (int, time.Time) {
+	var (
+		failures int
+		last     time.Time
+	)
+	for _, attempt := range attempts {
+		if !match(attempt) {
+			continue
+		} else if attempt.Success {
+			break
+		}
+
+		if failures == 0 {
+			last = attempt.CreatedAt
+		}
+		failures++
+	}
+
+	return failures, last
+}
+
+// loginBackoff doubles the wait on every failure over the threshold up to the maximum.
+func loginBackoff(failures int) time.Duration {
+	if failures < config.LOGIN_BACKOFF_THRESHOLD {
+		return 0
+	}
+
+	exponent := failures - config.LOGIN_BACKOFF_THRESHOLD
+	if exponent > 30 {
+		return config.LOGIN_BACKOFF_MAX
+	}
+
+	backoff := config.LOGIN_BACKOFF_BASE << exponent
+	if backoff <= 0 || backoff > config.LOGIN_BACKOFF_MAX {
+		return config.LOGIN_BACKOFF_MAX
+	}
+
+	return backoff
+}
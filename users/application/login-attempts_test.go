@@ -0,0 +1,235 @@
+package application
+
+import (
+	"errors"
+	"time"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/config"
+	"your-accounts-api/users/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func (suite *TestSuite) mockLoginAttempts(attempts []domain.LoginAttempt) {
+	suite.mockLoginAttemptRepo.On("SearchAllByEmailOrIpSince", suite.ctx, suite.email, suite.ip, mock.AnythingOfType("time.Time")).Return(attempts, nil)
+}
+
+func (suite *TestSuite) mockSaveAttempt(success bool) {
+	suite.mockLoginAttemptRepo.On("Save", suite.ctx, domain.LoginAttempt{
+		Email:   suite.email,
+		IP:      suite.ip,
+		Success: success,
+	}).Return(uint(1), nil)
+}
+
+func (suite *TestSuite) failedAttempts(count int, email, ip string, last time.Time) []domain.LoginAttempt {
+	attempts := []domain.LoginAttempt{}
+	for i := 0; i < count; i++ {
+		attempts = append(attempts, domain.LoginAttempt{
+			Email:     email,
+			IP:        ip,
+			CreatedAt: last.Add(-time.Duration(i) * time.Second),
+		})
+	}
+
+	return attempts
+}
+
+func (suite *TestSuite) mockLock(user domain.User, failures int) {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, mock.MatchedBy(func(u domain.User) bool {
+		return u.ID == user.ID && u.LockedUntil != nil && u.LockedUntil.After(time.Now())
+	})).Return(user.ID, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Bloqueo temporal de la cuenta por intentos fallidos", shared.User, user.ID, mock.MatchedBy(func(detail map[string]any) bool {
+		return detail["ip"] == suite.ip && detail["failures"] == failures
+	}), nil).Return(nil)
+}
+
+func (suite *TestSuite) TestLoginSuccessAfterBackoff() {
+	require := require.New(suite.T())
+	attempts := suite.failedAttempts(config.LOGIN_BACKOFF_THRESHOLD, suite.email, suite.ip, time.Now().Add(-config.LOGIN_BACKOFF_BASE-time.Second))
+	suite.mockLoginAttempts(attempts)
+	suite.mockSaveAttempt(true)
+	jwtGenerate = func(id uint, role shared.Role) (string, time.Time, error) {
+		return suite.token, time.Now(), nil
+	}
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.User{ID: 999, Email: suite.email}, nil)
+	suite.mockUserTokenRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.NoError(err)
+	require.Equal(suite.token, token)
+}
+
+func (suite *TestSuite) TestLoginErrorThrottledByEmail() {
+	require := require.New(suite.T())
+	attempts := suite.failedAttempts(config.LOGIN_BACKOFF_THRESHOLD+1, suite.email, "10.0.0.2", time.Now())
+	suite.mockLoginAttempts(attempts)
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.User{ID: 999, Email: suite.email}, nil)
+
+	token, expires, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	var throttled *LoginThrottledError
+	require.ErrorIs(err, ErrTooManyAttempts)
+	require.ErrorAs(err, &throttled)
+	require.InDelta((2 * config.LOGIN_BACKOFF_BASE).Seconds(), throttled.RetryAfter.Seconds(), 0.5)
+	require.Empty(token)
+	require.Empty(expires)
+}
+
+func (suite *TestSuite) TestLoginErrorThrottledByIp() {
+	require := require.New(suite.T())
+	attempts := suite.failedAttempts(config.LOGIN_BACKOFF_THRESHOLD, "other@example.com", suite.ip, time.Now())
+	suite.mockLoginAttempts(attempts)
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	_, _, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.ErrorIs(err, ErrTooManyAttempts)
+}
+
+func (suite *TestSuite) TestLoginSuccessResetBySuccess() {
+	require := require.New(suite.T())
+	attempts := append([]domain.LoginAttempt{{Email: suite.email, IP: suite.ip, Success: true, CreatedAt: time.Now()}},
+		suite.failedAttempts(config.LOGIN_LOCKOUT_THRESHOLD+5, suite.email, suite.ip, time.Now())...)
+	suite.mockLoginAttempts(attempts)
+	suite.mockSaveAttempt(true)
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.User{ID: 999, Email: suite.email}, nil)
+	suite.mockUserTokenRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
+
+	_, _, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestLoginSuccessAfterLockExpired() {
+	require := require.New(suite.T())
+	lockedUntil := time.Now().Add(-time.Second)
+	attempts := suite.failedAttempts(config.LOGIN_LOCKOUT_THRESHOLD, suite.email, suite.ip, lockedUntil.Add(-config.LOGIN_LOCKOUT_DURATION))
+	suite.mockLoginAttempts(attempts)
+	suite.mockSaveAttempt(true)
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.User{ID: 999, Email: suite.email, LockedUntil: &lockedUntil}, nil)
+	suite.mockUserTokenRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
+
+	_, _, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestLoginErrorAccountLocked() {
+	require := require.New(suite.T())
+	lockedUntil := time.Now().Add(time.Minute)
+	suite.mockLoginAttempts(nil)
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.User{ID: 999, Email: suite.email, LockedUntil: &lockedUntil}, nil)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.ErrorIs(err, ErrAccountLocked)
+	require.Empty(token)
+}
+
+func (suite *TestSuite) TestLoginErrorLockout() {
+	require := require.New(suite.T())
+	disabledAt := time.Now()
+	user := domain.User{ID: 999, Email: suite.email, DisabledAt: &disabledAt}
+	last := time.Now().Add(-config.LOGIN_BACKOFF_MAX - time.Second)
+	suite.mockLoginAttempts(suite.failedAttempts(config.LOGIN_LOCKOUT_THRESHOLD-1, suite.email, suite.ip, last))
+	suite.mockSaveAttempt(false)
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(user, nil)
+	suite.mockLock(user, config.LOGIN_LOCKOUT_THRESHOLD)
+	suite.mockMailer.On("Send", suite.ctx, mock.MatchedBy(func(mail shared.Mail) bool {
+		return mail.To == suite.email && mail.Subject == "Bloqueo temporal de la cuenta"
+	})).Return(nil)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.ErrorIs(err, ErrAccountLocked)
+	require.Empty(token)
+}
+
+func (suite *TestSuite) TestLoginErrorLockoutActiveUser() {
+	require := require.New(suite.T())
+	user := domain.User{ID: 999, Email: suite.email}
+	last := time.Now().Add(-config.LOGIN_BACKOFF_MAX - time.Second)
+	suite.mockLoginAttempts(suite.failedAttempts(config.LOGIN_LOCKOUT_THRESHOLD, suite.email, "10.0.0.2", last))
+	suite.mockSaveAttempt(false)
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(user, nil)
+	suite.mockLock(user, config.LOGIN_LOCKOUT_THRESHOLD+1)
+	var mail shared.Mail
+	suite.mockMailer.On("Send", suite.ctx, mock.Anything).Run(func(args mock.Arguments) {
+		mail = args.Get(1).(shared.Mail)
+	}).Return(nil)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.ErrorIs(err, ErrAccountLocked)
+	require.Empty(token)
+	require.Equal(suite.email, mail.To)
+	require.Equal("Bloqueo temporal de la cuenta", mail.Subject)
+	require.Contains(mail.Body, suite.ip)
+}
+
+func (suite *TestSuite) TestLoginErrorLockoutMailFailed() {
+	require := require.New(suite.T())
+	user := domain.User{ID: 999, Email: suite.email}
+	last := time.Now().Add(-config.LOGIN_BACKOFF_MAX - time.Second)
+	suite.mockLoginAttempts(suite.failedAttempts(config.LOGIN_LOCKOUT_THRESHOLD, suite.email, "10.0.0.2", last))
+	suite.mockSaveAttempt(false)
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(user, nil)
+	suite.mockLock(user, config.LOGIN_LOCKOUT_THRESHOLD+1)
+	suite.mockMailer.On("Send", suite.ctx, mock.Anything).Return(errors.New("smtp unavailable"))
+
+	token, _, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.ErrorIs(err, ErrAccountLocked)
+	require.Empty(token)
+}
+
+func (suite *TestSuite) TestLoginErrorNotFoundWithoutLock() {
+	require := require.New(suite.T())
+	last := time.Now().Add(-config.LOGIN_BACKOFF_MAX - time.Second)
+	suite.mockLoginAttempts(suite.failedAttempts(config.LOGIN_LOCKOUT_THRESHOLD, suite.email, suite.ip, last))
+	suite.mockSaveAttempt(false)
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	_, _, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestSuite) TestLoginErrorDatabaseNotCounted() {
+	require := require.New(suite.T())
+	errExpected := errors.New("connection refused")
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.User{}, errExpected)
+
+	_, _, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.EqualError(err, errExpected.Error())
+	suite.mockLoginAttemptRepo.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+}
+
+func (suite *TestSuite) TestLoginErrorSearchAttempts() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.User{ID: 999, Email: suite.email}, nil)
+	suite.mockLoginAttemptRepo.On("SearchAllByEmailOrIpSince", suite.ctx, suite.email, suite.ip, mock.Anything).Return(nil, gorm.ErrInvalidField)
+
+	_, _, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestLoginBackoff() {
+	require := require.New(suite.T())
+
+	require.Zero(loginBackoff(config.LOGIN_BACKOFF_THRESHOLD - 1))
+	require.Equal(config.LOGIN_BACKOFF_BASE, loginBackoff(config.LOGIN_BACKOFF_THRESHOLD))
+	require.Equal(4*config.LOGIN_BACKOFF_BASE, loginBackoff(config.LOGIN_BACKOFF_THRESHOLD+2))
+	require.Equal(config.LOGIN_BACKOFF_MAX, loginBackoff(config.LOGIN_BACKOFF_THRESHOLD+40))
+}
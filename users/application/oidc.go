@@ -0,0 +1,211 @@
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	"your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/config"
+	"your-accounts-api/users/domain"
+)
+
+var (
+	ErrUnknownProvider          = errors.New("unknown identity provider")
+	ErrInvalidState             = errors.New("invalid or expired login state")
+	ErrExternalEmailNotVerified = errors.New("email not verified by the identity provider")
+	ErrExternalAuthentication   = errors.New("external authentication failed")
+)
+
+type IOidcApp interface {
+	Providers() []string
+	Authorize(ctx context.Context, provider string) (string, error)
+	Login(ctx context.Context, provider, state, code string) (string, time.Time, error)
+	DeleteExpired(ctx context.Context) error
+}
+
+type oidcApp struct {
+	tm               persistent.TransactionManager
+	providers        map[string]domain.IdentityProvider
+	oidcStateRepo    domain.OidcStateRepository
+	userIdentityRepo domain.UserIdentityRepository
+	userRepo         domain.UserRepository
+	userTokenRepo    domain.UserTokenRepository
+	logApp           application.ILogApp
+}
+
+func (app *oidcApp) Providers() []string {
+	names := []string{}
+	for name := range app.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (app *oidcApp) Authorize(ctx context.Context, provider string) (string, error) {
+	identityProvider, ok := app.providers[provider]
+	if !ok {
+		return "", ErrUnknownProvider
+	}
+
+	state, err := verificationTokenGenerate()
+	if err != nil {
+		return "", err
+	}
+
+	codeVerifier, err := verificationTokenGenerate()
+	if err != nil {
+		return "", err
+	}
+
+	nonce, err := verificationTokenGenerate()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = app.oidcStateRepo.Save(ctx, domain.OidcState{
+		State:        state,
+		Provider:     provider,
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		ExpiresAt:    time.Now().Add(config.OIDC_STATE_TTL),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	challenge := sha256.Sum256([]byte(codeVerifier))
+	return identityProvider.AuthCodeURL(ctx, state, nonce, base64.RawURLEncoding.EncodeToString(challenge[:]))
+}
+
+func (app *oidcApp) Login(ctx context.Context, provider, state, code string) (string, time.Time, error) {
+	loginState, err := app.oidcStateRepo.SearchByExample(ctx, domain.OidcState{
+		State: state,
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	// The state is single use, it is removed before talking with the provider
+	if err := app.oidcStateRepo.Delete(ctx, loginState.ID); err != nil {
+		return "", time.Time{}, err
+	}
+
+	if loginState.Provider != provider || loginState.ExpiresAt.Before(time.Now()) {
+		return "", time.Time{}, ErrInvalidState
+	}
+
+	identityProvider, ok := app.providers[provider]
+	if !ok {
+		return "", time.Time{}, ErrUnknownProvider
+	}
+
+	identity, err := identityProvider.Exchange(ctx, code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%w: %v", ErrExternalAuthentication, err)
+	}
+
+	if !identity.EmailVerified || identity.Email == "" {
+		return "", time.Time{}, ErrExternalEmailNotVerified
+	}
+
+	user, err := app.findOrLinkUser(ctx, provider, identity)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if user.DisabledAt != nil {
+		return "", time.Time{}, ErrUserDisabled
+	} else if user.LockedUntil != nil && user.LockedUntil.After(time.Now()) {
+		return "", time.Time{}, ErrAccountLocked
+	}
+
+	return createUserToken(ctx, app.userTokenRepo, user)
+}
+
+func (app *oidcApp) DeleteExpired(ctx context.Context) error {
+	return app.oidcStateRepo.DeleteByExpiresAtLessThanNow(ctx)
+}
+
+func (app *oidcApp) findOrLinkUser(ctx context.Context, provider string, identity domain.ExternalIdentity) (domain.User, error) {
+	identities, err := app.userIdentityRepo.SearchAllByExample(ctx, domain.UserIdentity{
+		Provider: provider,
+		Subject:  identity.Subject,
+	})
+	if err != nil {
+		return domain.User{}, err
+	} else if len(identities) > 0 {
+		return app.userRepo.Search(ctx, identities[0].UserId)
+	}
+
+	example := domain.User{
+		Email: identity.Email,
+	}
+	exists, err := app.userRepo.ExistsByExample(ctx, example)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	user := domain.User{
+		Email: identity.Email,
+	}
+	description := "Se crea el usuario con una identidad externa"
+	if exists {
+		user, err = app.userRepo.SearchByExample(ctx, example)
+		if err != nil {
+			return domain.User{}, err
+		}
+
+		description = "Se vincula una identidad externa"
+	}
+
+	if user.EmailVerifiedAt == nil {
+		verifiedAt := time.Now()
+		user.EmailVerifiedAt = &verifiedAt
+	}
+
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		userRepo := app.userRepo.WithTransaction(tx)
+		id, err := userRepo.Save(ctx, user)
+		if err != nil {
+			return err
+		}
+		user.ID = id
+
+		userIdentityRepo := app.userIdentityRepo.WithTransaction(tx)
+		_, err = userIdentityRepo.Save(ctx, domain.UserIdentity{
+			Provider: provider,
+			Subject:  identity.Subject,
+			UserId:   user.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"provider": provider,
+			"subject":  identity.Subject,
+		}
+		return app.logApp.Create(ctx, description, shared.User, user.ID, detail, tx)
+	})
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	return user, nil
+}
+
+func NewOidcApp(
+	tm persistent.TransactionManager, providers map[string]domain.IdentityProvider, oidcStateRepo domain.OidcStateRepository,
+	userIdentityRepo domain.UserIdentityRepository, userRepo domain.UserRepository, userTokenRepo domain.UserTokenRepository,
+	logApp application.ILogApp,
+) IOidcApp {
+	return &oidcApp{tm, providers, oidcStateRepo, userIdentityRepo, userRepo, userTokenRepo, logApp}
+}
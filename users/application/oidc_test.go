@@ -0,0 +1,327 @@
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	mocks_domain "your-accounts-api/mocks/users/domain"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/users/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestOidcSuite struct {
+	suite.Suite
+	provider               string
+	email                  string
+	identity               domain.ExternalIdentity
+	loginState             domain.OidcState
+	mockTransactionManager *mocks_persistent.MockTransactionManager
+	mockIdentityProvider   *mocks_domain.MockIdentityProvider
+	mockOidcStateRepo      *mocks_domain.MockOidcStateRepository
+	mockUserIdentityRepo   *mocks_domain.MockUserIdentityRepository
+	mockUserRepo           *mocks_domain.MockUserRepository
+	mockUserTokenRepo      *mocks_domain.MockUserTokenRepository
+	mockLogApp             *mocks_application.MockILogApp
+	app                    IOidcApp
+	ctx                    context.Context
+	originalJwtGenerate    func(id uint, role shared.Role) (string, time.Time, error)
+	originalTokenGenerate  func() (string, error)
+}
+
+func (suite *TestOidcSuite) SetupSuite() {
+	suite.provider = "google"
+	suite.email = "example@exaple.com"
+	suite.identity = domain.ExternalIdentity{
+		Subject:       "<subject>",
+		Email:         suite.email,
+		EmailVerified: true,
+	}
+	suite.loginState = domain.OidcState{
+		ID:           999,
+		State:        "<state>",
+		Provider:     suite.provider,
+		CodeVerifier: "<verifier>",
+		Nonce:        "<nonce>",
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+	suite.ctx = context.Background()
+	suite.originalJwtGenerate = jwtGenerate
+	suite.originalTokenGenerate = verificationTokenGenerate
+}
+
+func (suite *TestOidcSuite) SetupTest() {
+	jwtGenerate = suite.originalJwtGenerate
+	verificationTokenGenerate = suite.originalTokenGenerate
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockIdentityProvider = mocks_domain.NewMockIdentityProvider(suite.T())
+	suite.mockOidcStateRepo = mocks_domain.NewMockOidcStateRepository(suite.T())
+	suite.mockUserIdentityRepo = mocks_domain.NewMockUserIdentityRepository(suite.T())
+	suite.mockUserRepo = mocks_domain.NewMockUserRepository(suite.T())
+	suite.mockUserTokenRepo = mocks_domain.NewMockUserTokenRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	providers := map[string]domain.IdentityProvider{
+		suite.provider: suite.mockIdentityProvider,
+	}
+	suite.app = NewOidcApp(suite.mockTransactionManager, providers, suite.mockOidcStateRepo, suite.mockUserIdentityRepo, suite.mockUserRepo, suite.mockUserTokenRepo, suite.mockLogApp)
+}
+
+func (suite *TestOidcSuite) mockExchange() {
+	suite.mockOidcStateRepo.On("SearchByExample", suite.ctx, domain.OidcState{
+		State: suite.loginState.State,
+	}).Return(suite.loginState, nil)
+	suite.mockOidcStateRepo.On("Delete", suite.ctx, suite.loginState.ID).Return(nil)
+	suite.mockIdentityProvider.On("Exchange", suite.ctx, "<code>", suite.loginState.CodeVerifier, suite.loginState.Nonce).Return(suite.identity, nil)
+}
+
+func (suite *TestOidcSuite) mockToken(userId uint) {
+	jwtGenerate = func(id uint, role shared.Role) (string, time.Time, error) {
+		return "<token>", time.Time{}, nil
+	}
+	suite.mockUserTokenRepo.On("Save", suite.ctx, domain.UserToken{
+		Token:  "<token>",
+		UserId: userId,
+	}).Return(uint(1), nil)
+}
+
+func (suite *TestOidcSuite) TestProvidersSuccess() {
+	require := require.New(suite.T())
+
+	res := suite.app.Providers()
+
+	require.Equal([]string{suite.provider}, res)
+}
+
+func (suite *TestOidcSuite) TestAuthorizeSuccess() {
+	require := require.New(suite.T())
+	verificationTokenGenerate = func() (string, error) {
+		return "<random>", nil
+	}
+	suite.mockOidcStateRepo.On("Save", suite.ctx, mock.MatchedBy(func(s domain.OidcState) bool {
+		return s.State == "<random>" && s.Provider == suite.provider && s.CodeVerifier == "<random>" && s.Nonce == "<random>" && s.ExpiresAt.After(time.Now())
+	})).Return(uint(1), nil)
+	challenge := sha256.Sum256([]byte("<random>"))
+	suite.mockIdentityProvider.On("AuthCodeURL", suite.ctx, "<random>", "<random>", base64.RawURLEncoding.EncodeToString(challenge[:])).Return("<url>", nil)
+
+	res, err := suite.app.Authorize(suite.ctx, suite.provider)
+
+	require.NoError(err)
+	require.Equal("<url>", res)
+}
+
+func (suite *TestOidcSuite) TestAuthorizeErrorUnknownProvider() {
+	require := require.New(suite.T())
+
+	res, err := suite.app.Authorize(suite.ctx, "unknown")
+
+	require.ErrorIs(err, ErrUnknownProvider)
+	require.Empty(res)
+}
+
+func (suite *TestOidcSuite) TestAuthorizeErrorSave() {
+	require := require.New(suite.T())
+	suite.mockOidcStateRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), gorm.ErrInvalidField)
+
+	res, err := suite.app.Authorize(suite.ctx, suite.provider)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Empty(res)
+}
+
+func (suite *TestOidcSuite) TestLoginSuccessLinked() {
+	require := require.New(suite.T())
+	suite.mockExchange()
+	suite.mockUserIdentityRepo.On("SearchAllByExample", suite.ctx, domain.UserIdentity{
+		Provider: suite.provider,
+		Subject:  suite.identity.Subject,
+	}).Return([]domain.UserIdentity{{ID: 1, UserId: 999}}, nil)
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{ID: 999}, nil)
+	suite.mockToken(999)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.provider, suite.loginState.State, "<code>")
+
+	require.NoError(err)
+	require.Equal("<token>", token)
+}
+
+func (suite *TestOidcSuite) TestLoginErrorUserDisabled() {
+	require := require.New(suite.T())
+	disabledAt := time.Now()
+	suite.mockExchange()
+	suite.mockUserIdentityRepo.On("SearchAllByExample", suite.ctx, mock.Anything).Return([]domain.UserIdentity{{ID: 1, UserId: 999}}, nil)
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{ID: 999, DisabledAt: &disabledAt}, nil)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.provider, suite.loginState.State, "<code>")
+
+	require.ErrorIs(err, ErrUserDisabled)
+	require.Empty(token)
+}
+
+func (suite *TestOidcSuite) TestLoginErrorAccountLocked() {
+	require := require.New(suite.T())
+	lockedUntil := time.Now().Add(time.Minute)
+	suite.mockExchange()
+	suite.mockUserIdentityRepo.On("SearchAllByExample", suite.ctx, mock.Anything).Return([]domain.UserIdentity{{ID: 1, UserId: 999}}, nil)
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{ID: 999, LockedUntil: &lockedUntil}, nil)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.provider, suite.loginState.State, "<code>")
+
+	require.ErrorIs(err, ErrAccountLocked)
+	require.Empty(token)
+}
+
+func (suite *TestOidcSuite) TestLoginSuccessLinkExistingUser() {
+	require := require.New(suite.T())
+	suite.mockExchange()
+	suite.mockUserIdentityRepo.On("SearchAllByExample", suite.ctx, mock.Anything).Return([]domain.UserIdentity{}, nil)
+	suite.mockUserRepo.On("ExistsByExample", suite.ctx, domain.User{
+		Email: suite.email,
+	}).Return(true, nil)
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, domain.User{
+		Email: suite.email,
+	}).Return(domain.User{
+		ID:    999,
+		Email: suite.email,
+	}, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, mock.MatchedBy(func(u domain.User) bool {
+		return u.ID == 999 && u.EmailVerifiedAt != nil
+	})).Return(uint(999), nil)
+	suite.mockUserIdentityRepo.On("WithTransaction", nil).Return(suite.mockUserIdentityRepo)
+	suite.mockUserIdentityRepo.On("Save", suite.ctx, domain.UserIdentity{
+		Provider: suite.provider,
+		Subject:  suite.identity.Subject,
+		UserId:   999,
+	}).Return(uint(1), nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se vincula una identidad externa", shared.User, uint(999), mock.Anything, nil).Return(nil)
+	suite.mockToken(999)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.provider, suite.loginState.State, "<code>")
+
+	require.NoError(err)
+	require.Equal("<token>", token)
+}
+
+func (suite *TestOidcSuite) TestLoginSuccessNewUser() {
+	require := require.New(suite.T())
+	suite.mockExchange()
+	suite.mockUserIdentityRepo.On("SearchAllByExample", suite.ctx, mock.Anything).Return([]domain.UserIdentity{}, nil)
+	suite.mockUserRepo.On("ExistsByExample", suite.ctx, mock.Anything).Return(false, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, mock.MatchedBy(func(u domain.User) bool {
+		return u.ID == 0 && u.Email == suite.email && u.EmailVerifiedAt != nil
+	})).Return(uint(1000), nil)
+	suite.mockUserIdentityRepo.On("WithTransaction", nil).Return(suite.mockUserIdentityRepo)
+	suite.mockUserIdentityRepo.On("Save", suite.ctx, mock.Anything).Return(uint(1), nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se crea el usuario con una identidad externa", shared.User, uint(1000), mock.Anything, nil).Return(nil)
+	suite.mockToken(1000)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.provider, suite.loginState.State, "<code>")
+
+	require.NoError(err)
+	require.Equal("<token>", token)
+}
+
+func (suite *TestOidcSuite) TestLoginErrorStateNotFound() {
+	require := require.New(suite.T())
+	suite.mockOidcStateRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.OidcState{}, gorm.ErrRecordNotFound)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.provider, suite.loginState.State, "<code>")
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Empty(token)
+}
+
+func (suite *TestOidcSuite) TestLoginErrorStateProvider() {
+	require := require.New(suite.T())
+	suite.mockOidcStateRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(suite.loginState, nil)
+	suite.mockOidcStateRepo.On("Delete", suite.ctx, suite.loginState.ID).Return(nil)
+
+	token, _, err := suite.app.Login(suite.ctx, "other", suite.loginState.State, "<code>")
+
+	require.ErrorIs(err, ErrInvalidState)
+	require.Empty(token)
+}
+
+func (suite *TestOidcSuite) TestLoginErrorStateExpired() {
+	require := require.New(suite.T())
+	loginState := suite.loginState
+	loginState.ExpiresAt = time.Now().Add(-time.Minute)
+	suite.mockOidcStateRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(loginState, nil)
+	suite.mockOidcStateRepo.On("Delete", suite.ctx, suite.loginState.ID).Return(nil)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.provider, suite.loginState.State, "<code>")
+
+	require.ErrorIs(err, ErrInvalidState)
+	require.Empty(token)
+}
+
+func (suite *TestOidcSuite) TestLoginErrorExchange() {
+	require := require.New(suite.T())
+	suite.mockOidcStateRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(suite.loginState, nil)
+	suite.mockOidcStateRepo.On("Delete", suite.ctx, suite.loginState.ID).Return(nil)
+	suite.mockIdentityProvider.On("Exchange", suite.ctx, "<code>", mock.Anything, mock.Anything).Return(domain.ExternalIdentity{}, errors.New("invalid grant"))
+
+	token, _, err := suite.app.Login(suite.ctx, suite.provider, suite.loginState.State, "<code>")
+
+	require.ErrorIs(err, ErrExternalAuthentication)
+	require.Empty(token)
+}
+
+func (suite *TestOidcSuite) TestLoginErrorEmailNotVerified() {
+	require := require.New(suite.T())
+	suite.mockOidcStateRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(suite.loginState, nil)
+	suite.mockOidcStateRepo.On("Delete", suite.ctx, suite.loginState.ID).Return(nil)
+	suite.mockIdentityProvider.On("Exchange", suite.ctx, "<code>", mock.Anything, mock.Anything).Return(domain.ExternalIdentity{
+		Subject: "<subject>",
+		Email:   suite.email,
+	}, nil)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.provider, suite.loginState.State, "<code>")
+
+	require.ErrorIs(err, ErrExternalEmailNotVerified)
+	require.Empty(token)
+}
+
+func (suite *TestOidcSuite) TestLoginErrorTransaction() {
+	require := require.New(suite.T())
+	errExpected := errors.New("Error in transaction")
+	suite.mockExchange()
+	suite.mockUserIdentityRepo.On("SearchAllByExample", suite.ctx, mock.Anything).Return([]domain.UserIdentity{}, nil)
+	suite.mockUserRepo.On("ExistsByExample", suite.ctx, mock.Anything).Return(false, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(errExpected)
+
+	token, _, err := suite.app.Login(suite.ctx, suite.provider, suite.loginState.State, "<code>")
+
+	require.EqualError(errExpected, err.Error())
+	require.Empty(token)
+}
+
+func (suite *TestOidcSuite) TestDeleteExpiredSuccess() {
+	require := require.New(suite.T())
+	suite.mockOidcStateRepo.On("DeleteByExpiresAtLessThanNow", suite.ctx).Return(nil)
+
+	err := suite.app.DeleteExpired(suite.ctx)
+
+	require.NoError(err)
+}
+
+func TestTestOidcSuite(t *testing.T) {
+	suite.Run(t, new(TestOidcSuite))
+}
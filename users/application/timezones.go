@@ -0,0 +1,24 @@
+package application
+
+import (
+	"context"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/users/domain"
+)
+
+type timezoneReader struct {
+	userRepo domain.UserRepository
+}
+
+func (r *timezoneReader) FindTimezone(ctx context.Context, userId uint) (string, error) {
+	user, err := r.userRepo.Search(ctx, userId)
+	if err != nil {
+		return "", err
+	}
+
+	return user.Timezone, nil
+}
+
+func NewTimezoneReader(userRepo domain.UserRepository) shared.TimezoneReader {
+	return &timezoneReader{userRepo}
+}
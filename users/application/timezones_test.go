@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"testing"
+	mocks_domain "your-accounts-api/mocks/users/domain"
+	"your-accounts-api/shared/domain"
+	users "your-accounts-api/users/domain"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestTimezoneSuite struct {
+	suite.Suite
+	userId       uint
+	mockUserRepo *mocks_domain.MockUserRepository
+	reader       domain.TimezoneReader
+	ctx          context.Context
+}
+
+func (suite *TestTimezoneSuite) SetupSuite() {
+	suite.userId = 1
+	suite.ctx = context.Background()
+}
+
+func (suite *TestTimezoneSuite) SetupTest() {
+	suite.mockUserRepo = mocks_domain.NewMockUserRepository(suite.T())
+	suite.reader = NewTimezoneReader(suite.mockUserRepo)
+}
+
+func (suite *TestTimezoneSuite) TestFindTimezoneSuccess() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(users.User{ID: suite.userId, Timezone: "America/Bogota"}, nil)
+
+	res, err := suite.reader.FindTimezone(suite.ctx, suite.userId)
+
+	require.NoError(err)
+	require.Equal("America/Bogota", res)
+}
+
+func (suite *TestTimezoneSuite) TestFindTimezoneError() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, suite.userId).Return(users.User{}, gorm.ErrRecordNotFound)
+
+	_, err := suite.reader.FindTimezone(suite.ctx, suite.userId)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestTestTimezoneSuite(t *testing.T) {
+	suite.Run(t, new(TestTimezoneSuite))
+}
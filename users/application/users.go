@@ -2,32 +2,66 @@ package application
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
+	budgets "your-accounts-api/budgets/domain"
+	"your-accounts-api/shared/application"
 	shared "your-accounts-api/shared/domain"
 	"your-accounts-api/shared/domain/persistent"
 	"your-accounts-api/shared/infrastructure/config"
 	"your-accounts-api/users/domain"
 
 	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
 )
 
 var (
-	ErrUserAlreadyExists = errors.New("user already exists")
-	ErrTokenRefreshed    = errors.New("token already refreshed")
+	ErrUserAlreadyExists        = errors.New("user already exists")
+	ErrTokenRefreshed           = errors.New("token already refreshed")
+	ErrInvalidConfirmation      = errors.New("invalid confirmation")
+	ErrDeletionAlreadyScheduled = errors.New("deletion already scheduled")
+	ErrDeletionNotScheduled     = errors.New("deletion not scheduled")
+	ErrInvalidTimezone          = errors.New("invalid timezone")
+	ErrEmailNotVerified         = errors.New("email not verified")
+	ErrEmailAlreadyVerified     = errors.New("email already verified")
+	ErrInvalidVerification      = errors.New("invalid or expired verification token")
+	ErrSameEmail                = errors.New("new email is the same as the current one")
+	ErrUserDisabled             = errors.New("user disabled")
+	ErrAccountLocked            = errors.New("account temporarily locked")
+	ErrTooManyAttempts          = errors.New("too many login attempts")
 )
 
 type IUserApp interface {
 	Create(ctx context.Context, email string) (uint, error)
-	Login(ctx context.Context, email string) (string, time.Time, error)
+	Login(ctx context.Context, email, ip string) (string, time.Time, error)
 	DeleteExpired(ctx context.Context) error
+	FindById(ctx context.Context, id uint) (domain.User, error)
+	UpdateProfile(ctx context.Context, id uint, profile domain.UserProfile) (domain.User, error)
+	RequestDeletion(ctx context.Context, id uint, confirmation string) (time.Time, error)
+	CancelDeletion(ctx context.Context, id uint) error
+	DeleteScheduled(ctx context.Context) error
+	Export(ctx context.Context, id uint) (domain.UserData, error)
+	RequestVerification(ctx context.Context, id uint) error
+	VerifyEmail(ctx context.Context, token string) error
+	ChangeEmail(ctx context.Context, id uint, email string) error
+	ValidateSession(ctx context.Context, token string) error
 }
 
 type userApp struct {
-	tm            persistent.TransactionManager
-	userRepo      domain.UserRepository
-	userTokenRepo domain.UserTokenRepository
+	tm                   persistent.TransactionManager
+	userRepo             domain.UserRepository
+	userTokenRepo        domain.UserTokenRepository
+	userVerificationRepo domain.UserVerificationRepository
+	loginAttemptRepo     domain.LoginAttemptRepository
+	budgetRepo           budgets.BudgetRepository
+	logApp               application.ILogApp
+	eventApp             application.IEventApp
+	mailer               shared.Mailer
 }
 
 func (app *userApp) Create(ctx context.Context, email string) (uint, error) {
@@ -42,56 +76,476 @@ func (app *userApp) Create(ctx context.Context, email string) (uint, error) {
 		return 0, ErrUserAlreadyExists
 	}
 
-	user := domain.User{
+	var id uint
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		userRepo := app.userRepo.WithTransaction(tx)
+		user := domain.User{
+			Email: email,
+		}
+
+		var err error
+		id, err = userRepo.Save(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		return app.sendVerification(ctx, tx, id, email)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (app *userApp) Login(ctx context.Context, email, ip string) (string, time.Time, error) {
+	email = strings.ToLower(email)
+	user, err := app.userRepo.SearchByExample(ctx, domain.User{
 		Email: email,
+	})
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		// The errors of the database are not failures of the login, they are not counted
+		return "", time.Time{}, err
+	}
+
+	failures, errAttempts := app.checkLoginAttempts(ctx, email, ip, user.LockedUntil)
+	if errAttempts != nil {
+		return "", time.Time{}, errAttempts
+	}
+
+	if user.LockedUntil != nil && user.LockedUntil.After(time.Now()) {
+		return "", time.Time{}, ErrAccountLocked
+	} else if err == nil && user.DisabledAt != nil {
+		err = ErrUserDisabled
 	}
-	return app.userRepo.Save(ctx, user)
+
+	// The failures are counted by email whether or not the user exists, so an active user is
+	// locked too once its email reaches the threshold
+	if err != nil || failures >= config.LOGIN_LOCKOUT_THRESHOLD {
+		if errAttempt := app.saveLoginAttempt(ctx, email, ip, false); errAttempt != nil {
+			return "", time.Time{}, errAttempt
+		}
+
+		if user.ID != 0 && failures+1 >= config.LOGIN_LOCKOUT_THRESHOLD {
+			if errLock := app.lockAccount(ctx, user, ip, failures+1); errLock != nil {
+				return "", time.Time{}, errLock
+			}
+
+			return "", time.Time{}, ErrAccountLocked
+		}
+
+		return "", time.Time{}, err
+	}
+
+	if err := app.saveLoginAttempt(ctx, email, ip, true); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return createUserToken(ctx, app.userTokenRepo, user)
 }
 
-func (app *userApp) Login(ctx context.Context, email string) (string, time.Time, error) {
-	example := domain.User{
-		Email: strings.ToLower(email),
+func (app *userApp) DeleteExpired(ctx context.Context) error {
+	err := app.userTokenRepo.DeleteByExpiresAtGreaterThanNow(ctx)
+	if err != nil {
+		return err
 	}
-	user, err := app.userRepo.SearchByExample(ctx, example)
+
+	err = app.userVerificationRepo.DeleteByExpiresAtLessThanNow(ctx)
 	if err != nil {
-		return "", time.Time{}, err
+		return err
 	}
 
-	token, expiresAt, err := jwtGenerate(user.ID)
+	err = app.loginAttemptRepo.DeleteByCreatedAtLessThan(ctx, time.Now().Add(-config.LOGIN_ATTEMPT_RETENTION))
 	if err != nil {
-		return "", time.Time{}, err
+		return err
 	}
 
-	userToken := domain.UserToken{
-		Token:     token,
-		UserId:    user.ID,
-		ExpiresAt: expiresAt,
+	return nil
+}
+
+func (app *userApp) FindById(ctx context.Context, id uint) (domain.User, error) {
+	return app.userRepo.Search(ctx, id)
+}
+
+func (app *userApp) UpdateProfile(ctx context.Context, id uint, profile domain.UserProfile) (domain.User, error) {
+	user, err := app.userRepo.Search(ctx, id)
+	if err != nil {
+		return domain.User{}, err
 	}
-	_, err = app.userTokenRepo.Save(ctx, userToken)
+
+	detail := map[string]any{}
+	if profile.DisplayName != nil {
+		user.DisplayName = *profile.DisplayName
+		detail["displayName"] = user.DisplayName
+	}
+
+	if profile.Locale != nil {
+		user.Locale = *profile.Locale
+		detail["locale"] = user.Locale
+	}
+
+	if profile.Timezone != nil {
+		if _, err := time.LoadLocation(*profile.Timezone); err != nil {
+			return domain.User{}, ErrInvalidTimezone
+		}
+
+		user.Timezone = *profile.Timezone
+		detail["timezone"] = user.Timezone
+	}
+
+	if profile.Currency != nil {
+		user.Currency = strings.ToUpper(*profile.Currency)
+		detail["currency"] = user.Currency
+	}
+
+	if profile.WeekStartDay != nil {
+		user.WeekStartDay = *profile.WeekStartDay
+		detail["weekStartDay"] = user.WeekStartDay
+	}
+
+	if profile.MonthStartDay != nil {
+		user.MonthStartDay = *profile.MonthStartDay
+		detail["monthStartDay"] = user.MonthStartDay
+	}
+
+	if profile.NotifyByEmail != nil {
+		user.NotifyByEmail = *profile.NotifyByEmail
+		detail["notifyByEmail"] = user.NotifyByEmail
+	}
+
+	if profile.NotifyPendingBills != nil {
+		user.NotifyPendingBills = *profile.NotifyPendingBills
+		detail["notifyPendingBills"] = user.NotifyPendingBills
+	}
+
+	if len(detail) == 0 {
+		return user, nil
+	}
+
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		userRepo := app.userRepo.WithTransaction(tx)
+		_, err := userRepo.Save(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		return app.logApp.Create(ctx, "Se actualiza el perfil", shared.User, id, detail, tx)
+	})
 	if err != nil {
-		return "", time.Time{}, err
+		return domain.User{}, err
 	}
 
-	return token, expiresAt, nil
+	return user, nil
 }
 
-func (app *userApp) DeleteExpired(ctx context.Context) error {
-	err := app.userTokenRepo.DeleteByExpiresAtGreaterThanNow(ctx)
+func (app *userApp) RequestDeletion(ctx context.Context, id uint, confirmation string) (time.Time, error) {
+	user, err := app.userRepo.Search(ctx, id)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if user.EmailVerifiedAt == nil {
+		return time.Time{}, ErrEmailNotVerified
+	} else if user.Email != strings.ToLower(confirmation) {
+		return time.Time{}, ErrInvalidConfirmation
+	} else if user.DeleteAt != nil {
+		return time.Time{}, ErrDeletionAlreadyScheduled
+	}
+
+	deleteAt := time.Now().Add(config.ACCOUNT_DELETION_GRACE)
+	user.DeleteAt = &deleteAt
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		userRepo := app.userRepo.WithTransaction(tx)
+		_, err := userRepo.Save(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"deleteAt": deleteAt,
+		}
+		return app.logApp.Create(ctx, "Solicitud de eliminación de la cuenta", shared.User, id, detail, tx)
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return deleteAt, nil
+}
+
+func (app *userApp) CancelDeletion(ctx context.Context, id uint) error {
+	user, err := app.userRepo.Search(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	if user.DeleteAt == nil {
+		return ErrDeletionNotScheduled
+	}
+
+	user.DeleteAt = nil
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		userRepo := app.userRepo.WithTransaction(tx)
+		_, err := userRepo.Save(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		return app.logApp.Create(ctx, "Cancelación de la eliminación de la cuenta", shared.User, id, nil, tx)
+	})
+}
+
+func (app *userApp) DeleteScheduled(ctx context.Context) error {
+	users, err := app.userRepo.SearchAllByDeleteAtLessThanNow(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		userC := user
+		err := app.tm.Transaction(func(tx persistent.Transaction) error {
+			userRepo := app.userRepo.WithTransaction(tx)
+			if err := userRepo.Delete(ctx, userC.ID); err != nil {
+				return err
+			}
+
+			detail := map[string]any{
+				"requestedDeleteAt": userC.DeleteAt,
+			}
+			if err := app.logApp.Create(ctx, "Eliminación de la cuenta", shared.User, userC.ID, detail, tx); err != nil {
+				return err
+			}
+
+			// The modules remove the rows of the user when the event is delivered
+			return app.eventApp.Publish(ctx, shared.UserDeleted, userC.ID, nil, tx)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func NewUserApp(tm persistent.TransactionManager, userRepo domain.UserRepository, userTokenRepo domain.UserTokenRepository) IUserApp {
-	return &userApp{tm, userRepo, userTokenRepo}
+func (app *userApp) Export(ctx context.Context, id uint) (domain.UserData, error) {
+	user, err := app.userRepo.Search(ctx, id)
+	if err != nil {
+		return domain.UserData{}, err
+	}
+
+	if user.EmailVerifiedAt == nil {
+		return domain.UserData{}, ErrEmailNotVerified
+	}
+
+	budgetList, err := app.budgetRepo.SearchAllByUserId(ctx, id)
+	if err != nil {
+		return domain.UserData{}, err
+	}
+
+	logs, err := app.logApp.FindAllByProject(ctx, shared.User, id)
+	if err != nil {
+		return domain.UserData{}, err
+	}
+
+	for _, budget := range budgetList {
+		budgetLogs, err := app.logApp.FindAllByProject(ctx, shared.Budget, *budget.ID)
+		if err != nil {
+			return domain.UserData{}, err
+		}
+		logs = append(logs, budgetLogs...)
+
+		for _, bill := range budget.BudgetBills {
+			billLogs, err := app.logApp.FindAllByProject(ctx, shared.BudgetBill, *bill.ID)
+			if err != nil {
+				return domain.UserData{}, err
+			}
+			logs = append(logs, billLogs...)
+		}
+	}
+
+	return domain.UserData{
+		User:    user,
+		Budgets: budgetList,
+		Logs:    logs,
+	}, nil
+}
+
+func (app *userApp) RequestVerification(ctx context.Context, id uint) error {
+	user, err := app.userRepo.Search(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return ErrEmailAlreadyVerified
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		return app.sendVerification(ctx, tx, id, user.Email)
+	})
+}
+
+func (app *userApp) VerifyEmail(ctx context.Context, token string) error {
+	verification, err := app.userVerificationRepo.SearchByExample(ctx, domain.UserVerification{
+		Token: verificationTokenHash(token),
+	})
+	if err != nil {
+		return err
+	}
+
+	if verification.ExpiresAt.Before(time.Now()) {
+		return ErrInvalidVerification
+	}
+
+	user, err := app.userRepo.Search(ctx, verification.UserId)
+	if err != nil {
+		return err
+	}
+
+	detail := map[string]any{
+		"email": verification.Email,
+	}
+	description := "Verificación del correo electrónico"
+	if user.Email != verification.Email {
+		exists, err := app.userRepo.ExistsByExample(ctx, domain.User{
+			Email: verification.Email,
+		})
+		if err != nil {
+			return err
+		} else if exists {
+			return ErrUserAlreadyExists
+		}
+
+		detail["previousEmail"] = user.Email
+		description = "Cambio del correo electrónico"
+		user.Email = verification.Email
+	}
+
+	verifiedAt := time.Now()
+	user.EmailVerifiedAt = &verifiedAt
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		userRepo := app.userRepo.WithTransaction(tx)
+		_, err := userRepo.Save(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		userVerificationRepo := app.userVerificationRepo.WithTransaction(tx)
+		err = userVerificationRepo.DeleteByUserId(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		return app.logApp.Create(ctx, description, shared.User, user.ID, detail, tx)
+	})
+}
+
+func (app *userApp) ChangeEmail(ctx context.Context, id uint, email string) error {
+	email = strings.ToLower(email)
+	user, err := app.userRepo.Search(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if user.Email == email {
+		return ErrSameEmail
+	}
+
+	exists, err := app.userRepo.ExistsByExample(ctx, domain.User{
+		Email: email,
+	})
+	if err != nil {
+		return err
+	} else if exists {
+		return ErrUserAlreadyExists
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		err := app.sendVerification(ctx, tx, id, email)
+		if err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"email": email,
+		}
+		return app.logApp.Create(ctx, "Solicitud de cambio del correo electrónico", shared.User, id, detail, tx)
+	})
+}
+
+func (app *userApp) ValidateSession(ctx context.Context, token string) error {
+	_, err := app.userTokenRepo.SearchByExample(ctx, domain.UserToken{
+		Token: token,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
-var jwtGenerate = func(id uint) (string, time.Time, error) {
+func (app *userApp) sendVerification(ctx context.Context, tx persistent.Transaction, userId uint, email string) error {
+	token, err := verificationTokenGenerate()
+	if err != nil {
+		return err
+	}
+
+	userVerificationRepo := app.userVerificationRepo.WithTransaction(tx)
+	verification := domain.UserVerification{
+		Token:     verificationTokenHash(token),
+		Email:     email,
+		UserId:    userId,
+		ExpiresAt: time.Now().Add(config.EMAIL_VERIFICATION_TTL),
+	}
+	_, err = userVerificationRepo.Save(ctx, verification)
+	if err != nil {
+		return err
+	}
+
+	return app.mailer.Send(ctx, shared.Mail{
+		To:      email,
+		Subject: "Verificación del correo electrónico",
+		Body:    fmt.Sprintf("Utiliza el siguiente código para verificar tu correo electrónico: %s", token),
+	})
+}
+
+func NewUserApp(
+	tm persistent.TransactionManager, userRepo domain.UserRepository, userTokenRepo domain.UserTokenRepository,
+	userVerificationRepo domain.UserVerificationRepository, loginAttemptRepo domain.LoginAttemptRepository,
+	budgetRepo budgets.BudgetRepository, logApp application.ILogApp, eventApp application.IEventApp, mailer shared.Mailer,
+) IUserApp {
+	return &userApp{tm, userRepo, userTokenRepo, userVerificationRepo, loginAttemptRepo, budgetRepo, logApp, eventApp, mailer}
+}
+
+func createUserToken(ctx context.Context, userTokenRepo domain.UserTokenRepository, user domain.User) (string, time.Time, error) {
+	role := user.Role
+	if role == "" {
+		role = shared.UserRole
+	}
+
+	token, expiresAt, err := jwtGenerate(user.ID, role)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	userToken := domain.UserToken{
+		Token:     token,
+		UserId:    user.ID,
+		ExpiresAt: expiresAt,
+	}
+	_, err = userTokenRepo.Save(ctx, userToken)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token, expiresAt, nil
+}
+
+var jwtGenerate = func(id uint, role shared.Role) (string, time.Time, error) {
 	expiresAt := time.Now().Add(720 * time.Hour)
 	t := jwt.NewWithClaims(jwt.SigningMethodHS256, &shared.JwtUserClaims{
-		ID: id,
+		ID:   id,
+		Role: role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -105,3 +559,19 @@ var jwtGenerate = func(id uint) (string, time.Time, error) {
 
 	return token, expiresAt, nil
 }
+
+// verificationTokenHash is what is stored of the token sent by mail, so a read of the table can
+// not verify emails
+func verificationTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+var verificationTokenGenerate = func() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
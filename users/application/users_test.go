@@ -3,10 +3,17 @@ package application
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
+	budgets "your-accounts-api/budgets/domain"
+	mocks_budgets "your-accounts-api/mocks/budgets/domain"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_shared "your-accounts-api/mocks/shared/domain"
 	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
 	mocks_domain "your-accounts-api/mocks/users/domain"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
 	"your-accounts-api/shared/infrastructure/config"
 	"your-accounts-api/users/domain"
 
@@ -20,31 +27,50 @@ import (
 type TestSuite struct {
 	suite.Suite
 	email                  string
+	ip                     string
 	token                  string
+	verifiedAt             time.Time
 	mockTransactionManager *mocks_persistent.MockTransactionManager
 	mockUserRepo           *mocks_domain.MockUserRepository
 	mockUserTokenRepo      *mocks_domain.MockUserTokenRepository
+	mockVerificationRepo   *mocks_domain.MockUserVerificationRepository
+	mockLoginAttemptRepo   *mocks_domain.MockLoginAttemptRepository
+	mockBudgetRepo         *mocks_budgets.MockBudgetRepository
+	mockLogApp             *mocks_application.MockILogApp
+	mockEventApp           *mocks_application.MockIEventApp
+	mockMailer             *mocks_shared.MockMailer
 	app                    IUserApp
 	ctx                    context.Context
-	originalJwtGenerate    func(id uint) (string, time.Time, error)
+	originalJwtGenerate    func(id uint, role shared.Role) (string, time.Time, error)
+	originalTokenGenerate  func() (string, error)
 	originalJwtSecret      []byte
 }
 
 func (suite *TestSuite) SetupSuite() {
 	suite.email = "example@exaple.com"
+	suite.ip = "10.0.0.1"
 	suite.token = "<token>"
+	suite.verifiedAt = time.Now()
 	suite.ctx = context.Background()
 	suite.originalJwtGenerate = jwtGenerate
+	suite.originalTokenGenerate = verificationTokenGenerate
 	suite.originalJwtSecret = config.JWT_SECRET
 }
 
 func (suite *TestSuite) SetupTest() {
 	jwtGenerate = suite.originalJwtGenerate
+	verificationTokenGenerate = suite.originalTokenGenerate
 	config.JWT_SECRET = suite.originalJwtSecret
 	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
 	suite.mockUserRepo = mocks_domain.NewMockUserRepository(suite.T())
 	suite.mockUserTokenRepo = mocks_domain.NewMockUserTokenRepository(suite.T())
-	suite.app = NewUserApp(suite.mockTransactionManager, suite.mockUserRepo, suite.mockUserTokenRepo)
+	suite.mockVerificationRepo = mocks_domain.NewMockUserVerificationRepository(suite.T())
+	suite.mockLoginAttemptRepo = mocks_domain.NewMockLoginAttemptRepository(suite.T())
+	suite.mockBudgetRepo = mocks_budgets.NewMockBudgetRepository(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.mockEventApp = mocks_application.NewMockIEventApp(suite.T())
+	suite.mockMailer = mocks_shared.NewMockMailer(suite.T())
+	suite.app = NewUserApp(suite.mockTransactionManager, suite.mockUserRepo, suite.mockUserTokenRepo, suite.mockVerificationRepo, suite.mockLoginAttemptRepo, suite.mockBudgetRepo, suite.mockLogApp, suite.mockEventApp, suite.mockMailer)
 }
 
 func (suite *TestSuite) TestCreateSuccess() {
@@ -55,7 +81,21 @@ func (suite *TestSuite) TestCreateSuccess() {
 	suite.mockUserRepo.On("ExistsByExample", suite.ctx, domain.User{
 		Email: suite.email,
 	}).Return(false, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
 	suite.mockUserRepo.On("Save", suite.ctx, user).Return(uint(999), nil)
+	verificationTokenGenerate = func() (string, error) {
+		return suite.token, nil
+	}
+	suite.mockVerificationRepo.On("WithTransaction", nil).Return(suite.mockVerificationRepo)
+	suite.mockVerificationRepo.On("Save", suite.ctx, mock.MatchedBy(func(v domain.UserVerification) bool {
+		return v.Token == verificationTokenHash(suite.token) && v.Email == suite.email && v.UserId == 999 && v.ExpiresAt.After(time.Now())
+	})).Return(uint(1), nil)
+	suite.mockMailer.On("Send", suite.ctx, mock.MatchedBy(func(m shared.Mail) bool {
+		return m.To == suite.email && strings.Contains(m.Body, suite.token)
+	})).Return(nil)
 
 	res, err := suite.app.Create(suite.ctx, suite.email)
 
@@ -63,6 +103,27 @@ func (suite *TestSuite) TestCreateSuccess() {
 	require.Equal(res, uint(999))
 }
 
+func (suite *TestSuite) TestCreateErrorSendVerification() {
+	require := require.New(suite.T())
+	errExpected := errors.New("not sent")
+	suite.mockUserRepo.On("ExistsByExample", suite.ctx, domain.User{
+		Email: suite.email,
+	}).Return(false, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, mock.Anything).Return(uint(999), nil)
+	suite.mockVerificationRepo.On("WithTransaction", nil).Return(suite.mockVerificationRepo)
+	suite.mockVerificationRepo.On("Save", suite.ctx, mock.Anything).Return(uint(1), nil)
+	suite.mockMailer.On("Send", suite.ctx, mock.Anything).Return(errExpected)
+
+	res, err := suite.app.Create(suite.ctx, suite.email)
+
+	require.EqualError(errExpected, err.Error())
+	require.Zero(res)
+}
+
 func (suite *TestSuite) TestCreateErrorExists() {
 	require := require.New(suite.T())
 	errExpected := errors.New("Not exists")
@@ -97,6 +158,10 @@ func (suite *TestSuite) TestCreateErrorCreate() {
 	suite.mockUserRepo.On("ExistsByExample", suite.ctx, domain.User{
 		Email: suite.email,
 	}).Return(false, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
 	suite.mockUserRepo.On("Save", suite.ctx, user).Return(uint(0), errExpected)
 
 	res, err := suite.app.Create(suite.ctx, suite.email)
@@ -107,6 +172,8 @@ func (suite *TestSuite) TestCreateErrorCreate() {
 
 func (suite *TestSuite) TestLoginSuccessOri() {
 	require := require.New(suite.T())
+	suite.mockLoginAttempts(nil)
+	suite.mockSaveAttempt(true)
 	userExpected := domain.User{
 		ID:    999,
 		Email: suite.email,
@@ -116,7 +183,7 @@ func (suite *TestSuite) TestLoginSuccessOri() {
 	}).Return(userExpected, nil)
 	suite.mockUserTokenRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
 
-	token, expires, err := suite.app.Login(suite.ctx, suite.email)
+	token, expires, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
 
 	require.NoError(err)
 	require.NotEmpty(token)
@@ -125,12 +192,14 @@ func (suite *TestSuite) TestLoginSuccessOri() {
 
 func (suite *TestSuite) TestLoginSuccessMock() {
 	require := require.New(suite.T())
+	suite.mockLoginAttempts(nil)
+	suite.mockSaveAttempt(true)
 	userExpected := domain.User{
 		ID:    999,
 		Email: suite.email,
 	}
 	expiresAt := time.Now().Add(1 * time.Hour)
-	jwtGenerate = func(id uint) (string, time.Time, error) {
+	jwtGenerate = func(id uint, role shared.Role) (string, time.Time, error) {
 		return suite.token, expiresAt, nil
 	}
 	suite.mockUserRepo.On("SearchByExample", suite.ctx, domain.User{
@@ -138,21 +207,62 @@ func (suite *TestSuite) TestLoginSuccessMock() {
 	}).Return(userExpected, nil)
 	suite.mockUserTokenRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
 
-	token, expires, err := suite.app.Login(suite.ctx, suite.email)
+	token, expires, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
 
 	require.NoError(err)
 	require.Equal(suite.token, token)
 	require.Equal(expiresAt, expires)
 }
 
+func (suite *TestSuite) TestLoginSuccessRole() {
+	require := require.New(suite.T())
+	suite.mockLoginAttempts(nil)
+	suite.mockSaveAttempt(true)
+	userExpected := domain.User{
+		ID:    999,
+		Email: suite.email,
+		Role:  shared.AdminRole,
+	}
+	var roleClaim shared.Role
+	jwtGenerate = func(id uint, role shared.Role) (string, time.Time, error) {
+		roleClaim = role
+		return suite.token, time.Now(), nil
+	}
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(userExpected, nil)
+	suite.mockUserTokenRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), nil)
+
+	_, _, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.NoError(err)
+	require.Equal(shared.AdminRole, roleClaim)
+}
+
+func (suite *TestSuite) TestLoginErrorDisabled() {
+	require := require.New(suite.T())
+	suite.mockLoginAttempts(nil)
+	suite.mockSaveAttempt(false)
+	disabledAt := time.Now()
+	suite.mockUserRepo.On("SearchByExample", suite.ctx, domain.User{
+		Email: suite.email,
+	}).Return(domain.User{ID: 999, DisabledAt: &disabledAt}, nil)
+
+	token, expires, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
+
+	require.ErrorIs(err, ErrUserDisabled)
+	require.Empty(token)
+	require.Empty(expires)
+}
+
 func (suite *TestSuite) TestLoginErrorFind() {
 	require := require.New(suite.T())
-	errExpected := errors.New("Not exists")
+	suite.mockLoginAttempts(nil)
+	suite.mockSaveAttempt(false)
+	errExpected := gorm.ErrRecordNotFound
 	suite.mockUserRepo.On("SearchByExample", suite.ctx, domain.User{
 		Email: suite.email,
 	}).Return(domain.User{}, errExpected)
 
-	token, expires, err := suite.app.Login(suite.ctx, suite.email)
+	token, expires, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
 
 	require.EqualError(errExpected, err.Error())
 	require.Empty(token)
@@ -161,18 +271,20 @@ func (suite *TestSuite) TestLoginErrorFind() {
 
 func (suite *TestSuite) TestLoginErrorJWTGenerateMock() {
 	require := require.New(suite.T())
+	suite.mockLoginAttempts(nil)
+	suite.mockSaveAttempt(true)
 	userExpected := domain.User{
 		ID:    999,
 		Email: suite.email,
 	}
-	jwtGenerate = func(id uint) (string, time.Time, error) {
+	jwtGenerate = func(id uint, role shared.Role) (string, time.Time, error) {
 		return "", time.Time{}, jwt.ErrInvalidKey
 	}
 	suite.mockUserRepo.On("SearchByExample", suite.ctx, domain.User{
 		Email: suite.email,
 	}).Return(userExpected, nil)
 
-	token, expires, err := suite.app.Login(suite.ctx, suite.email)
+	token, expires, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
 
 	require.EqualError(jwt.ErrInvalidKey, err.Error())
 	require.Empty(token)
@@ -181,12 +293,14 @@ func (suite *TestSuite) TestLoginErrorJWTGenerateMock() {
 
 func (suite *TestSuite) TestLoginErrorCreateUserToken() {
 	require := require.New(suite.T())
+	suite.mockLoginAttempts(nil)
+	suite.mockSaveAttempt(true)
 	userExpected := domain.User{
 		ID:    999,
 		Email: suite.email,
 	}
 	expiresAt := time.Now().Add(1 * time.Hour)
-	jwtGenerate = func(id uint) (string, time.Time, error) {
+	jwtGenerate = func(id uint, role shared.Role) (string, time.Time, error) {
 		return suite.token, expiresAt, nil
 	}
 	errExpected := errors.New("Error constraint")
@@ -195,7 +309,7 @@ func (suite *TestSuite) TestLoginErrorCreateUserToken() {
 	}).Return(userExpected, nil)
 	suite.mockUserTokenRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), errExpected)
 
-	token, expires, err := suite.app.Login(suite.ctx, suite.email)
+	token, expires, err := suite.app.Login(suite.ctx, suite.email, suite.ip)
 
 	require.EqualError(errExpected, err.Error())
 	require.Empty(token)
@@ -205,6 +319,10 @@ func (suite *TestSuite) TestLoginErrorCreateUserToken() {
 func (suite *TestSuite) TestDeleteExpiredSuccess() {
 	require := require.New(suite.T())
 	suite.mockUserTokenRepo.On("DeleteByExpiresAtGreaterThanNow", suite.ctx).Return(nil)
+	suite.mockVerificationRepo.On("DeleteByExpiresAtLessThanNow", suite.ctx).Return(nil)
+	suite.mockLoginAttemptRepo.On("DeleteByCreatedAtLessThan", suite.ctx, mock.MatchedBy(func(before time.Time) bool {
+		return before.Before(time.Now().Add(-config.LOGIN_ATTEMPT_RETENTION + time.Second))
+	})).Return(nil)
 
 	err := suite.app.DeleteExpired(suite.ctx)
 
@@ -220,6 +338,709 @@ func (suite *TestSuite) TestDeleteExpiredError() {
 	require.EqualError(gorm.ErrRecordNotFound, err.Error())
 }
 
+func (suite *TestSuite) TestDeleteExpiredErrorVerifications() {
+	require := require.New(suite.T())
+	suite.mockUserTokenRepo.On("DeleteByExpiresAtGreaterThanNow", suite.ctx).Return(nil)
+	suite.mockVerificationRepo.On("DeleteByExpiresAtLessThanNow", suite.ctx).Return(gorm.ErrInvalidField)
+
+	err := suite.app.DeleteExpired(suite.ctx)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteExpiredErrorLoginAttempts() {
+	require := require.New(suite.T())
+	suite.mockUserTokenRepo.On("DeleteByExpiresAtGreaterThanNow", suite.ctx).Return(nil)
+	suite.mockVerificationRepo.On("DeleteByExpiresAtLessThanNow", suite.ctx).Return(nil)
+	suite.mockLoginAttemptRepo.On("DeleteByCreatedAtLessThan", suite.ctx, mock.Anything).Return(gorm.ErrInvalidField)
+
+	err := suite.app.DeleteExpired(suite.ctx)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestFindByIdSuccess() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:    999,
+		Email: suite.email,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+
+	res, err := suite.app.FindById(suite.ctx, user.ID)
+
+	require.NoError(err)
+	require.Equal(user, res)
+}
+
+func (suite *TestSuite) TestFindByIdError() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	_, err := suite.app.FindById(suite.ctx, 999)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+}
+
+func (suite *TestSuite) TestUpdateProfileSuccess() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:       999,
+		Email:    suite.email,
+		Locale:   "es-CO",
+		Timezone: "America/Bogota",
+		Currency: "COP",
+	}
+	displayName := "Test"
+	timezone := "America/New_York"
+	currency := "usd"
+	monthStartDay := uint8(15)
+	notifyByEmail := false
+	expected := user
+	expected.DisplayName = displayName
+	expected.Timezone = timezone
+	expected.Currency = "USD"
+	expected.MonthStartDay = monthStartDay
+	expected.NotifyByEmail = notifyByEmail
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, expected).Return(user.ID, nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, user.ID, map[string]any{
+		"displayName":   displayName,
+		"timezone":      timezone,
+		"currency":      "USD",
+		"monthStartDay": monthStartDay,
+		"notifyByEmail": notifyByEmail,
+	}, nil).Return(nil)
+
+	res, err := suite.app.UpdateProfile(suite.ctx, user.ID, domain.UserProfile{
+		DisplayName:   &displayName,
+		Timezone:      &timezone,
+		Currency:      &currency,
+		MonthStartDay: &monthStartDay,
+		NotifyByEmail: &notifyByEmail,
+	})
+
+	require.NoError(err)
+	require.Equal(expected, res)
+}
+
+func (suite *TestSuite) TestUpdateProfileSuccessNoChanges() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:    999,
+		Email: suite.email,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+
+	res, err := suite.app.UpdateProfile(suite.ctx, user.ID, domain.UserProfile{})
+
+	require.NoError(err)
+	require.Equal(user, res)
+}
+
+func (suite *TestSuite) TestUpdateProfileErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	_, err := suite.app.UpdateProfile(suite.ctx, 999, domain.UserProfile{})
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+}
+
+func (suite *TestSuite) TestUpdateProfileErrorTimezone() {
+	require := require.New(suite.T())
+	timezone := "Invalid/Timezone"
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{ID: 999}, nil)
+
+	_, err := suite.app.UpdateProfile(suite.ctx, 999, domain.UserProfile{
+		Timezone: &timezone,
+	})
+
+	require.EqualError(ErrInvalidTimezone, err.Error())
+}
+
+func (suite *TestSuite) TestUpdateProfileErrorSave() {
+	require := require.New(suite.T())
+	locale := "en-US"
+	user := domain.User{
+		ID:    999,
+		Email: suite.email,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), gorm.ErrInvalidField)
+
+	_, err := suite.app.UpdateProfile(suite.ctx, user.ID, domain.UserProfile{
+		Locale: &locale,
+	})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestRequestDeletionSuccess() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:              999,
+		Email:           suite.email,
+		EmailVerifiedAt: &suite.verifiedAt,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, mock.MatchedBy(func(u domain.User) bool {
+		return u.ID == user.ID && u.DeleteAt != nil
+	})).Return(user.ID, nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, user.ID, mock.Anything, nil).Return(nil)
+
+	deleteAt, err := suite.app.RequestDeletion(suite.ctx, user.ID, "Example@Exaple.com")
+
+	require.NoError(err)
+	require.WithinDuration(time.Now().Add(config.ACCOUNT_DELETION_GRACE), deleteAt, time.Minute)
+}
+
+func (suite *TestSuite) TestRequestDeletionErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	deleteAt, err := suite.app.RequestDeletion(suite.ctx, 999, suite.email)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(deleteAt)
+}
+
+func (suite *TestSuite) TestRequestDeletionErrorNotVerified() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:    999,
+		Email: suite.email,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+
+	deleteAt, err := suite.app.RequestDeletion(suite.ctx, user.ID, suite.email)
+
+	require.EqualError(ErrEmailNotVerified, err.Error())
+	require.Zero(deleteAt)
+}
+
+func (suite *TestSuite) TestRequestDeletionErrorConfirmation() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:              999,
+		Email:           suite.email,
+		EmailVerifiedAt: &suite.verifiedAt,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+
+	deleteAt, err := suite.app.RequestDeletion(suite.ctx, user.ID, "other@exaple.com")
+
+	require.EqualError(ErrInvalidConfirmation, err.Error())
+	require.Zero(deleteAt)
+}
+
+func (suite *TestSuite) TestRequestDeletionErrorAlreadyScheduled() {
+	require := require.New(suite.T())
+	scheduled := time.Now()
+	user := domain.User{
+		ID:              999,
+		Email:           suite.email,
+		EmailVerifiedAt: &suite.verifiedAt,
+		DeleteAt:        &scheduled,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+
+	deleteAt, err := suite.app.RequestDeletion(suite.ctx, user.ID, suite.email)
+
+	require.EqualError(ErrDeletionAlreadyScheduled, err.Error())
+	require.Zero(deleteAt)
+}
+
+func (suite *TestSuite) TestRequestDeletionErrorSave() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:              999,
+		Email:           suite.email,
+		EmailVerifiedAt: &suite.verifiedAt,
+	}
+	errExpected := errors.New("not updated")
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), errExpected)
+
+	deleteAt, err := suite.app.RequestDeletion(suite.ctx, user.ID, suite.email)
+
+	require.EqualError(errExpected, err.Error())
+	require.Zero(deleteAt)
+}
+
+func (suite *TestSuite) TestCancelDeletionSuccess() {
+	require := require.New(suite.T())
+	scheduled := time.Now()
+	user := domain.User{
+		ID:       999,
+		Email:    suite.email,
+		DeleteAt: &scheduled,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, domain.User{
+		ID:    user.ID,
+		Email: suite.email,
+	}).Return(user.ID, nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, user.ID, mock.Anything, nil).Return(nil)
+
+	err := suite.app.CancelDeletion(suite.ctx, user.ID)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestCancelDeletionErrorNotScheduled() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:    999,
+		Email: suite.email,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+
+	err := suite.app.CancelDeletion(suite.ctx, user.ID)
+
+	require.EqualError(ErrDeletionNotScheduled, err.Error())
+}
+
+func (suite *TestSuite) TestCancelDeletionErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.CancelDeletion(suite.ctx, 999)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteScheduledSuccess() {
+	require := require.New(suite.T())
+	scheduled := time.Now()
+	users := []domain.User{
+		{ID: 1, Email: suite.email, DeleteAt: &scheduled},
+		{ID: 2, Email: suite.email, DeleteAt: &scheduled},
+	}
+	suite.mockUserRepo.On("SearchAllByDeleteAtLessThanNow", suite.ctx).Return(users, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Delete", suite.ctx, uint(1)).Return(nil).Once()
+	suite.mockUserRepo.On("Delete", suite.ctx, uint(2)).Return(nil).Once()
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, uint(1), mock.Anything, nil).Return(nil).Once()
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, uint(2), mock.Anything, nil).Return(nil).Once()
+	suite.mockEventApp.On("Publish", suite.ctx, shared.UserDeleted, uint(1), map[string]any(nil), nil).Return(nil).Once()
+	suite.mockEventApp.On("Publish", suite.ctx, shared.UserDeleted, uint(2), map[string]any(nil), nil).Return(nil).Once()
+
+	err := suite.app.DeleteScheduled(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteScheduledErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("SearchAllByDeleteAtLessThanNow", suite.ctx).Return(nil, gorm.ErrInvalidField)
+
+	err := suite.app.DeleteScheduled(suite.ctx)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteScheduledErrorDelete() {
+	require := require.New(suite.T())
+	scheduled := time.Now()
+	users := []domain.User{
+		{ID: 1, Email: suite.email, DeleteAt: &scheduled},
+	}
+	suite.mockUserRepo.On("SearchAllByDeleteAtLessThanNow", suite.ctx).Return(users, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Delete", suite.ctx, uint(1)).Return(gorm.ErrInvalidField)
+
+	err := suite.app.DeleteScheduled(suite.ctx)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteScheduledErrorPublish() {
+	require := require.New(suite.T())
+	scheduled := time.Now()
+	users := []domain.User{
+		{ID: 1, Email: suite.email, DeleteAt: &scheduled},
+	}
+	suite.mockUserRepo.On("SearchAllByDeleteAtLessThanNow", suite.ctx).Return(users, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Delete", suite.ctx, uint(1)).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, uint(1), mock.Anything, nil).Return(nil)
+	suite.mockEventApp.On("Publish", suite.ctx, shared.UserDeleted, uint(1), map[string]any(nil), nil).Return(gorm.ErrInvalidField)
+
+	err := suite.app.DeleteScheduled(suite.ctx)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestExportSuccess() {
+	require := require.New(suite.T())
+	budgetId := uint(10)
+	billId := uint(20)
+	user := domain.User{
+		ID:              999,
+		Email:           suite.email,
+		EmailVerifiedAt: &suite.verifiedAt,
+	}
+	budgetList := []budgets.Budget{
+		{
+			ID: &budgetId,
+			BudgetBills: []budgets.BudgetBill{
+				{ID: &billId},
+			},
+		},
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+	suite.mockBudgetRepo.On("SearchAllByUserId", suite.ctx, user.ID).Return(budgetList, nil)
+	suite.mockLogApp.On("FindAllByProject", suite.ctx, shared.User, user.ID).Return([]shared.Log{{ID: 1}}, nil)
+	suite.mockLogApp.On("FindAllByProject", suite.ctx, shared.Budget, budgetId).Return([]shared.Log{{ID: 2}}, nil)
+	suite.mockLogApp.On("FindAllByProject", suite.ctx, shared.BudgetBill, billId).Return([]shared.Log{{ID: 3}}, nil)
+
+	data, err := suite.app.Export(suite.ctx, user.ID)
+
+	require.NoError(err)
+	require.Equal(user, data.User)
+	require.Equal(budgetList, data.Budgets)
+	require.Len(data.Logs, 3)
+}
+
+func (suite *TestSuite) TestExportErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	data, err := suite.app.Export(suite.ctx, 999)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(data)
+}
+
+func (suite *TestSuite) TestExportErrorBudgets() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:              999,
+		Email:           suite.email,
+		EmailVerifiedAt: &suite.verifiedAt,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+	suite.mockBudgetRepo.On("SearchAllByUserId", suite.ctx, user.ID).Return(nil, gorm.ErrInvalidField)
+
+	data, err := suite.app.Export(suite.ctx, user.ID)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(data)
+}
+
+func (suite *TestSuite) TestExportErrorNotVerified() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:    999,
+		Email: suite.email,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+
+	data, err := suite.app.Export(suite.ctx, user.ID)
+
+	require.EqualError(ErrEmailNotVerified, err.Error())
+	require.Zero(data)
+}
+
+func (suite *TestSuite) TestRequestVerificationSuccess() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:    999,
+		Email: suite.email,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockVerificationRepo.On("WithTransaction", nil).Return(suite.mockVerificationRepo)
+	suite.mockVerificationRepo.On("Save", suite.ctx, mock.MatchedBy(func(v domain.UserVerification) bool {
+		return v.Email == suite.email && v.UserId == user.ID
+	})).Return(uint(1), nil)
+	suite.mockMailer.On("Send", suite.ctx, mock.MatchedBy(func(m shared.Mail) bool {
+		return m.To == suite.email
+	})).Return(nil)
+
+	err := suite.app.RequestVerification(suite.ctx, user.ID)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestRequestVerificationErrorAlreadyVerified() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:              999,
+		Email:           suite.email,
+		EmailVerifiedAt: &suite.verifiedAt,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+
+	err := suite.app.RequestVerification(suite.ctx, user.ID)
+
+	require.EqualError(ErrEmailAlreadyVerified, err.Error())
+}
+
+func (suite *TestSuite) TestRequestVerificationErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.RequestVerification(suite.ctx, 999)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+}
+
+func (suite *TestSuite) TestRequestVerificationErrorToken() {
+	require := require.New(suite.T())
+	errExpected := errors.New("no entropy")
+	user := domain.User{
+		ID:    999,
+		Email: suite.email,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	verificationTokenGenerate = func() (string, error) {
+		return "", errExpected
+	}
+
+	err := suite.app.RequestVerification(suite.ctx, user.ID)
+
+	require.EqualError(errExpected, err.Error())
+}
+
+func (suite *TestSuite) TestVerifyEmailSuccess() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:    999,
+		Email: suite.email,
+	}
+	suite.mockVerificationRepo.On("SearchByExample", suite.ctx, domain.UserVerification{Token: verificationTokenHash(suite.token)}).Return(domain.UserVerification{
+		Token:     suite.token,
+		Email:     suite.email,
+		UserId:    user.ID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, mock.MatchedBy(func(u domain.User) bool {
+		return u.Email == suite.email && u.EmailVerifiedAt != nil
+	})).Return(user.ID, nil)
+	suite.mockVerificationRepo.On("WithTransaction", nil).Return(suite.mockVerificationRepo)
+	suite.mockVerificationRepo.On("DeleteByUserId", suite.ctx, user.ID).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, user.ID, map[string]any{
+		"email": suite.email,
+	}, nil).Return(nil)
+
+	err := suite.app.VerifyEmail(suite.ctx, suite.token)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestVerifyEmailSuccessChange() {
+	require := require.New(suite.T())
+	newEmail := "new@exaple.com"
+	user := domain.User{
+		ID:              999,
+		Email:           suite.email,
+		EmailVerifiedAt: &suite.verifiedAt,
+	}
+	suite.mockVerificationRepo.On("SearchByExample", suite.ctx, domain.UserVerification{Token: verificationTokenHash(suite.token)}).Return(domain.UserVerification{
+		Token:     suite.token,
+		Email:     newEmail,
+		UserId:    user.ID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+	suite.mockUserRepo.On("ExistsByExample", suite.ctx, domain.User{Email: newEmail}).Return(false, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, mock.MatchedBy(func(u domain.User) bool {
+		return u.Email == newEmail && u.EmailVerifiedAt != nil
+	})).Return(user.ID, nil)
+	suite.mockVerificationRepo.On("WithTransaction", nil).Return(suite.mockVerificationRepo)
+	suite.mockVerificationRepo.On("DeleteByUserId", suite.ctx, user.ID).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, user.ID, map[string]any{
+		"email":         newEmail,
+		"previousEmail": suite.email,
+	}, nil).Return(nil)
+
+	err := suite.app.VerifyEmail(suite.ctx, suite.token)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestVerifyEmailErrorSearchToken() {
+	require := require.New(suite.T())
+	suite.mockVerificationRepo.On("SearchByExample", suite.ctx, domain.UserVerification{Token: verificationTokenHash(suite.token)}).Return(domain.UserVerification{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.VerifyEmail(suite.ctx, suite.token)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+}
+
+func (suite *TestSuite) TestVerifyEmailErrorExpired() {
+	require := require.New(suite.T())
+	suite.mockVerificationRepo.On("SearchByExample", suite.ctx, domain.UserVerification{Token: verificationTokenHash(suite.token)}).Return(domain.UserVerification{
+		Token:     suite.token,
+		Email:     suite.email,
+		UserId:    999,
+		ExpiresAt: time.Now().Add(-time.Hour),
+	}, nil)
+
+	err := suite.app.VerifyEmail(suite.ctx, suite.token)
+
+	require.EqualError(ErrInvalidVerification, err.Error())
+}
+
+func (suite *TestSuite) TestVerifyEmailErrorEmailTaken() {
+	require := require.New(suite.T())
+	newEmail := "new@exaple.com"
+	suite.mockVerificationRepo.On("SearchByExample", suite.ctx, domain.UserVerification{Token: verificationTokenHash(suite.token)}).Return(domain.UserVerification{
+		Token:     suite.token,
+		Email:     newEmail,
+		UserId:    999,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{ID: 999, Email: suite.email}, nil)
+	suite.mockUserRepo.On("ExistsByExample", suite.ctx, domain.User{Email: newEmail}).Return(true, nil)
+
+	err := suite.app.VerifyEmail(suite.ctx, suite.token)
+
+	require.EqualError(ErrUserAlreadyExists, err.Error())
+}
+
+func (suite *TestSuite) TestVerifyEmailErrorSave() {
+	require := require.New(suite.T())
+	suite.mockVerificationRepo.On("SearchByExample", suite.ctx, domain.UserVerification{Token: verificationTokenHash(suite.token)}).Return(domain.UserVerification{
+		Token:     suite.token,
+		Email:     suite.email,
+		UserId:    999,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{ID: 999, Email: suite.email}, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockUserRepo.On("WithTransaction", nil).Return(suite.mockUserRepo)
+	suite.mockUserRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), gorm.ErrInvalidField)
+
+	err := suite.app.VerifyEmail(suite.ctx, suite.token)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestChangeEmailSuccess() {
+	require := require.New(suite.T())
+	newEmail := "new@exaple.com"
+	user := domain.User{
+		ID:    999,
+		Email: suite.email,
+	}
+	suite.mockUserRepo.On("Search", suite.ctx, user.ID).Return(user, nil)
+	suite.mockUserRepo.On("ExistsByExample", suite.ctx, domain.User{Email: newEmail}).Return(false, nil)
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+	suite.mockVerificationRepo.On("WithTransaction", nil).Return(suite.mockVerificationRepo)
+	suite.mockVerificationRepo.On("Save", suite.ctx, mock.MatchedBy(func(v domain.UserVerification) bool {
+		return v.Email == newEmail && v.UserId == user.ID
+	})).Return(uint(1), nil)
+	suite.mockMailer.On("Send", suite.ctx, mock.MatchedBy(func(m shared.Mail) bool {
+		return m.To == newEmail
+	})).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, mock.Anything, shared.User, user.ID, map[string]any{
+		"email": newEmail,
+	}, nil).Return(nil)
+
+	err := suite.app.ChangeEmail(suite.ctx, user.ID, "New@Exaple.com")
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestChangeEmailErrorSame() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{ID: 999, Email: suite.email}, nil)
+
+	err := suite.app.ChangeEmail(suite.ctx, 999, suite.email)
+
+	require.EqualError(ErrSameEmail, err.Error())
+}
+
+func (suite *TestSuite) TestChangeEmailErrorExists() {
+	require := require.New(suite.T())
+	newEmail := "new@exaple.com"
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{ID: 999, Email: suite.email}, nil)
+	suite.mockUserRepo.On("ExistsByExample", suite.ctx, domain.User{Email: newEmail}).Return(true, nil)
+
+	err := suite.app.ChangeEmail(suite.ctx, 999, newEmail)
+
+	require.EqualError(ErrUserAlreadyExists, err.Error())
+}
+
+func (suite *TestSuite) TestChangeEmailErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockUserRepo.On("Search", suite.ctx, uint(999)).Return(domain.User{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.ChangeEmail(suite.ctx, 999, "new@exaple.com")
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+}
+
+func (suite *TestSuite) TestValidateSessionSuccess() {
+	require := require.New(suite.T())
+	suite.mockUserTokenRepo.On("SearchByExample", suite.ctx, domain.UserToken{
+		Token: suite.token,
+	}).Return(domain.UserToken{ID: 1, Token: suite.token}, nil)
+
+	err := suite.app.ValidateSession(suite.ctx, suite.token)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestValidateSessionError() {
+	require := require.New(suite.T())
+	suite.mockUserTokenRepo.On("SearchByExample", suite.ctx, mock.Anything).Return(domain.UserToken{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.ValidateSession(suite.ctx, suite.token)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
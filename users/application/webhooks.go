@@ -0,0 +1,499 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+	budgets "your-accounts-api/budgets/domain"
+	"your-accounts-api/shared/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/domain/utils/convert"
+	"your-accounts-api/shared/infrastructure/config"
+	"your-accounts-api/users/domain"
+
+	"gorm.io/gorm"
+)
+
+const (
+	webhookSecretPrefix  = "whsec_"
+	webhookDeliveryBatch = 50
+	webhookDeliveryLimit = 50
+)
+
+var (
+	ErrWebhookNotDisabled = errors.New("webhook not disabled")
+	ErrWebhookDisabled    = errors.New("webhook disabled")
+)
+
+type IWebhookApp interface {
+	Create(ctx context.Context, userId uint, url string, events []shared.EventName) (domain.Webhook, error)
+	FindByUserId(ctx context.Context, userId uint) ([]domain.Webhook, error)
+	Delete(ctx context.Context, userId, id uint) error
+	Enable(ctx context.Context, userId, id uint) error
+	FindDeliveries(ctx context.Context, userId, id uint) ([]domain.WebhookDelivery, error)
+	SendTest(ctx context.Context, userId, id uint) (domain.WebhookDelivery, error)
+	Enqueue(ctx context.Context, event shared.Event) error
+	Deliver(ctx context.Context) error
+	DeleteOldDeliveries(ctx context.Context) error
+}
+
+type webhookApp struct {
+	tm                  persistent.TransactionManager
+	webhookRepo         domain.WebhookRepository
+	webhookDeliveryRepo domain.WebhookDeliveryRepository
+	budgetRepo          budgets.BudgetRepository
+	sender              domain.WebhookSender
+	logApp              application.ILogApp
+}
+
+func (app *webhookApp) Create(ctx context.Context, userId uint, url string, events []shared.EventName) (domain.Webhook, error) {
+	secret, err := webhookSecretGenerate()
+	if err != nil {
+		return domain.Webhook{}, err
+	}
+
+	webhook := domain.Webhook{
+		UserId:    userId,
+		URL:       url,
+		Secret:    secret,
+		Events:    events,
+		CreatedAt: time.Now(),
+	}
+	err = app.tm.Transaction(func(tx persistent.Transaction) error {
+		webhookRepo := app.webhookRepo.WithTransaction(tx)
+		id, err := webhookRepo.Save(ctx, webhook)
+		if err != nil {
+			return err
+		}
+
+		webhook.ID = id
+		detail := map[string]any{
+			"id":     id,
+			"url":    url,
+			"events": events,
+		}
+		return app.logApp.Create(ctx, "Se registra un webhook", shared.User, userId, detail, tx)
+	})
+	if err != nil {
+		return domain.Webhook{}, err
+	}
+
+	return webhook, nil
+}
+
+func (app *webhookApp) FindByUserId(ctx context.Context, userId uint) ([]domain.Webhook, error) {
+	return app.webhookRepo.SearchAllByExample(ctx, domain.Webhook{
+		UserId: userId,
+	})
+}
+
+func (app *webhookApp) Delete(ctx context.Context, userId, id uint) error {
+	webhook, err := app.findWebhook(ctx, userId, id)
+	if err != nil {
+		return err
+	}
+
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		webhookRepo := app.webhookRepo.WithTransaction(tx)
+		if err := webhookRepo.Delete(ctx, webhook.ID); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"id":  webhook.ID,
+			"url": webhook.URL,
+		}
+		return app.logApp.Create(ctx, "Se elimina un webhook", shared.User, userId, detail, tx)
+	})
+}
+
+// Enable receives again the events in a webhook disabled by its failures, the events published
+// while it was disabled are not sent.
+func (app *webhookApp) Enable(ctx context.Context, userId, id uint) error {
+	webhook, err := app.findWebhook(ctx, userId, id)
+	if err != nil {
+		return err
+	}
+
+	if webhook.DisabledAt == nil {
+		return ErrWebhookNotDisabled
+	}
+
+	webhook.Failures = 0
+	webhook.DisabledAt = nil
+	return app.tm.Transaction(func(tx persistent.Transaction) error {
+		webhookRepo := app.webhookRepo.WithTransaction(tx)
+		if _, err := webhookRepo.Save(ctx, webhook); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"id":  webhook.ID,
+			"url": webhook.URL,
+		}
+		return app.logApp.Create(ctx, "Se habilita un webhook", shared.User, userId, detail, tx)
+	})
+}
+
+func (app *webhookApp) FindDeliveries(ctx context.Context, userId, id uint) ([]domain.WebhookDelivery, error) {
+	webhook, err := app.findWebhook(ctx, userId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.webhookDeliveryRepo.SearchAllByWebhookId(ctx, webhook.ID, webhookDeliveryLimit)
+}
+
+// SendTest sends a delivery to check the endpoint, it is stored in the delivery log but it is not
+// retried and its failure does not count to disable the webhook.
+func (app *webhookApp) SendTest(ctx context.Context, userId, id uint) (domain.WebhookDelivery, error) {
+	webhook, err := app.findWebhook(ctx, userId, id)
+	if err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+
+	if webhook.DisabledAt != nil {
+		return domain.WebhookDelivery{}, ErrWebhookDisabled
+	}
+
+	delivery := domain.WebhookDelivery{
+		WebhookId: webhook.ID,
+		Event:     domain.WebhookTest,
+		Payload:   webhookPayload(webhook.ID, time.Now(), map[string]any{"url": webhook.URL}),
+		Status:    domain.PendingDelivery,
+		CreatedAt: time.Now(),
+	}
+	delivery.ID, err = app.webhookDeliveryRepo.Save(ctx, delivery)
+	if err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+
+	delivery, err = app.send(ctx, webhook, delivery)
+	if err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+
+	if delivery.Status == domain.PendingDelivery {
+		delivery.Status = domain.FailedDelivery
+		delivery.NextAttemptAt = nil
+	}
+
+	if _, err := app.webhookDeliveryRepo.Save(ctx, delivery); err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+
+	return delivery, nil
+}
+
+// Enqueue creates the deliveries of an event for the enabled webhooks of its owner, it is an
+// idempotent subscriber because an event creates only one delivery by webhook.
+func (app *webhookApp) Enqueue(ctx context.Context, event shared.Event) error {
+	userId, err := app.eventOwner(ctx, event)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	webhooks, err := app.webhookRepo.SearchAllEnabledByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	deliveries := []domain.WebhookDelivery{}
+	for _, webhook := range webhooks {
+		if !webhookListens(webhook, event.Name) {
+			continue
+		}
+
+		eventId := event.ID
+		nextAttemptAt := now
+		deliveries = append(deliveries, domain.WebhookDelivery{
+			WebhookId:     webhook.ID,
+			EventId:       &eventId,
+			Event:         event.Name,
+			Payload:       webhookPayload(event.ResourceId, event.CreatedAt, event.Payload),
+			Status:        domain.PendingDelivery,
+			NextAttemptAt: &nextAttemptAt,
+			CreatedAt:     now,
+		})
+	}
+
+	return app.webhookDeliveryRepo.SaveAllNew(ctx, deliveries)
+}
+
+// Deliver claims the pending deliveries by batches and sends them outside of any transaction, each
+// result is recorded in its own transaction.
+func (app *webhookApp) Deliver(ctx context.Context) error {
+	for {
+		deliveries, err := app.claimPending(ctx)
+		if err != nil {
+			return err
+		}
+
+		webhooks := map[uint]domain.Webhook{}
+		for _, delivery := range deliveries {
+			webhook, ok := webhooks[delivery.WebhookId]
+			if !ok {
+				webhook, err = app.webhookRepo.SearchByExample(ctx, domain.Webhook{ID: delivery.WebhookId})
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					// The webhook was deleted after the deliveries were claimed, its deliveries are
+					// deleted with it so the rest of the batch goes on
+					continue
+				} else if err != nil {
+					return err
+				}
+			}
+
+			sent := webhook.DisabledAt == nil
+			if sent {
+				delivery, err = app.send(ctx, webhook, delivery)
+				if err != nil {
+					return err
+				}
+			} else {
+				delivery.Status = domain.FailedDelivery
+				delivery.Error = ErrWebhookDisabled.Error()
+				delivery.NextAttemptAt = nil
+			}
+
+			err = app.tm.Transaction(func(tx persistent.Transaction) error {
+				if sent {
+					webhook, err = app.countResult(ctx, webhook, delivery, tx)
+					if err != nil {
+						return err
+					}
+				}
+
+				_, err := app.webhookDeliveryRepo.WithTransaction(tx).Save(ctx, delivery)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			webhooks[webhook.ID] = webhook
+		}
+
+		if len(deliveries) < webhookDeliveryBatch {
+			return nil
+		}
+	}
+}
+
+func (app *webhookApp) DeleteOldDeliveries(ctx context.Context) error {
+	err := app.webhookDeliveryRepo.DeleteByCreatedAtLessThan(ctx, time.Now().Add(-config.WEBHOOK_DELIVERY_RETENTION))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (app *webhookApp) findWebhook(ctx context.Context, userId, id uint) (domain.Webhook, error) {
+	return app.webhookRepo.SearchByExample(ctx, domain.Webhook{
+		ID:     id,
+		UserId: userId,
+	})
+}
+
+// eventOwner returns the user of the resource of the event, the events of the bills only know
+// their budget.
+func (app *webhookApp) eventOwner(ctx context.Context, event shared.Event) (uint, error) {
+	if userId, ok := event.Payload["userId"]; ok && userId != nil {
+		id, err := convert.AsUint64(userId)
+		if err != nil {
+			return 0, err
+		}
+
+		return uint(id), nil
+	}
+
+	budgetId, ok := event.Payload["budgetId"]
+	if !ok || budgetId == nil {
+		return 0, gorm.ErrRecordNotFound
+	}
+
+	id, err := convert.AsUint64(budgetId)
+	if err != nil {
+		return 0, err
+	}
+
+	budget, err := app.budgetRepo.Search(ctx, uint(id))
+	if err != nil {
+		return 0, err
+	}
+
+	return *budget.UserId, nil
+}
+
+// claimPending postpones the next attempt of a batch of pending deliveries while they are sent, so
+// the other workers skip them and a worker stopped before recording a result leaves them pending
+// again once the timeouts of the whole batch have passed.
+func (app *webhookApp) claimPending(ctx context.Context) ([]domain.WebhookDelivery, error) {
+	var deliveries []domain.WebhookDelivery
+	err := app.tm.Transaction(func(tx persistent.Transaction) error {
+		webhookDeliveryRepo := app.webhookDeliveryRepo.WithTransaction(tx)
+		now := time.Now()
+		var err error
+		deliveries, err = webhookDeliveryRepo.SearchAllPending(ctx, now, webhookDeliveryBatch)
+		if err != nil {
+			return err
+		}
+
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := []uint{}
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID)
+		}
+
+		return webhookDeliveryRepo.UpdateNextAttemptAtByIds(ctx, ids, now.Add(time.Duration(len(deliveries)+1)*config.WEBHOOK_TIMEOUT))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// send makes an attempt of the delivery and returns it with its result, only the errors building
+// the body are returned because the failures of the endpoint are retried.
+func (app *webhookApp) send(ctx context.Context, webhook domain.Webhook, delivery domain.WebhookDelivery) (domain.WebhookDelivery, error) {
+	body := map[string]any{}
+	for key, value := range delivery.Payload {
+		body[key] = value
+	}
+	body["id"] = delivery.ID
+	body["event"] = delivery.Event
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+
+	now := time.Now()
+	delivery.Attempts += 1
+	delivery.StatusCode, err = app.sender.Send(ctx, domain.WebhookRequest{
+		URL:        webhook.URL,
+		Secret:     webhook.Secret,
+		Event:      delivery.Event,
+		DeliveryId: delivery.ID,
+		Body:       bytes,
+	})
+	if err != nil {
+		delivery.Error = err.Error()
+		if delivery.Attempts >= config.WEBHOOK_MAX_ATTEMPTS {
+			delivery.Status = domain.FailedDelivery
+			delivery.NextAttemptAt = nil
+		} else {
+			nextAttemptAt := now.Add(webhookRetryDelay(delivery.Attempts))
+			delivery.NextAttemptAt = &nextAttemptAt
+		}
+
+		return delivery, nil
+	}
+
+	delivery.Status = domain.SucceededDelivery
+	delivery.Error = ""
+	delivery.NextAttemptAt = nil
+	delivery.DeliveredAt = &now
+	return delivery, nil
+}
+
+// countResult keeps the consecutive failures of the webhook, it is disabled when they reach the limit
+func (app *webhookApp) countResult(ctx context.Context, webhook domain.Webhook, delivery domain.WebhookDelivery, tx persistent.Transaction) (domain.Webhook, error) {
+	if delivery.Status == domain.SucceededDelivery {
+		if webhook.Failures == 0 {
+			return webhook, nil
+		}
+
+		webhook.Failures = 0
+	} else {
+		webhook.Failures += 1
+		if webhook.Failures >= config.WEBHOOK_DISABLE_AFTER {
+			now := time.Now()
+			webhook.DisabledAt = &now
+		}
+	}
+
+	webhookRepo := app.webhookRepo.WithTransaction(tx)
+	if _, err := webhookRepo.Save(ctx, webhook); err != nil {
+		return domain.Webhook{}, err
+	}
+
+	if webhook.DisabledAt == nil {
+		return webhook, nil
+	}
+
+	detail := map[string]any{
+		"id":       webhook.ID,
+		"url":      webhook.URL,
+		"failures": webhook.Failures,
+		"error":    delivery.Error,
+	}
+	if err := app.logApp.Create(ctx, "Se deshabilita el webhook por fallos repetidos", shared.User, webhook.UserId, detail, tx); err != nil {
+		return domain.Webhook{}, err
+	}
+
+	return webhook, nil
+}
+
+func NewWebhookApp(
+	tm persistent.TransactionManager, webhookRepo domain.WebhookRepository, webhookDeliveryRepo domain.WebhookDeliveryRepository,
+	budgetRepo budgets.BudgetRepository, sender domain.WebhookSender, logApp application.ILogApp,
+) IWebhookApp {
+	return &webhookApp{tm, webhookRepo, webhookDeliveryRepo, budgetRepo, sender, logApp}
+}
+
+func webhookListens(webhook domain.Webhook, name shared.EventName) bool {
+	if len(webhook.Events) == 0 {
+		return true
+	}
+
+	for _, event := range webhook.Events {
+		if event == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func webhookPayload(resourceId uint, occurredAt time.Time, data map[string]any) map[string]any {
+	return map[string]any{
+		"resourceId": resourceId,
+		"occurredAt": occurredAt,
+		"data":       data,
+	}
+}
+
+// webhookRetryDelay doubles the wait on every failed attempt up to the maximum.
+func webhookRetryDelay(attempts int) time.Duration {
+	exponent := attempts - 1
+	if exponent > 30 {
+		return config.WEBHOOK_RETRY_MAX
+	}
+
+	delay := config.WEBHOOK_RETRY_BASE << exponent
+	if delay <= 0 || delay > config.WEBHOOK_RETRY_MAX {
+		return config.WEBHOOK_RETRY_MAX
+	}
+
+	return delay
+}
+
+var webhookSecretGenerate = func() (string, error) {
+	bytes := make([]byte, 24)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return webhookSecretPrefix + hex.EncodeToString(bytes), nil
+}
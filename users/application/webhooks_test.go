@@ -0,0 +1,569 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	budgets "your-accounts-api/budgets/domain"
+	mocks_budgets "your-accounts-api/mocks/budgets/domain"
+	mocks_application "your-accounts-api/mocks/shared/application"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	mocks_domain "your-accounts-api/mocks/users/domain"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/config"
+	"your-accounts-api/users/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestWebhookSuite struct {
+	suite.Suite
+	userId                  uint
+	id                      uint
+	url                     string
+	secret                  string
+	mockTransactionManager  *mocks_persistent.MockTransactionManager
+	mockWebhookRepo         *mocks_domain.MockWebhookRepository
+	mockWebhookDeliveryRepo *mocks_domain.MockWebhookDeliveryRepository
+	mockBudgetRepo          *mocks_budgets.MockBudgetRepository
+	mockSender              *mocks_domain.MockWebhookSender
+	mockLogApp              *mocks_application.MockILogApp
+	app                     IWebhookApp
+	ctx                     context.Context
+	originalSecretGenerate  func() (string, error)
+}
+
+func (suite *TestWebhookSuite) SetupSuite() {
+	suite.userId = 1
+	suite.id = 2
+	suite.url = "https://example.com/hook"
+	suite.secret = webhookSecretPrefix + "secret"
+	suite.ctx = context.Background()
+	suite.originalSecretGenerate = webhookSecretGenerate
+}
+
+func (suite *TestWebhookSuite) SetupTest() {
+	webhookSecretGenerate = suite.originalSecretGenerate
+	suite.mockTransactionManager = mocks_persistent.NewMockTransactionManager(suite.T())
+	suite.mockWebhookRepo = mocks_domain.NewMockWebhookRepository(suite.T())
+	suite.mockWebhookDeliveryRepo = mocks_domain.NewMockWebhookDeliveryRepository(suite.T())
+	suite.mockBudgetRepo = mocks_budgets.NewMockBudgetRepository(suite.T())
+	suite.mockSender = mocks_domain.NewMockWebhookSender(suite.T())
+	suite.mockLogApp = mocks_application.NewMockILogApp(suite.T())
+	suite.app = NewWebhookApp(suite.mockTransactionManager, suite.mockWebhookRepo, suite.mockWebhookDeliveryRepo,
+		suite.mockBudgetRepo, suite.mockSender, suite.mockLogApp)
+}
+
+func (suite *TestWebhookSuite) mockTransaction() {
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		return fc(nil)
+	})
+}
+
+func (suite *TestWebhookSuite) webhook() domain.Webhook {
+	return domain.Webhook{
+		ID:     suite.id,
+		UserId: suite.userId,
+		URL:    suite.url,
+		Secret: suite.secret,
+	}
+}
+
+func (suite *TestWebhookSuite) mockFindWebhook(webhook domain.Webhook, err error) {
+	suite.mockWebhookRepo.On("SearchByExample", suite.ctx, domain.Webhook{ID: suite.id, UserId: suite.userId}).Return(webhook, err)
+}
+
+func (suite *TestWebhookSuite) mockDeliver(deliveries []domain.WebhookDelivery) {
+	suite.mockTransaction()
+	suite.mockWebhookDeliveryRepo.On("WithTransaction", nil).Return(suite.mockWebhookDeliveryRepo)
+	suite.mockWebhookDeliveryRepo.On("SearchAllPending", suite.ctx, mock.Anything, webhookDeliveryBatch).Return(deliveries, nil).Once()
+	ids := []uint{}
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.ID)
+	}
+	suite.mockWebhookDeliveryRepo.On("UpdateNextAttemptAtByIds", suite.ctx, ids, mock.MatchedBy(func(nextAttemptAt time.Time) bool {
+		return time.Until(nextAttemptAt) > time.Duration(len(deliveries))*config.WEBHOOK_TIMEOUT
+	})).Return(nil).Once()
+}
+
+func (suite *TestWebhookSuite) TestCreateSuccess() {
+	require := require.New(suite.T())
+	events := []shared.EventName{shared.BillPaid}
+	webhookSecretGenerate = func() (string, error) {
+		return suite.secret, nil
+	}
+	suite.mockTransaction()
+	suite.mockWebhookRepo.On("WithTransaction", nil).Return(suite.mockWebhookRepo)
+	suite.mockWebhookRepo.On("Save", suite.ctx, mock.MatchedBy(func(w domain.Webhook) bool {
+		return w.UserId == suite.userId && w.URL == suite.url && w.Secret == suite.secret && len(w.Events) == 1
+	})).Return(suite.id, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se registra un webhook", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.Create(suite.ctx, suite.userId, suite.url, events)
+
+	require.NoError(err)
+	require.Equal(suite.id, res.ID)
+	require.Equal(suite.secret, res.Secret)
+}
+
+func (suite *TestWebhookSuite) TestCreateSuccessSecret() {
+	require := require.New(suite.T())
+	suite.mockTransaction()
+	suite.mockWebhookRepo.On("WithTransaction", nil).Return(suite.mockWebhookRepo)
+	suite.mockWebhookRepo.On("Save", suite.ctx, mock.Anything).Return(suite.id, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se registra un webhook", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	res, err := suite.app.Create(suite.ctx, suite.userId, suite.url, nil)
+
+	require.NoError(err)
+	require.Len(res.Secret, len(webhookSecretPrefix)+48)
+	require.Equal(webhookSecretPrefix, res.Secret[:len(webhookSecretPrefix)])
+}
+
+func (suite *TestWebhookSuite) TestCreateErrorSecret() {
+	require := require.New(suite.T())
+	errExpected := errors.New("no entropy")
+	webhookSecretGenerate = func() (string, error) {
+		return "", errExpected
+	}
+
+	_, err := suite.app.Create(suite.ctx, suite.userId, suite.url, nil)
+
+	require.ErrorIs(err, errExpected)
+}
+
+func (suite *TestWebhookSuite) TestCreateError() {
+	require := require.New(suite.T())
+	suite.mockTransactionManager.On("Transaction", mock.Anything).Return(gorm.ErrInvalidTransaction)
+
+	_, err := suite.app.Create(suite.ctx, suite.userId, suite.url, nil)
+
+	require.EqualError(gorm.ErrInvalidTransaction, err.Error())
+}
+
+func (suite *TestWebhookSuite) TestFindByUserIdSuccess() {
+	require := require.New(suite.T())
+	webhooksExpected := []domain.Webhook{suite.webhook()}
+	suite.mockWebhookRepo.On("SearchAllByExample", suite.ctx, domain.Webhook{UserId: suite.userId}).Return(webhooksExpected, nil)
+
+	res, err := suite.app.FindByUserId(suite.ctx, suite.userId)
+
+	require.NoError(err)
+	require.Equal(webhooksExpected, res)
+}
+
+func (suite *TestWebhookSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mockFindWebhook(suite.webhook(), nil)
+	suite.mockTransaction()
+	suite.mockWebhookRepo.On("WithTransaction", nil).Return(suite.mockWebhookRepo)
+	suite.mockWebhookRepo.On("Delete", suite.ctx, suite.id).Return(nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se elimina un webhook", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.Delete(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestDeleteErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockFindWebhook(domain.Webhook{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.Delete(suite.ctx, suite.userId, suite.id)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestWebhookSuite) TestEnableSuccess() {
+	require := require.New(suite.T())
+	disabledAt := time.Now()
+	webhook := suite.webhook()
+	webhook.Failures = config.WEBHOOK_DISABLE_AFTER
+	webhook.DisabledAt = &disabledAt
+	suite.mockFindWebhook(webhook, nil)
+	suite.mockTransaction()
+	suite.mockWebhookRepo.On("WithTransaction", nil).Return(suite.mockWebhookRepo)
+	suite.mockWebhookRepo.On("Save", suite.ctx, mock.MatchedBy(func(w domain.Webhook) bool {
+		return w.ID == suite.id && w.Failures == 0 && w.DisabledAt == nil
+	})).Return(suite.id, nil)
+	suite.mockLogApp.On("Create", suite.ctx, "Se habilita un webhook", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+
+	err := suite.app.Enable(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestEnableErrorNotDisabled() {
+	require := require.New(suite.T())
+	suite.mockFindWebhook(suite.webhook(), nil)
+
+	err := suite.app.Enable(suite.ctx, suite.userId, suite.id)
+
+	require.ErrorIs(err, ErrWebhookNotDisabled)
+}
+
+func (suite *TestWebhookSuite) TestFindDeliveriesSuccess() {
+	require := require.New(suite.T())
+	deliveriesExpected := []domain.WebhookDelivery{{ID: 1, WebhookId: suite.id}}
+	suite.mockFindWebhook(suite.webhook(), nil)
+	suite.mockWebhookDeliveryRepo.On("SearchAllByWebhookId", suite.ctx, suite.id, webhookDeliveryLimit).Return(deliveriesExpected, nil)
+
+	res, err := suite.app.FindDeliveries(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+	require.Equal(deliveriesExpected, res)
+}
+
+func (suite *TestWebhookSuite) TestFindDeliveriesErrorNotFound() {
+	require := require.New(suite.T())
+	suite.mockFindWebhook(domain.Webhook{}, gorm.ErrRecordNotFound)
+
+	_, err := suite.app.FindDeliveries(suite.ctx, suite.userId, suite.id)
+
+	require.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TestWebhookSuite) TestSendTestSuccess() {
+	require := require.New(suite.T())
+	suite.mockFindWebhook(suite.webhook(), nil)
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.MatchedBy(func(d domain.WebhookDelivery) bool {
+		return d.ID == 0 && d.Event == domain.WebhookTest && d.Status == domain.PendingDelivery
+	})).Return(uint(10), nil).Once()
+	suite.mockSender.On("Send", suite.ctx, mock.MatchedBy(func(r domain.WebhookRequest) bool {
+		return r.URL == suite.url && r.Secret == suite.secret && r.Event == domain.WebhookTest && r.DeliveryId == 10
+	})).Return(204, nil)
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.MatchedBy(func(d domain.WebhookDelivery) bool {
+		return d.ID == 10 && d.Status == domain.SucceededDelivery && d.Attempts == 1 && d.DeliveredAt != nil
+	})).Return(uint(10), nil).Once()
+
+	res, err := suite.app.SendTest(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+	require.Equal(domain.SucceededDelivery, res.Status)
+	require.Equal(204, res.StatusCode)
+}
+
+func (suite *TestWebhookSuite) TestSendTestSuccessFailed() {
+	require := require.New(suite.T())
+	suite.mockFindWebhook(suite.webhook(), nil)
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.MatchedBy(func(d domain.WebhookDelivery) bool {
+		return d.ID == 0
+	})).Return(uint(10), nil).Once()
+	suite.mockSender.On("Send", suite.ctx, mock.Anything).Return(500, errors.New("unexpected status code 500"))
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.MatchedBy(func(d domain.WebhookDelivery) bool {
+		return d.ID == 10 && d.Status == domain.FailedDelivery && d.NextAttemptAt == nil && d.Error == "unexpected status code 500"
+	})).Return(uint(10), nil).Once()
+
+	res, err := suite.app.SendTest(suite.ctx, suite.userId, suite.id)
+
+	require.NoError(err)
+	require.Equal(domain.FailedDelivery, res.Status)
+	require.Equal(500, res.StatusCode)
+}
+
+func (suite *TestWebhookSuite) TestSendTestErrorDisabled() {
+	require := require.New(suite.T())
+	disabledAt := time.Now()
+	webhook := suite.webhook()
+	webhook.DisabledAt = &disabledAt
+	suite.mockFindWebhook(webhook, nil)
+
+	_, err := suite.app.SendTest(suite.ctx, suite.userId, suite.id)
+
+	require.ErrorIs(err, ErrWebhookDisabled)
+}
+
+func (suite *TestWebhookSuite) TestEnqueueSuccess() {
+	require := require.New(suite.T())
+	event := shared.Event{
+		ID:         5,
+		Name:       shared.BudgetCreated,
+		ResourceId: 7,
+		Payload:    map[string]any{"userId": float64(suite.userId), "name": "Test"},
+	}
+	suite.mockWebhookRepo.On("SearchAllEnabledByUserId", suite.ctx, suite.userId).Return([]domain.Webhook{
+		{ID: 1, UserId: suite.userId},
+		{ID: 2, UserId: suite.userId, Events: []shared.EventName{shared.BudgetCreated}},
+		{ID: 3, UserId: suite.userId, Events: []shared.EventName{shared.BillPaid}},
+	}, nil)
+	suite.mockWebhookDeliveryRepo.On("SaveAllNew", suite.ctx, mock.MatchedBy(func(deliveries []domain.WebhookDelivery) bool {
+		return len(deliveries) == 2 && deliveries[0].WebhookId == 1 && deliveries[1].WebhookId == 2 &&
+			*deliveries[0].EventId == event.ID && deliveries[0].Status == domain.PendingDelivery &&
+			deliveries[0].Payload["resourceId"] == event.ResourceId && deliveries[0].NextAttemptAt != nil
+	})).Return(nil)
+
+	err := suite.app.Enqueue(suite.ctx, event)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestEnqueueSuccessBudgetOwner() {
+	require := require.New(suite.T())
+	event := shared.Event{
+		ID:         5,
+		Name:       shared.BillPaid,
+		ResourceId: 7,
+		Payload:    map[string]any{"budgetId": float64(3)},
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, uint(3)).Return(budgets.Budget{UserId: &suite.userId}, nil)
+	suite.mockWebhookRepo.On("SearchAllEnabledByUserId", suite.ctx, suite.userId).Return([]domain.Webhook{
+		{ID: 1, UserId: suite.userId},
+	}, nil)
+	suite.mockWebhookDeliveryRepo.On("SaveAllNew", suite.ctx, mock.MatchedBy(func(deliveries []domain.WebhookDelivery) bool {
+		return len(deliveries) == 1
+	})).Return(nil)
+
+	err := suite.app.Enqueue(suite.ctx, event)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestEnqueueSuccessBudgetNotFound() {
+	require := require.New(suite.T())
+	event := shared.Event{
+		ID:      5,
+		Name:    shared.BillPaid,
+		Payload: map[string]any{"budgetId": float64(3)},
+	}
+	suite.mockBudgetRepo.On("Search", suite.ctx, uint(3)).Return(budgets.Budget{}, gorm.ErrRecordNotFound)
+
+	err := suite.app.Enqueue(suite.ctx, event)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestEnqueueErrorSearch() {
+	require := require.New(suite.T())
+	event := shared.Event{
+		ID:      5,
+		Name:    shared.BudgetCreated,
+		Payload: map[string]any{"userId": float64(suite.userId)},
+	}
+	suite.mockWebhookRepo.On("SearchAllEnabledByUserId", suite.ctx, suite.userId).Return(nil, gorm.ErrInvalidField)
+
+	err := suite.app.Enqueue(suite.ctx, event)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestWebhookSuite) TestDeliverSuccess() {
+	require := require.New(suite.T())
+	webhook := suite.webhook()
+	webhook.Failures = 2
+	suite.mockWebhookRepo.On("WithTransaction", nil).Return(suite.mockWebhookRepo)
+	suite.mockDeliver([]domain.WebhookDelivery{
+		{ID: 10, WebhookId: suite.id, Event: shared.BillPaid, Status: domain.PendingDelivery},
+		{ID: 11, WebhookId: suite.id, Event: shared.BillPaid, Status: domain.PendingDelivery},
+	})
+	suite.mockWebhookRepo.On("SearchByExample", suite.ctx, domain.Webhook{ID: suite.id}).Return(webhook, nil).Once()
+	suite.mockSender.On("Send", suite.ctx, mock.Anything).Return(200, nil).Twice()
+	suite.mockWebhookRepo.On("Save", suite.ctx, mock.MatchedBy(func(w domain.Webhook) bool {
+		return w.Failures == 0 && w.DisabledAt == nil
+	})).Return(suite.id, nil).Once()
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.MatchedBy(func(d domain.WebhookDelivery) bool {
+		return d.Status == domain.SucceededDelivery && d.Attempts == 1 && d.StatusCode == 200 && d.DeliveredAt != nil
+	})).Return(uint(1), nil).Twice()
+
+	err := suite.app.Deliver(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestDeliverSuccessRetry() {
+	require := require.New(suite.T())
+	suite.mockWebhookRepo.On("WithTransaction", nil).Return(suite.mockWebhookRepo)
+	suite.mockDeliver([]domain.WebhookDelivery{
+		{ID: 10, WebhookId: suite.id, Event: shared.BillPaid, Status: domain.PendingDelivery, Attempts: 1},
+	})
+	suite.mockWebhookRepo.On("SearchByExample", suite.ctx, domain.Webhook{ID: suite.id}).Return(suite.webhook(), nil)
+	suite.mockSender.On("Send", suite.ctx, mock.Anything).Return(0, errors.New("connection refused"))
+	suite.mockWebhookRepo.On("Save", suite.ctx, mock.MatchedBy(func(w domain.Webhook) bool {
+		return w.Failures == 1 && w.DisabledAt == nil
+	})).Return(suite.id, nil)
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.MatchedBy(func(d domain.WebhookDelivery) bool {
+		return d.Status == domain.PendingDelivery && d.Attempts == 2 && d.Error == "connection refused" &&
+			time.Until(*d.NextAttemptAt) > config.WEBHOOK_RETRY_BASE
+	})).Return(uint(1), nil)
+
+	err := suite.app.Deliver(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestDeliverSuccessFailed() {
+	require := require.New(suite.T())
+	suite.mockWebhookRepo.On("WithTransaction", nil).Return(suite.mockWebhookRepo)
+	suite.mockDeliver([]domain.WebhookDelivery{
+		{ID: 10, WebhookId: suite.id, Event: shared.BillPaid, Status: domain.PendingDelivery, Attempts: config.WEBHOOK_MAX_ATTEMPTS - 1},
+	})
+	suite.mockWebhookRepo.On("SearchByExample", suite.ctx, domain.Webhook{ID: suite.id}).Return(suite.webhook(), nil)
+	suite.mockSender.On("Send", suite.ctx, mock.Anything).Return(500, errors.New("unexpected status code 500"))
+	suite.mockWebhookRepo.On("Save", suite.ctx, mock.Anything).Return(suite.id, nil)
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.MatchedBy(func(d domain.WebhookDelivery) bool {
+		return d.Status == domain.FailedDelivery && d.Attempts == config.WEBHOOK_MAX_ATTEMPTS && d.NextAttemptAt == nil
+	})).Return(uint(1), nil)
+
+	err := suite.app.Deliver(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestDeliverSuccessDisable() {
+	require := require.New(suite.T())
+	webhook := suite.webhook()
+	webhook.Failures = config.WEBHOOK_DISABLE_AFTER - 1
+	suite.mockWebhookRepo.On("WithTransaction", nil).Return(suite.mockWebhookRepo)
+	suite.mockDeliver([]domain.WebhookDelivery{
+		{ID: 10, WebhookId: suite.id, Event: shared.BillPaid, Status: domain.PendingDelivery},
+		{ID: 11, WebhookId: suite.id, Event: shared.BillPaid, Status: domain.PendingDelivery},
+	})
+	suite.mockWebhookRepo.On("SearchByExample", suite.ctx, domain.Webhook{ID: suite.id}).Return(webhook, nil).Once()
+	suite.mockSender.On("Send", suite.ctx, mock.Anything).Return(0, errors.New("connection refused")).Once()
+	suite.mockWebhookRepo.On("Save", suite.ctx, mock.MatchedBy(func(w domain.Webhook) bool {
+		return w.Failures == config.WEBHOOK_DISABLE_AFTER && w.DisabledAt != nil
+	})).Return(suite.id, nil).Once()
+	suite.mockLogApp.On("Create", suite.ctx, "Se deshabilita el webhook por fallos repetidos", shared.User, suite.userId, mock.Anything, nil).Return(nil)
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.MatchedBy(func(d domain.WebhookDelivery) bool {
+		return d.ID == 10 && d.Status == domain.PendingDelivery
+	})).Return(uint(10), nil).Once()
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.MatchedBy(func(d domain.WebhookDelivery) bool {
+		return d.ID == 11 && d.Status == domain.FailedDelivery && d.Attempts == 0 && d.Error == ErrWebhookDisabled.Error()
+	})).Return(uint(11), nil).Once()
+
+	err := suite.app.Deliver(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestDeliverSuccessDeletedWebhook() {
+	require := require.New(suite.T())
+	deletedId := uint(99)
+	suite.mockDeliver([]domain.WebhookDelivery{
+		{ID: 10, WebhookId: deletedId, Event: shared.BillPaid, Status: domain.PendingDelivery},
+		{ID: 11, WebhookId: suite.id, Event: shared.BillPaid, Status: domain.PendingDelivery},
+	})
+	suite.mockWebhookRepo.On("SearchByExample", suite.ctx, domain.Webhook{ID: deletedId}).Return(domain.Webhook{}, gorm.ErrRecordNotFound)
+	suite.mockWebhookRepo.On("SearchByExample", suite.ctx, domain.Webhook{ID: suite.id}).Return(suite.webhook(), nil)
+	suite.mockSender.On("Send", suite.ctx, mock.Anything).Return(200, nil).Once()
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.MatchedBy(func(d domain.WebhookDelivery) bool {
+		return d.ID == 11 && d.Status == domain.SucceededDelivery
+	})).Return(uint(11), nil).Once()
+
+	err := suite.app.Deliver(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestDeliverSuccessBatches() {
+	require := require.New(suite.T())
+	deliveries := make([]domain.WebhookDelivery, webhookDeliveryBatch)
+	for i := range deliveries {
+		deliveries[i] = domain.WebhookDelivery{ID: uint(i + 1), WebhookId: suite.id, Status: domain.PendingDelivery}
+	}
+	suite.mockDeliver(deliveries)
+	suite.mockWebhookDeliveryRepo.On("SearchAllPending", suite.ctx, mock.Anything, webhookDeliveryBatch).Return([]domain.WebhookDelivery{}, nil).Once()
+	suite.mockWebhookRepo.On("SearchByExample", suite.ctx, domain.Webhook{ID: suite.id}).Return(suite.webhook(), nil).Once()
+	suite.mockSender.On("Send", suite.ctx, mock.Anything).Return(200, nil).Times(webhookDeliveryBatch)
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.Anything).Return(uint(1), nil).Times(webhookDeliveryBatch)
+
+	err := suite.app.Deliver(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestDeliverErrorSearch() {
+	require := require.New(suite.T())
+	suite.mockTransaction()
+	suite.mockWebhookDeliveryRepo.On("WithTransaction", nil).Return(suite.mockWebhookDeliveryRepo)
+	suite.mockWebhookDeliveryRepo.On("SearchAllPending", suite.ctx, mock.Anything, webhookDeliveryBatch).Return(nil, gorm.ErrInvalidField)
+
+	err := suite.app.Deliver(suite.ctx)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestWebhookSuite) TestDeliverErrorSave() {
+	require := require.New(suite.T())
+	suite.mockDeliver([]domain.WebhookDelivery{
+		{ID: 10, WebhookId: suite.id, Status: domain.PendingDelivery},
+	})
+	suite.mockWebhookRepo.On("SearchByExample", suite.ctx, domain.Webhook{ID: suite.id}).Return(suite.webhook(), nil)
+	suite.mockSender.On("Send", suite.ctx, mock.Anything).Return(200, nil)
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.Anything).Return(uint(0), gorm.ErrInvalidField)
+
+	err := suite.app.Deliver(suite.ctx)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestWebhookSuite) TestDeliverSuccessSendOutsideTransaction() {
+	require := require.New(suite.T())
+	inTransaction := false
+	suite.mockTransactionManager.On("Transaction", mock.AnythingOfType("func(persistent.Transaction) error")).Return(func(fc func(persistent.Transaction) error) error {
+		inTransaction = true
+		defer func() {
+			inTransaction = false
+		}()
+		return fc(nil)
+	})
+	suite.mockWebhookDeliveryRepo.On("WithTransaction", nil).Return(suite.mockWebhookDeliveryRepo)
+	suite.mockWebhookDeliveryRepo.On("SearchAllPending", suite.ctx, mock.Anything, webhookDeliveryBatch).Return([]domain.WebhookDelivery{
+		{ID: 10, WebhookId: suite.id, Status: domain.PendingDelivery},
+	}, nil)
+	suite.mockWebhookDeliveryRepo.On("UpdateNextAttemptAtByIds", suite.ctx, []uint{10}, mock.Anything).Return(nil)
+	suite.mockWebhookRepo.On("SearchByExample", suite.ctx, domain.Webhook{ID: suite.id}).Return(suite.webhook(), nil)
+	suite.mockSender.On("Send", suite.ctx, mock.Anything).Run(func(args mock.Arguments) {
+		require.False(inTransaction)
+	}).Return(200, nil)
+	suite.mockWebhookDeliveryRepo.On("Save", suite.ctx, mock.Anything).Run(func(args mock.Arguments) {
+		require.True(inTransaction)
+	}).Return(uint(10), nil)
+
+	err := suite.app.Deliver(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestDeliverErrorClaim() {
+	require := require.New(suite.T())
+	suite.mockTransaction()
+	suite.mockWebhookDeliveryRepo.On("WithTransaction", nil).Return(suite.mockWebhookDeliveryRepo)
+	suite.mockWebhookDeliveryRepo.On("SearchAllPending", suite.ctx, mock.Anything, webhookDeliveryBatch).Return([]domain.WebhookDelivery{
+		{ID: 10, WebhookId: suite.id, Status: domain.PendingDelivery},
+	}, nil)
+	suite.mockWebhookDeliveryRepo.On("UpdateNextAttemptAtByIds", suite.ctx, []uint{10}, mock.Anything).Return(gorm.ErrInvalidField)
+
+	err := suite.app.Deliver(suite.ctx)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	suite.mockSender.AssertNotCalled(suite.T(), "Send", mock.Anything, mock.Anything)
+}
+
+func (suite *TestWebhookSuite) TestDeleteOldDeliveriesSuccess() {
+	require := require.New(suite.T())
+	suite.mockWebhookDeliveryRepo.On("DeleteByCreatedAtLessThan", suite.ctx, mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= config.WEBHOOK_DELIVERY_RETENTION
+	})).Return(nil)
+
+	err := suite.app.DeleteOldDeliveries(suite.ctx)
+
+	require.NoError(err)
+}
+
+func (suite *TestWebhookSuite) TestDeleteOldDeliveriesError() {
+	require := require.New(suite.T())
+	suite.mockWebhookDeliveryRepo.On("DeleteByCreatedAtLessThan", suite.ctx, mock.Anything).Return(gorm.ErrInvalidField)
+
+	err := suite.app.DeleteOldDeliveries(suite.ctx)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestWebhookSuite(t *testing.T) {
+	suite.Run(t, new(TestWebhookSuite))
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+type ApiKey struct {
+	ID         uint
+	Name       string
+	Prefix     string
+	Hash       string
+	Scope      ApiKeyScope
+	UserId     uint
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+type ApiKeyRepository interface {
+	persistent.TransactionRepository[ApiKeyRepository]
+	persistent.SaveRepository[ApiKey]
+	persistent.SearchByExampleRepository[ApiKey]
+	persistent.SearchAllByExampleRepository[ApiKey]
+	persistent.DeleteRepository
+	UpdateLastUsedAt(ctx context.Context, id uint, lastUsedAt time.Time) error
+}
@@ -0,0 +1,16 @@
+package domain
+
+type ApiKeyScope string
+
+const (
+	ReadScope  ApiKeyScope = "read"
+	WriteScope ApiKeyScope = "write"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	PendingDelivery   WebhookDeliveryStatus = "pending"
+	SucceededDelivery WebhookDeliveryStatus = "succeeded"
+	FailedDelivery    WebhookDeliveryStatus = "failed"
+)
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+type LoginAttempt struct {
+	ID        uint
+	Email     string
+	IP        string
+	Success   bool
+	CreatedAt time.Time
+}
+
+type LoginAttemptRepository interface {
+	persistent.TransactionRepository[LoginAttemptRepository]
+	persistent.SaveRepository[LoginAttempt]
+	SearchAllByEmailOrIpSince(ctx context.Context, email, ip string, since time.Time) ([]LoginAttempt, error)
+	DeleteByCreatedAtLessThan(ctx context.Context, before time.Time) error
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+type OidcState struct {
+	ID           uint
+	State        string
+	Provider     string
+	CodeVerifier string
+	Nonce        string
+	ExpiresAt    time.Time
+}
+
+type OidcStateRepository interface {
+	persistent.TransactionRepository[OidcStateRepository]
+	persistent.SaveRepository[OidcState]
+	persistent.SearchByExampleRepository[OidcState]
+	persistent.DeleteRepository
+	DeleteByExpiresAtLessThanNow(ctx context.Context) error
+}
+
+type ExternalIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+type IdentityProvider interface {
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (ExternalIdentity, error)
+}
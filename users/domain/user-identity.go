@@ -0,0 +1,16 @@
+package domain
+
+import "your-accounts-api/shared/domain/persistent"
+
+type UserIdentity struct {
+	ID       uint
+	Provider string
+	Subject  string
+	UserId   uint
+}
+
+type UserIdentityRepository interface {
+	persistent.TransactionRepository[UserIdentityRepository]
+	persistent.SaveRepository[UserIdentity]
+	persistent.SearchAllByExampleRepository[UserIdentity]
+}
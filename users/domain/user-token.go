@@ -18,4 +18,5 @@ type UserTokenRepository interface {
 	persistent.SaveRepository[UserToken]
 	persistent.SearchByExampleRepository[UserToken]
 	DeleteByExpiresAtGreaterThanNow(ctx context.Context) error
+	DeleteByUserId(ctx context.Context, userId uint) error
 }
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+// UserVerification keeps the SHA-256 of the token sent by mail in Token
+type UserVerification struct {
+	ID        uint
+	Token     string
+	Email     string
+	UserId    uint
+	ExpiresAt time.Time
+}
+
+type UserVerificationRepository interface {
+	persistent.TransactionRepository[UserVerificationRepository]
+	persistent.SaveRepository[UserVerification]
+	persistent.SearchByExampleRepository[UserVerification]
+	DeleteByUserId(ctx context.Context, userId uint) error
+	DeleteByExpiresAtLessThanNow(ctx context.Context) error
+}
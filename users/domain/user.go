@@ -2,17 +2,61 @@ package domain
 
 import (
 	"context"
+	"time"
+	budgets "your-accounts-api/budgets/domain"
+	shared "your-accounts-api/shared/domain"
 	"your-accounts-api/shared/domain/persistent"
 )
 
 type User struct {
-	ID    uint
-	Email string
+	ID                 uint
+	Email              string
+	DisplayName        string
+	Locale             string
+	Timezone           string
+	Currency           string
+	WeekStartDay       uint8
+	MonthStartDay      uint8
+	NotifyByEmail      bool
+	NotifyPendingBills bool
+	Role               shared.Role
+	EmailVerifiedAt    *time.Time
+	DisabledAt         *time.Time
+	LockedUntil        *time.Time
+	DeleteAt           *time.Time
+}
+
+type UserFilter struct {
+	Email  string
+	Role   shared.Role
+	Offset int
+	Limit  int
+}
+
+type UserProfile struct {
+	DisplayName        *string
+	Locale             *string
+	Timezone           *string
+	Currency           *string
+	WeekStartDay       *uint8
+	MonthStartDay      *uint8
+	NotifyByEmail      *bool
+	NotifyPendingBills *bool
+}
+
+type UserData struct {
+	User    User
+	Budgets []budgets.Budget
+	Logs    []shared.Log
 }
 
 type UserRepository interface {
 	persistent.TransactionRepository[UserRepository]
 	persistent.SaveRepository[User]
+	persistent.SearchRepository[User]
 	persistent.SearchByExampleRepository[User]
+	persistent.DeleteRepository
 	ExistsByExample(ctx context.Context, example User) (bool, error)
+	SearchAllByDeleteAtLessThanNow(ctx context.Context) ([]User, error)
+	SearchAllByFilter(ctx context.Context, filter UserFilter) ([]User, error)
 }
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"context"
+	"time"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+)
+
+// WebhookTest is the event sent by the test of an endpoint, it is never published in the outbox
+const WebhookTest shared.EventName = "webhook.test"
+
+// Webhook is an endpoint of the user that receives the events, an empty Events receives all of them
+type Webhook struct {
+	ID         uint
+	UserId     uint
+	URL        string
+	Secret     string
+	Events     []shared.EventName
+	Failures   int
+	DisabledAt *time.Time
+	CreatedAt  time.Time
+}
+
+// WebhookDelivery is the sending of an event to a webhook, it keeps the result of the last attempt
+type WebhookDelivery struct {
+	ID            uint
+	WebhookId     uint
+	EventId       *uint
+	Event         shared.EventName
+	Payload       map[string]any
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	StatusCode    int
+	Error         string
+	NextAttemptAt *time.Time
+	DeliveredAt   *time.Time
+	CreatedAt     time.Time
+}
+
+// WebhookRequest is a signed delivery sent to the URL of a webhook
+type WebhookRequest struct {
+	URL        string
+	Secret     string
+	Event      shared.EventName
+	DeliveryId uint
+	Body       []byte
+}
+
+type WebhookRepository interface {
+	persistent.TransactionRepository[WebhookRepository]
+	persistent.SaveRepository[Webhook]
+	persistent.SearchByExampleRepository[Webhook]
+	persistent.SearchAllByExampleRepository[Webhook]
+	persistent.DeleteRepository
+	SearchAllEnabledByUserId(ctx context.Context, userId uint) ([]Webhook, error)
+}
+
+type WebhookDeliveryRepository interface {
+	persistent.TransactionRepository[WebhookDeliveryRepository]
+	persistent.SaveRepository[WebhookDelivery]
+	SaveAllNew(ctx context.Context, deliveries []WebhookDelivery) error
+	SearchAllByWebhookId(ctx context.Context, webhookId uint, limit int) ([]WebhookDelivery, error)
+	SearchAllPending(ctx context.Context, now time.Time, limit int) ([]WebhookDelivery, error)
+	UpdateNextAttemptAtByIds(ctx context.Context, ids []uint, nextAttemptAt time.Time) error
+	DeleteByCreatedAtLessThan(ctx context.Context, before time.Time) error
+}
+
+// WebhookSender signs and sends the deliveries, it returns the status code of the response
+type WebhookSender interface {
+	Send(ctx context.Context, request WebhookRequest) (int, error)
+}
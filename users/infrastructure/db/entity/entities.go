@@ -3,19 +3,105 @@ package entity
 import (
 	"time"
 	budgets "your-accounts-api/budgets/infrastructure/db/entity"
+	shared "your-accounts-api/shared/domain"
 	"your-accounts-api/shared/infrastructure/db/entity"
+	"your-accounts-api/users/domain"
 )
 
 type User struct {
 	entity.BaseModel
-	Email      string           `gorm:"not null;unique"`
-	Budgets    []budgets.Budget `gorm:"foreignKey:UserId"`
-	UserTokens []UserToken      `gorm:"foreignKey:UserId"`
+	Email              string      `gorm:"not null;unique"`
+	DisplayName        string      `gorm:"not null;size:60;default:''"`
+	Locale             string      `gorm:"not null;size:35;default:es-CO"`
+	Timezone           string      `gorm:"not null;size:60;default:America/Bogota"`
+	Currency           string      `gorm:"not null;size:3;default:COP"`
+	WeekStartDay       uint8       `gorm:"not null;default:1"`
+	MonthStartDay      uint8       `gorm:"not null;default:1"`
+	NotifyByEmail      bool        `gorm:"not null;default:true"`
+	NotifyPendingBills bool        `gorm:"not null;default:true"`
+	Role               shared.Role `gorm:"not null;size:10;default:user"`
+	EmailVerifiedAt    *time.Time
+	DisabledAt         *time.Time
+	LockedUntil        *time.Time
+	DeleteAt           *time.Time         `gorm:"index"`
+	Budgets            []budgets.Budget   `gorm:"foreignKey:UserId"`
+	UserTokens         []UserToken        `gorm:"foreignKey:UserId"`
+	UserVerifications  []UserVerification `gorm:"foreignKey:UserId"`
+	ApiKeys            []ApiKey           `gorm:"foreignKey:UserId"`
+	UserIdentities     []UserIdentity     `gorm:"foreignKey:UserId"`
+	Webhooks           []Webhook          `gorm:"foreignKey:UserId"`
 }
 
 type UserToken struct {
 	entity.BaseModel
-	Token     string    `gorm:"not null;size:2000"`
+	Token     string    `gorm:"not null;size:2000;index"`
 	UserId    uint      `gorm:"not null"`
 	ExpiresAt time.Time `gorm:"not null"`
 }
+
+type UserVerification struct {
+	entity.BaseModel
+	Token     string    `gorm:"not null;unique;size:64"`
+	Email     string    `gorm:"not null"`
+	UserId    uint      `gorm:"not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+}
+
+type ApiKey struct {
+	entity.BaseModel
+	Name       string             `gorm:"not null;size:40"`
+	Prefix     string             `gorm:"not null;size:12"`
+	Hash       string             `gorm:"not null;unique;size:64"`
+	Scope      domain.ApiKeyScope `gorm:"not null;size:5"`
+	UserId     uint               `gorm:"not null;index"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+}
+
+type UserIdentity struct {
+	entity.BaseModel
+	Provider string `gorm:"not null;size:40;uniqueIndex:idx_user_identity"`
+	Subject  string `gorm:"not null;size:255;uniqueIndex:idx_user_identity"`
+	UserId   uint   `gorm:"not null;index"`
+}
+
+type OidcState struct {
+	entity.BaseModel
+	State        string    `gorm:"not null;unique;size:64"`
+	Provider     string    `gorm:"not null;size:40"`
+	CodeVerifier string    `gorm:"not null;size:64"`
+	Nonce        string    `gorm:"not null;size:64"`
+	ExpiresAt    time.Time `gorm:"not null"`
+}
+
+type LoginAttempt struct {
+	entity.BaseModel
+	Email   string `gorm:"not null;index"`
+	IP      string `gorm:"not null;size:45;index"`
+	Success bool   `gorm:"not null"`
+}
+
+type Webhook struct {
+	entity.BaseModel
+	UserId            uint              `gorm:"not null;index"`
+	URL               string            `gorm:"not null;size:500"`
+	Secret            string            `gorm:"not null;size:80"`
+	Events            []string          `gorm:"not null;type:json;serializer:json"`
+	Failures          int               `gorm:"not null;default:0"`
+	DisabledAt        *time.Time        `gorm:"index"`
+	WebhookDeliveries []WebhookDelivery `gorm:"foreignKey:WebhookId"`
+}
+
+type WebhookDelivery struct {
+	entity.BaseModel
+	WebhookId     uint                         `gorm:"not null;uniqueIndex:idx_webhook_delivery_event"`
+	EventId       *uint                        `gorm:"uniqueIndex:idx_webhook_delivery_event"`
+	Event         shared.EventName             `gorm:"not null;size:40"`
+	Payload       map[string]any               `gorm:"not null;type:json;serializer:json"`
+	Status        domain.WebhookDeliveryStatus `gorm:"not null;size:10;index"`
+	Attempts      int                          `gorm:"not null;default:0"`
+	StatusCode    int                          `gorm:"not null;default:0"`
+	Error         string                       `gorm:"not null;size:500;default:''"`
+	NextAttemptAt *time.Time                   `gorm:"index"`
+	DeliveredAt   *time.Time
+}
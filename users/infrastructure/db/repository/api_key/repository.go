@@ -0,0 +1,113 @@
+package api_key
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	shared_ent "your-accounts-api/shared/infrastructure/db/entity"
+	"your-accounts-api/users/domain"
+	"your-accounts-api/users/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.ApiKeyRepository {
+	return db.DefaultWithTransaction[domain.ApiKeyRepository](tx, NewRepository, r)
+}
+
+func (r *gormRepository) Save(ctx context.Context, apiKey domain.ApiKey) (uint, error) {
+	model := &entity.ApiKey{
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		Hash:      apiKey.Hash,
+		Scope:     apiKey.Scope,
+		UserId:    apiKey.UserId,
+		ExpiresAt: apiKey.ExpiresAt,
+	}
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchByExample(ctx context.Context, example domain.ApiKey) (domain.ApiKey, error) {
+	where := entity.ApiKey{
+		BaseModel: shared_ent.BaseModel{
+			ID: example.ID,
+		},
+		Hash:   example.Hash,
+		UserId: example.UserId,
+	}
+	model := new(entity.ApiKey)
+	if err := r.db.WithContext(ctx).Where(where).First(model).Error; err != nil {
+		return domain.ApiKey{}, err
+	}
+
+	return toDomain(*model), nil
+}
+
+func (r *gormRepository) SearchAllByExample(ctx context.Context, example domain.ApiKey) ([]domain.ApiKey, error) {
+	where := entity.ApiKey{
+		UserId: example.UserId,
+	}
+	var models []entity.ApiKey
+	if err := r.db.WithContext(ctx).Where(where).Order("created_at desc").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	apiKeys := []domain.ApiKey{}
+	for _, model := range models {
+		apiKeys = append(apiKeys, toDomain(model))
+	}
+
+	return apiKeys, nil
+}
+
+func (r *gormRepository) UpdateLastUsedAt(ctx context.Context, id uint, lastUsedAt time.Time) error {
+	if err := r.db.WithContext(ctx).Model(&entity.ApiKey{
+		BaseModel: shared_ent.BaseModel{
+			ID: id,
+		},
+	}).Update("last_used_at", lastUsedAt).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormRepository) Delete(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Delete(&entity.ApiKey{
+		BaseModel: shared_ent.BaseModel{
+			ID: id,
+		},
+	}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toDomain(model entity.ApiKey) domain.ApiKey {
+	return domain.ApiKey{
+		ID:         model.ID,
+		Name:       model.Name,
+		Prefix:     model.Prefix,
+		Hash:       model.Hash,
+		Scope:      model.Scope,
+		UserId:     model.UserId,
+		ExpiresAt:  model.ExpiresAt,
+		LastUsedAt: model.LastUsedAt,
+		CreatedAt:  model.CreatedAt,
+	}
+}
+
+func NewRepository(db *gorm.DB) domain.ApiKeyRepository {
+	return &gormRepository{db}
+}
@@ -0,0 +1,292 @@
+package api_key
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain/test_utils"
+	"your-accounts-api/users/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	name       string
+	prefix     string
+	hash       string
+	userId     uint
+	expiresAt  time.Time
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.ApiKeyRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.name = "Test"
+	suite.prefix = "ya_12345678"
+	suite.hash = "<hash>"
+	suite.userId = 999
+	suite.expiresAt = time.Now().Add(24 * time.Hour)
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveSuccess() {
+	require := require.New(suite.T())
+
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "api_keys" ("created_at","name","prefix","hash","scope","user_id","expires_at","last_used_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.name, suite.prefix, suite.hash, domain.ReadScope, suite.userId, suite.expiresAt, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(999)))
+	suite.mock.ExpectCommit()
+	apiKey := domain.ApiKey{
+		Name:      suite.name,
+		Prefix:    suite.prefix,
+		Hash:      suite.hash,
+		Scope:     domain.ReadScope,
+		UserId:    suite.userId,
+		ExpiresAt: &suite.expiresAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), apiKey)
+
+	require.NoError(err)
+	require.Equal(uint(999), res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "api_keys" ("created_at","name","prefix","hash","scope","user_id","expires_at","last_used_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.name, suite.prefix, suite.hash, domain.WriteScope, suite.userId, nil, nil).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+	apiKey := domain.ApiKey{
+		Name:   suite.name,
+		Prefix: suite.prefix,
+		Hash:   suite.hash,
+		Scope:  domain.WriteScope,
+		UserId: suite.userId,
+	}
+
+	res, err := suite.repository.Save(context.Background(), apiKey)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSearchByExampleSuccess() {
+	require := require.New(suite.T())
+	createdAt := time.Now()
+	example := domain.ApiKey{
+		Hash: suite.hash,
+	}
+	apiKeyExpected := domain.ApiKey{
+		ID:        999,
+		Name:      suite.name,
+		Prefix:    suite.prefix,
+		Hash:      suite.hash,
+		Scope:     domain.ReadScope,
+		UserId:    suite.userId,
+		ExpiresAt: &suite.expiresAt,
+		CreatedAt: createdAt,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "api_keys" WHERE "api_keys"."hash" = $1 ORDER BY "api_keys"."id" LIMIT 1`)).
+		WithArgs(suite.hash).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "name", "prefix", "hash", "scope", "user_id", "expires_at", "last_used_at", "created_at"}).
+			AddRow(apiKeyExpected.ID, apiKeyExpected.Name, apiKeyExpected.Prefix, apiKeyExpected.Hash, apiKeyExpected.Scope, apiKeyExpected.UserId, suite.expiresAt, nil, createdAt),
+		)
+
+	res, err := suite.repository.SearchByExample(context.Background(), example)
+
+	require.NoError(err)
+	require.Equal(apiKeyExpected, res)
+}
+
+func (suite *TestSuite) TestSearchByExampleError() {
+	require := require.New(suite.T())
+	example := domain.ApiKey{
+		ID:     999,
+		UserId: suite.userId,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "api_keys" WHERE "api_keys"."id" = $1 AND "api_keys"."user_id" = $2 ORDER BY "api_keys"."id" LIMIT 1`)).
+		WithArgs(999, suite.userId).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	res, err := suite.repository.SearchByExample(context.Background(), example)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleSuccess() {
+	require := require.New(suite.T())
+	createdAt := time.Now()
+	lastUsedAt := time.Now()
+	example := domain.ApiKey{
+		UserId: suite.userId,
+	}
+	apiKeysExpected := []domain.ApiKey{
+		{
+			ID:         999,
+			Name:       suite.name,
+			Prefix:     suite.prefix,
+			Hash:       suite.hash,
+			Scope:      domain.WriteScope,
+			UserId:     suite.userId,
+			LastUsedAt: &lastUsedAt,
+			CreatedAt:  createdAt,
+		},
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "api_keys" WHERE "api_keys"."user_id" = $1 ORDER BY created_at desc`)).
+		WithArgs(suite.userId).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "name", "prefix", "hash", "scope", "user_id", "expires_at", "last_used_at", "created_at"}).
+			AddRow(999, suite.name, suite.prefix, suite.hash, domain.WriteScope, suite.userId, nil, lastUsedAt, createdAt),
+		)
+
+	res, err := suite.repository.SearchAllByExample(context.Background(), example)
+
+	require.NoError(err)
+	require.Equal(apiKeysExpected, res)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleError() {
+	require := require.New(suite.T())
+	example := domain.ApiKey{
+		UserId: suite.userId,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "api_keys" WHERE "api_keys"."user_id" = $1 ORDER BY created_at desc`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	res, err := suite.repository.SearchAllByExample(context.Background(), example)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(res)
+}
+
+func (suite *TestSuite) TestUpdateLastUsedAtSuccess() {
+	require := require.New(suite.T())
+	lastUsedAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "api_keys" SET "last_used_at"=$1 WHERE "id" = $2`)).
+		WithArgs(lastUsedAt, 999).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.UpdateLastUsedAt(context.Background(), 999, lastUsedAt)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestUpdateLastUsedAtError() {
+	require := require.New(suite.T())
+	lastUsedAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "api_keys" SET "last_used_at"=$1 WHERE "id" = $2`)).
+		WithArgs(lastUsedAt, 999).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.UpdateLastUsedAt(context.Background(), 999, lastUsedAt)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "api_keys" WHERE "api_keys"."id" = $1`)).
+		WithArgs(999).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.Delete(context.Background(), 999)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "api_keys" WHERE "api_keys"."id" = $1`)).
+		WithArgs(999).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.Delete(context.Background(), 999)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,66 @@
+package login_attempt
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	"your-accounts-api/users/domain"
+	"your-accounts-api/users/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.LoginAttemptRepository {
+	return db.DefaultWithTransaction[domain.LoginAttemptRepository](tx, NewRepository, r)
+}
+
+func (r *gormRepository) Save(ctx context.Context, attempt domain.LoginAttempt) (uint, error) {
+	model := &entity.LoginAttempt{
+		Email:   attempt.Email,
+		IP:      attempt.IP,
+		Success: attempt.Success,
+	}
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchAllByEmailOrIpSince(ctx context.Context, email, ip string, since time.Time) ([]domain.LoginAttempt, error) {
+	var models []entity.LoginAttempt
+	if err := r.db.WithContext(ctx).Where("(email = ? OR ip = ?) AND created_at >= ?", email, ip, since).Order("created_at desc").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	attempts := []domain.LoginAttempt{}
+	for _, model := range models {
+		attempts = append(attempts, domain.LoginAttempt{
+			ID:        model.ID,
+			Email:     model.Email,
+			IP:        model.IP,
+			Success:   model.Success,
+			CreatedAt: model.CreatedAt,
+		})
+	}
+
+	return attempts, nil
+}
+
+func (r *gormRepository) DeleteByCreatedAtLessThan(ctx context.Context, before time.Time) error {
+	if err := r.db.WithContext(ctx).Where("created_at < ?", before).Delete(entity.LoginAttempt{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewRepository(db *gorm.DB) domain.LoginAttemptRepository {
+	return &gormRepository{db}
+}
@@ -0,0 +1,192 @@
+package login_attempt
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain/test_utils"
+	"your-accounts-api/users/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	email      string
+	ip         string
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.LoginAttemptRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.email = "example@exaple.com"
+	suite.ip = "10.0.0.1"
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveSuccess() {
+	require := require.New(suite.T())
+
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "login_attempts" ("created_at","email","ip","success") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.email, suite.ip, false).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(999)))
+	suite.mock.ExpectCommit()
+	attempt := domain.LoginAttempt{
+		Email: suite.email,
+		IP:    suite.ip,
+	}
+
+	res, err := suite.repository.Save(context.Background(), attempt)
+
+	require.NoError(err)
+	require.Equal(uint(999), res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "login_attempts" ("created_at","email","ip","success") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.email, suite.ip, true).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+	attempt := domain.LoginAttempt{
+		Email:   suite.email,
+		IP:      suite.ip,
+		Success: true,
+	}
+
+	res, err := suite.repository.Save(context.Background(), attempt)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSearchAllByEmailOrIpSinceSuccess() {
+	require := require.New(suite.T())
+	since := time.Now().Add(-15 * time.Minute)
+	createdAt := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "login_attempts" WHERE (email = $1 OR ip = $2) AND created_at >= $3 ORDER BY created_at desc`)).
+		WithArgs(suite.email, suite.ip, since).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "email", "ip", "success"}).
+			AddRow(2, createdAt, suite.email, suite.ip, false).
+			AddRow(1, createdAt, suite.email, "10.0.0.2", true),
+		)
+
+	res, err := suite.repository.SearchAllByEmailOrIpSince(context.Background(), suite.email, suite.ip, since)
+
+	require.NoError(err)
+	require.Equal([]domain.LoginAttempt{
+		{ID: 2, Email: suite.email, IP: suite.ip, Success: false, CreatedAt: createdAt},
+		{ID: 1, Email: suite.email, IP: "10.0.0.2", Success: true, CreatedAt: createdAt},
+	}, res)
+}
+
+func (suite *TestSuite) TestSearchAllByEmailOrIpSinceError() {
+	require := require.New(suite.T())
+	since := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "login_attempts" WHERE (email = $1 OR ip = $2) AND created_at >= $3 ORDER BY created_at desc`)).
+		WithArgs(suite.email, suite.ip, since).
+		WillReturnError(gorm.ErrInvalidField)
+
+	res, err := suite.repository.SearchAllByEmailOrIpSince(context.Background(), suite.email, suite.ip, since)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(res)
+}
+
+func (suite *TestSuite) TestDeleteByCreatedAtLessThanSuccess() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "login_attempts" WHERE created_at < $1`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 10))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteByCreatedAtLessThan(context.Background(), before)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteByCreatedAtLessThanError() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "login_attempts" WHERE created_at < $1`)).
+		WithArgs(before).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteByCreatedAtLessThan(context.Background(), before)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
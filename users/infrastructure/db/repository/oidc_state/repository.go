@@ -0,0 +1,79 @@
+package oidc_state
+
+import (
+	"context"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	shared_ent "your-accounts-api/shared/infrastructure/db/entity"
+	"your-accounts-api/users/domain"
+	"your-accounts-api/users/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.OidcStateRepository {
+	return db.DefaultWithTransaction[domain.OidcStateRepository](tx, NewRepository, r)
+}
+
+func (r *gormRepository) Save(ctx context.Context, state domain.OidcState) (uint, error) {
+	model := &entity.OidcState{
+		State:        state.State,
+		Provider:     state.Provider,
+		CodeVerifier: state.CodeVerifier,
+		Nonce:        state.Nonce,
+		ExpiresAt:    state.ExpiresAt,
+	}
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchByExample(ctx context.Context, example domain.OidcState) (domain.OidcState, error) {
+	where := entity.OidcState{
+		State: example.State,
+	}
+	model := new(entity.OidcState)
+	if err := r.db.WithContext(ctx).Where(where).First(model).Error; err != nil {
+		return domain.OidcState{}, err
+	}
+
+	return domain.OidcState{
+		ID:           model.ID,
+		State:        model.State,
+		Provider:     model.Provider,
+		CodeVerifier: model.CodeVerifier,
+		Nonce:        model.Nonce,
+		ExpiresAt:    model.ExpiresAt,
+	}, nil
+}
+
+func (r *gormRepository) Delete(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Delete(&entity.OidcState{
+		BaseModel: shared_ent.BaseModel{
+			ID: id,
+		},
+	}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormRepository) DeleteByExpiresAtLessThanNow(ctx context.Context) error {
+	if err := r.db.WithContext(ctx).Where("expires_at < NOW()").Delete(entity.OidcState{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewRepository(db *gorm.DB) domain.OidcStateRepository {
+	return &gormRepository{db}
+}
@@ -0,0 +1,234 @@
+package oidc_state
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain/test_utils"
+	"your-accounts-api/users/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	state      string
+	provider   string
+	verifier   string
+	nonce      string
+	expiresAt  time.Time
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.OidcStateRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.state = "<state>"
+	suite.provider = "google"
+	suite.verifier = "<verifier>"
+	suite.nonce = "<nonce>"
+	suite.expiresAt = time.Now().Add(10 * time.Minute)
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveSuccess() {
+	require := require.New(suite.T())
+
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "oidc_states" ("created_at","state","provider","code_verifier","nonce","expires_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.state, suite.provider, suite.verifier, suite.nonce, suite.expiresAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(999)))
+	suite.mock.ExpectCommit()
+	state := domain.OidcState{
+		State:        suite.state,
+		Provider:     suite.provider,
+		CodeVerifier: suite.verifier,
+		Nonce:        suite.nonce,
+		ExpiresAt:    suite.expiresAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), state)
+
+	require.NoError(err)
+	require.Equal(uint(999), res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "oidc_states" ("created_at","state","provider","code_verifier","nonce","expires_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.state, suite.provider, suite.verifier, suite.nonce, suite.expiresAt).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+	state := domain.OidcState{
+		State:        suite.state,
+		Provider:     suite.provider,
+		CodeVerifier: suite.verifier,
+		Nonce:        suite.nonce,
+		ExpiresAt:    suite.expiresAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), state)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSearchByExampleSuccess() {
+	require := require.New(suite.T())
+	example := domain.OidcState{
+		State: suite.state,
+	}
+	stateExpected := domain.OidcState{
+		ID:           999,
+		State:        suite.state,
+		Provider:     suite.provider,
+		CodeVerifier: suite.verifier,
+		Nonce:        suite.nonce,
+		ExpiresAt:    suite.expiresAt,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "oidc_states" WHERE "oidc_states"."state" = $1 ORDER BY "oidc_states"."id" LIMIT 1`)).
+		WithArgs(suite.state).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "state", "provider", "code_verifier", "nonce", "expires_at"}).
+			AddRow(stateExpected.ID, stateExpected.State, stateExpected.Provider, stateExpected.CodeVerifier, stateExpected.Nonce, stateExpected.ExpiresAt),
+		)
+
+	res, err := suite.repository.SearchByExample(context.Background(), example)
+
+	require.NoError(err)
+	require.Equal(stateExpected, res)
+}
+
+func (suite *TestSuite) TestSearchByExampleError() {
+	require := require.New(suite.T())
+	example := domain.OidcState{
+		State: suite.state,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "oidc_states" WHERE "oidc_states"."state" = $1 ORDER BY "oidc_states"."id" LIMIT 1`)).
+		WithArgs(suite.state).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	res, err := suite.repository.SearchByExample(context.Background(), example)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "oidc_states" WHERE "oidc_states"."id" = $1`)).
+		WithArgs(999).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.Delete(context.Background(), 999)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "oidc_states" WHERE "oidc_states"."id" = $1`)).
+		WithArgs(999).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.Delete(context.Background(), 999)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteByExpiresAtLessThanNowSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "oidc_states" WHERE expires_at < NOW()`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteByExpiresAtLessThanNow(context.Background())
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteByExpiresAtLessThanNowError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "oidc_states" WHERE expires_at < NOW()`)).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteByExpiresAtLessThanNow(context.Background())
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
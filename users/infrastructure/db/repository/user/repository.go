@@ -4,6 +4,7 @@ import (
 	"context"
 	"your-accounts-api/shared/domain/persistent"
 	"your-accounts-api/shared/infrastructure/db"
+	shared_ent "your-accounts-api/shared/infrastructure/db/entity"
 	"your-accounts-api/users/domain"
 	"your-accounts-api/users/infrastructure/db/entity"
 
@@ -19,10 +20,42 @@ func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.UserR
 }
 
 func (r *gormRepository) Save(ctx context.Context, user domain.User) (uint, error) {
-	model := &entity.User{
-		Email: user.Email,
+	model := new(entity.User)
+	if user.ID != 0 {
+		if err := r.db.WithContext(ctx).First(model, user.ID).Error; err != nil {
+			return 0, err
+		}
+
+		if user.Email != "" {
+			model.Email = user.Email
+		}
+
+		model.DisplayName = user.DisplayName
+		model.Locale = user.Locale
+		model.Timezone = user.Timezone
+		model.Currency = user.Currency
+		model.WeekStartDay = user.WeekStartDay
+		model.MonthStartDay = user.MonthStartDay
+		model.NotifyByEmail = user.NotifyByEmail
+		model.NotifyPendingBills = user.NotifyPendingBills
+		if user.Role != "" {
+			model.Role = user.Role
+		}
+
+		model.EmailVerifiedAt = user.EmailVerifiedAt
+		model.DisabledAt = user.DisabledAt
+		model.LockedUntil = user.LockedUntil
+		model.DeleteAt = user.DeleteAt
+		if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+			return 0, err
+		}
+
+		return model.ID, nil
 	}
 
+	model.Email = user.Email
+	model.Role = user.Role
+	model.EmailVerifiedAt = user.EmailVerifiedAt
 	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
 		return 0, err
 	}
@@ -30,6 +63,15 @@ func (r *gormRepository) Save(ctx context.Context, user domain.User) (uint, erro
 	return model.ID, nil
 }
 
+func (r *gormRepository) Search(ctx context.Context, id uint) (domain.User, error) {
+	model := new(entity.User)
+	if err := r.db.WithContext(ctx).First(model, id).Error; err != nil {
+		return domain.User{}, err
+	}
+
+	return toDomain(model), nil
+}
+
 func (r *gormRepository) SearchByExample(ctx context.Context, example domain.User) (domain.User, error) {
 	where := entity.User{
 		Email: example.Email,
@@ -39,10 +81,7 @@ func (r *gormRepository) SearchByExample(ctx context.Context, example domain.Use
 		return domain.User{}, err
 	}
 
-	return domain.User{
-		ID:    model.ID,
-		Email: model.Email,
-	}, nil
+	return toDomain(model), nil
 }
 
 func (r *gormRepository) ExistsByExample(ctx context.Context, example domain.User) (bool, error) {
@@ -60,6 +99,109 @@ func (r *gormRepository) ExistsByExample(ctx context.Context, example domain.Use
 	return count > 0, nil
 }
 
+func (r *gormRepository) SearchAllByDeleteAtLessThanNow(ctx context.Context) ([]domain.User, error) {
+	var models []entity.User
+	if err := r.db.WithContext(ctx).Where("delete_at < NOW()").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	var users []domain.User
+	for _, model := range models {
+		modelC := model
+		users = append(users, toDomain(&modelC))
+	}
+
+	return users, nil
+}
+
+func (r *gormRepository) SearchAllByFilter(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	query := r.db.WithContext(ctx).Model(entity.User{})
+	if filter.Email != "" {
+		query = query.Where(`email LIKE ? ESCAPE '\'`, db.ContainsPattern(filter.Email))
+	}
+
+	if filter.Role != "" {
+		query = query.Where("role = ?", filter.Role)
+	}
+
+	var models []entity.User
+	if err := query.Order("id").Offset(filter.Offset).Limit(filter.Limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	users := []domain.User{}
+	for _, model := range models {
+		modelC := model
+		users = append(users, toDomain(&modelC))
+	}
+
+	return users, nil
+}
+
+// Delete removes the user with the rows of the users module, the other modules remove theirs
+// when the UserDeleted event is delivered
+func (r *gormRepository) Delete(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", id).Delete(entity.UserToken{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("user_id = ?", id).Delete(entity.UserVerification{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("user_id = ?", id).Delete(entity.ApiKey{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("user_id = ?", id).Delete(entity.UserIdentity{}).Error; err != nil {
+		return err
+	}
+
+	webhookIds := r.db.Model(entity.Webhook{}).Select("id").Where("user_id = ?", id)
+	if err := r.db.WithContext(ctx).Where("webhook_id IN (?)", webhookIds).Delete(entity.WebhookDelivery{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("user_id = ?", id).Delete(entity.Webhook{}).Error; err != nil {
+		return err
+	}
+
+	emails := r.db.Model(entity.User{}).Select("email").Where("id = ?", id)
+	if err := r.db.WithContext(ctx).Where("email IN (?)", emails).Delete(entity.LoginAttempt{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Delete(&entity.User{
+		BaseModel: shared_ent.BaseModel{
+			ID: id,
+		},
+	}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toDomain(model *entity.User) domain.User {
+	return domain.User{
+		ID:                 model.ID,
+		Email:              model.Email,
+		DisplayName:        model.DisplayName,
+		Locale:             model.Locale,
+		Timezone:           model.Timezone,
+		Currency:           model.Currency,
+		WeekStartDay:       model.WeekStartDay,
+		MonthStartDay:      model.MonthStartDay,
+		NotifyByEmail:      model.NotifyByEmail,
+		NotifyPendingBills: model.NotifyPendingBills,
+		Role:               model.Role,
+		EmailVerifiedAt:    model.EmailVerifiedAt,
+		DisabledAt:         model.DisabledAt,
+		LockedUntil:        model.LockedUntil,
+		DeleteAt:           model.DeleteAt,
+	}
+}
+
 func NewRepository(db *gorm.DB) domain.UserRepository {
 	return &gormRepository{db}
 }
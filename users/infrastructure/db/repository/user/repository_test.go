@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	shared "your-accounts-api/shared/domain"
 	"your-accounts-api/shared/domain/test_utils"
 	"your-accounts-api/users/domain"
 
@@ -85,8 +86,8 @@ func (suite *TestSuite) TestSaveSuccess() {
 
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users" ("created_at","email") VALUES ($1,$2) RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, suite.email).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users" ("created_at","email","display_name","locale","timezone","currency","week_start_day","month_start_day","notify_by_email","notify_pending_bills","role","email_verified_at","disabled_at","locked_until","delete_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.email, "", "es-CO", "America/Bogota", "COP", 1, 1, true, true, shared.UserRole, nil, nil, nil, nil).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uint(999)))
 	suite.mock.ExpectCommit()
 	user := domain.User{
@@ -105,8 +106,8 @@ func (suite *TestSuite) TestSaveError() {
 
 	suite.mock.ExpectBegin()
 	suite.mock.
-		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users" ("created_at","email") VALUES ($1,$2) RETURNING "id"`)).
-		WithArgs(test_utils.AnyTime{}, suite.email).
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users" ("created_at","email","display_name","locale","timezone","currency","week_start_day","month_start_day","notify_by_email","notify_pending_bills","role","email_verified_at","disabled_at","locked_until","delete_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.email, "", "es-CO", "America/Bogota", "COP", 1, 1, true, true, shared.UserRole, nil, nil, nil, nil).
 		WillReturnError(gorm.ErrInvalidField)
 	suite.mock.ExpectRollback()
 	user := domain.User{
@@ -119,6 +120,121 @@ func (suite *TestSuite) TestSaveError() {
 	require.Zero(res)
 }
 
+func (suite *TestSuite) TestSaveUpdateSuccess() {
+	require := require.New(suite.T())
+	deleteAt := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."id" = $1 ORDER BY "users"."id" LIMIT 1`)).
+		WithArgs(999).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "email"}).
+			AddRow(999, time.Now(), suite.email),
+		)
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "created_at"=$1,"email"=$2,"display_name"=$3,"locale"=$4,"timezone"=$5,"currency"=$6,"week_start_day"=$7,"month_start_day"=$8,"notify_by_email"=$9,"notify_pending_bills"=$10,"role"=$11,"email_verified_at"=$12,"disabled_at"=$13,"locked_until"=$14,"delete_at"=$15 WHERE "id" = $16`)).
+		WithArgs(test_utils.AnyTime{}, suite.email, "Test", "es-CO", "America/Bogota", "COP", 1, 1, true, false, "", nil, nil, nil, deleteAt, 999).
+		WillReturnResult(sqlmock.NewResult(999, 1))
+	suite.mock.ExpectCommit()
+	user := domain.User{
+		ID:                 999,
+		DisplayName:        "Test",
+		Locale:             "es-CO",
+		Timezone:           "America/Bogota",
+		Currency:           "COP",
+		WeekStartDay:       1,
+		MonthStartDay:      1,
+		NotifyByEmail:      true,
+		NotifyPendingBills: false,
+		DeleteAt:           &deleteAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), user)
+
+	require.NoError(err)
+	require.Equal(uint(999), res)
+}
+
+func (suite *TestSuite) TestSaveUpdateErrorFind() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."id" = $1 ORDER BY "users"."id" LIMIT 1`)).
+		WithArgs(999).
+		WillReturnError(gorm.ErrRecordNotFound)
+	user := domain.User{
+		ID: 999,
+	}
+
+	res, err := suite.repository.Save(context.Background(), user)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSaveUpdateError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."id" = $1 ORDER BY "users"."id" LIMIT 1`)).
+		WithArgs(999).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "email"}).
+			AddRow(999, time.Now(), suite.email),
+		)
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "created_at"=$1,"email"=$2,"display_name"=$3,"locale"=$4,"timezone"=$5,"currency"=$6,"week_start_day"=$7,"month_start_day"=$8,"notify_by_email"=$9,"notify_pending_bills"=$10,"role"=$11,"email_verified_at"=$12,"disabled_at"=$13,"locked_until"=$14,"delete_at"=$15 WHERE "id" = $16`)).
+		WithArgs(test_utils.AnyTime{}, suite.email, "", "", "", "", 0, 0, false, false, "", nil, nil, nil, nil, 999).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+	user := domain.User{
+		ID: 999,
+	}
+
+	res, err := suite.repository.Save(context.Background(), user)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSearchSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."id" = $1 ORDER BY "users"."id" LIMIT 1`)).
+		WithArgs(999).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "email", "display_name", "locale", "timezone", "currency", "week_start_day", "month_start_day", "notify_by_email", "notify_pending_bills"}).
+			AddRow(999, time.Now(), suite.email, "Test", "en-US", "America/New_York", "USD", 0, 15, true, false),
+		)
+
+	user, err := suite.repository.Search(context.Background(), 999)
+
+	require.NoError(err)
+	require.Equal(uint(999), user.ID)
+	require.Equal(suite.email, user.Email)
+	require.Equal("Test", user.DisplayName)
+	require.Equal("en-US", user.Locale)
+	require.Equal("America/New_York", user.Timezone)
+	require.Equal("USD", user.Currency)
+	require.Equal(uint8(0), user.WeekStartDay)
+	require.Equal(uint8(15), user.MonthStartDay)
+	require.True(user.NotifyByEmail)
+	require.False(user.NotifyPendingBills)
+	require.Nil(user.DeleteAt)
+}
+
+func (suite *TestSuite) TestSearchError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."id" = $1 ORDER BY "users"."id" LIMIT 1`)).
+		WithArgs(999).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	user, err := suite.repository.Search(context.Background(), 999)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(user)
+}
+
 func (suite *TestSuite) TestSearchByExampleSuccess() {
 	require := require.New(suite.T())
 	example := domain.User{
@@ -203,6 +319,152 @@ func (suite *TestSuite) TestExistsByExampleError() {
 	require.False(exists)
 }
 
+func (suite *TestSuite) TestSearchAllByDeleteAtLessThanNowSuccess() {
+	require := require.New(suite.T())
+	deleteAt := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE delete_at < NOW()`)).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "email", "delete_at"}).
+			AddRow(999, time.Now(), suite.email, deleteAt),
+		)
+
+	users, err := suite.repository.SearchAllByDeleteAtLessThanNow(context.Background())
+
+	require.NoError(err)
+	require.Len(users, 1)
+	require.Equal(uint(999), users[0].ID)
+	require.Equal(deleteAt, *users[0].DeleteAt)
+}
+
+func (suite *TestSuite) TestSearchAllByDeleteAtLessThanNowError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE delete_at < NOW()`)).
+		WillReturnError(gorm.ErrInvalidField)
+
+	users, err := suite.repository.SearchAllByDeleteAtLessThanNow(context.Background())
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(users)
+}
+
+func (suite *TestSuite) TestSearchAllByFilterSuccess() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE email LIKE $1 ESCAPE '\' AND role = $2 ORDER BY id LIMIT 10 OFFSET 10`)).
+		WithArgs("%test%", shared.AdminRole).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "email", "role"}).
+			AddRow(999, time.Now(), suite.email, shared.AdminRole),
+		)
+
+	users, err := suite.repository.SearchAllByFilter(context.Background(), domain.UserFilter{Email: "test", Role: shared.AdminRole, Offset: 10, Limit: 10})
+
+	require.NoError(err)
+	require.Len(users, 1)
+	require.Equal(uint(999), users[0].ID)
+	require.Equal(shared.AdminRole, users[0].Role)
+}
+
+func (suite *TestSuite) TestSearchAllByFilterSuccessEscapedEmail() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE email LIKE $1 ESCAPE '\' ORDER BY id LIMIT 10`)).
+		WithArgs(`%first\_last\%%`).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "created_at", "email", "role"}).
+			AddRow(999, time.Now(), suite.email, shared.AdminRole),
+		)
+
+	users, err := suite.repository.SearchAllByFilter(context.Background(), domain.UserFilter{Email: "first_last%", Limit: 10})
+
+	require.NoError(err)
+	require.Len(users, 1)
+}
+
+func (suite *TestSuite) TestSearchAllByFilterError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" ORDER BY id LIMIT 10`)).
+		WillReturnError(gorm.ErrInvalidField)
+
+	users, err := suite.repository.SearchAllByFilter(context.Background(), domain.UserFilter{Limit: 10})
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(users)
+}
+
+func (suite *TestSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "user_tokens" WHERE user_id = $1`)).
+		WithArgs(999).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "user_verifications" WHERE user_id = $1`)).
+		WithArgs(999).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "api_keys" WHERE user_id = $1`)).
+		WithArgs(999).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "user_identities" WHERE user_id = $1`)).
+		WithArgs(999).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "webhook_deliveries" WHERE webhook_id IN (SELECT "id" FROM "webhooks" WHERE user_id = $1)`)).
+		WithArgs(999).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "webhooks" WHERE user_id = $1`)).
+		WithArgs(999).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "login_attempts" WHERE email IN (SELECT "email" FROM "users" WHERE id = $1)`)).
+		WithArgs(999).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "users" WHERE "users"."id" = $1`)).
+		WithArgs(999).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.Delete(context.Background(), 999)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "user_tokens" WHERE user_id = $1`)).
+		WithArgs(999).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.Delete(context.Background(), 999)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
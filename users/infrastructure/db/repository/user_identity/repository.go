@@ -0,0 +1,61 @@
+package user_identity
+
+import (
+	"context"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	"your-accounts-api/users/domain"
+	"your-accounts-api/users/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.UserIdentityRepository {
+	return db.DefaultWithTransaction[domain.UserIdentityRepository](tx, NewRepository, r)
+}
+
+func (r *gormRepository) Save(ctx context.Context, identity domain.UserIdentity) (uint, error) {
+	model := &entity.UserIdentity{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		UserId:   identity.UserId,
+	}
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchAllByExample(ctx context.Context, example domain.UserIdentity) ([]domain.UserIdentity, error) {
+	where := entity.UserIdentity{
+		Provider: example.Provider,
+		Subject:  example.Subject,
+		UserId:   example.UserId,
+	}
+	var models []entity.UserIdentity
+	if err := r.db.WithContext(ctx).Where(where).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	identities := []domain.UserIdentity{}
+	for _, model := range models {
+		identities = append(identities, domain.UserIdentity{
+			ID:       model.ID,
+			Provider: model.Provider,
+			Subject:  model.Subject,
+			UserId:   model.UserId,
+		})
+	}
+
+	return identities, nil
+}
+
+func NewRepository(db *gorm.DB) domain.UserIdentityRepository {
+	return &gormRepository{db}
+}
@@ -0,0 +1,189 @@
+package user_identity
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain/test_utils"
+	"your-accounts-api/users/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	provider   string
+	subject    string
+	userId     uint
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.UserIdentityRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.provider = "google"
+	suite.subject = "<subject>"
+	suite.userId = 999
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveSuccess() {
+	require := require.New(suite.T())
+
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "user_identities" ("created_at","provider","subject","user_id") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.provider, suite.subject, suite.userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(999)))
+	suite.mock.ExpectCommit()
+	identity := domain.UserIdentity{
+		Provider: suite.provider,
+		Subject:  suite.subject,
+		UserId:   suite.userId,
+	}
+
+	res, err := suite.repository.Save(context.Background(), identity)
+
+	require.NoError(err)
+	require.Equal(uint(999), res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "user_identities" ("created_at","provider","subject","user_id") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.provider, suite.subject, suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+	identity := domain.UserIdentity{
+		Provider: suite.provider,
+		Subject:  suite.subject,
+		UserId:   suite.userId,
+	}
+
+	res, err := suite.repository.Save(context.Background(), identity)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleSuccess() {
+	require := require.New(suite.T())
+	example := domain.UserIdentity{
+		Provider: suite.provider,
+		Subject:  suite.subject,
+	}
+	identitiesExpected := []domain.UserIdentity{
+		{
+			ID:       999,
+			Provider: suite.provider,
+			Subject:  suite.subject,
+			UserId:   suite.userId,
+		},
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_identities" WHERE "user_identities"."provider" = $1 AND "user_identities"."subject" = $2`)).
+		WithArgs(suite.provider, suite.subject).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "provider", "subject", "user_id"}).
+			AddRow(999, suite.provider, suite.subject, suite.userId),
+		)
+
+	res, err := suite.repository.SearchAllByExample(context.Background(), example)
+
+	require.NoError(err)
+	require.Equal(identitiesExpected, res)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleEmpty() {
+	require := require.New(suite.T())
+	example := domain.UserIdentity{
+		UserId: suite.userId,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_identities" WHERE "user_identities"."user_id" = $1`)).
+		WithArgs(suite.userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "provider", "subject", "user_id"}))
+
+	res, err := suite.repository.SearchAllByExample(context.Background(), example)
+
+	require.NoError(err)
+	require.Empty(res)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleError() {
+	require := require.New(suite.T())
+	example := domain.UserIdentity{
+		Provider: suite.provider,
+		Subject:  suite.subject,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_identities" WHERE "user_identities"."provider" = $1 AND "user_identities"."subject" = $2`)).
+		WithArgs(suite.provider, suite.subject).
+		WillReturnError(gorm.ErrInvalidField)
+
+	res, err := suite.repository.SearchAllByExample(context.Background(), example)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(res)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -58,6 +58,14 @@ func (r *gormRepository) DeleteByExpiresAtGreaterThanNow(ctx context.Context) er
 	return nil
 }
 
+func (r *gormRepository) DeleteByUserId(ctx context.Context, userId uint) error {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userId).Delete(entity.UserToken{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func NewRepository(db *gorm.DB) domain.UserTokenRepository {
 	return &gormRepository{db}
 }
@@ -195,6 +195,34 @@ func (suite *TestSuite) TestDeleteByExpiresAtGreaterThanNowError() {
 	require.EqualError(gorm.ErrRecordNotFound, err.Error())
 }
 
+func (suite *TestSuite) TestDeleteByUserIdSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "user_tokens" WHERE user_id = $1`)).
+		WithArgs(999).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteByUserId(context.Background(), 999)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteByUserIdError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "user_tokens" WHERE user_id = $1`)).
+		WithArgs(999).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteByUserId(context.Background(), 999)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
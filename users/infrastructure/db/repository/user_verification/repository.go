@@ -0,0 +1,73 @@
+package user_verification
+
+import (
+	"context"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	"your-accounts-api/users/domain"
+	"your-accounts-api/users/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.UserVerificationRepository {
+	return db.DefaultWithTransaction[domain.UserVerificationRepository](tx, NewRepository, r)
+}
+
+func (r *gormRepository) Save(ctx context.Context, verification domain.UserVerification) (uint, error) {
+	model := &entity.UserVerification{
+		Token:     verification.Token,
+		Email:     verification.Email,
+		UserId:    verification.UserId,
+		ExpiresAt: verification.ExpiresAt,
+	}
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchByExample(ctx context.Context, example domain.UserVerification) (domain.UserVerification, error) {
+	where := entity.UserVerification{
+		Token:  example.Token,
+		UserId: example.UserId,
+	}
+	model := new(entity.UserVerification)
+	if err := r.db.WithContext(ctx).Where(where).First(model).Error; err != nil {
+		return domain.UserVerification{}, err
+	}
+
+	return domain.UserVerification{
+		ID:        model.ID,
+		Token:     model.Token,
+		Email:     model.Email,
+		UserId:    model.UserId,
+		ExpiresAt: model.ExpiresAt,
+	}, nil
+}
+
+func (r *gormRepository) DeleteByUserId(ctx context.Context, userId uint) error {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userId).Delete(entity.UserVerification{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormRepository) DeleteByExpiresAtLessThanNow(ctx context.Context) error {
+	if err := r.db.WithContext(ctx).Where("expires_at < NOW()").Delete(entity.UserVerification{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewRepository(db *gorm.DB) domain.UserVerificationRepository {
+	return &gormRepository{db}
+}
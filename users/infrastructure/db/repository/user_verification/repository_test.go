@@ -0,0 +1,229 @@
+package user_verification
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	"your-accounts-api/shared/domain/test_utils"
+	"your-accounts-api/users/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	token      string
+	email      string
+	userId     uint
+	expiresAt  time.Time
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.UserVerificationRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.token = "<token>"
+	suite.email = "example@exaple.com"
+	suite.userId = 999
+	suite.expiresAt = time.Now().Add(1 * time.Hour)
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveSuccess() {
+	require := require.New(suite.T())
+
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "user_verifications" ("created_at","token","email","user_id","expires_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.token, suite.email, suite.userId, suite.expiresAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(999)))
+	suite.mock.ExpectCommit()
+	verification := domain.UserVerification{
+		Token:     suite.token,
+		Email:     suite.email,
+		UserId:    suite.userId,
+		ExpiresAt: suite.expiresAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), verification)
+
+	require.NoError(err)
+	require.Equal(uint(999), res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "user_verifications" ("created_at","token","email","user_id","expires_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.token, suite.email, suite.userId, suite.expiresAt).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+	verification := domain.UserVerification{
+		Token:     suite.token,
+		Email:     suite.email,
+		UserId:    suite.userId,
+		ExpiresAt: suite.expiresAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), verification)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSearchByExampleSuccess() {
+	require := require.New(suite.T())
+	example := domain.UserVerification{
+		Token: suite.token,
+	}
+	verificationExpected := domain.UserVerification{
+		ID:        999,
+		Token:     suite.token,
+		Email:     suite.email,
+		UserId:    suite.userId,
+		ExpiresAt: suite.expiresAt,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_verifications" WHERE "user_verifications"."token" = $1 ORDER BY "user_verifications"."id" LIMIT 1`)).
+		WithArgs(suite.token).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "token", "email", "user_id", "created_at", "expires_at"}).
+			AddRow(verificationExpected.ID, verificationExpected.Token, verificationExpected.Email, verificationExpected.UserId, time.Now(), verificationExpected.ExpiresAt),
+		)
+
+	res, err := suite.repository.SearchByExample(context.Background(), example)
+
+	require.NoError(err)
+	require.Equal(verificationExpected, res)
+}
+
+func (suite *TestSuite) TestSearchByExampleError() {
+	require := require.New(suite.T())
+	example := domain.UserVerification{
+		Token: suite.token,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_verifications" WHERE "user_verifications"."token" = $1 ORDER BY "user_verifications"."id" LIMIT 1`)).
+		WithArgs(suite.token).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	res, err := suite.repository.SearchByExample(context.Background(), example)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestDeleteByUserIdSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "user_verifications" WHERE user_id = $1`)).
+		WithArgs(suite.userId).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteByUserId(context.Background(), suite.userId)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteByUserIdError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "user_verifications" WHERE user_id = $1`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteByUserId(context.Background(), suite.userId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteByExpiresAtLessThanNowSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "user_verifications" WHERE expires_at < NOW()`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteByExpiresAtLessThanNow(context.Background())
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteByExpiresAtLessThanNowError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "user_verifications" WHERE expires_at < NOW()`)).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteByExpiresAtLessThanNow(context.Background())
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"context"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	shared_ent "your-accounts-api/shared/infrastructure/db/entity"
+	"your-accounts-api/users/domain"
+	"your-accounts-api/users/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.WebhookRepository {
+	return db.DefaultWithTransaction[domain.WebhookRepository](tx, NewRepository, r)
+}
+
+// Save creates the webhook, an existing webhook only updates its URL, events and state, the secret never changes
+func (r *gormRepository) Save(ctx context.Context, webhook domain.Webhook) (uint, error) {
+	events := []string{}
+	for _, event := range webhook.Events {
+		events = append(events, string(event))
+	}
+
+	model := &entity.Webhook{
+		URL:        webhook.URL,
+		Events:     events,
+		Failures:   webhook.Failures,
+		DisabledAt: webhook.DisabledAt,
+	}
+	if webhook.ID != 0 {
+		model.ID = webhook.ID
+		if err := r.db.WithContext(ctx).Model(model).Select("URL", "Events", "Failures", "DisabledAt").Updates(model).Error; err != nil {
+			return 0, err
+		}
+
+		return model.ID, nil
+	}
+
+	model.UserId = webhook.UserId
+	model.Secret = webhook.Secret
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+func (r *gormRepository) SearchByExample(ctx context.Context, example domain.Webhook) (domain.Webhook, error) {
+	where := entity.Webhook{
+		BaseModel: shared_ent.BaseModel{
+			ID: example.ID,
+		},
+		UserId: example.UserId,
+	}
+	model := new(entity.Webhook)
+	if err := r.db.WithContext(ctx).Where(where).First(model).Error; err != nil {
+		return domain.Webhook{}, err
+	}
+
+	return toDomain(*model), nil
+}
+
+func (r *gormRepository) SearchAllByExample(ctx context.Context, example domain.Webhook) ([]domain.Webhook, error) {
+	where := entity.Webhook{
+		UserId: example.UserId,
+	}
+	var models []entity.Webhook
+	if err := r.db.WithContext(ctx).Where(where).Order("id desc").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomains(models), nil
+}
+
+func (r *gormRepository) SearchAllEnabledByUserId(ctx context.Context, userId uint) ([]domain.Webhook, error) {
+	var models []entity.Webhook
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND disabled_at IS NULL", userId).Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomains(models), nil
+}
+
+func (r *gormRepository) Delete(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Where("webhook_id = ?", id).Delete(entity.WebhookDelivery{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Delete(&entity.Webhook{
+		BaseModel: shared_ent.BaseModel{
+			ID: id,
+		},
+	}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toDomain(model entity.Webhook) domain.Webhook {
+	events := []shared.EventName{}
+	for _, event := range model.Events {
+		events = append(events, shared.EventName(event))
+	}
+
+	return domain.Webhook{
+		ID:         model.ID,
+		UserId:     model.UserId,
+		URL:        model.URL,
+		Secret:     model.Secret,
+		Events:     events,
+		Failures:   model.Failures,
+		DisabledAt: model.DisabledAt,
+		CreatedAt:  model.CreatedAt,
+	}
+}
+
+func toDomains(models []entity.Webhook) []domain.Webhook {
+	webhooks := []domain.Webhook{}
+	for _, model := range models {
+		webhooks = append(webhooks, toDomain(model))
+	}
+
+	return webhooks
+}
+
+func NewRepository(db *gorm.DB) domain.WebhookRepository {
+	return &gormRepository{db}
+}
@@ -0,0 +1,342 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/test_utils"
+	"your-accounts-api/users/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	url        string
+	secret     string
+	userId     uint
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.WebhookRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.url = "https://example.com/hook"
+	suite.secret = "whsec_secret"
+	suite.userId = 999
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveSuccessNew() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "webhooks" ("created_at","user_id","url","secret","events","failures","disabled_at") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.userId, suite.url, suite.secret, `["bill.paid"]`, 0, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+	suite.mock.ExpectCommit()
+	webhook := domain.Webhook{
+		UserId: suite.userId,
+		URL:    suite.url,
+		Secret: suite.secret,
+		Events: []shared.EventName{shared.BillPaid},
+	}
+
+	res, err := suite.repository.Save(context.Background(), webhook)
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSaveSuccessExists() {
+	require := require.New(suite.T())
+	disabledAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "webhooks" SET "url"=$1,"events"=$2,"failures"=$3,"disabled_at"=$4 WHERE "id" = $5`)).
+		WithArgs(suite.url, `[]`, 5, disabledAt, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	webhook := domain.Webhook{
+		ID:         1,
+		UserId:     suite.userId,
+		URL:        suite.url,
+		Secret:     suite.secret,
+		Failures:   5,
+		DisabledAt: &disabledAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), webhook)
+
+	require.NoError(err)
+	require.Equal(uint(1), res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "webhooks" ("created_at","user_id","url","secret","events","failures","disabled_at") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.userId, suite.url, suite.secret, `[]`, 0, nil).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+	webhook := domain.Webhook{
+		UserId: suite.userId,
+		URL:    suite.url,
+		Secret: suite.secret,
+	}
+
+	res, err := suite.repository.Save(context.Background(), webhook)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSearchByExampleSuccess() {
+	require := require.New(suite.T())
+	createdAt := time.Now()
+	example := domain.Webhook{
+		ID:     1,
+		UserId: suite.userId,
+	}
+	webhookExpected := domain.Webhook{
+		ID:        1,
+		UserId:    suite.userId,
+		URL:       suite.url,
+		Secret:    suite.secret,
+		Events:    []shared.EventName{shared.BillPaid, shared.BudgetOverspent},
+		CreatedAt: createdAt,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE "webhooks"."id" = $1 AND "webhooks"."user_id" = $2 ORDER BY "webhooks"."id" LIMIT 1`)).
+		WithArgs(1, suite.userId).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "user_id", "url", "secret", "events", "failures", "disabled_at", "created_at"}).
+			AddRow(1, suite.userId, suite.url, suite.secret, `["bill.paid","budget.overspent"]`, 0, nil, createdAt),
+		)
+
+	res, err := suite.repository.SearchByExample(context.Background(), example)
+
+	require.NoError(err)
+	require.Equal(webhookExpected, res)
+}
+
+func (suite *TestSuite) TestSearchByExampleError() {
+	require := require.New(suite.T())
+	example := domain.Webhook{
+		ID:     2,
+		UserId: suite.userId,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE "webhooks"."id" = $1 AND "webhooks"."user_id" = $2 ORDER BY "webhooks"."id" LIMIT 1`)).
+		WithArgs(2, suite.userId).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	res, err := suite.repository.SearchByExample(context.Background(), example)
+
+	require.EqualError(gorm.ErrRecordNotFound, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleSuccess() {
+	require := require.New(suite.T())
+	createdAt := time.Now()
+	disabledAt := time.Now()
+	example := domain.Webhook{
+		UserId: suite.userId,
+	}
+	webhooksExpected := []domain.Webhook{
+		{
+			ID:         2,
+			UserId:     suite.userId,
+			URL:        suite.url,
+			Secret:     suite.secret,
+			Events:     []shared.EventName{},
+			Failures:   5,
+			DisabledAt: &disabledAt,
+			CreatedAt:  createdAt,
+		},
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE "webhooks"."user_id" = $1 ORDER BY id desc`)).
+		WithArgs(suite.userId).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "user_id", "url", "secret", "events", "failures", "disabled_at", "created_at"}).
+			AddRow(2, suite.userId, suite.url, suite.secret, `[]`, 5, disabledAt, createdAt),
+		)
+
+	res, err := suite.repository.SearchAllByExample(context.Background(), example)
+
+	require.NoError(err)
+	require.Equal(webhooksExpected, res)
+}
+
+func (suite *TestSuite) TestSearchAllByExampleError() {
+	require := require.New(suite.T())
+	example := domain.Webhook{
+		UserId: suite.userId,
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE "webhooks"."user_id" = $1 ORDER BY id desc`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	res, err := suite.repository.SearchAllByExample(context.Background(), example)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(res)
+}
+
+func (suite *TestSuite) TestSearchAllEnabledByUserIdSuccess() {
+	require := require.New(suite.T())
+	createdAt := time.Now()
+	webhooksExpected := []domain.Webhook{
+		{
+			ID:        1,
+			UserId:    suite.userId,
+			URL:       suite.url,
+			Secret:    suite.secret,
+			Events:    []shared.EventName{shared.BillPaid},
+			CreatedAt: createdAt,
+		},
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE user_id = $1 AND disabled_at IS NULL ORDER BY id`)).
+		WithArgs(suite.userId).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "user_id", "url", "secret", "events", "failures", "disabled_at", "created_at"}).
+			AddRow(1, suite.userId, suite.url, suite.secret, `["bill.paid"]`, 0, nil, createdAt),
+		)
+
+	res, err := suite.repository.SearchAllEnabledByUserId(context.Background(), suite.userId)
+
+	require.NoError(err)
+	require.Equal(webhooksExpected, res)
+}
+
+func (suite *TestSuite) TestSearchAllEnabledByUserIdError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE user_id = $1 AND disabled_at IS NULL ORDER BY id`)).
+		WithArgs(suite.userId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	res, err := suite.repository.SearchAllEnabledByUserId(context.Background(), suite.userId)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(res)
+}
+
+func (suite *TestSuite) TestDeleteSuccess() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "webhook_deliveries" WHERE webhook_id = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "webhooks" WHERE "webhooks"."id" = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.Delete(context.Background(), 1)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteErrorDeliveries() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "webhook_deliveries" WHERE webhook_id = $1`)).
+		WithArgs(2).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.Delete(context.Background(), 2)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "webhook_deliveries" WHERE webhook_id = $1`)).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "webhooks" WHERE "webhooks"."id" = $1`)).
+		WithArgs(3).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.Delete(context.Background(), 3)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,147 @@
+package webhook_delivery
+
+import (
+	"context"
+	"time"
+	"your-accounts-api/shared/domain/persistent"
+	"your-accounts-api/shared/infrastructure/db"
+	"your-accounts-api/users/domain"
+	"your-accounts-api/users/infrastructure/db/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRepository) WithTransaction(tx persistent.Transaction) domain.WebhookDeliveryRepository {
+	return db.DefaultWithTransaction[domain.WebhookDeliveryRepository](tx, NewRepository, r)
+}
+
+// Save creates the delivery, an existing delivery only updates the result of its attempts
+func (r *gormRepository) Save(ctx context.Context, delivery domain.WebhookDelivery) (uint, error) {
+	model := toModel(delivery)
+	if delivery.ID != 0 {
+		model.ID = delivery.ID
+		if err := r.db.WithContext(ctx).Model(model).Select("Status", "Attempts", "StatusCode", "Error", "NextAttemptAt", "DeliveredAt").Updates(model).Error; err != nil {
+			return 0, err
+		}
+
+		return model.ID, nil
+	}
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return 0, err
+	}
+
+	return model.ID, nil
+}
+
+// SaveAllNew skips the deliveries of an event already created for the webhook, the events can be
+// received more than once.
+func (r *gormRepository) SaveAllNew(ctx context.Context, deliveries []domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	models := []*entity.WebhookDelivery{}
+	for _, delivery := range deliveries {
+		models = append(models, toModel(delivery))
+	}
+
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&models).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormRepository) SearchAllByWebhookId(ctx context.Context, webhookId uint, limit int) ([]domain.WebhookDelivery, error) {
+	where := entity.WebhookDelivery{
+		WebhookId: webhookId,
+	}
+	var models []entity.WebhookDelivery
+	if err := r.db.WithContext(ctx).Where(where).Order("id desc").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomains(models), nil
+}
+
+// SearchAllPending locks the deliveries until the end of the transaction, the deliveries locked
+// by another worker are skipped.
+func (r *gormRepository) SearchAllPending(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	var models []entity.WebhookDelivery
+	if err := r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND next_attempt_at <= ?", domain.PendingDelivery, now).
+		Order("id").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return toDomains(models), nil
+}
+
+// UpdateNextAttemptAtByIds postpones the deliveries, the claimed deliveries are not pending again
+// until the next attempt.
+func (r *gormRepository) UpdateNextAttemptAtByIds(ctx context.Context, ids []uint, nextAttemptAt time.Time) error {
+	if err := r.db.WithContext(ctx).Model(&entity.WebhookDelivery{}).Where("id IN ?", ids).Update("next_attempt_at", nextAttemptAt).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *gormRepository) DeleteByCreatedAtLessThan(ctx context.Context, before time.Time) error {
+	if err := r.db.WithContext(ctx).Where("created_at < ?", before).Delete(entity.WebhookDelivery{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toModel(delivery domain.WebhookDelivery) *entity.WebhookDelivery {
+	if delivery.Payload == nil {
+		delivery.Payload = map[string]any{}
+	}
+
+	return &entity.WebhookDelivery{
+		WebhookId:     delivery.WebhookId,
+		EventId:       delivery.EventId,
+		Event:         delivery.Event,
+		Payload:       delivery.Payload,
+		Status:        delivery.Status,
+		Attempts:      delivery.Attempts,
+		StatusCode:    delivery.StatusCode,
+		Error:         delivery.Error,
+		NextAttemptAt: delivery.NextAttemptAt,
+		DeliveredAt:   delivery.DeliveredAt,
+	}
+}
+
+func toDomains(models []entity.WebhookDelivery) []domain.WebhookDelivery {
+	deliveries := []domain.WebhookDelivery{}
+	for _, model := range models {
+		deliveries = append(deliveries, domain.WebhookDelivery{
+			ID:            model.ID,
+			WebhookId:     model.WebhookId,
+			EventId:       model.EventId,
+			Event:         model.Event,
+			Payload:       model.Payload,
+			Status:        model.Status,
+			Attempts:      model.Attempts,
+			StatusCode:    model.StatusCode,
+			Error:         model.Error,
+			NextAttemptAt: model.NextAttemptAt,
+			DeliveredAt:   model.DeliveredAt,
+			CreatedAt:     model.CreatedAt,
+		})
+	}
+
+	return deliveries
+}
+
+func NewRepository(db *gorm.DB) domain.WebhookDeliveryRepository {
+	return &gormRepository{db}
+}
@@ -0,0 +1,350 @@
+package webhook_delivery
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	mocks_persistent "your-accounts-api/mocks/shared/domain/persistent"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/domain/test_utils"
+	"your-accounts-api/users/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type TestSuite struct {
+	suite.Suite
+	webhookId  uint
+	eventId    uint
+	mock       sqlmock.Sqlmock
+	mockTX     *mocks_persistent.MockTransaction
+	repository domain.WebhookDeliveryRepository
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.webhookId = 1
+	suite.eventId = 2
+
+	require := require.New(suite.T())
+
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, suite.mock, err = sqlmock.New()
+	require.NoError(err)
+	suite.mock.MatchExpectationsInOrder(false)
+
+	DB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	require.NoError(err)
+
+	suite.mockTX = mocks_persistent.NewMockTransaction(suite.T())
+	suite.repository = NewRepository(DB)
+}
+
+func (suite *TestSuite) TearDownTest() {
+	require.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessNew() {
+	require := require.New(suite.T())
+
+	suite.mockTX.On("Get").Return(new(gorm.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.NotEqual(suite.repository, repo)
+}
+
+func (suite *TestSuite) TestWithTransactionSuccessExists() {
+	require := require.New(suite.T())
+
+	getMock := suite.mockTX.On("Get").Return(new(sql.DB))
+
+	repo := suite.repository.WithTransaction(suite.mockTX)
+
+	require.NotNil(repo)
+	require.Equal(suite.repository, repo)
+	getMock.Unset()
+}
+
+func (suite *TestSuite) TestSaveSuccessNew() {
+	require := require.New(suite.T())
+	deliveredAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "webhook_deliveries" ("created_at","webhook_id","event_id","event","payload","status","attempts","status_code","error","next_attempt_at","delivered_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, suite.webhookId, nil, domain.WebhookTest, `{}`, domain.SucceededDelivery, 1, 200, "", nil, deliveredAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(10)))
+	suite.mock.ExpectCommit()
+	delivery := domain.WebhookDelivery{
+		WebhookId:   suite.webhookId,
+		Event:       domain.WebhookTest,
+		Status:      domain.SucceededDelivery,
+		Attempts:    1,
+		StatusCode:  200,
+		DeliveredAt: &deliveredAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), delivery)
+
+	require.NoError(err)
+	require.Equal(uint(10), res)
+}
+
+func (suite *TestSuite) TestSaveSuccessExists() {
+	require := require.New(suite.T())
+	nextAttemptAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "status"=$1,"attempts"=$2,"status_code"=$3,"error"=$4,"next_attempt_at"=$5,"delivered_at"=$6 WHERE "id" = $7`)).
+		WithArgs(domain.PendingDelivery, 2, 500, "unexpected status code 500", nextAttemptAt, nil, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	delivery := domain.WebhookDelivery{
+		ID:            10,
+		WebhookId:     suite.webhookId,
+		Status:        domain.PendingDelivery,
+		Attempts:      2,
+		StatusCode:    500,
+		Error:         "unexpected status code 500",
+		NextAttemptAt: &nextAttemptAt,
+	}
+
+	res, err := suite.repository.Save(context.Background(), delivery)
+
+	require.NoError(err)
+	require.Equal(uint(10), res)
+}
+
+func (suite *TestSuite) TestSaveError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "status"=$1,"attempts"=$2,"status_code"=$3,"error"=$4,"next_attempt_at"=$5,"delivered_at"=$6 WHERE "id" = $7`)).
+		WithArgs(domain.FailedDelivery, 6, 0, "timeout", nil, nil, 11).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+	delivery := domain.WebhookDelivery{
+		ID:       11,
+		Status:   domain.FailedDelivery,
+		Attempts: 6,
+		Error:    "timeout",
+	}
+
+	res, err := suite.repository.Save(context.Background(), delivery)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestSaveAllNewSuccess() {
+	require := require.New(suite.T())
+	nextAttemptAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "webhook_deliveries" ("created_at","webhook_id","event_id","event","payload","status","attempts","status_code","error","next_attempt_at","delivered_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11),($12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22) ON CONFLICT DO NOTHING RETURNING "id"`)).
+		WithArgs(
+			test_utils.AnyTime{}, suite.webhookId, suite.eventId, shared.BillPaid, `{"amount":10}`, domain.PendingDelivery, 0, 0, "", nextAttemptAt, nil,
+			test_utils.AnyTime{}, 3, suite.eventId, shared.BillPaid, `{"amount":10}`, domain.PendingDelivery, 0, 0, "", nextAttemptAt, nil,
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(10)).AddRow(int64(11)))
+	suite.mock.ExpectCommit()
+	deliveries := []domain.WebhookDelivery{
+		{WebhookId: suite.webhookId, EventId: &suite.eventId, Event: shared.BillPaid, Payload: map[string]any{"amount": 10}, Status: domain.PendingDelivery, NextAttemptAt: &nextAttemptAt},
+		{WebhookId: 3, EventId: &suite.eventId, Event: shared.BillPaid, Payload: map[string]any{"amount": 10}, Status: domain.PendingDelivery, NextAttemptAt: &nextAttemptAt},
+	}
+
+	err := suite.repository.SaveAllNew(context.Background(), deliveries)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestSaveAllNewSuccessEmpty() {
+	require := require.New(suite.T())
+
+	err := suite.repository.SaveAllNew(context.Background(), []domain.WebhookDelivery{})
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestSaveAllNewError() {
+	require := require.New(suite.T())
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`INSERT INTO "webhook_deliveries" ("created_at","webhook_id","event_id","event","payload","status","attempts","status_code","error","next_attempt_at","delivered_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) ON CONFLICT DO NOTHING RETURNING "id"`)).
+		WithArgs(test_utils.AnyTime{}, 4, suite.eventId, shared.BudgetCreated, `{}`, domain.PendingDelivery, 0, 0, "", nil, nil).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+	deliveries := []domain.WebhookDelivery{
+		{WebhookId: 4, EventId: &suite.eventId, Event: shared.BudgetCreated, Status: domain.PendingDelivery},
+	}
+
+	err := suite.repository.SaveAllNew(context.Background(), deliveries)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestSearchAllByWebhookIdSuccess() {
+	require := require.New(suite.T())
+	createdAt := time.Now()
+	deliveriesExpected := []domain.WebhookDelivery{
+		{
+			ID:         10,
+			WebhookId:  suite.webhookId,
+			EventId:    &suite.eventId,
+			Event:      shared.BillPaid,
+			Payload:    map[string]any{"amount": float64(10)},
+			Status:     domain.FailedDelivery,
+			Attempts:   6,
+			StatusCode: 500,
+			Error:      "unexpected status code 500",
+			CreatedAt:  createdAt,
+		},
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE "webhook_deliveries"."webhook_id" = $1 ORDER BY id desc LIMIT 20`)).
+		WithArgs(suite.webhookId).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "webhook_id", "event_id", "event", "payload", "status", "attempts", "status_code", "error", "next_attempt_at", "delivered_at", "created_at"}).
+			AddRow(10, suite.webhookId, suite.eventId, shared.BillPaid, `{"amount":10}`, domain.FailedDelivery, 6, 500, "unexpected status code 500", nil, nil, createdAt),
+		)
+
+	res, err := suite.repository.SearchAllByWebhookId(context.Background(), suite.webhookId, 20)
+
+	require.NoError(err)
+	require.Equal(deliveriesExpected, res)
+}
+
+func (suite *TestSuite) TestSearchAllByWebhookIdError() {
+	require := require.New(suite.T())
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE "webhook_deliveries"."webhook_id" = $1 ORDER BY id desc LIMIT 50`)).
+		WithArgs(suite.webhookId).
+		WillReturnError(gorm.ErrInvalidField)
+
+	res, err := suite.repository.SearchAllByWebhookId(context.Background(), suite.webhookId, 50)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(res)
+}
+
+func (suite *TestSuite) TestSearchAllPendingSuccess() {
+	require := require.New(suite.T())
+	now := time.Now()
+	deliveriesExpected := []domain.WebhookDelivery{
+		{
+			ID:            10,
+			WebhookId:     suite.webhookId,
+			EventId:       &suite.eventId,
+			Event:         shared.BillPaid,
+			Payload:       map[string]any{},
+			Status:        domain.PendingDelivery,
+			NextAttemptAt: &now,
+			CreatedAt:     now,
+		},
+	}
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE status = $1 AND next_attempt_at <= $2 ORDER BY id LIMIT 10 FOR UPDATE SKIP LOCKED`)).
+		WithArgs(domain.PendingDelivery, now).
+		WillReturnRows(sqlmock.
+			NewRows([]string{"id", "webhook_id", "event_id", "event", "payload", "status", "attempts", "status_code", "error", "next_attempt_at", "delivered_at", "created_at"}).
+			AddRow(10, suite.webhookId, suite.eventId, shared.BillPaid, `{}`, domain.PendingDelivery, 0, 0, "", now, nil, now),
+		)
+
+	res, err := suite.repository.SearchAllPending(context.Background(), now, 10)
+
+	require.NoError(err)
+	require.Equal(deliveriesExpected, res)
+}
+
+func (suite *TestSuite) TestSearchAllPendingError() {
+	require := require.New(suite.T())
+	now := time.Now()
+	suite.mock.
+		ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE status = $1 AND next_attempt_at <= $2 ORDER BY id LIMIT 30 FOR UPDATE SKIP LOCKED`)).
+		WithArgs(domain.PendingDelivery, now).
+		WillReturnError(gorm.ErrInvalidField)
+
+	res, err := suite.repository.SearchAllPending(context.Background(), now, 30)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+	require.Nil(res)
+}
+
+func (suite *TestSuite) TestUpdateNextAttemptAtByIdsSuccess() {
+	require := require.New(suite.T())
+	nextAttemptAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "next_attempt_at"=$1 WHERE id IN ($2,$3)`)).
+		WithArgs(nextAttemptAt, 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.UpdateNextAttemptAtByIds(context.Background(), []uint{1, 2}, nextAttemptAt)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestUpdateNextAttemptAtByIdsError() {
+	require := require.New(suite.T())
+	nextAttemptAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "next_attempt_at"=$1 WHERE id IN ($2,$3)`)).
+		WithArgs(nextAttemptAt, 1, 2).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.UpdateNextAttemptAtByIds(context.Background(), []uint{1, 2}, nextAttemptAt)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func (suite *TestSuite) TestDeleteByCreatedAtLessThanSuccess() {
+	require := require.New(suite.T())
+	before := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "webhook_deliveries" WHERE created_at < $1`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 10))
+	suite.mock.ExpectCommit()
+
+	err := suite.repository.DeleteByCreatedAtLessThan(context.Background(), before)
+
+	require.NoError(err)
+}
+
+func (suite *TestSuite) TestDeleteByCreatedAtLessThanError() {
+	require := require.New(suite.T())
+	before := time.Now().Add(time.Hour)
+	suite.mock.ExpectBegin()
+	suite.mock.
+		ExpectExec(regexp.QuoteMeta(`DELETE FROM "webhook_deliveries" WHERE created_at < $1`)).
+		WithArgs(before).
+		WillReturnError(gorm.ErrInvalidField)
+	suite.mock.ExpectRollback()
+
+	err := suite.repository.DeleteByCreatedAtLessThan(context.Background(), before)
+
+	require.EqualError(gorm.ErrInvalidField, err.Error())
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
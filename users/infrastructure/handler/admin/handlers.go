@@ -0,0 +1,266 @@
+package admin
+
+import (
+	"errors"
+	budgets "your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/auth"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+	"your-accounts-api/users/application"
+	"your-accounts-api/users/infrastructure/handler/apikeys"
+	"your-accounts-api/users/infrastructure/model"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type controller struct {
+	app application.IAdminApp
+}
+
+// AdminUsersHandler godoc
+//
+//	@Summary		Search users
+//	@Description	search the users of the system by email and role, requires the support or admin role
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization			header		string		true	"Access token"
+//	@Param			email					query		string		false	"Part of the email"
+//	@Param			role					query		string		false	"Role"	Enums(user, support, admin)
+//	@Param			page					query		int			false	"Page, starting at 1"
+//	@Success		200						{array}		model.AdminUserResponse
+//	@Failure		400						{string}	string
+//	@Failure		401						{string}	string
+//	@Failure		403						{string}	string
+//	@Failure		422						{string}	string
+//	@Failure		500						{string}	string
+//	@Router			/api/v1/admin/users/	[get]
+func (ctrl *controller) users(c *fiber.Ctx) error {
+	userData := getUserData(c)
+	request := c.Locals(validation.RequestQuery).(*model.AdminUsersRequest)
+
+	users, err := ctrl.app.FindUsers(c.UserContext(), userData.ID, request.Email, request.Role, request.Page)
+	if err != nil {
+		log.Error("Error searching users:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error searching users")
+	}
+
+	response := []model.AdminUserResponse{}
+	for _, user := range users {
+		response = append(response, model.NewAdminUserResponse(user))
+	}
+
+	return c.JSON(response)
+}
+
+// AdminDisableHandler godoc
+//
+//	@Summary		Disable user
+//	@Description	disable the account of an user and close its sessions, requires the admin role
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization						header		string	true	"Access token"
+//	@Param			id									path		uint	true	"User ID"
+//	@Success		204									{string}	string
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		403									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		409									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/admin/users/{id}/disable	[put]
+func (ctrl *controller) disable(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.Disable(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error disabling user:", err)
+		return handleError(err, "Error disabling user")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// AdminEnableHandler godoc
+//
+//	@Summary		Enable user
+//	@Description	enable again the account of a disabled user, requires the admin role
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization						header		string	true	"Access token"
+//	@Param			id									path		uint	true	"User ID"
+//	@Success		204									{string}	string
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		403									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		409									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/admin/users/{id}/enable	[put]
+func (ctrl *controller) enable(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.Enable(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error enabling user:", err)
+		return handleError(err, "Error enabling user")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// AdminLogoutHandler godoc
+//
+//	@Summary		Force logout
+//	@Description	close all the sessions of an user, requires the support or admin role
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization						header		string	true	"Access token"
+//	@Param			id									path		uint	true	"User ID"
+//	@Success		204									{string}	string
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		403									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/admin/users/{id}/sessions	[delete]
+func (ctrl *controller) logout(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.Logout(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error closing user sessions:", err)
+		return handleError(err, "Error closing user sessions")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// AdminChangeRoleHandler godoc
+//
+//	@Summary		Change role
+//	@Description	change the role of an user and close its sessions, requires the admin role
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization					header		string					true	"Access token"
+//	@Param			id								path		uint					true	"User ID"
+//	@Param			request							body		model.ChangeRoleRequest	true	"Role data"
+//	@Success		204								{string}	string
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		403								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		422								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/admin/users/{id}/role	[put]
+func (ctrl *controller) changeRole(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+	request := c.Locals(validation.RequestBody).(*model.ChangeRoleRequest)
+
+	err = ctrl.app.ChangeRole(c.UserContext(), userData.ID, uint(id), request.Role)
+	if err != nil {
+		log.Error("Error changing user role:", err)
+		return handleError(err, "Error changing user role")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// AdminBudgetsHandler godoc
+//
+//	@Summary		Read user budgets
+//	@Description	read the budgets of an user for support, requires the support or admin role
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization						header		string	true	"Access token"
+//	@Param			id									path		uint	true	"User ID"
+//	@Success		200									{array}		budgets.ReadByIDResponse
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		403									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/admin/users/{id}/budgets	[get]
+func (ctrl *controller) budgets(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	budgetList, err := ctrl.app.FindBudgets(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error reading user budgets:", err)
+		return handleError(err, "Error reading user budgets")
+	}
+
+	response := []budgets.ReadByIDResponse{}
+	for _, budget := range budgetList {
+		response = append(response, budgets.NewReadByIDResponse(budget))
+	}
+
+	return c.JSON(response)
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.AdminApp}
+	adminOnly := auth.RequireRoles(shared.AdminRole)
+
+	group := router.Group("/admin", apikeys.RequireSession(), auth.RequireRoles(shared.SupportRole, shared.AdminRole))
+	group.Get("/users/", validation.RequestQueryValid(model.AdminUsersRequest{}), controller.users)
+	group.Put("/users/:id<min(1)>/disable", adminOnly, controller.disable)
+	group.Put("/users/:id<min(1)>/enable", adminOnly, controller.enable)
+	group.Delete("/users/:id<min(1)>/sessions", controller.logout)
+	group.Put("/users/:id<min(1)>/role", adminOnly, validation.RequestBodyValid(model.ChangeRoleRequest{}), controller.changeRole)
+	group.Get("/users/:id<min(1)>/budgets", controller.budgets)
+}
+
+func handleError(err error, message string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, "User not found")
+	} else if errors.Is(err, application.ErrSelfAction) {
+		return fiber.NewError(fiber.StatusForbidden, err.Error())
+	} else if errors.Is(err, application.ErrUserAlreadyDisabled) || errors.Is(err, application.ErrUserNotDisabled) {
+		return fiber.NewError(fiber.StatusConflict, err.Error())
+	} else if errors.Is(err, application.ErrInvalidRole) {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return fiber.NewError(fiber.StatusInternalServerError, message)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
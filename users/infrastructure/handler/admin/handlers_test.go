@@ -0,0 +1,306 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	budgets_domain "your-accounts-api/budgets/domain"
+	budgets "your-accounts-api/budgets/infrastructure/model"
+	mocks_application "your-accounts-api/mocks/users/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/users/application"
+	"your-accounts-api/users/domain"
+	"your-accounts-api/users/infrastructure/handler/apikeys"
+	"your-accounts-api/users/infrastructure/model"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	adminId uint
+	userId  uint
+	role    shared.Role
+	app     *fiber.App
+	mock    *mocks_application.MockIAdminApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.adminId = 1
+	suite.userId = 999
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.role = shared.AdminRole
+	suite.mock = mocks_application.NewMockIAdminApp(suite.T())
+	injection.AdminApp = suite.mock
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", &jwt.Token{
+			Claims: &shared.JwtUserClaims{
+				ID:   suite.adminId,
+				Role: suite.role,
+			},
+		})
+		if c.Get("X-Test-ApiKey") != "" {
+			c.Locals(apikeys.ApiKeyLocal, domain.ApiKey{UserId: suite.adminId, Scope: domain.WriteScope})
+		}
+
+		return c.Next()
+	})
+	NewRoute(suite.app)
+}
+
+func (suite *TestSuite) TestUsers200() {
+	require := require.New(suite.T())
+	users := []domain.User{{ID: suite.userId, Email: "test@email.com", Role: shared.UserRole}}
+	suite.role = shared.SupportRole
+	suite.mock.On("FindUsers", mock.Anything, suite.adminId, "test", shared.UserRole, 2).Return(users, nil)
+	expectedBody, err := json.Marshal([]model.AdminUserResponse{model.NewAdminUserResponse(users[0])})
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/admin/users/?email=test&role=user&page=2", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestUsers403() {
+	require := require.New(suite.T())
+	suite.role = shared.UserRole
+
+	request := httptest.NewRequest(fiber.MethodGet, "/admin/users/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestUsers403ApiKey() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/admin/users/", nil)
+	request.Header.Set("X-Test-ApiKey", "true")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestUsers422() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/admin/users/?role=root", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func (suite *TestSuite) TestUsers500() {
+	require := require.New(suite.T())
+	suite.mock.On("FindUsers", mock.Anything, suite.adminId, "", shared.Role(""), 0).Return(nil, errors.New("connection lost"))
+
+	request := httptest.NewRequest(fiber.MethodGet, "/admin/users/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDisable204() {
+	require := require.New(suite.T())
+	suite.mock.On("Disable", mock.Anything, suite.adminId, suite.userId).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/admin/users/999/disable", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNoContent, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDisable403Support() {
+	require := require.New(suite.T())
+	suite.role = shared.SupportRole
+
+	request := httptest.NewRequest(fiber.MethodPut, "/admin/users/999/disable", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDisable403SelfAction() {
+	require := require.New(suite.T())
+	suite.mock.On("Disable", mock.Anything, suite.adminId, suite.adminId).Return(application.ErrSelfAction)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/admin/users/1/disable", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDisable404() {
+	require := require.New(suite.T())
+	suite.mock.On("Disable", mock.Anything, suite.adminId, suite.userId).Return(gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/admin/users/999/disable", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDisable409() {
+	require := require.New(suite.T())
+	suite.mock.On("Disable", mock.Anything, suite.adminId, suite.userId).Return(application.ErrUserAlreadyDisabled)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/admin/users/999/disable", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+}
+
+func (suite *TestSuite) TestEnable204() {
+	require := require.New(suite.T())
+	suite.mock.On("Enable", mock.Anything, suite.adminId, suite.userId).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/admin/users/999/enable", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNoContent, response.StatusCode)
+}
+
+func (suite *TestSuite) TestEnable500() {
+	require := require.New(suite.T())
+	suite.mock.On("Enable", mock.Anything, suite.adminId, suite.userId).Return(errors.New("connection lost"))
+
+	request := httptest.NewRequest(fiber.MethodPut, "/admin/users/999/enable", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func (suite *TestSuite) TestLogout204() {
+	require := require.New(suite.T())
+	suite.role = shared.SupportRole
+	suite.mock.On("Logout", mock.Anything, suite.adminId, suite.userId).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/admin/users/999/sessions", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNoContent, response.StatusCode)
+}
+
+func (suite *TestSuite) TestLogout404() {
+	require := require.New(suite.T())
+	suite.mock.On("Logout", mock.Anything, suite.adminId, suite.userId).Return(gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/admin/users/999/sessions", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestChangeRole204() {
+	require := require.New(suite.T())
+	body, err := json.Marshal(model.ChangeRoleRequest{Role: shared.SupportRole})
+	require.NoError(err)
+	suite.mock.On("ChangeRole", mock.Anything, suite.adminId, suite.userId, shared.SupportRole).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/admin/users/999/role", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNoContent, response.StatusCode)
+}
+
+func (suite *TestSuite) TestChangeRole422() {
+	require := require.New(suite.T())
+	body, err := json.Marshal(model.ChangeRoleRequest{Role: shared.Role("root")})
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/admin/users/999/role", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func (suite *TestSuite) TestBudgets200() {
+	require := require.New(suite.T())
+	id := uint(5)
+	name := "Test"
+	year := uint16(2023)
+	month := uint8(9)
+	income := 1000.0
+	budgetList := []budgets_domain.Budget{{ID: &id, Name: &name, Year: &year, Month: &month, FixedIncome: &income, AdditionalIncome: &income}}
+	suite.role = shared.SupportRole
+	suite.mock.On("FindBudgets", mock.Anything, suite.adminId, suite.userId).Return(budgetList, nil)
+	expectedBody, err := json.Marshal([]budgets.ReadByIDResponse{budgets.NewReadByIDResponse(budgetList[0])})
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/admin/users/999/budgets", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestBudgets404() {
+	require := require.New(suite.T())
+	suite.mock.On("FindBudgets", mock.Anything, suite.adminId, suite.userId).Return(nil, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/admin/users/999/budgets", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
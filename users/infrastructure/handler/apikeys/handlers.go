@@ -0,0 +1,138 @@
+package apikeys
+
+import (
+	"errors"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+	"your-accounts-api/users/application"
+	"your-accounts-api/users/infrastructure/model"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type controller struct {
+	app application.IApiKeyApp
+}
+
+// ApiKeyCreateHandler godoc
+//
+//	@Summary		Create API key
+//	@Description	create a personal API key, the key is only returned in this response
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization			header		string						true	"Access token"
+//	@Param			request					body		model.CreateApiKeyRequest	true	"API key data"
+//	@Success		201						{object}	model.CreateApiKeyResponse
+//	@Failure		400						{string}	string
+//	@Failure		401						{string}	string
+//	@Failure		403						{string}	string
+//	@Failure		404						{string}	string
+//	@Failure		422						{string}	string
+//	@Failure		500						{string}	string
+//	@Router			/api/v1/user/api-keys/	[post]
+func (ctrl *controller) create(c *fiber.Ctx) error {
+	userData := getUserData(c)
+	request := c.Locals(validation.RequestBody).(*model.CreateApiKeyRequest)
+
+	apiKey, key, err := ctrl.app.Create(c.UserContext(), userData.ID, request.Name, request.Scope, request.ExpiresAt)
+	if err != nil {
+		log.Error("Error creating API key:", err)
+		if errors.Is(err, application.ErrInvalidExpiry) {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		} else if errors.Is(err, application.ErrEmailNotVerified) {
+			return fiber.NewError(fiber.StatusForbidden, err.Error())
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "User not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error creating API key")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewCreateApiKeyResponse(apiKey, key))
+}
+
+// ApiKeyReadHandler godoc
+//
+//	@Summary		Read API keys
+//	@Description	read the API keys of the authenticated user without the key
+//	@Tags			user
+//	@Produce		json
+//	@Param			Authorization			header		string	true	"Access token"
+//	@Success		200						{array}		model.ApiKeyResponse
+//	@Failure		401						{string}	string
+//	@Failure		403						{string}	string
+//	@Failure		500						{string}	string
+//	@Router			/api/v1/user/api-keys/	[get]
+func (ctrl *controller) read(c *fiber.Ctx) error {
+	userData := getUserData(c)
+
+	apiKeys, err := ctrl.app.FindByUserId(c.UserContext(), userData.ID)
+	if err != nil {
+		log.Error("Error reading API keys:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading API keys")
+	}
+
+	response := []model.ApiKeyResponse{}
+	for _, apiKey := range apiKeys {
+		response = append(response, model.NewApiKeyResponse(apiKey))
+	}
+
+	return c.JSON(response)
+}
+
+// ApiKeyRevokeHandler godoc
+//
+//	@Summary		Revoke API key
+//	@Description	revoke an API key of the authenticated user
+//	@Tags			user
+//	@Produce		json
+//	@Param			Authorization				header		string	true	"Access token"
+//	@Param			id							path		uint	true	"API key ID"
+//	@Success		204							{string}	string
+//	@Failure		400							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		403							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/api/v1/user/api-keys/{id}	[delete]
+func (ctrl *controller) revoke(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.Revoke(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error revoking API key:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "API key not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error revoking API key")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.ApiKeyApp}
+
+	group := router.Group("/api-keys", RequireSession())
+	group.Post("/", validation.RequestBodyValid(model.CreateApiKeyRequest{}), controller.create)
+	group.Get("/", controller.read)
+	group.Delete("/:id<min(1)>", controller.revoke)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
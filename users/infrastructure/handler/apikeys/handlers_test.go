@@ -0,0 +1,245 @@
+package apikeys
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+	mocks_application "your-accounts-api/mocks/users/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/users/application"
+	"your-accounts-api/users/domain"
+	"your-accounts-api/users/infrastructure/model"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId uint
+	apiKey domain.ApiKey
+	app    *fiber.App
+	mock   *mocks_application.MockIApiKeyApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	suite.apiKey = domain.ApiKey{
+		ID:        999,
+		Name:      "Test",
+		Prefix:    "ya_12345678",
+		Scope:     domain.ReadScope,
+		UserId:    suite.userId,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIApiKeyApp(suite.T())
+	injection.ApiKeyApp = suite.mock
+
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		if c.Get("X-Test-ApiKey") != "" {
+			c.Locals(ApiKeyLocal, suite.apiKey)
+		}
+
+		return c.Next()
+	})
+	NewRoute(suite.app)
+}
+
+func (suite *TestSuite) TestCreate201() {
+	require := require.New(suite.T())
+	requestBody := model.CreateApiKeyRequest{
+		Name:  suite.apiKey.Name,
+		Scope: suite.apiKey.Scope,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("Create", mock.Anything, suite.userId, suite.apiKey.Name, suite.apiKey.Scope, (*time.Time)(nil)).Return(suite.apiKey, "ya_key", nil)
+	expectedBody, err := json.Marshal(model.NewCreateApiKeyResponse(suite.apiKey, "ya_key"))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/api-keys/", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusCreated, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreate400() {
+	require := require.New(suite.T())
+	requestBody := model.CreateApiKeyRequest{
+		Name:  suite.apiKey.Name,
+		Scope: suite.apiKey.Scope,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("Create", mock.Anything, suite.userId, suite.apiKey.Name, suite.apiKey.Scope, (*time.Time)(nil)).Return(domain.ApiKey{}, "", application.ErrInvalidExpiry)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/api-keys/", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusBadRequest, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCreate403EmailNotVerified() {
+	require := require.New(suite.T())
+	requestBody := model.CreateApiKeyRequest{
+		Name:  suite.apiKey.Name,
+		Scope: suite.apiKey.Scope,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("Create", mock.Anything, suite.userId, suite.apiKey.Name, suite.apiKey.Scope, (*time.Time)(nil)).Return(domain.ApiKey{}, "", application.ErrEmailNotVerified)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/api-keys/", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCreate403ApiKey() {
+	require := require.New(suite.T())
+	requestBody := model.CreateApiKeyRequest{
+		Name:  suite.apiKey.Name,
+		Scope: suite.apiKey.Scope,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/api-keys/", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	request.Header.Set("X-Test-ApiKey", "true")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCreate422() {
+	require := require.New(suite.T())
+	requestBody := model.CreateApiKeyRequest{
+		Name:  suite.apiKey.Name,
+		Scope: "admin",
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/api-keys/", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRead200() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByUserId", mock.Anything, suite.userId).Return([]domain.ApiKey{suite.apiKey}, nil)
+	expectedBody, err := json.Marshal([]model.ApiKeyResponse{model.NewApiKeyResponse(suite.apiKey)})
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/api-keys/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestRead403ApiKey() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/api-keys/", nil)
+	request.Header.Set("X-Test-ApiKey", "true")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRead500() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByUserId", mock.Anything, suite.userId).Return(nil, gorm.ErrInvalidField)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/api-keys/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRevoke204() {
+	require := require.New(suite.T())
+	suite.mock.On("Revoke", mock.Anything, suite.userId, uint(999)).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/api-keys/999", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNoContent, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRevoke403ApiKey() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/api-keys/999", nil)
+	request.Header.Set("X-Test-ApiKey", "true")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRevoke404() {
+	require := require.New(suite.T())
+	suite.mock.On("Revoke", mock.Anything, suite.userId, uint(999)).Return(gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/api-keys/999", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,76 @@
+package apikeys
+
+import (
+	"errors"
+	"strings"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/users/application"
+	"your-accounts-api/users/domain"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+const (
+	AuthScheme  = "ApiKey"
+	ApiKeyLocal = "apiKey"
+)
+
+// NewMiddleware authenticates requests sent with the "Authorization: ApiKey <key>" header,
+// requests with any other scheme are left to the next middleware.
+func NewMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), AuthScheme+" ")
+		if !ok {
+			return c.Next()
+		}
+
+		apiKey, err := injection.ApiKeyApp.Authenticate(c.UserContext(), strings.TrimSpace(key))
+		if err != nil {
+			log.Error("Error authenticating API key:", err)
+			if errors.Is(err, application.ErrInvalidApiKey) || errors.Is(err, application.ErrUserDisabled) || errors.Is(err, gorm.ErrRecordNotFound) {
+				return fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired API key")
+			}
+
+			return fiber.NewError(fiber.StatusInternalServerError, "Error authenticating API key")
+		}
+
+		if apiKey.Scope == domain.ReadScope && c.Method() != fiber.MethodGet && c.Method() != fiber.MethodHead {
+			return fiber.NewError(fiber.StatusForbidden, "API key scope does not allow this operation")
+		}
+
+		c.Locals(ApiKeyLocal, apiKey)
+		c.Locals("user", &jwt.Token{
+			// API keys never carry elevated roles, the admin API requires a session
+			Claims: &shared.JwtUserClaims{
+				ID:   apiKey.UserId,
+				Role: shared.UserRole,
+			},
+			Valid: true,
+		})
+		return c.Next()
+	}
+}
+
+// IsApiKeyRequest reports whether the request was authenticated with an API key,
+// used to skip the JWT validation.
+func IsApiKeyRequest(c *fiber.Ctx) bool {
+	return c.Locals(ApiKeyLocal) != nil
+}
+
+// RequireSession rejects the requests authenticated with an API key, the routes that manage the
+// credentials, the email, the account, the webhooks and the admin API need a session so a leaked
+// key can not take over the account.
+func RequireSession() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if IsApiKeyRequest(c) {
+			return fiber.NewError(fiber.StatusForbidden, "API keys can not be used on this route")
+		}
+
+		return c.Next()
+	}
+}
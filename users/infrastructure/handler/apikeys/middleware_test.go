@@ -0,0 +1,160 @@
+package apikeys
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+	mocks_application "your-accounts-api/mocks/users/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/users/application"
+	"your-accounts-api/users/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestMiddlewareSuite struct {
+	suite.Suite
+	key  string
+	app  *fiber.App
+	mock *mocks_application.MockIApiKeyApp
+}
+
+func (suite *TestMiddlewareSuite) SetupSuite() {
+	suite.key = "ya_key"
+}
+
+func (suite *TestMiddlewareSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIApiKeyApp(suite.T())
+	injection.ApiKeyApp = suite.mock
+
+	suite.app = fiber.New()
+	suite.app.Use(NewMiddleware())
+	handler := func(c *fiber.Ctx) error {
+		if !IsApiKeyRequest(c) {
+			return c.SendString("Next")
+		}
+
+		token := c.Locals("user").(*jwt.Token)
+		return c.JSON(token.Claims.(*shared.JwtUserClaims).ID)
+	}
+	suite.app.Get("/", handler)
+	suite.app.Post("/", handler)
+}
+
+func (suite *TestMiddlewareSuite) TestWithoutApiKey() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	request.Header.Set(fiber.HeaderAuthorization, "Bearer <token>")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal([]byte("Next"), resp)
+}
+
+func (suite *TestMiddlewareSuite) TestApiKeySuccess() {
+	require := require.New(suite.T())
+	suite.mock.On("Authenticate", mock.Anything, suite.key).Return(domain.ApiKey{
+		ID:     999,
+		Scope:  domain.WriteScope,
+		UserId: 1,
+	}, nil)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/", nil)
+	request.Header.Set(fiber.HeaderAuthorization, "ApiKey "+suite.key)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal([]byte("1"), resp)
+}
+
+func (suite *TestMiddlewareSuite) TestApiKeyReadScopeSuccess() {
+	require := require.New(suite.T())
+	suite.mock.On("Authenticate", mock.Anything, suite.key).Return(domain.ApiKey{
+		ID:     999,
+		Scope:  domain.ReadScope,
+		UserId: 1,
+	}, nil)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	request.Header.Set(fiber.HeaderAuthorization, "ApiKey "+suite.key)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+}
+
+func (suite *TestMiddlewareSuite) TestApiKeyReadScopeForbidden() {
+	require := require.New(suite.T())
+	suite.mock.On("Authenticate", mock.Anything, suite.key).Return(domain.ApiKey{
+		ID:     999,
+		Scope:  domain.ReadScope,
+		UserId: 1,
+	}, nil)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/", nil)
+	request.Header.Set(fiber.HeaderAuthorization, "ApiKey "+suite.key)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestMiddlewareSuite) TestApiKeyUnauthorized() {
+	require := require.New(suite.T())
+	suite.mock.On("Authenticate", mock.Anything, suite.key).Return(domain.ApiKey{}, application.ErrInvalidApiKey)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	request.Header.Set(fiber.HeaderAuthorization, "ApiKey "+suite.key)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnauthorized, response.StatusCode)
+}
+
+func (suite *TestMiddlewareSuite) TestApiKeyNotFound() {
+	require := require.New(suite.T())
+	suite.mock.On("Authenticate", mock.Anything, suite.key).Return(domain.ApiKey{}, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	request.Header.Set(fiber.HeaderAuthorization, "ApiKey "+suite.key)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnauthorized, response.StatusCode)
+}
+
+func (suite *TestMiddlewareSuite) TestApiKeyError() {
+	require := require.New(suite.T())
+	suite.mock.On("Authenticate", mock.Anything, suite.key).Return(domain.ApiKey{}, gorm.ErrInvalidField)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	request.Header.Set(fiber.HeaderAuthorization, "ApiKey "+suite.key)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func TestTestMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(TestMiddlewareSuite))
+}
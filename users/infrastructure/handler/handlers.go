@@ -2,12 +2,22 @@ package handler
 
 import (
 	"errors"
+	"math"
+	"strconv"
 
 	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
 
+	shared "your-accounts-api/shared/domain"
 	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/ratelimit"
 	"your-accounts-api/shared/infrastructure/validation"
 	"your-accounts-api/users/application"
+	"your-accounts-api/users/domain"
+	"your-accounts-api/users/infrastructure/handler/admin"
+	"your-accounts-api/users/infrastructure/handler/apikeys"
+	"your-accounts-api/users/infrastructure/handler/oidc"
+	"your-accounts-api/users/infrastructure/handler/webhooks"
 	"your-accounts-api/users/infrastructure/model"
 
 	"github.com/gofiber/fiber/v2"
@@ -58,16 +68,27 @@ func (ctrl *controller) create(c *fiber.Ctx) error {
 //	@Success		200		{object}	model.LoginResponse
 //	@Failure		400		{string}	string
 //	@Failure		401		{string}	string
+//	@Failure		403		{string}	string
 //	@Failure		422		{string}	string
+//	@Failure		423		{string}	string
+//	@Failure		429		{string}	string
 //	@Failure		500		{string}	string
 //	@Router			/login	[post]
 func (ctrl *controller) login(c *fiber.Ctx) error {
 	request := c.Locals(validation.RequestBody).(*model.LoginRequest)
-	token, expiresAt, err := ctrl.app.Login(c.UserContext(), request.Email)
+	token, expiresAt, err := ctrl.app.Login(c.UserContext(), request.Email, c.IP())
 	if err != nil {
 		log.Error("Error authenticate user:", err)
+		var throttled *application.LoginThrottledError
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return fiber.NewError(fiber.StatusUnauthorized, "Invalid credentials")
+		} else if errors.Is(err, application.ErrUserDisabled) {
+			return fiber.NewError(fiber.StatusForbidden, err.Error())
+		} else if errors.Is(err, application.ErrAccountLocked) {
+			return fiber.NewError(fiber.StatusLocked, err.Error())
+		} else if errors.As(err, &throttled) {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+			return fiber.NewError(fiber.StatusTooManyRequests, application.ErrTooManyAttempts.Error())
 		}
 
 		return fiber.NewError(fiber.StatusInternalServerError, "Error authenticate user")
@@ -76,9 +97,307 @@ func (ctrl *controller) login(c *fiber.Ctx) error {
 	return c.JSON(model.NewLoginResponse(token, expiresAt))
 }
 
+// UserVerifyHandler godoc
+//
+//	@Summary		Verify email
+//	@Description	verify the email of an user with the token sent by email
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		model.VerifyRequest	true	"Verification data"
+//	@Success		200		{string}	string
+//	@Failure		400		{string}	string
+//	@Failure		409		{string}	string
+//	@Failure		422		{string}	string
+//	@Failure		500		{string}	string
+//	@Router			/user/verify	[post]
+func (ctrl *controller) verify(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestBody).(*model.VerifyRequest)
+	err := ctrl.app.VerifyEmail(c.UserContext(), request.Token)
+	if err != nil {
+		log.Error("Error verifying email:", err)
+		if errors.Is(err, application.ErrInvalidVerification) || errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusBadRequest, "Invalid verification token")
+		} else if errors.Is(err, application.ErrUserAlreadyExists) {
+			return fiber.NewError(fiber.StatusConflict, err.Error())
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error verifying email")
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// UserRequestVerificationHandler godoc
+//
+//	@Summary		Request email verification
+//	@Description	send a new verification token to the email of the authenticated user
+//	@Tags			user
+//	@Produce		json
+//	@Param			Authorization				header		string	true	"Access token"
+//	@Success		202							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		409							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/api/v1/user/verification	[post]
+func (ctrl *controller) requestVerification(c *fiber.Ctx) error {
+	userData := getUserData(c)
+
+	err := ctrl.app.RequestVerification(c.UserContext(), userData.ID)
+	if err != nil {
+		log.Error("Error requesting email verification:", err)
+		if errors.Is(err, application.ErrEmailAlreadyVerified) {
+			return fiber.NewError(fiber.StatusConflict, err.Error())
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "User not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error requesting email verification")
+	}
+
+	return c.SendStatus(fiber.StatusAccepted)
+}
+
+// UserChangeEmailHandler godoc
+//
+//	@Summary		Change email
+//	@Description	send a verification token to the new email, the email is changed once it is verified
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization		header		string						true	"Access token"
+//	@Param			request				body		model.ChangeEmailRequest	true	"New email"
+//	@Success		202					{string}	string
+//	@Failure		400					{string}	string
+//	@Failure		401					{string}	string
+//	@Failure		403					{string}	string
+//	@Failure		404					{string}	string
+//	@Failure		409					{string}	string
+//	@Failure		422					{string}	string
+//	@Failure		500					{string}	string
+//	@Router			/api/v1/user/email	[put]
+func (ctrl *controller) changeEmail(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestBody).(*model.ChangeEmailRequest)
+	userData := getUserData(c)
+
+	err := ctrl.app.ChangeEmail(c.UserContext(), userData.ID, request.Email)
+	if err != nil {
+		log.Error("Error changing email:", err)
+		if errors.Is(err, application.ErrSameEmail) {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		} else if errors.Is(err, application.ErrUserAlreadyExists) {
+			return fiber.NewError(fiber.StatusConflict, err.Error())
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "User not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error changing email")
+	}
+
+	return c.SendStatus(fiber.StatusAccepted)
+}
+
+// UserProfileHandler godoc
+//
+//	@Summary		Read user profile
+//	@Description	read the profile and preferences of the authenticated user
+//	@Tags			user
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Access token"
+//	@Success		200				{object}	model.ProfileResponse
+//	@Failure		401				{string}	string
+//	@Failure		404				{string}	string
+//	@Failure		500				{string}	string
+//	@Router			/api/v1/user/me	[get]
+func (ctrl *controller) profile(c *fiber.Ctx) error {
+	userData := getUserData(c)
+
+	user, err := ctrl.app.FindById(c.UserContext(), userData.ID)
+	if err != nil {
+		log.Error("Error reading user profile:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "User not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading user profile")
+	}
+
+	return c.JSON(model.NewProfileResponse(user))
+}
+
+// UserUpdateProfileHandler godoc
+//
+//	@Summary		Update user profile
+//	@Description	update the profile and preferences of the authenticated user
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string						true	"Access token"
+//	@Param			request			body		model.UpdateProfileRequest	true	"Profile data"
+//	@Success		200				{object}	model.ProfileResponse
+//	@Failure		400				{string}	string
+//	@Failure		401				{string}	string
+//	@Failure		404				{string}	string
+//	@Failure		422				{string}	string
+//	@Failure		500				{string}	string
+//	@Router			/api/v1/user/me	[patch]
+func (ctrl *controller) updateProfile(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestBody).(*model.UpdateProfileRequest)
+	userData := getUserData(c)
+
+	user, err := ctrl.app.UpdateProfile(c.UserContext(), userData.ID, domain.UserProfile(*request))
+	if err != nil {
+		log.Error("Error updating user profile:", err)
+		if errors.Is(err, application.ErrInvalidTimezone) {
+			return fiber.NewError(fiber.StatusBadRequest, "Invalid timezone")
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "User not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error updating user profile")
+	}
+
+	return c.JSON(model.NewProfileResponse(user))
+}
+
+// UserDeleteHandler godoc
+//
+//	@Summary		Delete user
+//	@Description	schedule the deletion of the authenticated user after the grace period
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string				true	"Access token"
+//	@Param			request			body		model.DeleteRequest	true	"Confirmation data"
+//	@Success		202				{object}	model.DeleteResponse
+//	@Failure		400				{string}	string
+//	@Failure		401				{string}	string
+//	@Failure		403				{string}	string
+//	@Failure		404				{string}	string
+//	@Failure		409				{string}	string
+//	@Failure		422				{string}	string
+//	@Failure		500				{string}	string
+//	@Router			/api/v1/user	[delete]
+func (ctrl *controller) delete(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestBody).(*model.DeleteRequest)
+	userData := getUserData(c)
+
+	deleteAt, err := ctrl.app.RequestDeletion(c.UserContext(), userData.ID, request.Email)
+	if err != nil {
+		log.Error("Error deleting user:", err)
+		if errors.Is(err, application.ErrEmailNotVerified) {
+			return fiber.NewError(fiber.StatusForbidden, err.Error())
+		} else if errors.Is(err, application.ErrInvalidConfirmation) {
+			return fiber.NewError(fiber.StatusBadRequest, "Invalid confirmation")
+		} else if errors.Is(err, application.ErrDeletionAlreadyScheduled) {
+			return fiber.NewError(fiber.StatusConflict, err.Error())
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "User not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error deleting user")
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(model.NewDeleteResponse(deleteAt))
+}
+
+// UserCancelDeleteHandler godoc
+//
+//	@Summary		Cancel user deletion
+//	@Description	cancel the scheduled deletion of the authenticated user
+//	@Tags			user
+//	@Produce		json
+//	@Param			Authorization				header		string	true	"Access token"
+//	@Success		200							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		403							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		409							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/api/v1/user/cancel-delete	[put]
+func (ctrl *controller) cancelDelete(c *fiber.Ctx) error {
+	userData := getUserData(c)
+
+	err := ctrl.app.CancelDeletion(c.UserContext(), userData.ID)
+	if err != nil {
+		log.Error("Error canceling user deletion:", err)
+		if errors.Is(err, application.ErrDeletionNotScheduled) {
+			return fiber.NewError(fiber.StatusConflict, err.Error())
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "User not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error canceling user deletion")
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// UserExportHandler godoc
+//
+//	@Summary		Export user data
+//	@Description	export the personal data associated to the authenticated user
+//	@Tags			user
+//	@Produce		json
+//	@Param			Authorization		header		string	true	"Access token"
+//	@Success		200					{object}	model.ExportResponse
+//	@Failure		401					{string}	string
+//	@Failure		403					{string}	string
+//	@Failure		404					{string}	string
+//	@Failure		500					{string}	string
+//	@Router			/api/v1/user/export				[get]
+func (ctrl *controller) export(c *fiber.Ctx) error {
+	userData := getUserData(c)
+
+	data, err := ctrl.app.Export(c.UserContext(), userData.ID)
+	if err != nil {
+		log.Error("Error exporting user data:", err)
+		if errors.Is(err, application.ErrEmailNotVerified) {
+			return fiber.NewError(fiber.StatusForbidden, err.Error())
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "User not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error exporting user data")
+	}
+
+	c.Attachment("user-data.json")
+	return c.JSON(model.NewExportResponse(data))
+}
+
 func NewRoute(router fiber.Router) {
 	controller := &controller{injection.UserApp}
 
-	router.Post("/user", validation.RequestBodyValid(model.CreateRequest{}), controller.create)
-	router.Post("/login", validation.RequestBodyValid(model.LoginRequest{}), controller.login)
+	public := ratelimit.New("public")
+
+	router.Post("/user", public, validation.RequestBodyValid(model.CreateRequest{}), controller.create)
+	router.Post("/login", ratelimit.New("auth"), validation.RequestBodyValid(model.LoginRequest{}), controller.login)
+	router.Post("/user/verify", public, validation.RequestBodyValid(model.VerifyRequest{}), controller.verify)
+
+	// Additional routes
+	oidc.NewRoute(router)
+}
+
+func NewPrivateRoute(router fiber.Router) {
+	controller := &controller{injection.UserApp}
+
+	group := router.Group("/user")
+	group.Get("/me", controller.profile)
+	group.Patch("/me", validation.RequestBodyValid(model.UpdateProfileRequest{}), controller.updateProfile)
+	group.Post("/verification", controller.requestVerification)
+	group.Put("/email", apikeys.RequireSession(), validation.RequestBodyValid(model.ChangeEmailRequest{}), controller.changeEmail)
+	group.Delete("/", apikeys.RequireSession(), validation.RequestBodyValid(model.DeleteRequest{}), controller.delete)
+	group.Put("/cancel-delete", apikeys.RequireSession(), controller.cancelDelete)
+	group.Get("/export", apikeys.RequireSession(), controller.export)
+
+	// Additional routes
+	apikeys.NewRoute(group)
+	webhooks.NewRoute(group)
+	admin.NewRoute(router)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
 }
@@ -5,15 +5,20 @@ import (
 	"encoding/json"
 	"io"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 	mocks_application "your-accounts-api/mocks/users/application"
+	shared "your-accounts-api/shared/domain"
 	"your-accounts-api/shared/infrastructure/injection"
 	"your-accounts-api/shared/infrastructure/validation"
 	"your-accounts-api/users/application"
+	"your-accounts-api/users/domain"
+	"your-accounts-api/users/infrastructure/handler/apikeys"
 	"your-accounts-api/users/infrastructure/model"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -22,23 +27,43 @@ import (
 
 type TestSuite struct {
 	suite.Suite
-	email string
-	token string
-	app   *fiber.App
-	mock  *mocks_application.MockIUserApp
+	userId            uint
+	email             string
+	token             string
+	verificationToken string
+	app               *fiber.App
+	mock              *mocks_application.MockIUserApp
 }
 
 func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
 	suite.email = "example@exaple.com"
 	suite.token = "<token>"
+	suite.verificationToken = strings.Repeat("a", 64)
 }
 
 func (suite *TestSuite) SetupTest() {
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
 	suite.mock = mocks_application.NewMockIUserApp(suite.T())
 
 	injection.UserApp = suite.mock
 	suite.app = fiber.New()
 	NewRoute(suite.app)
+
+	private := suite.app.Group("/api/v1", func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		if c.Get("X-Test-ApiKey") != "" {
+			c.Locals(apikeys.ApiKeyLocal, domain.ApiKey{UserId: suite.userId, Scope: domain.WriteScope})
+		}
+
+		return c.Next()
+	})
+	NewPrivateRoute(private)
 }
 
 func (suite *TestSuite) TestCreate201() {
@@ -158,7 +183,7 @@ func (suite *TestSuite) TestLogin200() {
 	body, err := json.Marshal(requestBody)
 	require.NoError(err)
 	expiresAt := time.Now()
-	suite.mock.On("Login", mock.Anything, suite.email).Return(suite.token, expiresAt, nil)
+	suite.mock.On("Login", mock.Anything, suite.email, "0.0.0.0").Return(suite.token, expiresAt, nil)
 	expectedBody, err := json.Marshal(model.NewLoginResponse(suite.token, expiresAt))
 	require.NoError(err)
 
@@ -195,7 +220,7 @@ func (suite *TestSuite) TestLogin401() {
 	}
 	body, err := json.Marshal(requestBody)
 	require.NoError(err)
-	suite.mock.On("Login", mock.Anything, suite.email).Return("", time.Time{}, gorm.ErrRecordNotFound)
+	suite.mock.On("Login", mock.Anything, suite.email, "0.0.0.0").Return("", time.Time{}, gorm.ErrRecordNotFound)
 	expectedErr := []byte("Invalid credentials")
 
 	request := httptest.NewRequest(fiber.MethodPost, "/login", bytes.NewReader(body))
@@ -210,6 +235,57 @@ func (suite *TestSuite) TestLogin401() {
 	require.Equal(expectedErr, resp)
 }
 
+func (suite *TestSuite) TestLogin403() {
+	require := require.New(suite.T())
+	requestBody := &model.LoginRequest{
+		CreateRequest: model.CreateRequest{
+			Email: suite.email,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("Login", mock.Anything, suite.email, "0.0.0.0").Return("", time.Time{}, application.ErrUserDisabled)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/login", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestLogin423() {
+	require := require.New(suite.T())
+	body, err := json.Marshal(&model.LoginRequest{CreateRequest: model.CreateRequest{Email: suite.email}})
+	require.NoError(err)
+	suite.mock.On("Login", mock.Anything, suite.email, "0.0.0.0").Return("", time.Time{}, application.ErrAccountLocked)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/login", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusLocked, response.StatusCode)
+}
+
+func (suite *TestSuite) TestLogin429() {
+	require := require.New(suite.T())
+	body, err := json.Marshal(&model.LoginRequest{CreateRequest: model.CreateRequest{Email: suite.email}})
+	require.NoError(err)
+	suite.mock.On("Login", mock.Anything, suite.email, "0.0.0.0").Return("", time.Time{}, &application.LoginThrottledError{RetryAfter: 1500 * time.Millisecond})
+
+	request := httptest.NewRequest(fiber.MethodPost, "/login", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusTooManyRequests, response.StatusCode)
+	require.Equal("2", response.Header.Get(fiber.HeaderRetryAfter))
+}
+
 func (suite *TestSuite) TestLogin422() {
 	require := require.New(suite.T())
 	requestBody := &model.LoginRequest{
@@ -249,7 +325,7 @@ func (suite *TestSuite) TestLogin500() {
 	}
 	body, err := json.Marshal(requestBody)
 	require.NoError(err)
-	suite.mock.On("Login", mock.Anything, suite.email).Return("", time.Time{}, gorm.ErrInvalidField)
+	suite.mock.On("Login", mock.Anything, suite.email, "0.0.0.0").Return("", time.Time{}, gorm.ErrInvalidField)
 	expectedErr := []byte("Error authenticate user")
 
 	request := httptest.NewRequest(fiber.MethodPost, "/login", bytes.NewReader(body))
@@ -264,6 +340,719 @@ func (suite *TestSuite) TestLogin500() {
 	require.Equal(expectedErr, resp)
 }
 
+func (suite *TestSuite) TestProfile200() {
+	require := require.New(suite.T())
+	user := domain.User{
+		ID:       suite.userId,
+		Email:    suite.email,
+		Locale:   "es-CO",
+		Timezone: "America/Bogota",
+		Currency: "COP",
+	}
+	suite.mock.On("FindById", mock.Anything, suite.userId).Return(user, nil)
+	expectedBody, err := json.Marshal(model.NewProfileResponse(user))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/api/v1/user/me", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestProfile404() {
+	require := require.New(suite.T())
+	suite.mock.On("FindById", mock.Anything, suite.userId).Return(domain.User{}, gorm.ErrRecordNotFound)
+	expectedErr := []byte("User not found")
+
+	request := httptest.NewRequest(fiber.MethodGet, "/api/v1/user/me", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestProfile500() {
+	require := require.New(suite.T())
+	suite.mock.On("FindById", mock.Anything, suite.userId).Return(domain.User{}, gorm.ErrInvalidField)
+	expectedErr := []byte("Error reading user profile")
+
+	request := httptest.NewRequest(fiber.MethodGet, "/api/v1/user/me", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestUpdateProfile200() {
+	require := require.New(suite.T())
+	displayName := "Test"
+	timezone := "America/New_York"
+	requestBody := &model.UpdateProfileRequest{
+		DisplayName: &displayName,
+		Timezone:    &timezone,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	user := domain.User{
+		ID:          suite.userId,
+		Email:       suite.email,
+		DisplayName: displayName,
+		Timezone:    timezone,
+	}
+	suite.mock.On("UpdateProfile", mock.Anything, suite.userId, domain.UserProfile(*requestBody)).Return(user, nil)
+	expectedBody, err := json.Marshal(model.NewProfileResponse(user))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPatch, "/api/v1/user/me", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestUpdateProfile400() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodPatch, "/api/v1/user/me", nil)
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusBadRequest, response.StatusCode)
+}
+
+func (suite *TestSuite) TestUpdateProfile422() {
+	require := require.New(suite.T())
+	monthStartDay := uint8(31)
+	requestBody := &model.UpdateProfileRequest{
+		MonthStartDay: &monthStartDay,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPatch, "/api/v1/user/me", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func (suite *TestSuite) TestUpdateProfile500() {
+	require := require.New(suite.T())
+	locale := "en-US"
+	requestBody := &model.UpdateProfileRequest{
+		Locale: &locale,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("UpdateProfile", mock.Anything, suite.userId, domain.UserProfile(*requestBody)).Return(domain.User{}, gorm.ErrInvalidField)
+	expectedErr := []byte("Error updating user profile")
+
+	request := httptest.NewRequest(fiber.MethodPatch, "/api/v1/user/me", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestDelete202() {
+	require := require.New(suite.T())
+	requestBody := &model.DeleteRequest{
+		CreateRequest: model.CreateRequest{
+			Email: suite.email,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	deleteAt := time.Now()
+	suite.mock.On("RequestDeletion", mock.Anything, suite.userId, suite.email).Return(deleteAt, nil)
+	expectedBody, err := json.Marshal(model.NewDeleteResponse(deleteAt))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/api/v1/user", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusAccepted, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestDelete400() {
+	require := require.New(suite.T())
+	requestBody := &model.DeleteRequest{
+		CreateRequest: model.CreateRequest{
+			Email: suite.email,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("RequestDeletion", mock.Anything, suite.userId, suite.email).Return(time.Time{}, application.ErrInvalidConfirmation)
+	expectedErr := []byte("Invalid confirmation")
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/api/v1/user", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusBadRequest, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestDelete403() {
+	require := require.New(suite.T())
+	requestBody := &model.DeleteRequest{
+		CreateRequest: model.CreateRequest{
+			Email: suite.email,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("RequestDeletion", mock.Anything, suite.userId, suite.email).Return(time.Time{}, application.ErrEmailNotVerified)
+	expectedErr := []byte(application.ErrEmailNotVerified.Error())
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/api/v1/user", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestDelete403ApiKey() {
+	require := require.New(suite.T())
+	requestBody := &model.DeleteRequest{
+		CreateRequest: model.CreateRequest{
+			Email: suite.email,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/api/v1/user", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	request.Header.Set("X-Test-ApiKey", "true")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDelete409() {
+	require := require.New(suite.T())
+	requestBody := &model.DeleteRequest{
+		CreateRequest: model.CreateRequest{
+			Email: suite.email,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("RequestDeletion", mock.Anything, suite.userId, suite.email).Return(time.Time{}, application.ErrDeletionAlreadyScheduled)
+	expectedErr := []byte(application.ErrDeletionAlreadyScheduled.Error())
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/api/v1/user", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestDelete422() {
+	require := require.New(suite.T())
+	body, err := json.Marshal(new(model.DeleteRequest))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/api/v1/user", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDelete500() {
+	require := require.New(suite.T())
+	requestBody := &model.DeleteRequest{
+		CreateRequest: model.CreateRequest{
+			Email: suite.email,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("RequestDeletion", mock.Anything, suite.userId, suite.email).Return(time.Time{}, gorm.ErrInvalidField)
+	expectedErr := []byte("Error deleting user")
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/api/v1/user", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestCancelDelete200() {
+	require := require.New(suite.T())
+	suite.mock.On("CancelDeletion", mock.Anything, suite.userId).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/api/v1/user/cancel-delete", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCancelDelete403ApiKey() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodPut, "/api/v1/user/cancel-delete", nil)
+	request.Header.Set("X-Test-ApiKey", "true")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCancelDelete409() {
+	require := require.New(suite.T())
+	suite.mock.On("CancelDeletion", mock.Anything, suite.userId).Return(application.ErrDeletionNotScheduled)
+	expectedErr := []byte(application.ErrDeletionNotScheduled.Error())
+
+	request := httptest.NewRequest(fiber.MethodPut, "/api/v1/user/cancel-delete", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestCancelDelete500() {
+	require := require.New(suite.T())
+	suite.mock.On("CancelDeletion", mock.Anything, suite.userId).Return(gorm.ErrInvalidField)
+	expectedErr := []byte("Error canceling user deletion")
+
+	request := httptest.NewRequest(fiber.MethodPut, "/api/v1/user/cancel-delete", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestExport200() {
+	require := require.New(suite.T())
+	data := domain.UserData{
+		User: domain.User{
+			ID:    suite.userId,
+			Email: suite.email,
+		},
+	}
+	suite.mock.On("Export", mock.Anything, suite.userId).Return(data, nil)
+	expectedBody, err := json.Marshal(model.NewExportResponse(data))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/api/v1/user/export", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	require.Contains(response.Header.Get(fiber.HeaderContentDisposition), "user-data.json")
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestExport403() {
+	require := require.New(suite.T())
+	suite.mock.On("Export", mock.Anything, suite.userId).Return(domain.UserData{}, application.ErrEmailNotVerified)
+	expectedErr := []byte(application.ErrEmailNotVerified.Error())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/api/v1/user/export", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestExport403ApiKey() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/api/v1/user/export", nil)
+	request.Header.Set("X-Test-ApiKey", "true")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestExport404() {
+	require := require.New(suite.T())
+	suite.mock.On("Export", mock.Anything, suite.userId).Return(domain.UserData{}, gorm.ErrRecordNotFound)
+	expectedErr := []byte("User not found")
+
+	request := httptest.NewRequest(fiber.MethodGet, "/api/v1/user/export", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestExport500() {
+	require := require.New(suite.T())
+	suite.mock.On("Export", mock.Anything, suite.userId).Return(domain.UserData{}, gorm.ErrInvalidField)
+	expectedErr := []byte("Error exporting user data")
+
+	request := httptest.NewRequest(fiber.MethodGet, "/api/v1/user/export", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestVerify200() {
+	require := require.New(suite.T())
+	requestBody := &model.VerifyRequest{
+		Token: suite.verificationToken,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("VerifyEmail", mock.Anything, requestBody.Token).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/user/verify", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+}
+
+func (suite *TestSuite) TestVerify400() {
+	require := require.New(suite.T())
+	requestBody := &model.VerifyRequest{
+		Token: suite.verificationToken,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("VerifyEmail", mock.Anything, requestBody.Token).Return(application.ErrInvalidVerification)
+	expectedErr := []byte("Invalid verification token")
+
+	request := httptest.NewRequest(fiber.MethodPost, "/user/verify", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusBadRequest, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestVerify409() {
+	require := require.New(suite.T())
+	requestBody := &model.VerifyRequest{
+		Token: suite.verificationToken,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("VerifyEmail", mock.Anything, requestBody.Token).Return(application.ErrUserAlreadyExists)
+	expectedErr := []byte(application.ErrUserAlreadyExists.Error())
+
+	request := httptest.NewRequest(fiber.MethodPost, "/user/verify", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestVerify422() {
+	require := require.New(suite.T())
+	requestBody := &model.VerifyRequest{
+		Token: "<token>",
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/user/verify", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func (suite *TestSuite) TestVerify500() {
+	require := require.New(suite.T())
+	requestBody := &model.VerifyRequest{
+		Token: suite.verificationToken,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("VerifyEmail", mock.Anything, requestBody.Token).Return(gorm.ErrInvalidField)
+	expectedErr := []byte("Error verifying email")
+
+	request := httptest.NewRequest(fiber.MethodPost, "/user/verify", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestRequestVerification202() {
+	require := require.New(suite.T())
+	suite.mock.On("RequestVerification", mock.Anything, suite.userId).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/api/v1/user/verification", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusAccepted, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRequestVerification409() {
+	require := require.New(suite.T())
+	suite.mock.On("RequestVerification", mock.Anything, suite.userId).Return(application.ErrEmailAlreadyVerified)
+	expectedErr := []byte(application.ErrEmailAlreadyVerified.Error())
+
+	request := httptest.NewRequest(fiber.MethodPost, "/api/v1/user/verification", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestRequestVerification500() {
+	require := require.New(suite.T())
+	suite.mock.On("RequestVerification", mock.Anything, suite.userId).Return(gorm.ErrInvalidField)
+	expectedErr := []byte("Error requesting email verification")
+
+	request := httptest.NewRequest(fiber.MethodPost, "/api/v1/user/verification", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestChangeEmail202() {
+	require := require.New(suite.T())
+	requestBody := &model.ChangeEmailRequest{
+		CreateRequest: model.CreateRequest{
+			Email: suite.email,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("ChangeEmail", mock.Anything, suite.userId, requestBody.Email).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/api/v1/user/email", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusAccepted, response.StatusCode)
+}
+
+func (suite *TestSuite) TestChangeEmail403ApiKey() {
+	require := require.New(suite.T())
+	requestBody := &model.ChangeEmailRequest{
+		CreateRequest: model.CreateRequest{
+			Email: suite.email,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/api/v1/user/email", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	request.Header.Set("X-Test-ApiKey", "true")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestChangeEmail400() {
+	require := require.New(suite.T())
+	requestBody := &model.ChangeEmailRequest{
+		CreateRequest: model.CreateRequest{
+			Email: suite.email,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("ChangeEmail", mock.Anything, suite.userId, requestBody.Email).Return(application.ErrSameEmail)
+	expectedErr := []byte(application.ErrSameEmail.Error())
+
+	request := httptest.NewRequest(fiber.MethodPut, "/api/v1/user/email", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusBadRequest, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestChangeEmail409() {
+	require := require.New(suite.T())
+	requestBody := &model.ChangeEmailRequest{
+		CreateRequest: model.CreateRequest{
+			Email: suite.email,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("ChangeEmail", mock.Anything, suite.userId, requestBody.Email).Return(application.ErrUserAlreadyExists)
+	expectedErr := []byte(application.ErrUserAlreadyExists.Error())
+
+	request := httptest.NewRequest(fiber.MethodPut, "/api/v1/user/email", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
+func (suite *TestSuite) TestChangeEmail422() {
+	require := require.New(suite.T())
+	requestBody := &model.ChangeEmailRequest{
+		CreateRequest: model.CreateRequest{
+			Email: "invalid",
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPut, "/api/v1/user/email", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func (suite *TestSuite) TestChangeEmail500() {
+	require := require.New(suite.T())
+	requestBody := &model.ChangeEmailRequest{
+		CreateRequest: model.CreateRequest{
+			Email: suite.email,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("ChangeEmail", mock.Anything, suite.userId, requestBody.Email).Return(gorm.ErrInvalidField)
+	expectedErr := []byte("Error changing email")
+
+	request := httptest.NewRequest(fiber.MethodPut, "/api/v1/user/email", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedErr, resp)
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
@@ -0,0 +1,107 @@
+package oidc
+
+import (
+	"errors"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/ratelimit"
+	"your-accounts-api/shared/infrastructure/validation"
+	"your-accounts-api/users/application"
+	"your-accounts-api/users/infrastructure/model"
+
+	"github.com/gofiber/fiber/v2/log"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type controller struct {
+	app application.IOidcApp
+}
+
+// OidcProvidersHandler godoc
+//
+//	@Summary		Read identity providers
+//	@Description	read the names of the external identity providers enabled for login
+//	@Tags			user
+//	@Produce		json
+//	@Success		200					{array}	string
+//	@Router			/oidc/providers	[get]
+func (ctrl *controller) providers(c *fiber.Ctx) error {
+	return c.JSON(ctrl.app.Providers())
+}
+
+// OidcAuthorizeHandler godoc
+//
+//	@Summary		Start external login
+//	@Description	redirect to the identity provider to start the authorization code flow with PKCE
+//	@Tags			user
+//	@Param			provider					path		string	true	"Provider name"
+//	@Success		302							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/oidc/{provider}/authorize	[get]
+func (ctrl *controller) authorize(c *fiber.Ctx) error {
+	url, err := ctrl.app.Authorize(c.UserContext(), c.Params("provider"))
+	if err != nil {
+		log.Error("Error starting external login:", err)
+		if errors.Is(err, application.ErrUnknownProvider) {
+			return fiber.NewError(fiber.StatusNotFound, err.Error())
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error starting external login")
+	}
+
+	return c.Redirect(url)
+}
+
+// OidcCallbackHandler godoc
+//
+//	@Summary		Finish external login
+//	@Description	exchange the authorization code of the identity provider for an access token
+//	@Tags			user
+//	@Produce		json
+//	@Param			provider					path		string	true	"Provider name"
+//	@Param			code						query		string	true	"Authorization code"
+//	@Param			state						query		string	true	"Login state"
+//	@Success		200							{object}	model.LoginResponse
+//	@Failure		400							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		403							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		423							{string}	string
+//	@Failure		422							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/oidc/{provider}/callback	[get]
+func (ctrl *controller) callback(c *fiber.Ctx) error {
+	request := c.Locals(validation.RequestQuery).(*model.OidcCallbackRequest)
+	token, expiresAt, err := ctrl.app.Login(c.UserContext(), c.Params("provider"), request.State, request.Code)
+	if err != nil {
+		log.Error("Error finishing external login:", err)
+		if errors.Is(err, application.ErrInvalidState) || errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusBadRequest, "Invalid or expired login state")
+		} else if errors.Is(err, application.ErrUnknownProvider) {
+			return fiber.NewError(fiber.StatusNotFound, err.Error())
+		} else if errors.Is(err, application.ErrExternalEmailNotVerified) {
+			return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+		} else if errors.Is(err, application.ErrExternalAuthentication) {
+			return fiber.NewError(fiber.StatusUnauthorized, application.ErrExternalAuthentication.Error())
+		} else if errors.Is(err, application.ErrUserDisabled) {
+			return fiber.NewError(fiber.StatusForbidden, err.Error())
+		} else if errors.Is(err, application.ErrAccountLocked) {
+			return fiber.NewError(fiber.StatusLocked, err.Error())
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error finishing external login")
+	}
+
+	return c.JSON(model.NewLoginResponse(token, expiresAt))
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.OidcApp}
+
+	group := router.Group("/oidc", ratelimit.New("public"))
+	group.Get("/providers", controller.providers)
+	group.Get("/:provider/authorize", controller.authorize)
+	group.Get("/:provider/callback", validation.RequestQueryValid(model.OidcCallbackRequest{}), controller.callback)
+}
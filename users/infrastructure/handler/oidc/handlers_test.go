@@ -0,0 +1,189 @@
+package oidc
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	mocks_application "your-accounts-api/mocks/users/application"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/users/application"
+	"your-accounts-api/users/infrastructure/model"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	provider string
+	state    string
+	app      *fiber.App
+	mock     *mocks_application.MockIOidcApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.provider = "google"
+	suite.state = strings.Repeat("a", 64)
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIOidcApp(suite.T())
+	injection.OidcApp = suite.mock
+
+	suite.app = fiber.New()
+	NewRoute(suite.app)
+}
+
+func (suite *TestSuite) TestProviders200() {
+	require := require.New(suite.T())
+	suite.mock.On("Providers").Return([]string{suite.provider})
+	expectedBody, err := json.Marshal([]string{suite.provider})
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/oidc/providers", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestAuthorize302() {
+	require := require.New(suite.T())
+	suite.mock.On("Authorize", mock.Anything, suite.provider).Return("https://accounts.example.com/authorize?state=1", nil)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/oidc/google/authorize", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusFound, response.StatusCode)
+	require.Equal("https://accounts.example.com/authorize?state=1", response.Header.Get(fiber.HeaderLocation))
+}
+
+func (suite *TestSuite) TestAuthorize404() {
+	require := require.New(suite.T())
+	suite.mock.On("Authorize", mock.Anything, "unknown").Return("", application.ErrUnknownProvider)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/oidc/unknown/authorize", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestAuthorize500() {
+	require := require.New(suite.T())
+	suite.mock.On("Authorize", mock.Anything, suite.provider).Return("", errors.New("discovery error"))
+
+	request := httptest.NewRequest(fiber.MethodGet, "/oidc/google/authorize", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCallback200() {
+	require := require.New(suite.T())
+	expiresAt := time.Now()
+	suite.mock.On("Login", mock.Anything, suite.provider, suite.state, "<code>").Return("<token>", expiresAt, nil)
+	expectedBody, err := json.Marshal(model.NewLoginResponse("<token>", expiresAt))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/oidc/google/callback?code=%3Ccode%3E&state="+suite.state, nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCallback400() {
+	require := require.New(suite.T())
+	suite.mock.On("Login", mock.Anything, suite.provider, suite.state, "<code>").Return("", time.Time{}, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/oidc/google/callback?code=%3Ccode%3E&state="+suite.state, nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusBadRequest, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCallback401() {
+	require := require.New(suite.T())
+	suite.mock.On("Login", mock.Anything, suite.provider, suite.state, "<code>").Return("", time.Time{}, application.ErrExternalEmailNotVerified)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/oidc/google/callback?code=%3Ccode%3E&state="+suite.state, nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnauthorized, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCallback403() {
+	require := require.New(suite.T())
+	suite.mock.On("Login", mock.Anything, suite.provider, suite.state, "<code>").Return("", time.Time{}, application.ErrUserDisabled)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/oidc/google/callback?code=%3Ccode%3E&state="+suite.state, nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCallback423() {
+	require := require.New(suite.T())
+	suite.mock.On("Login", mock.Anything, suite.provider, suite.state, "<code>").Return("", time.Time{}, application.ErrAccountLocked)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/oidc/google/callback?code=%3Ccode%3E&state="+suite.state, nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusLocked, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCallback422() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/oidc/google/callback?state=abc", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+}
+
+func (suite *TestSuite) TestCallback500() {
+	require := require.New(suite.T())
+	suite.mock.On("Login", mock.Anything, suite.provider, suite.state, "<code>").Return("", time.Time{}, gorm.ErrInvalidDB)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/oidc/google/callback?code=%3Ccode%3E&state="+suite.state, nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,38 @@
+package session
+
+import (
+	"errors"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/users/infrastructure/handler/apikeys"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+// NewMiddleware rejects the JWTs whose session was removed, e.g. after a logout forced
+// by an administrator, it must be registered after the JWT middleware.
+func NewMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if apikeys.IsApiKeyRequest(c) {
+			return c.Next()
+		}
+
+		token, ok := c.Locals("user").(*jwt.Token)
+		if !ok {
+			return fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired JWT")
+		}
+
+		if err := injection.UserApp.ValidateSession(c.UserContext(), token.Raw); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired JWT")
+			}
+
+			log.Error("Error validating session:", err)
+			return fiber.NewError(fiber.StatusInternalServerError, "Error validating session")
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,122 @@
+package session
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	mocks_application "your-accounts-api/mocks/users/application"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/users/infrastructure/handler/apikeys"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	token string
+	app   *fiber.App
+	mock  *mocks_application.MockIUserApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.token = "<token>"
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIUserApp(suite.T())
+	injection.UserApp = suite.mock
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		switch c.Get(fiber.HeaderAuthorization) {
+		case "ApiKey valid":
+			c.Locals(apikeys.ApiKeyLocal, true)
+		case "Bearer " + suite.token:
+			c.Locals("user", &jwt.Token{Raw: suite.token, Valid: true})
+		}
+
+		return c.Next()
+	})
+	suite.app.Use(NewMiddleware())
+	suite.app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendString("Next")
+	})
+}
+
+func (suite *TestSuite) TestSessionValid() {
+	require := require.New(suite.T())
+	suite.mock.On("ValidateSession", mock.Anything, suite.token).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	request.Header.Set(fiber.HeaderAuthorization, "Bearer "+suite.token)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal([]byte("Next"), resp)
+}
+
+func (suite *TestSuite) TestSessionApiKey() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	request.Header.Set(fiber.HeaderAuthorization, "ApiKey valid")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+}
+
+func (suite *TestSuite) TestSessionWithoutToken() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnauthorized, response.StatusCode)
+}
+
+func (suite *TestSuite) TestSessionRevoked() {
+	require := require.New(suite.T())
+	suite.mock.On("ValidateSession", mock.Anything, suite.token).Return(gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	request.Header.Set(fiber.HeaderAuthorization, "Bearer "+suite.token)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusUnauthorized, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal([]byte("Invalid or expired JWT"), resp)
+}
+
+func (suite *TestSuite) TestSessionError() {
+	require := require.New(suite.T())
+	suite.mock.On("ValidateSession", mock.Anything, suite.token).Return(errors.New("connection lost"))
+
+	request := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	request.Header.Set(fiber.HeaderAuthorization, "Bearer "+suite.token)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,255 @@
+package webhooks
+
+import (
+	"errors"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/shared/infrastructure/validation"
+	"your-accounts-api/users/application"
+	"your-accounts-api/users/infrastructure/handler/apikeys"
+	"your-accounts-api/users/infrastructure/model"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type controller struct {
+	app application.IWebhookApp
+}
+
+// WebhookCreateHandler godoc
+//
+//	@Summary		Create webhook
+//	@Description	register an endpoint that receives the events of the user, the secret to validate the signatures is only returned in this response
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization			header		string						true	"Access token"
+//	@Param			request					body		model.CreateWebhookRequest	true	"Webhook data"
+//	@Success		201						{object}	model.CreateWebhookResponse
+//	@Failure		400						{string}	string
+//	@Failure		401						{string}	string
+//	@Failure		403						{string}	string
+//	@Failure		422						{string}	string
+//	@Failure		500						{string}	string
+//	@Router			/api/v1/user/webhooks/	[post]
+func (ctrl *controller) create(c *fiber.Ctx) error {
+	userData := getUserData(c)
+	request := c.Locals(validation.RequestBody).(*model.CreateWebhookRequest)
+
+	webhook, err := ctrl.app.Create(c.UserContext(), userData.ID, request.URL, request.Events)
+	if err != nil {
+		log.Error("Error creating webhook:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error creating webhook")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewCreateWebhookResponse(webhook))
+}
+
+// WebhookReadHandler godoc
+//
+//	@Summary		Read webhooks
+//	@Description	read the webhooks of the authenticated user without the secret
+//	@Tags			user
+//	@Produce		json
+//	@Param			Authorization			header		string	true	"Access token"
+//	@Success		200						{array}		model.WebhookResponse
+//	@Failure		401						{string}	string
+//	@Failure		403						{string}	string
+//	@Failure		500						{string}	string
+//	@Router			/api/v1/user/webhooks/	[get]
+func (ctrl *controller) read(c *fiber.Ctx) error {
+	userData := getUserData(c)
+
+	webhooks, err := ctrl.app.FindByUserId(c.UserContext(), userData.ID)
+	if err != nil {
+		log.Error("Error reading webhooks:", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading webhooks")
+	}
+
+	response := []model.WebhookResponse{}
+	for _, webhook := range webhooks {
+		response = append(response, model.NewWebhookResponse(webhook))
+	}
+
+	return c.JSON(response)
+}
+
+// WebhookDeleteHandler godoc
+//
+//	@Summary		Delete webhook
+//	@Description	delete a webhook of the authenticated user with its deliveries
+//	@Tags			user
+//	@Produce		json
+//	@Param			Authorization				header		string	true	"Access token"
+//	@Param			id							path		uint	true	"Webhook ID"
+//	@Success		204							{string}	string
+//	@Failure		400							{string}	string
+//	@Failure		401							{string}	string
+//	@Failure		403							{string}	string
+//	@Failure		404							{string}	string
+//	@Failure		500							{string}	string
+//	@Router			/api/v1/user/webhooks/{id}	[delete]
+func (ctrl *controller) delete(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.Delete(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error deleting webhook:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "Webhook not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error deleting webhook")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// WebhookEnableHandler godoc
+//
+//	@Summary		Enable webhook
+//	@Description	enable again a webhook disabled by its repeated failures
+//	@Tags			user
+//	@Produce		json
+//	@Param			Authorization						header		string	true	"Access token"
+//	@Param			id									path		uint	true	"Webhook ID"
+//	@Success		204									{string}	string
+//	@Failure		400									{string}	string
+//	@Failure		401									{string}	string
+//	@Failure		403									{string}	string
+//	@Failure		404									{string}	string
+//	@Failure		409									{string}	string
+//	@Failure		500									{string}	string
+//	@Router			/api/v1/user/webhooks/{id}/enable	[post]
+func (ctrl *controller) enable(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	err = ctrl.app.Enable(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error enabling webhook:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "Webhook not found")
+		} else if errors.Is(err, application.ErrWebhookNotDisabled) {
+			return fiber.NewError(fiber.StatusConflict, err.Error())
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error enabling webhook")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// WebhookTestHandler godoc
+//
+//	@Summary		Test webhook
+//	@Description	send a test event to the webhook and return the result of the delivery, it is not retried
+//	@Tags			user
+//	@Produce		json
+//	@Param			Authorization					header		string	true	"Access token"
+//	@Param			id								path		uint	true	"Webhook ID"
+//	@Success		200								{object}	model.WebhookDeliveryResponse
+//	@Failure		400								{string}	string
+//	@Failure		401								{string}	string
+//	@Failure		403								{string}	string
+//	@Failure		404								{string}	string
+//	@Failure		409								{string}	string
+//	@Failure		500								{string}	string
+//	@Router			/api/v1/user/webhooks/{id}/test	[post]
+func (ctrl *controller) test(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	delivery, err := ctrl.app.SendTest(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error testing webhook:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "Webhook not found")
+		} else if errors.Is(err, application.ErrWebhookDisabled) {
+			return fiber.NewError(fiber.StatusConflict, err.Error())
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error testing webhook")
+	}
+
+	return c.JSON(model.NewWebhookDeliveryResponse(delivery))
+}
+
+// WebhookDeliveriesHandler godoc
+//
+//	@Summary		Read webhook deliveries
+//	@Description	read the last deliveries of a webhook with the result of their attempts
+//	@Tags			user
+//	@Produce		json
+//	@Param			Authorization							header		string	true	"Access token"
+//	@Param			id										path		uint	true	"Webhook ID"
+//	@Success		200										{array}		model.WebhookDeliveryResponse
+//	@Failure		400										{string}	string
+//	@Failure		401										{string}	string
+//	@Failure		403										{string}	string
+//	@Failure		404										{string}	string
+//	@Failure		500										{string}	string
+//	@Router			/api/v1/user/webhooks/{id}/deliveries	[get]
+func (ctrl *controller) deliveries(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		log.Error("Error getting param 'id':", err)
+		return fiber.ErrBadRequest
+	}
+
+	userData := getUserData(c)
+
+	deliveries, err := ctrl.app.FindDeliveries(c.UserContext(), userData.ID, uint(id))
+	if err != nil {
+		log.Error("Error reading webhook deliveries:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "Webhook not found")
+		}
+
+		return fiber.NewError(fiber.StatusInternalServerError, "Error reading webhook deliveries")
+	}
+
+	response := []model.WebhookDeliveryResponse{}
+	for _, delivery := range deliveries {
+		response = append(response, model.NewWebhookDeliveryResponse(delivery))
+	}
+
+	return c.JSON(response)
+}
+
+func NewRoute(router fiber.Router) {
+	controller := &controller{injection.WebhookApp}
+
+	group := router.Group("/webhooks", apikeys.RequireSession())
+	group.Post("/", validation.RequestBodyValid(model.CreateWebhookRequest{}), controller.create)
+	group.Get("/", controller.read)
+	group.Delete("/:id<min(1)>", controller.delete)
+	group.Post("/:id<min(1)>/enable", controller.enable)
+	group.Post("/:id<min(1)>/test", controller.test)
+	group.Get("/:id<min(1)>/deliveries", controller.deliveries)
+}
+
+func getUserData(c *fiber.Ctx) *shared.JwtUserClaims {
+	token := c.Locals("user").(*jwt.Token)
+	return token.Claims.(*shared.JwtUserClaims)
+}
@@ -0,0 +1,306 @@
+package webhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+	mocks_application "your-accounts-api/mocks/users/application"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/shared/infrastructure/injection"
+	"your-accounts-api/users/application"
+	"your-accounts-api/users/domain"
+	"your-accounts-api/users/infrastructure/handler/apikeys"
+	"your-accounts-api/users/infrastructure/model"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestSuite struct {
+	suite.Suite
+	userId   uint
+	webhook  domain.Webhook
+	delivery domain.WebhookDelivery
+	app      *fiber.App
+	mock     *mocks_application.MockIWebhookApp
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.userId = 1
+	suite.webhook = domain.Webhook{
+		ID:        999,
+		UserId:    suite.userId,
+		URL:       "https://example.com/hook",
+		Secret:    "whsec_secret",
+		Events:    []shared.EventName{shared.BillPaid},
+		CreatedAt: time.Now(),
+	}
+	deliveredAt := time.Now()
+	suite.delivery = domain.WebhookDelivery{
+		ID:          10,
+		WebhookId:   suite.webhook.ID,
+		Event:       domain.WebhookTest,
+		Status:      domain.SucceededDelivery,
+		Attempts:    1,
+		StatusCode:  200,
+		DeliveredAt: &deliveredAt,
+		CreatedAt:   time.Now(),
+	}
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.mock = mocks_application.NewMockIWebhookApp(suite.T())
+	injection.WebhookApp = suite.mock
+
+	token := &jwt.Token{
+		Claims: &shared.JwtUserClaims{
+			ID: suite.userId,
+		},
+	}
+
+	suite.app = fiber.New()
+	suite.app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", token)
+		if c.Get("X-Test-ApiKey") != "" {
+			c.Locals(apikeys.ApiKeyLocal, domain.ApiKey{UserId: suite.userId, Scope: domain.WriteScope})
+		}
+
+		return c.Next()
+	})
+	NewRoute(suite.app)
+}
+
+func (suite *TestSuite) TestCreate201() {
+	require := require.New(suite.T())
+	requestBody := model.CreateWebhookRequest{
+		URL:    suite.webhook.URL,
+		Events: suite.webhook.Events,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("Create", mock.Anything, suite.userId, suite.webhook.URL, suite.webhook.Events).Return(suite.webhook, nil)
+	expectedBody, err := json.Marshal(model.NewCreateWebhookResponse(suite.webhook))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/webhooks/", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusCreated, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestCreate422() {
+	require := require.New(suite.T())
+	requests := []model.CreateWebhookRequest{
+		{URL: "not an url"},
+		{URL: "ftp://example.com/hook"},
+		{URL: suite.webhook.URL, Events: []shared.EventName{"user.created"}},
+	}
+
+	for _, requestBody := range requests {
+		body, err := json.Marshal(requestBody)
+		require.NoError(err)
+
+		request := httptest.NewRequest(fiber.MethodPost, "/webhooks/", bytes.NewReader(body))
+		request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		response, err := suite.app.Test(request)
+
+		require.NoError(err)
+		require.NotNil(response)
+		require.Equal(fiber.StatusUnprocessableEntity, response.StatusCode)
+	}
+}
+
+func (suite *TestSuite) TestCreate500() {
+	require := require.New(suite.T())
+	requestBody := model.CreateWebhookRequest{
+		URL: suite.webhook.URL,
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(err)
+	suite.mock.On("Create", mock.Anything, suite.userId, suite.webhook.URL, ([]shared.EventName)(nil)).Return(domain.Webhook{}, gorm.ErrInvalidTransaction)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/webhooks/", bytes.NewReader(body))
+	request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRead200() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByUserId", mock.Anything, suite.userId).Return([]domain.Webhook{suite.webhook}, nil)
+	expectedBody, err := json.Marshal([]model.WebhookResponse{model.NewWebhookResponse(suite.webhook)})
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/webhooks/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+	require.NotContains(string(resp), suite.webhook.Secret)
+}
+
+func (suite *TestSuite) TestRead403ApiKey() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodGet, "/webhooks/", nil)
+	request.Header.Set("X-Test-ApiKey", "true")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestRead500() {
+	require := require.New(suite.T())
+	suite.mock.On("FindByUserId", mock.Anything, suite.userId).Return(nil, gorm.ErrInvalidTransaction)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/webhooks/", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusInternalServerError, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDelete204() {
+	require := require.New(suite.T())
+	suite.mock.On("Delete", mock.Anything, suite.userId, suite.webhook.ID).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/webhooks/999", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNoContent, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDelete403ApiKey() {
+	require := require.New(suite.T())
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/webhooks/999", nil)
+	request.Header.Set("X-Test-ApiKey", "true")
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusForbidden, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDelete404() {
+	require := require.New(suite.T())
+	suite.mock.On("Delete", mock.Anything, suite.userId, suite.webhook.ID).Return(gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodDelete, "/webhooks/999", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func (suite *TestSuite) TestEnable204() {
+	require := require.New(suite.T())
+	suite.mock.On("Enable", mock.Anything, suite.userId, suite.webhook.ID).Return(nil)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/webhooks/999/enable", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNoContent, response.StatusCode)
+}
+
+func (suite *TestSuite) TestEnable409() {
+	require := require.New(suite.T())
+	suite.mock.On("Enable", mock.Anything, suite.userId, suite.webhook.ID).Return(application.ErrWebhookNotDisabled)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/webhooks/999/enable", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+}
+
+func (suite *TestSuite) TestTest200() {
+	require := require.New(suite.T())
+	suite.mock.On("SendTest", mock.Anything, suite.userId, suite.webhook.ID).Return(suite.delivery, nil)
+	expectedBody, err := json.Marshal(model.NewWebhookDeliveryResponse(suite.delivery))
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/webhooks/999/test", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestTest409() {
+	require := require.New(suite.T())
+	suite.mock.On("SendTest", mock.Anything, suite.userId, suite.webhook.ID).Return(domain.WebhookDelivery{}, application.ErrWebhookDisabled)
+
+	request := httptest.NewRequest(fiber.MethodPost, "/webhooks/999/test", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusConflict, response.StatusCode)
+}
+
+func (suite *TestSuite) TestDeliveries200() {
+	require := require.New(suite.T())
+	suite.mock.On("FindDeliveries", mock.Anything, suite.userId, suite.webhook.ID).Return([]domain.WebhookDelivery{suite.delivery}, nil)
+	expectedBody, err := json.Marshal([]model.WebhookDeliveryResponse{model.NewWebhookDeliveryResponse(suite.delivery)})
+	require.NoError(err)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/webhooks/999/deliveries", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusOK, response.StatusCode)
+	resp, err := io.ReadAll(response.Body)
+	require.NoError(err)
+	require.Equal(expectedBody, resp)
+}
+
+func (suite *TestSuite) TestDeliveries404() {
+	require := require.New(suite.T())
+	suite.mock.On("FindDeliveries", mock.Anything, suite.userId, suite.webhook.ID).Return(nil, gorm.ErrRecordNotFound)
+
+	request := httptest.NewRequest(fiber.MethodGet, "/webhooks/999/deliveries", nil)
+	response, err := suite.app.Test(request)
+
+	require.NoError(err)
+	require.NotNil(response)
+	require.Equal(fiber.StatusNotFound, response.StatusCode)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
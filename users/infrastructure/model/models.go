@@ -2,7 +2,10 @@ package model
 
 import (
 	"time"
+	budgets "your-accounts-api/budgets/infrastructure/model"
+	shared "your-accounts-api/shared/domain"
 	"your-accounts-api/shared/infrastructure/model"
+	"your-accounts-api/users/domain"
 )
 
 type CreateRequest struct {
@@ -34,3 +37,301 @@ func NewLoginResponse(token string, expiresAt time.Time) LoginResponse {
 		ExpiresAt: expiresAt.UnixMilli(),
 	}
 }
+
+type DeleteRequest struct {
+	CreateRequest
+}
+
+type DeleteResponse struct {
+	DeleteAt int64 `json:"deleteAt"`
+}
+
+func NewDeleteResponse(deleteAt time.Time) DeleteResponse {
+	return DeleteResponse{
+		DeleteAt: deleteAt.UnixMilli(),
+	}
+}
+
+type VerifyRequest struct {
+	Token string `json:"token" validate:"required,hexadecimal,len=64"`
+}
+
+type OidcCallbackRequest struct {
+	Code  string `query:"code" json:"code" validate:"required"`
+	State string `query:"state" json:"state" validate:"required,hexadecimal,len=64"`
+}
+
+type ChangeEmailRequest struct {
+	CreateRequest
+}
+
+type ProfileResponse struct {
+	model.IDResponse
+	Email              string `json:"email"`
+	EmailVerified      bool   `json:"emailVerified"`
+	DisplayName        string `json:"displayName"`
+	Locale             string `json:"locale"`
+	Timezone           string `json:"timezone"`
+	Currency           string `json:"currency"`
+	WeekStartDay       uint8  `json:"weekStartDay"`
+	MonthStartDay      uint8  `json:"monthStartDay"`
+	NotifyByEmail      bool   `json:"notifyByEmail"`
+	NotifyPendingBills bool   `json:"notifyPendingBills"`
+}
+
+func NewProfileResponse(user domain.User) ProfileResponse {
+	return ProfileResponse{
+		IDResponse:         model.NewIDResponse(user.ID),
+		Email:              user.Email,
+		EmailVerified:      user.EmailVerifiedAt != nil,
+		DisplayName:        user.DisplayName,
+		Locale:             user.Locale,
+		Timezone:           user.Timezone,
+		Currency:           user.Currency,
+		WeekStartDay:       user.WeekStartDay,
+		MonthStartDay:      user.MonthStartDay,
+		NotifyByEmail:      user.NotifyByEmail,
+		NotifyPendingBills: user.NotifyPendingBills,
+	}
+}
+
+type UpdateProfileRequest struct {
+	DisplayName        *string `json:"displayName" validate:"omitempty,max=60"`
+	Locale             *string `json:"locale" validate:"omitempty,bcp47_language_tag"`
+	Timezone           *string `json:"timezone" validate:"omitempty,timezone"`
+	Currency           *string `json:"currency" validate:"omitempty,iso4217"`
+	WeekStartDay       *uint8  `json:"weekStartDay" validate:"omitempty,max=6"`
+	MonthStartDay      *uint8  `json:"monthStartDay" validate:"omitempty,min=1,max=28"`
+	NotifyByEmail      *bool   `json:"notifyByEmail"`
+	NotifyPendingBills *bool   `json:"notifyPendingBills"`
+}
+
+type CreateApiKeyRequest struct {
+	Name      string             `json:"name" validate:"required,max=40"`
+	Scope     domain.ApiKeyScope `json:"scope" validate:"required,oneof=read write"`
+	ExpiresAt *time.Time         `json:"expiresAt"`
+}
+
+type ApiKeyResponse struct {
+	model.IDResponse
+	Name       string             `json:"name"`
+	Prefix     string             `json:"prefix"`
+	Scope      domain.ApiKeyScope `json:"scope"`
+	ExpiresAt  *int64             `json:"expiresAt"`
+	LastUsedAt *int64             `json:"lastUsedAt"`
+	CreatedAt  int64              `json:"createdAt"`
+}
+
+func NewApiKeyResponse(apiKey domain.ApiKey) ApiKeyResponse {
+	var expiresAt, lastUsedAt *int64
+	if apiKey.ExpiresAt != nil {
+		value := apiKey.ExpiresAt.UnixMilli()
+		expiresAt = &value
+	}
+
+	if apiKey.LastUsedAt != nil {
+		value := apiKey.LastUsedAt.UnixMilli()
+		lastUsedAt = &value
+	}
+
+	return ApiKeyResponse{
+		IDResponse: model.NewIDResponse(apiKey.ID),
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scope:      apiKey.Scope,
+		ExpiresAt:  expiresAt,
+		LastUsedAt: lastUsedAt,
+		CreatedAt:  apiKey.CreatedAt.UnixMilli(),
+	}
+}
+
+type CreateApiKeyResponse struct {
+	ApiKeyResponse
+	Key string `json:"key"`
+}
+
+func NewCreateApiKeyResponse(apiKey domain.ApiKey, key string) CreateApiKeyResponse {
+	return CreateApiKeyResponse{
+		ApiKeyResponse: NewApiKeyResponse(apiKey),
+		Key:            key,
+	}
+}
+
+type CreateWebhookRequest struct {
+	URL    string             `json:"url" validate:"required,url,startswith=http,max=500"`
+	Events []shared.EventName `json:"events" validate:"max=10,dive,oneof=budget.created budget.deleted budget.overspent bill.created bill.paid bill.completed"`
+}
+
+type WebhookResponse struct {
+	model.IDResponse
+	URL        string             `json:"url"`
+	Events     []shared.EventName `json:"events"`
+	Failures   int                `json:"failures"`
+	DisabledAt *int64             `json:"disabledAt"`
+	CreatedAt  int64              `json:"createdAt"`
+}
+
+func NewWebhookResponse(webhook domain.Webhook) WebhookResponse {
+	var disabledAt *int64
+	if webhook.DisabledAt != nil {
+		value := webhook.DisabledAt.UnixMilli()
+		disabledAt = &value
+	}
+
+	events := webhook.Events
+	if events == nil {
+		events = []shared.EventName{}
+	}
+
+	return WebhookResponse{
+		IDResponse: model.NewIDResponse(webhook.ID),
+		URL:        webhook.URL,
+		Events:     events,
+		Failures:   webhook.Failures,
+		DisabledAt: disabledAt,
+		CreatedAt:  webhook.CreatedAt.UnixMilli(),
+	}
+}
+
+type CreateWebhookResponse struct {
+	WebhookResponse
+	Secret string `json:"secret"`
+}
+
+func NewCreateWebhookResponse(webhook domain.Webhook) CreateWebhookResponse {
+	return CreateWebhookResponse{
+		WebhookResponse: NewWebhookResponse(webhook),
+		Secret:          webhook.Secret,
+	}
+}
+
+type WebhookDeliveryResponse struct {
+	model.IDResponse
+	Event         shared.EventName             `json:"event"`
+	Status        domain.WebhookDeliveryStatus `json:"status"`
+	Attempts      int                          `json:"attempts"`
+	StatusCode    int                          `json:"statusCode"`
+	Error         string                       `json:"error"`
+	NextAttemptAt *int64                       `json:"nextAttemptAt"`
+	DeliveredAt   *int64                       `json:"deliveredAt"`
+	CreatedAt     int64                        `json:"createdAt"`
+}
+
+func NewWebhookDeliveryResponse(delivery domain.WebhookDelivery) WebhookDeliveryResponse {
+	var nextAttemptAt, deliveredAt *int64
+	if delivery.NextAttemptAt != nil {
+		value := delivery.NextAttemptAt.UnixMilli()
+		nextAttemptAt = &value
+	}
+
+	if delivery.DeliveredAt != nil {
+		value := delivery.DeliveredAt.UnixMilli()
+		deliveredAt = &value
+	}
+
+	return WebhookDeliveryResponse{
+		IDResponse:    model.NewIDResponse(delivery.ID),
+		Event:         delivery.Event,
+		Status:        delivery.Status,
+		Attempts:      delivery.Attempts,
+		StatusCode:    delivery.StatusCode,
+		Error:         delivery.Error,
+		NextAttemptAt: nextAttemptAt,
+		DeliveredAt:   deliveredAt,
+		CreatedAt:     delivery.CreatedAt.UnixMilli(),
+	}
+}
+
+type ExportLogResponse struct {
+	model.ReadLogsResponse
+	Code       shared.CodeLog `json:"code"`
+	ResourceId uint           `json:"resourceId"`
+}
+
+type ExportResponse struct {
+	model.IDResponse
+	Email    string                     `json:"email"`
+	DeleteAt *int64                     `json:"deleteAt"`
+	Budgets  []budgets.ReadByIDResponse `json:"budgets"`
+	Logs     []ExportLogResponse        `json:"logs"`
+}
+
+func NewExportResponse(data domain.UserData) ExportResponse {
+	var deleteAt *int64
+	if data.User.DeleteAt != nil {
+		value := data.User.DeleteAt.UnixMilli()
+		deleteAt = &value
+	}
+
+	budgetList := []budgets.ReadByIDResponse{}
+	for _, budget := range data.Budgets {
+		budgetList = append(budgetList, budgets.NewReadByIDResponse(budget))
+	}
+
+	logs := []ExportLogResponse{}
+	for _, log := range data.Logs {
+		logs = append(logs, ExportLogResponse{
+			ReadLogsResponse: model.NewReadLogsResponse(log),
+			Code:             log.Code,
+			ResourceId:       log.ResourceId,
+		})
+	}
+
+	return ExportResponse{
+		IDResponse: model.NewIDResponse(data.User.ID),
+		Email:      data.User.Email,
+		DeleteAt:   deleteAt,
+		Budgets:    budgetList,
+		Logs:       logs,
+	}
+}
+
+type AdminUsersRequest struct {
+	Email string      `query:"email" json:"email" validate:"omitempty,max=255"`
+	Role  shared.Role `query:"role" json:"role" validate:"omitempty,oneof=user support admin"`
+	Page  int         `query:"page" json:"page" validate:"omitempty,min=1"`
+}
+
+type AdminUserResponse struct {
+	model.IDResponse
+	Email         string      `json:"email"`
+	EmailVerified bool        `json:"emailVerified"`
+	DisplayName   string      `json:"displayName"`
+	Role          shared.Role `json:"role"`
+	DisabledAt    *int64      `json:"disabledAt"`
+	LockedUntil   *int64      `json:"lockedUntil"`
+	DeleteAt      *int64      `json:"deleteAt"`
+}
+
+func NewAdminUserResponse(user domain.User) AdminUserResponse {
+	var disabledAt, lockedUntil, deleteAt *int64
+	if user.DisabledAt != nil {
+		value := user.DisabledAt.UnixMilli()
+		disabledAt = &value
+	}
+
+	if user.LockedUntil != nil {
+		value := user.LockedUntil.UnixMilli()
+		lockedUntil = &value
+	}
+
+	if user.DeleteAt != nil {
+		value := user.DeleteAt.UnixMilli()
+		deleteAt = &value
+	}
+
+	return AdminUserResponse{
+		IDResponse:    model.NewIDResponse(user.ID),
+		Email:         user.Email,
+		EmailVerified: user.EmailVerifiedAt != nil,
+		DisplayName:   user.DisplayName,
+		Role:          user.Role,
+		DisabledAt:    disabledAt,
+		LockedUntil:   lockedUntil,
+		DeleteAt:      deleteAt,
+	}
+}
+
+type ChangeRoleRequest struct {
+	Role shared.Role `json:"role" validate:"required,oneof=user support admin"`
+}
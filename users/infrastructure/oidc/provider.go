@@ -0,0 +1,201 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"your-accounts-api/shared/infrastructure/config"
+	"your-accounts-api/users/domain"
+
+	"github.com/MicahParks/keyfunc/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrDiscovery      = errors.New("error loading the provider discovery document")
+	ErrExchange       = errors.New("error exchanging the authorization code")
+	ErrInvalidNonce   = errors.New("invalid nonce in id token")
+	ErrMissingIDToken = errors.New("token response without id token")
+)
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+type tokenResponse struct {
+	IDToken string `json:"id_token"`
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+}
+
+type provider struct {
+	config    config.OidcProvider
+	client    *http.Client
+	mu        sync.Mutex
+	discovery *discovery
+	jwks      *keyfunc.JWKS
+}
+
+func (p *provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	document, _, err := p.load(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.config.ClientId},
+		"redirect_uri":          {p.config.RedirectURL},
+		"scope":                 {"openid email profile"},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+
+	separator := "?"
+	if strings.Contains(document.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return document.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+func (p *provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (domain.ExternalIdentity, error) {
+	document, jwks, err := p.load(ctx)
+	if err != nil {
+		return domain.ExternalIdentity{}, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.config.RedirectURL},
+		"client_id":     {p.config.ClientId},
+		"code_verifier": {codeVerifier},
+	}
+	if p.config.ClientSecret != "" {
+		form.Set("client_secret", p.config.ClientSecret)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, document.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return domain.ExternalIdentity{}, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return domain.ExternalIdentity{}, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return domain.ExternalIdentity{}, fmt.Errorf("%w: status %d", ErrExchange, response.StatusCode)
+	}
+
+	token := new(tokenResponse)
+	if err := json.NewDecoder(response.Body).Decode(token); err != nil {
+		return domain.ExternalIdentity{}, fmt.Errorf("%w: %v", ErrExchange, err)
+	} else if token.IDToken == "" {
+		return domain.ExternalIdentity{}, ErrMissingIDToken
+	}
+
+	claims := new(idTokenClaims)
+	_, err = jwt.ParseWithClaims(token.IDToken, claims, jwks.Keyfunc,
+		jwt.WithIssuer(document.Issuer),
+		jwt.WithAudience(p.config.ClientId),
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "PS256"}),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return domain.ExternalIdentity{}, err
+	}
+
+	if claims.Nonce != nonce {
+		return domain.ExternalIdentity{}, ErrInvalidNonce
+	}
+
+	return domain.ExternalIdentity{
+		Subject:       claims.Subject,
+		Email:         strings.ToLower(claims.Email),
+		EmailVerified: claims.EmailVerified,
+	}, nil
+}
+
+// load fetches the discovery document and the signing keys of the provider the first time
+// they are needed, so an unavailable provider doesn't prevent the server from starting.
+func (p *provider) load(ctx context.Context) (*discovery, *keyfunc.JWKS, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, p.jwks, nil
+	}
+
+	wellKnown := strings.TrimSuffix(p.config.Issuer, "/") + "/.well-known/openid-configuration"
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("%w: status %d", ErrDiscovery, response.StatusCode)
+	}
+
+	document := new(discovery)
+	if err := json.NewDecoder(response.Body).Decode(document); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrDiscovery, err)
+	}
+
+	jwks, err := keyfunc.Get(document.JwksURI, keyfunc.Options{
+		Client:            p.client,
+		RefreshUnknownKID: true,
+		RefreshRateLimit:  5 * time.Minute,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p.discovery = document
+	p.jwks = jwks
+	return p.discovery, p.jwks, nil
+}
+
+func NewProvider(providerConfig config.OidcProvider, client *http.Client) domain.IdentityProvider {
+	return &provider{
+		config: providerConfig,
+		client: client,
+	}
+}
+
+func NewProviders() map[string]domain.IdentityProvider {
+	client := &http.Client{Timeout: 10 * time.Second}
+	providers := map[string]domain.IdentityProvider{}
+	for _, providerConfig := range config.OIDC_PROVIDERS {
+		providers[providerConfig.Name] = NewProvider(providerConfig, client)
+	}
+
+	return providers
+}
@@ -0,0 +1,271 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+	"your-accounts-api/shared/infrastructure/config"
+	"your-accounts-api/users/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// mockServer is a minimal OpenID Connect provider that issues an ID token for the last
+// authorization code it handed out, checking the PKCE verifier against the challenge.
+// The ID token is signed with key, which can be replaced to simulate an invalid signature.
+type mockServer struct {
+	*httptest.Server
+	key           *rsa.PrivateKey
+	code          string
+	codeChallenge string
+	claims        jwt.MapClaims
+	tokenStatus   int
+}
+
+func newMockServer(key *rsa.PrivateKey) *mockServer {
+	server := &mockServer{
+		key:         key,
+		tokenStatus: http.StatusOK,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"jwks_uri":               server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"kid": "test",
+					"use": "sig",
+					"alg": "RS256",
+					"n":   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+				},
+			},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || server.tokenStatus != http.StatusOK {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != server.code || base64.RawURLEncoding.EncodeToString(challenge[:]) != server.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, server.claims)
+		token.Header["kid"] = "test"
+		idToken, err := token.SignedString(server.key)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "<access token>",
+			"id_token":     idToken,
+		})
+	})
+	server.Server = httptest.NewServer(mux)
+
+	return server
+}
+
+type TestSuite struct {
+	suite.Suite
+	key      *rsa.PrivateKey
+	server   *mockServer
+	provider domain.IdentityProvider
+	ctx      context.Context
+}
+
+func (suite *TestSuite) SetupSuite() {
+	var err error
+	suite.key, err = rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(suite.T(), err)
+	suite.ctx = context.Background()
+}
+
+func (suite *TestSuite) SetupTest() {
+	suite.server = newMockServer(suite.key)
+	suite.provider = NewProvider(config.OidcProvider{
+		Name:        "mock",
+		Issuer:      suite.server.URL,
+		ClientId:    "client",
+		RedirectURL: "http://localhost:8080/oidc/mock/callback",
+	}, suite.server.Client())
+}
+
+func (suite *TestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *TestSuite) authorize(verifier, nonce string) {
+	require := require.New(suite.T())
+	challenge := sha256.Sum256([]byte(verifier))
+
+	authURL, err := suite.provider.AuthCodeURL(suite.ctx, "<state>", nonce, base64.RawURLEncoding.EncodeToString(challenge[:]))
+	require.NoError(err)
+
+	parsed, err := url.Parse(authURL)
+	require.NoError(err)
+	suite.server.code = "<code>"
+	suite.server.codeChallenge = parsed.Query().Get("code_challenge")
+}
+
+func (suite *TestSuite) claims(nonce string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            suite.server.URL,
+		"aud":            "client",
+		"sub":            "<subject>",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          nonce,
+		"email":          "Example@Exaple.com",
+		"email_verified": true,
+	}
+}
+
+func (suite *TestSuite) TestAuthCodeURLSuccess() {
+	require := require.New(suite.T())
+
+	res, err := suite.provider.AuthCodeURL(suite.ctx, "<state>", "<nonce>", "<challenge>")
+
+	require.NoError(err)
+	parsed, err := url.Parse(res)
+	require.NoError(err)
+	require.Equal(suite.server.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	query := parsed.Query()
+	require.Equal("code", query.Get("response_type"))
+	require.Equal("client", query.Get("client_id"))
+	require.Equal("http://localhost:8080/oidc/mock/callback", query.Get("redirect_uri"))
+	require.Equal("openid email profile", query.Get("scope"))
+	require.Equal("<state>", query.Get("state"))
+	require.Equal("<nonce>", query.Get("nonce"))
+	require.Equal("<challenge>", query.Get("code_challenge"))
+	require.Equal("S256", query.Get("code_challenge_method"))
+}
+
+func (suite *TestSuite) TestAuthCodeURLErrorDiscovery() {
+	require := require.New(suite.T())
+	provider := NewProvider(config.OidcProvider{
+		Issuer:   suite.server.URL + "/unknown",
+		ClientId: "client",
+	}, suite.server.Client())
+
+	res, err := provider.AuthCodeURL(suite.ctx, "<state>", "<nonce>", "<challenge>")
+
+	require.ErrorIs(err, ErrDiscovery)
+	require.Empty(res)
+}
+
+func (suite *TestSuite) TestExchangeSuccess() {
+	require := require.New(suite.T())
+	suite.authorize("<verifier>", "<nonce>")
+	suite.server.claims = suite.claims("<nonce>")
+
+	res, err := suite.provider.Exchange(suite.ctx, "<code>", "<verifier>", "<nonce>")
+
+	require.NoError(err)
+	require.Equal(domain.ExternalIdentity{
+		Subject:       "<subject>",
+		Email:         "example@exaple.com",
+		EmailVerified: true,
+	}, res)
+}
+
+func (suite *TestSuite) TestExchangeErrorVerifier() {
+	require := require.New(suite.T())
+	suite.authorize("<verifier>", "<nonce>")
+	suite.server.claims = suite.claims("<nonce>")
+
+	res, err := suite.provider.Exchange(suite.ctx, "<code>", "<other verifier>", "<nonce>")
+
+	require.ErrorIs(err, ErrExchange)
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestExchangeErrorNonce() {
+	require := require.New(suite.T())
+	suite.authorize("<verifier>", "<nonce>")
+	suite.server.claims = suite.claims("<other nonce>")
+
+	res, err := suite.provider.Exchange(suite.ctx, "<code>", "<verifier>", "<nonce>")
+
+	require.ErrorIs(err, ErrInvalidNonce)
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestExchangeErrorAudience() {
+	require := require.New(suite.T())
+	suite.authorize("<verifier>", "<nonce>")
+	suite.server.claims = suite.claims("<nonce>")
+	suite.server.claims["aud"] = "other"
+
+	res, err := suite.provider.Exchange(suite.ctx, "<code>", "<verifier>", "<nonce>")
+
+	require.ErrorIs(err, jwt.ErrTokenInvalidAudience)
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestExchangeErrorExpired() {
+	require := require.New(suite.T())
+	suite.authorize("<verifier>", "<nonce>")
+	suite.server.claims = suite.claims("<nonce>")
+	suite.server.claims["exp"] = time.Now().Add(-time.Hour).Unix()
+
+	res, err := suite.provider.Exchange(suite.ctx, "<code>", "<verifier>", "<nonce>")
+
+	require.ErrorIs(err, jwt.ErrTokenExpired)
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestExchangeErrorSignature() {
+	require := require.New(suite.T())
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(err)
+	suite.authorize("<verifier>", "<nonce>")
+	suite.server.claims = suite.claims("<nonce>")
+	suite.server.key = otherKey
+
+	res, err := suite.provider.Exchange(suite.ctx, "<code>", "<verifier>", "<nonce>")
+
+	require.ErrorIs(err, jwt.ErrTokenSignatureInvalid)
+	require.Zero(res)
+}
+
+func (suite *TestSuite) TestExchangeErrorTokenEndpoint() {
+	require := require.New(suite.T())
+	suite.authorize("<verifier>", "<nonce>")
+	suite.server.tokenStatus = http.StatusUnauthorized
+
+	res, err := suite.provider.Exchange(suite.ctx, "<code>", "<verifier>", "<nonce>")
+
+	require.ErrorIs(err, ErrExchange)
+	require.Zero(res)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
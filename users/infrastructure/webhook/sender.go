@@ -0,0 +1,128 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+	"your-accounts-api/shared/infrastructure/config"
+	"your-accounts-api/users/domain"
+)
+
+const (
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+
+	userAgent        = "YourAccounts-Webhook/1.0"
+	maxResponseBytes = 64 * 1024
+)
+
+// ErrForbiddenAddress rejects the endpoints in the loopback, the private or the link local
+// networks, so the webhooks can not reach the internal services.
+var ErrForbiddenAddress = errors.New("webhook address not allowed")
+
+type httpSender struct {
+	client *http.Client
+}
+
+func (s *httpSender) Send(ctx context.Context, request domain.WebhookRequest) (int, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return 0, err
+	}
+
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", userAgent)
+	httpRequest.Header.Set(EventHeader, string(request.Event))
+	httpRequest.Header.Set(DeliveryHeader, strconv.FormatUint(uint64(request.DeliveryId), 10))
+	httpRequest.Header.Set(TimestampHeader, timestamp)
+	httpRequest.Header.Set(SignatureHeader, "sha256="+Signature(request.Secret, timestamp, request.Body))
+
+	response, err := s.client.Do(httpRequest)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	// The body is read to reuse the connection, its content is ignored
+	io.Copy(io.Discard, io.LimitReader(response.Body, maxResponseBytes))
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, fmt.Errorf("unexpected status code %d", response.StatusCode)
+	}
+
+	return response.StatusCode, nil
+}
+
+// Signature is the HMAC-SHA256 in hex of <timestamp>.<body>, the receivers compute it again
+// with the secret of the webhook to validate the deliveries.
+func Signature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func NewHttpSender(client *http.Client) domain.WebhookSender {
+	return &httpSender{client}
+}
+
+// NewSender does not follow the redirects, the endpoint registered is the only one that receives
+// the events. The address is checked when it is dialed, after the DNS resolution, so a name can
+// not be pointed later to the internal network.
+func NewSender() domain.WebhookSender {
+	return NewHttpSender(newClient(publicAddress))
+}
+
+func newClient(control func(network, address string, conn syscall.RawConn) error) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: config.WEBHOOK_TIMEOUT,
+		Control: control,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// With a proxy the dialed address would be the one of the proxy
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   config.WEBHOOK_TIMEOUT,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// publicAddress is the control of the dialer, it runs before connecting to each resolved address
+func publicAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+
+	ip = ip.Unmap()
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified() || ip.IsMulticast() {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, ip)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	shared "your-accounts-api/shared/domain"
+	"your-accounts-api/users/domain"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// receiver is a local endpoint that keeps the last delivery and answers with status
+type receiver struct {
+	*httptest.Server
+	status int
+	header http.Header
+	body   []byte
+	calls  int
+}
+
+func newReceiver(status int) *receiver {
+	r := &receiver{status: status}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.calls += 1
+		r.header = req.Header.Clone()
+		r.body, _ = io.ReadAll(req.Body)
+		if r.status == http.StatusFound {
+			http.Redirect(w, req, "/other", r.status)
+			return
+		}
+
+		w.WriteHeader(r.status)
+	}))
+	return r
+}
+
+type TestSuite struct {
+	suite.Suite
+	secret string
+	body   []byte
+	sender domain.WebhookSender
+}
+
+func (suite *TestSuite) SetupSuite() {
+	suite.secret = "whsec_secret"
+	suite.body = []byte(`{"id":1,"event":"bill.paid"}`)
+	// The receivers listen on the loopback, forbidden for the webhooks of the users
+	suite.sender = NewHttpSender(newClient(nil))
+}
+
+func (suite *TestSuite) request(url string) domain.WebhookRequest {
+	return domain.WebhookRequest{
+		URL:        url,
+		Secret:     suite.secret,
+		Event:      shared.BillPaid,
+		DeliveryId: 1,
+		Body:       suite.body,
+	}
+}
+
+func (suite *TestSuite) TestSendSuccess() {
+	require := require.New(suite.T())
+	receiver := newReceiver(http.StatusNoContent)
+	defer receiver.Close()
+
+	status, err := suite.sender.Send(context.Background(), suite.request(receiver.URL))
+
+	require.NoError(err)
+	require.Equal(http.StatusNoContent, status)
+	require.Equal(suite.body, receiver.body)
+	require.Equal("application/json", receiver.header.Get("Content-Type"))
+	require.Equal(string(shared.BillPaid), receiver.header.Get(EventHeader))
+	require.Equal("1", receiver.header.Get(DeliveryHeader))
+	timestamp := receiver.header.Get(TimestampHeader)
+	require.NotEmpty(timestamp)
+	require.Equal("sha256="+Signature(suite.secret, timestamp, suite.body), receiver.header.Get(SignatureHeader))
+}
+
+func (suite *TestSuite) TestSendErrorStatus() {
+	require := require.New(suite.T())
+	receiver := newReceiver(http.StatusInternalServerError)
+	defer receiver.Close()
+
+	status, err := suite.sender.Send(context.Background(), suite.request(receiver.URL))
+
+	require.EqualError(err, "unexpected status code 500")
+	require.Equal(http.StatusInternalServerError, status)
+}
+
+func (suite *TestSuite) TestSendErrorRedirect() {
+	require := require.New(suite.T())
+	receiver := newReceiver(http.StatusFound)
+	defer receiver.Close()
+
+	status, err := suite.sender.Send(context.Background(), suite.request(receiver.URL))
+
+	require.EqualError(err, "unexpected status code 302")
+	require.Equal(http.StatusFound, status)
+	require.Equal(1, receiver.calls)
+}
+
+func (suite *TestSuite) TestSendErrorTimeout() {
+	require := require.New(suite.T())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+	sender := NewHttpSender(&http.Client{Timeout: 50 * time.Millisecond})
+
+	status, err := sender.Send(context.Background(), suite.request(server.URL))
+
+	require.Error(err)
+	require.Zero(status)
+}
+
+func (suite *TestSuite) TestSendErrorURL() {
+	require := require.New(suite.T())
+
+	status, err := suite.sender.Send(context.Background(), suite.request("://invalid"))
+
+	require.Error(err)
+	require.Zero(status)
+}
+
+func (suite *TestSuite) TestSendErrorForbiddenAddress() {
+	require := require.New(suite.T())
+	receiver := newReceiver(http.StatusNoContent)
+	defer receiver.Close()
+
+	status, err := NewSender().Send(context.Background(), suite.request(receiver.URL))
+
+	require.ErrorIs(err, ErrForbiddenAddress)
+	require.Zero(status)
+	require.Zero(receiver.calls)
+}
+
+func (suite *TestSuite) TestPublicAddress() {
+	require := require.New(suite.T())
+	forbidden := []string{
+		"127.0.0.1:80", "10.1.2.3:443", "172.16.0.1:80", "192.168.1.1:80", "169.254.169.254:80",
+		"0.0.0.0:80", "[::1]:80", "[fe80::1]:80", "[fd00::1]:80", "[::ffff:127.0.0.1]:80",
+	}
+	for _, address := range forbidden {
+		require.ErrorIs(publicAddress("tcp", address, nil), ErrForbiddenAddress, address)
+	}
+
+	require.NoError(publicAddress("tcp4", "93.184.216.34:443", nil))
+	require.NoError(publicAddress("tcp6", "[2606:2800:220:1:248:1893:25c8:1946]:443", nil))
+}
+
+func (suite *TestSuite) TestSignature() {
+	require := require.New(suite.T())
+
+	signature := Signature("secret", "1700000000", []byte(`{}`))
+
+	require.Len(signature, 64)
+	require.Equal(signature, Signature("secret", "1700000000", []byte(`{}`)))
+	require.NotEqual(signature, Signature("other", "1700000000", []byte(`{}`)))
+	require.NotEqual(signature, Signature("secret", "1700000001", []byte(`{}`)))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}